@@ -3,13 +3,14 @@ package amqp
 import (
 	"context"
 	"io"
-	"log"
 	"math"
 	"net"
+	"sync/atomic"
 	"time"
 
 	"eventter.io/mq/amqp/v0"
 	"eventter.io/mq/amqp/v1"
+	"eventter.io/mq/logging"
 	"eventter.io/mq/sasl"
 	"github.com/pkg/errors"
 )
@@ -39,10 +40,13 @@ type Server struct {
 	HandlerV0 HandlerV0
 	// Handle AMQPv1 connection.
 	HandlerV1 HandlerV1
+	// Logger for connection errors. Handlers get connection's logger in context, see `logging.FromContext`.
+	Logger logging.Logger
 
-	listener net.Listener
-	ctx      context.Context
-	cancel   func()
+	listener     net.Listener
+	ctx          context.Context
+	cancel       func()
+	connectionID uint64
 }
 
 func (s *Server) init() error {
@@ -50,6 +54,10 @@ func (s *Server) init() error {
 		return errors.New("no handler")
 	}
 
+	if s.Logger == nil {
+		s.Logger = logging.Nop()
+	}
+
 	if s.ConnectTimeout == 0 {
 		s.ConnectTimeout = defaultConnectTimeout
 	}
@@ -102,13 +110,23 @@ func (s *Server) Serve(l net.Listener) error {
 
 func (s *Server) accept(conn net.Conn) {
 	defer conn.Close()
-	err := s.handle(conn)
+	connection := &Connection{
+		ID:          atomic.AddUint64(&s.connectionID, 1),
+		RemoteAddr:  conn.RemoteAddr().String(),
+		ConnectedAt: time.Now(),
+	}
+	logger := s.Logger.With(
+		logging.ConnectionID(connection.ID),
+		logging.F("remote_addr", connection.RemoteAddr),
+	)
+	ctx := NewConnectionContext(logging.NewContext(s.ctx, logger), connection)
+	err := s.handle(ctx, conn)
 	if err != nil {
-		log.Printf("[AMQP %s] %s", conn.RemoteAddr(), err.Error())
+		logger.Error("connection failed", logging.Error(err))
 	}
 }
 
-func (s *Server) handle(conn net.Conn) error {
+func (s *Server) handle(ctx context.Context, conn net.Conn) error {
 	deadline := time.Now().Add(s.ConnectTimeout)
 	err := conn.SetDeadline(deadline)
 	if err != nil {
@@ -126,7 +144,7 @@ func (s *Server) handle(conn net.Conn) error {
 		proto[5] == v0.Major && proto[6] == v0.Minor && proto[7] == v0.Revision && s.HandlerV0 != nil {
 
 		transport := v0.NewTransport(conn)
-		ctx, err := s.initV0(transport, deadline)
+		ctx, err := s.initV0(ctx, transport, deadline)
 		if err != nil {
 			return errors.Wrapf(err, "init v%d.%d.%d connection failed", proto[5], proto[6], proto[7])
 		}
@@ -137,7 +155,7 @@ func (s *Server) handle(conn net.Conn) error {
 		proto[5] == v1.Major && proto[6] == v1.Minor && proto[7] == v1.Revision && s.HandlerV1 != nil {
 
 		transport := v1.NewTransport(conn) // intentionally un-buffered
-		ctx, err := s.initV1(transport, deadline, conn, proto[4])
+		ctx, err := s.initV1(ctx, transport, deadline, conn, proto[4])
 		if err != nil {
 			return errors.Wrapf(err, "init v%d.%d.%d connection failed", proto[5], proto[6], proto[7])
 		}
@@ -2,6 +2,7 @@ package amqp
 
 import (
 	"context"
+	"time"
 
 	"eventter.io/mq/sasl"
 	"github.com/pkg/errors"
@@ -10,9 +11,18 @@ import (
 type contextKeyType int
 
 const (
-	tokenContextKey contextKeyType = 0
+	tokenContextKey      contextKeyType = 0
+	connectionContextKey contextKeyType = 1
 )
 
+// Connection describes client connection accepted by the server.
+type Connection struct {
+	// Connection ID, unique within the server.
+	ID          uint64
+	RemoteAddr  string
+	ConnectedAt time.Time
+}
+
 func NewServerContext(parent context.Context, token sasl.Token) context.Context {
 	return context.WithValue(parent, tokenContextKey, token)
 }
@@ -24,3 +34,15 @@ func TokenFromContext(ctx context.Context) (sasl.Token, error) {
 	}
 	return token, nil
 }
+
+func NewConnectionContext(parent context.Context, connection *Connection) context.Context {
+	return context.WithValue(parent, connectionContextKey, connection)
+}
+
+func ConnectionFromContext(ctx context.Context) (*Connection, error) {
+	connection, ok := ctx.Value(connectionContextKey).(*Connection)
+	if !ok {
+		return nil, errors.New("context key not found")
+	}
+	return connection, nil
+}
@@ -201,7 +201,7 @@ func TestServer_Handle_Negotiation(t *testing.T) {
 			go func() {
 				defer wg.Done()
 				defer serverConn.Close()
-				handleErr = test.server.handle(serverConn)
+				handleErr = test.server.handle(test.server.ctx, serverConn)
 			}()
 
 			_, err = clientConn.Write([]byte(test.request))
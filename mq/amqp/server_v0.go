@@ -16,8 +16,8 @@ type HandlerV0 interface {
 	ServeAMQPv0(ctx context.Context, transport *v0.Transport) error
 }
 
-func (s *Server) initV0(transport *v0.Transport, deadline time.Time) (ctx context.Context, err error) {
-	ctx, cancel := context.WithDeadline(s.ctx, deadline)
+func (s *Server) initV0(parent context.Context, transport *v0.Transport, deadline time.Time) (ctx context.Context, err error) {
+	ctx, cancel := context.WithDeadline(parent, deadline)
 	defer cancel()
 
 	var mechanisms []string
@@ -120,5 +120,5 @@ func (s *Server) initV0(transport *v0.Transport, deadline time.Time) (ctx contex
 		return nil, errors.Wrap(err, "set send timeout failed")
 	}
 
-	return NewServerContext(s.ctx, token), nil
+	return NewServerContext(parent, token), nil
 }
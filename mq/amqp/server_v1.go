@@ -20,8 +20,8 @@ type HandlerV1 interface {
 	ServeAMQPv1(ctx context.Context, transport *v1.Transport) error // TODO: should serve accept connection / session / link?
 }
 
-func (s *Server) initV1(transport *v1.Transport, deadline time.Time, conn net.Conn, protoID byte) (ctx context.Context, err error) {
-	ctx, cancel := context.WithDeadline(s.ctx, deadline)
+func (s *Server) initV1(parent context.Context, transport *v1.Transport, deadline time.Time, conn net.Conn, protoID byte) (ctx context.Context, err error) {
+	ctx, cancel := context.WithDeadline(parent, deadline)
 	defer cancel()
 
 	var token sasl.Token
@@ -47,7 +47,7 @@ Start:
 			return nil, errors.Wrap(err, "start of transport buffering failed")
 		}
 
-		return NewServerContext(s.ctx, token), nil
+		return NewServerContext(parent, token), nil
 
 	case protoSASL:
 		if len(s.SASLProviders) == 0 {
@@ -50,7 +50,7 @@ func (x ClusterSegment_Type) String() string {
 	return proto.EnumName(ClusterSegment_Type_name, int32(x))
 }
 func (ClusterSegment_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{4, 0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{4, 0}
 }
 
 type ClusterNode_State int32
@@ -73,77 +73,111 @@ func (x ClusterNode_State) String() string {
 	return proto.EnumName(ClusterNode_State_name, int32(x))
 }
 func (ClusterNode_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{5, 0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{5, 0}
+}
+
+type ClusterNode_AdminState int32
+
+const (
+	ClusterNode_ACTIVE ClusterNode_AdminState = 0
+	// Node is being drained - no new segments or consumer groups are placed on it & the ones it holds are moved
+	// to other nodes.
+	ClusterNode_DRAINING ClusterNode_AdminState = 1
+)
+
+var ClusterNode_AdminState_name = map[int32]string{
+	0: "ACTIVE",
+	1: "DRAINING",
+}
+var ClusterNode_AdminState_value = map[string]int32{
+	"ACTIVE":   0,
+	"DRAINING": 1,
+}
+
+func (x ClusterNode_AdminState) String() string {
+	return proto.EnumName(ClusterNode_AdminState_name, int32(x))
+}
+func (ClusterNode_AdminState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{5, 1}
 }
 
 type ClusterCommandSegmentNodesUpdate_Which int32
 
 const (
-	ClusterCommandSegmentNodesUpdate_INVALID ClusterCommandSegmentNodesUpdate_Which = 0
-	ClusterCommandSegmentNodesUpdate_OPEN    ClusterCommandSegmentNodesUpdate_Which = 1
-	ClusterCommandSegmentNodesUpdate_CLOSED  ClusterCommandSegmentNodesUpdate_Which = 2
+	ClusterCommandSegmentNodesUpdate_INVALID   ClusterCommandSegmentNodesUpdate_Which = 0
+	ClusterCommandSegmentNodesUpdate_OPEN      ClusterCommandSegmentNodesUpdate_Which = 1
+	ClusterCommandSegmentNodesUpdate_CLOSED    ClusterCommandSegmentNodesUpdate_Which = 2
+	ClusterCommandSegmentNodesUpdate_REPLACING ClusterCommandSegmentNodesUpdate_Which = 3
 )
 
 var ClusterCommandSegmentNodesUpdate_Which_name = map[int32]string{
 	0: "INVALID",
 	1: "OPEN",
 	2: "CLOSED",
+	3: "REPLACING",
 }
 var ClusterCommandSegmentNodesUpdate_Which_value = map[string]int32{
-	"INVALID": 0,
-	"OPEN":    1,
-	"CLOSED":  2,
+	"INVALID":   0,
+	"OPEN":      1,
+	"CLOSED":    2,
+	"REPLACING": 3,
 }
 
 func (x ClusterCommandSegmentNodesUpdate_Which) String() string {
 	return proto.EnumName(ClusterCommandSegmentNodesUpdate_Which_name, int32(x))
 }
 func (ClusterCommandSegmentNodesUpdate_Which) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{15, 0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{16, 0}
 }
 
 type ClusterCommandSegmentDelete_Which int32
 
 const (
-	ClusterCommandSegmentDelete_INVALID ClusterCommandSegmentDelete_Which = 0
-	ClusterCommandSegmentDelete_OPEN    ClusterCommandSegmentDelete_Which = 1
-	ClusterCommandSegmentDelete_CLOSED  ClusterCommandSegmentDelete_Which = 2
+	ClusterCommandSegmentDelete_INVALID   ClusterCommandSegmentDelete_Which = 0
+	ClusterCommandSegmentDelete_OPEN      ClusterCommandSegmentDelete_Which = 1
+	ClusterCommandSegmentDelete_CLOSED    ClusterCommandSegmentDelete_Which = 2
+	ClusterCommandSegmentDelete_REPLACING ClusterCommandSegmentDelete_Which = 3
 )
 
 var ClusterCommandSegmentDelete_Which_name = map[int32]string{
 	0: "INVALID",
 	1: "OPEN",
 	2: "CLOSED",
+	3: "REPLACING",
 }
 var ClusterCommandSegmentDelete_Which_value = map[string]int32{
-	"INVALID": 0,
-	"OPEN":    1,
-	"CLOSED":  2,
+	"INVALID":   0,
+	"OPEN":      1,
+	"CLOSED":    2,
+	"REPLACING": 3,
 }
 
 func (x ClusterCommandSegmentDelete_Which) String() string {
 	return proto.EnumName(ClusterCommandSegmentDelete_Which_name, int32(x))
 }
 func (ClusterCommandSegmentDelete_Which) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{16, 0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{17, 0}
 }
 
 type ClusterState struct {
-	Index                uint64              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	CurrentSegmentID     uint64              `protobuf:"varint,2,opt,name=current_segment_id,json=currentSegmentId,proto3" json:"current_segment_id,omitempty"`
-	Namespaces           []*ClusterNamespace `protobuf:"bytes,3,rep,name=namespaces" json:"namespaces,omitempty"`
-	OpenSegments         []*ClusterSegment   `protobuf:"bytes,4,rep,name=open_segments,json=openSegments" json:"open_segments,omitempty"`
-	ClosedSegments       []*ClusterSegment   `protobuf:"bytes,5,rep,name=closed_segments,json=closedSegments" json:"closed_segments,omitempty"`
-	Nodes                []*ClusterNode      `protobuf:"bytes,6,rep,name=nodes" json:"nodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Index            uint64              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	CurrentSegmentID uint64              `protobuf:"varint,2,opt,name=current_segment_id,json=currentSegmentId,proto3" json:"current_segment_id,omitempty"`
+	Namespaces       []*ClusterNamespace `protobuf:"bytes,3,rep,name=namespaces" json:"namespaces,omitempty"`
+	OpenSegments     []*ClusterSegment   `protobuf:"bytes,4,rep,name=open_segments,json=openSegments" json:"open_segments,omitempty"`
+	ClosedSegments   []*ClusterSegment   `protobuf:"bytes,5,rep,name=closed_segments,json=closedSegments" json:"closed_segments,omitempty"`
+	Nodes            []*ClusterNode      `protobuf:"bytes,6,rep,name=nodes" json:"nodes,omitempty"`
+	// Closed segments written by topic compaction waiting to be replicated before they replace segments they were
+	// compacted from.
+	ReplacingSegments    []*ClusterSegment `protobuf:"bytes,7,rep,name=replacing_segments,json=replacingSegments" json:"replacing_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ClusterState) Reset()         { *m = ClusterState{} }
 func (m *ClusterState) String() string { return proto.CompactTextString(m) }
 func (*ClusterState) ProtoMessage()    {}
 func (*ClusterState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{0}
 }
 func (m *ClusterState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,10 +248,18 @@ func (m *ClusterState) GetNodes() []*ClusterNode {
 	return nil
 }
 
+func (m *ClusterState) GetReplacingSegments() []*ClusterSegment {
+	if m != nil {
+		return m.ReplacingSegments
+	}
+	return nil
+}
+
 type ClusterNamespace struct {
 	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Topics               []*ClusterTopic         `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
 	ConsumerGroups       []*ClusterConsumerGroup `protobuf:"bytes,3,rep,name=consumer_groups,json=consumerGroups" json:"consumer_groups,omitempty"`
+	RetentionBytes       uint64                  `protobuf:"varint,4,opt,name=retention_bytes,json=retentionBytes,proto3" json:"retention_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
 }
@@ -226,7 +268,7 @@ func (m *ClusterNamespace) Reset()         { *m = ClusterNamespace{} }
 func (m *ClusterNamespace) String() string { return proto.CompactTextString(m) }
 func (*ClusterNamespace) ProtoMessage()    {}
 func (*ClusterNamespace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{1}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{1}
 }
 func (m *ClusterNamespace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,12 +318,25 @@ func (m *ClusterNamespace) GetConsumerGroups() []*ClusterConsumerGroup {
 	return nil
 }
 
+func (m *ClusterNamespace) GetRetentionBytes() uint64 {
+	if m != nil {
+		return m.RetentionBytes
+	}
+	return 0
+}
+
 type ClusterTopic struct {
 	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Shards               uint32        `protobuf:"varint,2,opt,name=shards,proto3" json:"shards,omitempty"`
 	ReplicationFactor    uint32        `protobuf:"varint,3,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
 	Retention            time.Duration `protobuf:"bytes,4,opt,name=retention,stdduration" json:"retention"`
 	DefaultExchangeType  string        `protobuf:"bytes,5,opt,name=default_exchange_type,json=defaultExchangeType,proto3" json:"default_exchange_type,omitempty"`
+	IndexedHeaders       []string      `protobuf:"bytes,6,rep,name=indexed_headers,json=indexedHeaders" json:"indexed_headers,omitempty"`
+	PartitionKey         string        `protobuf:"bytes,7,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	DeduplicationWindow  time.Duration `protobuf:"bytes,8,opt,name=deduplication_window,json=deduplicationWindow,stdduration" json:"deduplication_window"`
+	CompactionKey        string        `protobuf:"bytes,9,opt,name=compaction_key,json=compactionKey,proto3" json:"compaction_key,omitempty"`
+	RetentionBytes       uint64        `protobuf:"varint,10,opt,name=retention_bytes,json=retentionBytes,proto3" json:"retention_bytes,omitempty"`
+	ForceRetentionBytes  bool          `protobuf:"varint,11,opt,name=force_retention_bytes,json=forceRetentionBytes,proto3" json:"force_retention_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
@@ -290,7 +345,7 @@ func (m *ClusterTopic) Reset()         { *m = ClusterTopic{} }
 func (m *ClusterTopic) String() string { return proto.CompactTextString(m) }
 func (*ClusterTopic) ProtoMessage()    {}
 func (*ClusterTopic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{2}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{2}
 }
 func (m *ClusterTopic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,22 +409,71 @@ func (m *ClusterTopic) GetDefaultExchangeType() string {
 	return ""
 }
 
+func (m *ClusterTopic) GetIndexedHeaders() []string {
+	if m != nil {
+		return m.IndexedHeaders
+	}
+	return nil
+}
+
+func (m *ClusterTopic) GetPartitionKey() string {
+	if m != nil {
+		return m.PartitionKey
+	}
+	return ""
+}
+
+func (m *ClusterTopic) GetDeduplicationWindow() time.Duration {
+	if m != nil {
+		return m.DeduplicationWindow
+	}
+	return 0
+}
+
+func (m *ClusterTopic) GetCompactionKey() string {
+	if m != nil {
+		return m.CompactionKey
+	}
+	return ""
+}
+
+func (m *ClusterTopic) GetRetentionBytes() uint64 {
+	if m != nil {
+		return m.RetentionBytes
+	}
+	return 0
+}
+
+func (m *ClusterTopic) GetForceRetentionBytes() bool {
+	if m != nil {
+		return m.ForceRetentionBytes
+	}
+	return false
+}
+
 type ClusterConsumerGroup struct {
 	Name     string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Bindings []*ClusterConsumerGroup_Binding `protobuf:"bytes,2,rep,name=bindings" json:"bindings,omitempty"`
 	Size_    uint32                          `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	// Time from which to consider messages eligible to be consumed by this consumer group.
-	Since                time.Time                            `protobuf:"bytes,4,opt,name=since,stdtime" json:"since"`
-	OffsetCommits        []*ClusterConsumerGroup_OffsetCommit `protobuf:"bytes,5,rep,name=offset_commits,json=offsetCommits" json:"offset_commits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+	Since         time.Time                            `protobuf:"bytes,4,opt,name=since,stdtime" json:"since"`
+	OffsetCommits []*ClusterConsumerGroup_OffsetCommit `protobuf:"bytes,5,rep,name=offset_commits,json=offsetCommits" json:"offset_commits,omitempty"`
+	// Incremented every time offset commits are imported. Running consumer group restarts when it changes.
+	OffsetCommitsGeneration uint64 `protobuf:"varint,6,opt,name=offset_commits_generation,json=offsetCommitsGeneration,proto3" json:"offset_commits_generation,omitempty"`
+	// Offset commits were imported & open offset commits segment may still contain offsets committed before the
+	// import. Cleared when the segment is closed.
+	OffsetCommitsImportPending bool     `protobuf:"varint,7,opt,name=offset_commits_import_pending,json=offsetCommitsImportPending,proto3" json:"offset_commits_import_pending,omitempty"`
+	MaxPriority                uint32   `protobuf:"varint,8,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty"`
+	SingleActiveConsumer       bool     `protobuf:"varint,9,opt,name=single_active_consumer,json=singleActiveConsumer,proto3" json:"single_active_consumer,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *ClusterConsumerGroup) Reset()         { *m = ClusterConsumerGroup{} }
 func (m *ClusterConsumerGroup) String() string { return proto.CompactTextString(m) }
 func (*ClusterConsumerGroup) ProtoMessage()    {}
 func (*ClusterConsumerGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{3}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{3}
 }
 func (m *ClusterConsumerGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,6 +537,34 @@ func (m *ClusterConsumerGroup) GetOffsetCommits() []*ClusterConsumerGroup_Offset
 	return nil
 }
 
+func (m *ClusterConsumerGroup) GetOffsetCommitsGeneration() uint64 {
+	if m != nil {
+		return m.OffsetCommitsGeneration
+	}
+	return 0
+}
+
+func (m *ClusterConsumerGroup) GetOffsetCommitsImportPending() bool {
+	if m != nil {
+		return m.OffsetCommitsImportPending
+	}
+	return false
+}
+
+func (m *ClusterConsumerGroup) GetMaxPriority() uint32 {
+	if m != nil {
+		return m.MaxPriority
+	}
+	return 0
+}
+
+func (m *ClusterConsumerGroup) GetSingleActiveConsumer() bool {
+	if m != nil {
+		return m.SingleActiveConsumer
+	}
+	return false
+}
+
 type ClusterConsumerGroup_Binding struct {
 	TopicName    string `protobuf:"bytes,1,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
 	ExchangeType string `protobuf:"bytes,2,opt,name=exchange_type,json=exchangeType,proto3" json:"exchange_type,omitempty"`
@@ -441,6 +573,7 @@ type ClusterConsumerGroup_Binding struct {
 	//	*ClusterConsumerGroup_Binding_HeadersAll
 	//	*ClusterConsumerGroup_Binding_HeadersAny
 	By                   isClusterConsumerGroup_Binding_By `protobuf_oneof:"by"`
+	Filter               string                            `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
 }
@@ -449,7 +582,7 @@ func (m *ClusterConsumerGroup_Binding) Reset()         { *m = ClusterConsumerGro
 func (m *ClusterConsumerGroup_Binding) String() string { return proto.CompactTextString(m) }
 func (*ClusterConsumerGroup_Binding) ProtoMessage()    {}
 func (*ClusterConsumerGroup_Binding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{3, 0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{3, 0}
 }
 func (m *ClusterConsumerGroup_Binding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,6 +673,13 @@ func (m *ClusterConsumerGroup_Binding) GetHeadersAny() *types.Struct {
 	return nil
 }
 
+func (m *ClusterConsumerGroup_Binding) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ClusterConsumerGroup_Binding) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ClusterConsumerGroup_Binding_OneofMarshaler, _ClusterConsumerGroup_Binding_OneofUnmarshaler, _ClusterConsumerGroup_Binding_OneofSizer, []interface{}{
@@ -640,7 +780,7 @@ func (m *ClusterConsumerGroup_OffsetCommit) Reset()         { *m = ClusterConsum
 func (m *ClusterConsumerGroup_OffsetCommit) String() string { return proto.CompactTextString(m) }
 func (*ClusterConsumerGroup_OffsetCommit) ProtoMessage()    {}
 func (*ClusterConsumerGroup_OffsetCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{3, 1}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{3, 1}
 }
 func (m *ClusterConsumerGroup_OffsetCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,26 +824,31 @@ func (m *ClusterConsumerGroup_OffsetCommit) GetOffset() int64 {
 }
 
 type ClusterSegment struct {
-	ID                   uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                 ClusterSegment_Type  `protobuf:"varint,2,opt,name=type,proto3,enum=io.eventter.mq.ClusterSegment_Type" json:"type,omitempty"`
-	OwnerNamespace       string               `protobuf:"bytes,3,opt,name=owner_namespace,json=ownerNamespace,proto3" json:"owner_namespace,omitempty"`
-	OwnerName            string               `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
-	Generation           uint32               `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
-	Shard                uint32               `protobuf:"varint,6,opt,name=shard,proto3" json:"shard,omitempty"`
-	CreatedAt            time.Time            `protobuf:"bytes,7,opt,name=created_at,json=createdAt,stdtime" json:"created_at"`
-	ClosedAt             time.Time            `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,stdtime" json:"closed_at"`
-	Nodes                ClusterSegment_Nodes `protobuf:"bytes,9,opt,name=nodes" json:"nodes"`
-	Size_                int64                `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
-	Sha1                 []byte               `protobuf:"bytes,11,opt,name=sha1,proto3" json:"sha1,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ID             uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           ClusterSegment_Type  `protobuf:"varint,2,opt,name=type,proto3,enum=io.eventter.mq.ClusterSegment_Type" json:"type,omitempty"`
+	OwnerNamespace string               `protobuf:"bytes,3,opt,name=owner_namespace,json=ownerNamespace,proto3" json:"owner_namespace,omitempty"`
+	OwnerName      string               `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	Generation     uint32               `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
+	Shard          uint32               `protobuf:"varint,6,opt,name=shard,proto3" json:"shard,omitempty"`
+	CreatedAt      time.Time            `protobuf:"bytes,7,opt,name=created_at,json=createdAt,stdtime" json:"created_at"`
+	ClosedAt       time.Time            `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,stdtime" json:"closed_at"`
+	Nodes          ClusterSegment_Nodes `protobuf:"bytes,9,opt,name=nodes" json:"nodes"`
+	Size_          int64                `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
+	Sha1           []byte               `protobuf:"bytes,11,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	// Segment was created by compaction of other segments.
+	Compacted bool `protobuf:"varint,12,opt,name=compacted,proto3" json:"compacted,omitempty"`
+	// Time segment was uploaded to tiered storage. Offloaded segment isn't held by any node, nodes fetch it from
+	// tiered storage on demand.
+	OffloadedAt          time.Time `protobuf:"bytes,13,opt,name=offloaded_at,json=offloadedAt,stdtime" json:"offloaded_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *ClusterSegment) Reset()         { *m = ClusterSegment{} }
 func (m *ClusterSegment) String() string { return proto.CompactTextString(m) }
 func (*ClusterSegment) ProtoMessage()    {}
 func (*ClusterSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{4}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{4}
 }
 func (m *ClusterSegment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,6 +954,20 @@ func (m *ClusterSegment) GetSha1() []byte {
 	return nil
 }
 
+func (m *ClusterSegment) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
+func (m *ClusterSegment) GetOffloadedAt() time.Time {
+	if m != nil {
+		return m.OffloadedAt
+	}
+	return time.Time{}
+}
+
 type ClusterSegment_Nodes struct {
 	PrimaryNodeID        uint64   `protobuf:"varint,1,opt,name=primary_node_id,json=primaryNodeId,proto3" json:"primary_node_id,omitempty"`
 	DoneNodeIDs          []uint64 `protobuf:"varint,2,rep,packed,name=done_node_ids,json=doneNodeIds" json:"done_node_ids,omitempty"`
@@ -821,7 +980,7 @@ func (m *ClusterSegment_Nodes) Reset()         { *m = ClusterSegment_Nodes{} }
 func (m *ClusterSegment_Nodes) String() string { return proto.CompactTextString(m) }
 func (*ClusterSegment_Nodes) ProtoMessage()    {}
 func (*ClusterSegment_Nodes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{4, 0}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{4, 0}
 }
 func (m *ClusterSegment_Nodes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,19 +1031,26 @@ func (m *ClusterSegment_Nodes) GetReplicatingNodeIDs() []uint64 {
 }
 
 type ClusterNode struct {
-	ID                   uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	State                ClusterNode_State `protobuf:"varint,3,opt,name=state,proto3,enum=io.eventter.mq.ClusterNode_State" json:"state,omitempty"`
-	LastSeenAlive        *time.Time        `protobuf:"bytes,4,opt,name=last_seen_alive,json=lastSeenAlive,stdtime" json:"last_seen_alive,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	State         ClusterNode_State      `protobuf:"varint,3,opt,name=state,proto3,enum=io.eventter.mq.ClusterNode_State" json:"state,omitempty"`
+	LastSeenAlive *time.Time             `protobuf:"bytes,4,opt,name=last_seen_alive,json=lastSeenAlive,stdtime" json:"last_seen_alive,omitempty"`
+	AdminState    ClusterNode_AdminState `protobuf:"varint,5,opt,name=admin_state,json=adminState,proto3,enum=io.eventter.mq.ClusterNode_AdminState" json:"admin_state,omitempty"`
+	// Zone (or rack) the node is located in. Segment replicas are spread across distinct zones if possible.
+	Zone string `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
+	// Capacity of volume with segments in bytes, zero if unknown.
+	DiskTotal uint64 `protobuf:"varint,7,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	// Free space on volume with segments in bytes.
+	DiskFree             uint64   `protobuf:"varint,8,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ClusterNode) Reset()         { *m = ClusterNode{} }
 func (m *ClusterNode) String() string { return proto.CompactTextString(m) }
 func (*ClusterNode) ProtoMessage()    {}
 func (*ClusterNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{5}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{5}
 }
 func (m *ClusterNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,8 +1107,37 @@ func (m *ClusterNode) GetLastSeenAlive() *time.Time {
 	return nil
 }
 
+func (m *ClusterNode) GetAdminState() ClusterNode_AdminState {
+	if m != nil {
+		return m.AdminState
+	}
+	return ClusterNode_ACTIVE
+}
+
+func (m *ClusterNode) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ClusterNode) GetDiskTotal() uint64 {
+	if m != nil {
+		return m.DiskTotal
+	}
+	return 0
+}
+
+func (m *ClusterNode) GetDiskFree() uint64 {
+	if m != nil {
+		return m.DiskFree
+	}
+	return 0
+}
+
 type ClusterCommandNamespaceCreate struct {
 	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RetentionBytes       uint64   `protobuf:"varint,2,opt,name=retention_bytes,json=retentionBytes,proto3" json:"retention_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -951,7 +1146,7 @@ func (m *ClusterCommandNamespaceCreate) Reset()         { *m = ClusterCommandNam
 func (m *ClusterCommandNamespaceCreate) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandNamespaceCreate) ProtoMessage()    {}
 func (*ClusterCommandNamespaceCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{6}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{6}
 }
 func (m *ClusterCommandNamespaceCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,6 +1182,13 @@ func (m *ClusterCommandNamespaceCreate) GetNamespace() string {
 	return ""
 }
 
+func (m *ClusterCommandNamespaceCreate) GetRetentionBytes() uint64 {
+	if m != nil {
+		return m.RetentionBytes
+	}
+	return 0
+}
+
 type ClusterCommandNamespaceDelete struct {
 	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -997,7 +1199,7 @@ func (m *ClusterCommandNamespaceDelete) Reset()         { *m = ClusterCommandNam
 func (m *ClusterCommandNamespaceDelete) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandNamespaceDelete) ProtoMessage()    {}
 func (*ClusterCommandNamespaceDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{7}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{7}
 }
 func (m *ClusterCommandNamespaceDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,7 +1246,7 @@ func (m *ClusterCommandTopicCreate) Reset()         { *m = ClusterCommandTopicCr
 func (m *ClusterCommandTopicCreate) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandTopicCreate) ProtoMessage()    {}
 func (*ClusterCommandTopicCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{8}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{8}
 }
 func (m *ClusterCommandTopicCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,7 +1300,7 @@ func (m *ClusterCommandTopicDelete) Reset()         { *m = ClusterCommandTopicDe
 func (m *ClusterCommandTopicDelete) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandTopicDelete) ProtoMessage()    {}
 func (*ClusterCommandTopicDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{9}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{9}
 }
 func (m *ClusterCommandTopicDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1152,7 +1354,7 @@ func (m *ClusterCommandConsumerGroupCreate) Reset()         { *m = ClusterComman
 func (m *ClusterCommandConsumerGroupCreate) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandConsumerGroupCreate) ProtoMessage()    {}
 func (*ClusterCommandConsumerGroupCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{10}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{10}
 }
 func (m *ClusterCommandConsumerGroupCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1408,7 @@ func (m *ClusterCommandConsumerGroupDelete) Reset()         { *m = ClusterComman
 func (m *ClusterCommandConsumerGroupDelete) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandConsumerGroupDelete) ProtoMessage()    {}
 func (*ClusterCommandConsumerGroupDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{11}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{11}
 }
 func (m *ClusterCommandConsumerGroupDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1469,7 @@ func (m *ClusterCommandSegmentCreate) Reset()         { *m = ClusterCommandSegme
 func (m *ClusterCommandSegmentCreate) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandSegmentCreate) ProtoMessage()    {}
 func (*ClusterCommandSegmentCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{12}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{12}
 }
 func (m *ClusterCommandSegmentCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1373,7 +1575,7 @@ func (m *ClusterCommandSegmentClose) Reset()         { *m = ClusterCommandSegmen
 func (m *ClusterCommandSegmentClose) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandSegmentClose) ProtoMessage()    {}
 func (*ClusterCommandSegmentClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{13}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{13}
 }
 func (m *ClusterCommandSegmentClose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,6 +1644,9 @@ type ClusterCommandNodeUpdate struct {
 	Address              string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	State                ClusterNode_State `protobuf:"varint,3,opt,name=state,proto3,enum=io.eventter.mq.ClusterNode_State" json:"state,omitempty"`
 	LastSeenAlive        *time.Time        `protobuf:"bytes,4,opt,name=last_seen_alive,json=lastSeenAlive,stdtime" json:"last_seen_alive,omitempty"`
+	Zone                 string            `protobuf:"bytes,5,opt,name=zone,proto3" json:"zone,omitempty"`
+	DiskTotal            uint64            `protobuf:"varint,6,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	DiskFree             uint64            `protobuf:"varint,7,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
@@ -1450,7 +1655,7 @@ func (m *ClusterCommandNodeUpdate) Reset()         { *m = ClusterCommandNodeUpda
 func (m *ClusterCommandNodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandNodeUpdate) ProtoMessage()    {}
 func (*ClusterCommandNodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{14}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{14}
 }
 func (m *ClusterCommandNodeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,6 +1712,81 @@ func (m *ClusterCommandNodeUpdate) GetLastSeenAlive() *time.Time {
 	return nil
 }
 
+func (m *ClusterCommandNodeUpdate) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ClusterCommandNodeUpdate) GetDiskTotal() uint64 {
+	if m != nil {
+		return m.DiskTotal
+	}
+	return 0
+}
+
+func (m *ClusterCommandNodeUpdate) GetDiskFree() uint64 {
+	if m != nil {
+		return m.DiskFree
+	}
+	return 0
+}
+
+type ClusterCommandNodeAdminStateUpdate struct {
+	ID                   uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminState           ClusterNode_AdminState `protobuf:"varint,2,opt,name=admin_state,json=adminState,proto3,enum=io.eventter.mq.ClusterNode_AdminState" json:"admin_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ClusterCommandNodeAdminStateUpdate) Reset()         { *m = ClusterCommandNodeAdminStateUpdate{} }
+func (m *ClusterCommandNodeAdminStateUpdate) String() string { return proto.CompactTextString(m) }
+func (*ClusterCommandNodeAdminStateUpdate) ProtoMessage()    {}
+func (*ClusterCommandNodeAdminStateUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{15}
+}
+func (m *ClusterCommandNodeAdminStateUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCommandNodeAdminStateUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCommandNodeAdminStateUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterCommandNodeAdminStateUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCommandNodeAdminStateUpdate.Merge(dst, src)
+}
+func (m *ClusterCommandNodeAdminStateUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCommandNodeAdminStateUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCommandNodeAdminStateUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCommandNodeAdminStateUpdate proto.InternalMessageInfo
+
+func (m *ClusterCommandNodeAdminStateUpdate) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ClusterCommandNodeAdminStateUpdate) GetAdminState() ClusterNode_AdminState {
+	if m != nil {
+		return m.AdminState
+	}
+	return ClusterNode_ACTIVE
+}
+
 type ClusterCommandSegmentNodesUpdate struct {
 	ID                   uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Which                ClusterCommandSegmentNodesUpdate_Which `protobuf:"varint,2,opt,name=which,proto3,enum=io.eventter.mq.ClusterCommandSegmentNodesUpdate_Which" json:"which,omitempty"`
@@ -1519,7 +1799,7 @@ func (m *ClusterCommandSegmentNodesUpdate) Reset()         { *m = ClusterCommand
 func (m *ClusterCommandSegmentNodesUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandSegmentNodesUpdate) ProtoMessage()    {}
 func (*ClusterCommandSegmentNodesUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{15}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{16}
 }
 func (m *ClusterCommandSegmentNodesUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1580,7 +1860,7 @@ func (m *ClusterCommandSegmentDelete) Reset()         { *m = ClusterCommandSegme
 func (m *ClusterCommandSegmentDelete) String() string { return proto.CompactTextString(m) }
 func (*ClusterCommandSegmentDelete) ProtoMessage()    {}
 func (*ClusterCommandSegmentDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{16}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{17}
 }
 func (m *ClusterCommandSegmentDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,11 +1904,13 @@ func (m *ClusterCommandSegmentDelete) GetWhich() ClusterCommandSegmentDelete_Whi
 }
 
 type ClusterCommandConsumerGroupOffsetCommitsUpdate struct {
-	Namespace            string                               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name                 string                               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OffsetCommits        []*ClusterConsumerGroup_OffsetCommit `protobuf:"bytes,3,rep,name=offset_commits,json=offsetCommits" json:"offset_commits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+	Namespace     string                               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OffsetCommits []*ClusterConsumerGroup_OffsetCommit `protobuf:"bytes,3,rep,name=offset_commits,json=offsetCommits" json:"offset_commits,omitempty"`
+	// Offset commits overwrite offsets committed so far (even if they are lower).
+	Imported             bool     `protobuf:"varint,4,opt,name=imported,proto3" json:"imported,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Reset() {
@@ -1639,7 +1921,7 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) String() string {
 }
 func (*ClusterCommandConsumerGroupOffsetCommitsUpdate) ProtoMessage() {}
 func (*ClusterCommandConsumerGroupOffsetCommitsUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{17}
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{18}
 }
 func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,37 +1971,32 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) GetOffsetCommits() []*C
 	return nil
 }
 
-type ClusterCommand struct {
-	// Types that are valid to be assigned to Command:
-	//	*ClusterCommand_CreateNamespace
-	//	*ClusterCommand_DeleteNamespace
-	//	*ClusterCommand_CreateTopic
-	//	*ClusterCommand_DeleteTopic
-	//	*ClusterCommand_CreateConsumerGroup
-	//	*ClusterCommand_DeleteConsumerGroup
-	//	*ClusterCommand_UpdateConsumerGroupOffsetCommits
-	//	*ClusterCommand_CreateSegment
-	//	*ClusterCommand_DeleteSegment
-	//	*ClusterCommand_CloseSegment
-	//	*ClusterCommand_UpdateSegmentNodes
-	//	*ClusterCommand_UpdateNode
-	Command              isClusterCommand_Command `protobuf_oneof:"command"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) GetImported() bool {
+	if m != nil {
+		return m.Imported
+	}
+	return false
 }
 
-func (m *ClusterCommand) Reset()         { *m = ClusterCommand{} }
-func (m *ClusterCommand) String() string { return proto.CompactTextString(m) }
-func (*ClusterCommand) ProtoMessage()    {}
-func (*ClusterCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cluster_state_1049bb77208945e4, []int{18}
+type ClusterCommandSegmentOffload struct {
+	ID                   uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OffloadedAt          time.Time `protobuf:"bytes,2,opt,name=offloaded_at,json=offloadedAt,stdtime" json:"offloaded_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *ClusterCommand) XXX_Unmarshal(b []byte) error {
+
+func (m *ClusterCommandSegmentOffload) Reset()         { *m = ClusterCommandSegmentOffload{} }
+func (m *ClusterCommandSegmentOffload) String() string { return proto.CompactTextString(m) }
+func (*ClusterCommandSegmentOffload) ProtoMessage()    {}
+func (*ClusterCommandSegmentOffload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{19}
+}
+func (m *ClusterCommandSegmentOffload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterCommandSegmentOffload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterCommand.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterCommandSegmentOffload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1729,60 +2006,254 @@ func (m *ClusterCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *ClusterCommand) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterCommand.Merge(dst, src)
+func (dst *ClusterCommandSegmentOffload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCommandSegmentOffload.Merge(dst, src)
 }
-func (m *ClusterCommand) XXX_Size() int {
+func (m *ClusterCommandSegmentOffload) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClusterCommand) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterCommand.DiscardUnknown(m)
+func (m *ClusterCommandSegmentOffload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCommandSegmentOffload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterCommand proto.InternalMessageInfo
+var xxx_messageInfo_ClusterCommandSegmentOffload proto.InternalMessageInfo
 
-type isClusterCommand_Command interface {
-	isClusterCommand_Command()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *ClusterCommandSegmentOffload) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
 }
 
-type ClusterCommand_CreateNamespace struct {
-	CreateNamespace *ClusterCommandNamespaceCreate `protobuf:"bytes,10,opt,name=create_namespace,json=createNamespace,oneof"`
+func (m *ClusterCommandSegmentOffload) GetOffloadedAt() time.Time {
+	if m != nil {
+		return m.OffloadedAt
+	}
+	return time.Time{}
 }
-type ClusterCommand_DeleteNamespace struct {
-	DeleteNamespace *ClusterCommandNamespaceDelete `protobuf:"bytes,11,opt,name=delete_namespace,json=deleteNamespace,oneof"`
+
+type ClusterCommandSegmentsReplacingCreate struct {
+	// Closed segments to be replicated before they replace other segments.
+	Segments             []*ClusterSegment `protobuf:"bytes,1,rep,name=segments" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-type ClusterCommand_CreateTopic struct {
-	CreateTopic *ClusterCommandTopicCreate `protobuf:"bytes,20,opt,name=create_topic,json=createTopic,oneof"`
+
+func (m *ClusterCommandSegmentsReplacingCreate) Reset()         { *m = ClusterCommandSegmentsReplacingCreate{} }
+func (m *ClusterCommandSegmentsReplacingCreate) String() string { return proto.CompactTextString(m) }
+func (*ClusterCommandSegmentsReplacingCreate) ProtoMessage()    {}
+func (*ClusterCommandSegmentsReplacingCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{20}
 }
-type ClusterCommand_DeleteTopic struct {
-	DeleteTopic *ClusterCommandTopicDelete `protobuf:"bytes,21,opt,name=delete_topic,json=deleteTopic,oneof"`
+func (m *ClusterCommandSegmentsReplacingCreate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type ClusterCommand_CreateConsumerGroup struct {
-	CreateConsumerGroup *ClusterCommandConsumerGroupCreate `protobuf:"bytes,30,opt,name=create_consumer_group,json=createConsumerGroup,oneof"`
+func (m *ClusterCommandSegmentsReplacingCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCommandSegmentsReplacingCreate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type ClusterCommand_DeleteConsumerGroup struct {
-	DeleteConsumerGroup *ClusterCommandConsumerGroupDelete `protobuf:"bytes,31,opt,name=delete_consumer_group,json=deleteConsumerGroup,oneof"`
+func (dst *ClusterCommandSegmentsReplacingCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCommandSegmentsReplacingCreate.Merge(dst, src)
 }
-type ClusterCommand_UpdateConsumerGroupOffsetCommits struct {
-	UpdateConsumerGroupOffsetCommits *ClusterCommandConsumerGroupOffsetCommitsUpdate `protobuf:"bytes,32,opt,name=update_consumer_group_offset_commits,json=updateConsumerGroupOffsetCommits,oneof"`
+func (m *ClusterCommandSegmentsReplacingCreate) XXX_Size() int {
+	return m.Size()
 }
-type ClusterCommand_CreateSegment struct {
-	CreateSegment *ClusterCommandSegmentCreate `protobuf:"bytes,40,opt,name=create_segment,json=createSegment,oneof"`
+func (m *ClusterCommandSegmentsReplacingCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCommandSegmentsReplacingCreate.DiscardUnknown(m)
 }
-type ClusterCommand_DeleteSegment struct {
-	DeleteSegment *ClusterCommandSegmentDelete `protobuf:"bytes,41,opt,name=delete_segment,json=deleteSegment,oneof"`
+
+var xxx_messageInfo_ClusterCommandSegmentsReplacingCreate proto.InternalMessageInfo
+
+func (m *ClusterCommandSegmentsReplacingCreate) GetSegments() []*ClusterSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
 }
-type ClusterCommand_CloseSegment struct {
-	CloseSegment *ClusterCommandSegmentClose `protobuf:"bytes,42,opt,name=close_segment,json=closeSegment,oneof"`
+
+type ClusterCommandSegmentsReplace struct {
+	// Closed segments to be deleted.
+	ReplacedSegmentIDs []uint64 `protobuf:"varint,1,rep,packed,name=replaced_segment_ids,json=replacedSegmentIds" json:"replaced_segment_ids,omitempty"`
+	// Closed segments that replace them (removed from replacing segments).
+	Segments []*ClusterSegment `protobuf:"bytes,2,rep,name=segments" json:"segments,omitempty"`
+	// Offset commits of consumer groups remapped from replaced segments to the new ones.
+	OffsetCommitsUpdates []*ClusterCommandConsumerGroupOffsetCommitsUpdate `protobuf:"bytes,3,rep,name=offset_commits_updates,json=offsetCommitsUpdates" json:"offset_commits_updates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
+	XXX_sizecache        int32                                             `json:"-"`
+}
+
+func (m *ClusterCommandSegmentsReplace) Reset()         { *m = ClusterCommandSegmentsReplace{} }
+func (m *ClusterCommandSegmentsReplace) String() string { return proto.CompactTextString(m) }
+func (*ClusterCommandSegmentsReplace) ProtoMessage()    {}
+func (*ClusterCommandSegmentsReplace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{21}
+}
+func (m *ClusterCommandSegmentsReplace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCommandSegmentsReplace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCommandSegmentsReplace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterCommandSegmentsReplace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCommandSegmentsReplace.Merge(dst, src)
+}
+func (m *ClusterCommandSegmentsReplace) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCommandSegmentsReplace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCommandSegmentsReplace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCommandSegmentsReplace proto.InternalMessageInfo
+
+func (m *ClusterCommandSegmentsReplace) GetReplacedSegmentIDs() []uint64 {
+	if m != nil {
+		return m.ReplacedSegmentIDs
+	}
+	return nil
+}
+
+func (m *ClusterCommandSegmentsReplace) GetSegments() []*ClusterSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ClusterCommandSegmentsReplace) GetOffsetCommitsUpdates() []*ClusterCommandConsumerGroupOffsetCommitsUpdate {
+	if m != nil {
+		return m.OffsetCommitsUpdates
+	}
+	return nil
+}
+
+type ClusterCommand struct {
+	// Types that are valid to be assigned to Command:
+	//	*ClusterCommand_CreateNamespace
+	//	*ClusterCommand_DeleteNamespace
+	//	*ClusterCommand_CreateTopic
+	//	*ClusterCommand_DeleteTopic
+	//	*ClusterCommand_CreateConsumerGroup
+	//	*ClusterCommand_DeleteConsumerGroup
+	//	*ClusterCommand_UpdateConsumerGroupOffsetCommits
+	//	*ClusterCommand_CreateSegment
+	//	*ClusterCommand_DeleteSegment
+	//	*ClusterCommand_CloseSegment
+	//	*ClusterCommand_UpdateSegmentNodes
+	//	*ClusterCommand_ReplaceSegments
+	//	*ClusterCommand_OffloadSegment
+	//	*ClusterCommand_CreateReplacingSegments
+	//	*ClusterCommand_UpdateNode
+	//	*ClusterCommand_UpdateNodeAdminState
+	Command              isClusterCommand_Command `protobuf_oneof:"command"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ClusterCommand) Reset()         { *m = ClusterCommand{} }
+func (m *ClusterCommand) String() string { return proto.CompactTextString(m) }
+func (*ClusterCommand) ProtoMessage()    {}
+func (*ClusterCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cluster_state_889553f368bb7cbc, []int{22}
+}
+func (m *ClusterCommand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterCommand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCommand.Merge(dst, src)
+}
+func (m *ClusterCommand) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCommand proto.InternalMessageInfo
+
+type isClusterCommand_Command interface {
+	isClusterCommand_Command()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ClusterCommand_CreateNamespace struct {
+	CreateNamespace *ClusterCommandNamespaceCreate `protobuf:"bytes,10,opt,name=create_namespace,json=createNamespace,oneof"`
+}
+type ClusterCommand_DeleteNamespace struct {
+	DeleteNamespace *ClusterCommandNamespaceDelete `protobuf:"bytes,11,opt,name=delete_namespace,json=deleteNamespace,oneof"`
+}
+type ClusterCommand_CreateTopic struct {
+	CreateTopic *ClusterCommandTopicCreate `protobuf:"bytes,20,opt,name=create_topic,json=createTopic,oneof"`
+}
+type ClusterCommand_DeleteTopic struct {
+	DeleteTopic *ClusterCommandTopicDelete `protobuf:"bytes,21,opt,name=delete_topic,json=deleteTopic,oneof"`
+}
+type ClusterCommand_CreateConsumerGroup struct {
+	CreateConsumerGroup *ClusterCommandConsumerGroupCreate `protobuf:"bytes,30,opt,name=create_consumer_group,json=createConsumerGroup,oneof"`
+}
+type ClusterCommand_DeleteConsumerGroup struct {
+	DeleteConsumerGroup *ClusterCommandConsumerGroupDelete `protobuf:"bytes,31,opt,name=delete_consumer_group,json=deleteConsumerGroup,oneof"`
+}
+type ClusterCommand_UpdateConsumerGroupOffsetCommits struct {
+	UpdateConsumerGroupOffsetCommits *ClusterCommandConsumerGroupOffsetCommitsUpdate `protobuf:"bytes,32,opt,name=update_consumer_group_offset_commits,json=updateConsumerGroupOffsetCommits,oneof"`
+}
+type ClusterCommand_CreateSegment struct {
+	CreateSegment *ClusterCommandSegmentCreate `protobuf:"bytes,40,opt,name=create_segment,json=createSegment,oneof"`
+}
+type ClusterCommand_DeleteSegment struct {
+	DeleteSegment *ClusterCommandSegmentDelete `protobuf:"bytes,41,opt,name=delete_segment,json=deleteSegment,oneof"`
+}
+type ClusterCommand_CloseSegment struct {
+	CloseSegment *ClusterCommandSegmentClose `protobuf:"bytes,42,opt,name=close_segment,json=closeSegment,oneof"`
 }
 type ClusterCommand_UpdateSegmentNodes struct {
 	UpdateSegmentNodes *ClusterCommandSegmentNodesUpdate `protobuf:"bytes,43,opt,name=update_segment_nodes,json=updateSegmentNodes,oneof"`
 }
+type ClusterCommand_ReplaceSegments struct {
+	ReplaceSegments *ClusterCommandSegmentsReplace `protobuf:"bytes,44,opt,name=replace_segments,json=replaceSegments,oneof"`
+}
+type ClusterCommand_OffloadSegment struct {
+	OffloadSegment *ClusterCommandSegmentOffload `protobuf:"bytes,45,opt,name=offload_segment,json=offloadSegment,oneof"`
+}
+type ClusterCommand_CreateReplacingSegments struct {
+	CreateReplacingSegments *ClusterCommandSegmentsReplacingCreate `protobuf:"bytes,46,opt,name=create_replacing_segments,json=createReplacingSegments,oneof"`
+}
 type ClusterCommand_UpdateNode struct {
 	UpdateNode *ClusterCommandNodeUpdate `protobuf:"bytes,50,opt,name=update_node,json=updateNode,oneof"`
 }
+type ClusterCommand_UpdateNodeAdminState struct {
+	UpdateNodeAdminState *ClusterCommandNodeAdminStateUpdate `protobuf:"bytes,51,opt,name=update_node_admin_state,json=updateNodeAdminState,oneof"`
+}
 
 func (*ClusterCommand_CreateNamespace) isClusterCommand_Command()                  {}
 func (*ClusterCommand_DeleteNamespace) isClusterCommand_Command()                  {}
@@ -1795,7 +2266,11 @@ func (*ClusterCommand_CreateSegment) isClusterCommand_Command()
 func (*ClusterCommand_DeleteSegment) isClusterCommand_Command()                    {}
 func (*ClusterCommand_CloseSegment) isClusterCommand_Command()                     {}
 func (*ClusterCommand_UpdateSegmentNodes) isClusterCommand_Command()               {}
+func (*ClusterCommand_ReplaceSegments) isClusterCommand_Command()                  {}
+func (*ClusterCommand_OffloadSegment) isClusterCommand_Command()                   {}
+func (*ClusterCommand_CreateReplacingSegments) isClusterCommand_Command()          {}
 func (*ClusterCommand_UpdateNode) isClusterCommand_Command()                       {}
+func (*ClusterCommand_UpdateNodeAdminState) isClusterCommand_Command()             {}
 
 func (m *ClusterCommand) GetCommand() isClusterCommand_Command {
 	if m != nil {
@@ -1881,6 +2356,27 @@ func (m *ClusterCommand) GetUpdateSegmentNodes() *ClusterCommandSegmentNodesUpda
 	return nil
 }
 
+func (m *ClusterCommand) GetReplaceSegments() *ClusterCommandSegmentsReplace {
+	if x, ok := m.GetCommand().(*ClusterCommand_ReplaceSegments); ok {
+		return x.ReplaceSegments
+	}
+	return nil
+}
+
+func (m *ClusterCommand) GetOffloadSegment() *ClusterCommandSegmentOffload {
+	if x, ok := m.GetCommand().(*ClusterCommand_OffloadSegment); ok {
+		return x.OffloadSegment
+	}
+	return nil
+}
+
+func (m *ClusterCommand) GetCreateReplacingSegments() *ClusterCommandSegmentsReplacingCreate {
+	if x, ok := m.GetCommand().(*ClusterCommand_CreateReplacingSegments); ok {
+		return x.CreateReplacingSegments
+	}
+	return nil
+}
+
 func (m *ClusterCommand) GetUpdateNode() *ClusterCommandNodeUpdate {
 	if x, ok := m.GetCommand().(*ClusterCommand_UpdateNode); ok {
 		return x.UpdateNode
@@ -1888,6 +2384,13 @@ func (m *ClusterCommand) GetUpdateNode() *ClusterCommandNodeUpdate {
 	return nil
 }
 
+func (m *ClusterCommand) GetUpdateNodeAdminState() *ClusterCommandNodeAdminStateUpdate {
+	if x, ok := m.GetCommand().(*ClusterCommand_UpdateNodeAdminState); ok {
+		return x.UpdateNodeAdminState
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ClusterCommand) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ClusterCommand_OneofMarshaler, _ClusterCommand_OneofUnmarshaler, _ClusterCommand_OneofSizer, []interface{}{
@@ -1902,7 +2405,11 @@ func (*ClusterCommand) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer
 		(*ClusterCommand_DeleteSegment)(nil),
 		(*ClusterCommand_CloseSegment)(nil),
 		(*ClusterCommand_UpdateSegmentNodes)(nil),
+		(*ClusterCommand_ReplaceSegments)(nil),
+		(*ClusterCommand_OffloadSegment)(nil),
+		(*ClusterCommand_CreateReplacingSegments)(nil),
 		(*ClusterCommand_UpdateNode)(nil),
+		(*ClusterCommand_UpdateNodeAdminState)(nil),
 	}
 }
 
@@ -1965,11 +2472,31 @@ func _ClusterCommand_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.UpdateSegmentNodes); err != nil {
 			return err
 		}
+	case *ClusterCommand_ReplaceSegments:
+		_ = b.EncodeVarint(44<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ReplaceSegments); err != nil {
+			return err
+		}
+	case *ClusterCommand_OffloadSegment:
+		_ = b.EncodeVarint(45<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OffloadSegment); err != nil {
+			return err
+		}
+	case *ClusterCommand_CreateReplacingSegments:
+		_ = b.EncodeVarint(46<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CreateReplacingSegments); err != nil {
+			return err
+		}
 	case *ClusterCommand_UpdateNode:
 		_ = b.EncodeVarint(50<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.UpdateNode); err != nil {
 			return err
 		}
+	case *ClusterCommand_UpdateNodeAdminState:
+		_ = b.EncodeVarint(51<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UpdateNodeAdminState); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ClusterCommand.Command has unexpected type %T", x)
@@ -2068,6 +2595,30 @@ func _ClusterCommand_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Command = &ClusterCommand_UpdateSegmentNodes{msg}
 		return true, err
+	case 44: // command.replace_segments
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClusterCommandSegmentsReplace)
+		err := b.DecodeMessage(msg)
+		m.Command = &ClusterCommand_ReplaceSegments{msg}
+		return true, err
+	case 45: // command.offload_segment
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClusterCommandSegmentOffload)
+		err := b.DecodeMessage(msg)
+		m.Command = &ClusterCommand_OffloadSegment{msg}
+		return true, err
+	case 46: // command.create_replacing_segments
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClusterCommandSegmentsReplacingCreate)
+		err := b.DecodeMessage(msg)
+		m.Command = &ClusterCommand_CreateReplacingSegments{msg}
+		return true, err
 	case 50: // command.update_node
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -2076,6 +2627,14 @@ func _ClusterCommand_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Command = &ClusterCommand_UpdateNode{msg}
 		return true, err
+	case 51: // command.update_node_admin_state
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClusterCommandNodeAdminStateUpdate)
+		err := b.DecodeMessage(msg)
+		m.Command = &ClusterCommand_UpdateNodeAdminState{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2140,11 +2699,31 @@ func _ClusterCommand_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ClusterCommand_ReplaceSegments:
+		s := proto.Size(x.ReplaceSegments)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ClusterCommand_OffloadSegment:
+		s := proto.Size(x.OffloadSegment)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ClusterCommand_CreateReplacingSegments:
+		s := proto.Size(x.CreateReplacingSegments)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *ClusterCommand_UpdateNode:
 		s := proto.Size(x.UpdateNode)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ClusterCommand_UpdateNodeAdminState:
+		s := proto.Size(x.UpdateNodeAdminState)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2171,12 +2750,17 @@ func init() {
 	proto.RegisterType((*ClusterCommandSegmentCreate)(nil), "io.eventter.mq.ClusterCommandSegmentCreate")
 	proto.RegisterType((*ClusterCommandSegmentClose)(nil), "io.eventter.mq.ClusterCommandSegmentClose")
 	proto.RegisterType((*ClusterCommandNodeUpdate)(nil), "io.eventter.mq.ClusterCommandNodeUpdate")
+	proto.RegisterType((*ClusterCommandNodeAdminStateUpdate)(nil), "io.eventter.mq.ClusterCommandNodeAdminStateUpdate")
 	proto.RegisterType((*ClusterCommandSegmentNodesUpdate)(nil), "io.eventter.mq.ClusterCommandSegmentNodesUpdate")
 	proto.RegisterType((*ClusterCommandSegmentDelete)(nil), "io.eventter.mq.ClusterCommandSegmentDelete")
 	proto.RegisterType((*ClusterCommandConsumerGroupOffsetCommitsUpdate)(nil), "io.eventter.mq.ClusterCommandConsumerGroupOffsetCommitsUpdate")
+	proto.RegisterType((*ClusterCommandSegmentOffload)(nil), "io.eventter.mq.ClusterCommandSegmentOffload")
+	proto.RegisterType((*ClusterCommandSegmentsReplacingCreate)(nil), "io.eventter.mq.ClusterCommandSegmentsReplacingCreate")
+	proto.RegisterType((*ClusterCommandSegmentsReplace)(nil), "io.eventter.mq.ClusterCommandSegmentsReplace")
 	proto.RegisterType((*ClusterCommand)(nil), "io.eventter.mq.ClusterCommand")
 	proto.RegisterEnum("io.eventter.mq.ClusterSegment_Type", ClusterSegment_Type_name, ClusterSegment_Type_value)
 	proto.RegisterEnum("io.eventter.mq.ClusterNode_State", ClusterNode_State_name, ClusterNode_State_value)
+	proto.RegisterEnum("io.eventter.mq.ClusterNode_AdminState", ClusterNode_AdminState_name, ClusterNode_AdminState_value)
 	proto.RegisterEnum("io.eventter.mq.ClusterCommandSegmentNodesUpdate_Which", ClusterCommandSegmentNodesUpdate_Which_name, ClusterCommandSegmentNodesUpdate_Which_value)
 	proto.RegisterEnum("io.eventter.mq.ClusterCommandSegmentDelete_Which", ClusterCommandSegmentDelete_Which_name, ClusterCommandSegmentDelete_Which_value)
 }
@@ -2253,6 +2837,18 @@ func (m *ClusterState) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ReplacingSegments) > 0 {
+		for _, msg := range m.ReplacingSegments {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintClusterState(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -2301,6 +2897,11 @@ func (m *ClusterNamespace) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RetentionBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.RetentionBytes))
+	}
 	return i, nil
 }
 
@@ -2349,6 +2950,56 @@ func (m *ClusterTopic) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintClusterState(dAtA, i, uint64(len(m.DefaultExchangeType)))
 		i += copy(dAtA[i:], m.DefaultExchangeType)
 	}
+	if len(m.IndexedHeaders) > 0 {
+		for _, s := range m.IndexedHeaders {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PartitionKey) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(len(m.PartitionKey)))
+		i += copy(dAtA[i:], m.PartitionKey)
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeduplicationWindow)))
+	n2, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DeduplicationWindow, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.CompactionKey) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(len(m.CompactionKey)))
+		i += copy(dAtA[i:], m.CompactionKey)
+	}
+	if m.RetentionBytes != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.RetentionBytes))
+	}
+	if m.ForceRetentionBytes {
+		dAtA[i] = 0x58
+		i++
+		if m.ForceRetentionBytes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -2393,11 +3044,11 @@ func (m *ClusterConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)))
-	n2, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i:])
+	n3, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n3
 	if len(m.OffsetCommits) > 0 {
 		for _, msg := range m.OffsetCommits {
 			dAtA[i] = 0x2a
@@ -2410,6 +3061,36 @@ func (m *ClusterConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.OffsetCommitsGeneration != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.OffsetCommitsGeneration))
+	}
+	if m.OffsetCommitsImportPending {
+		dAtA[i] = 0x38
+		i++
+		if m.OffsetCommitsImportPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxPriority != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.MaxPriority))
+	}
+	if m.SingleActiveConsumer {
+		dAtA[i] = 0x48
+		i++
+		if m.SingleActiveConsumer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -2441,11 +3122,17 @@ func (m *ClusterConsumerGroup_Binding) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.ExchangeType)
 	}
 	if m.By != nil {
-		nn3, err := m.By.MarshalTo(dAtA[i:])
+		nn4, err := m.By.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn3
+		i += nn4
+	}
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
 	}
 	return i, nil
 }
@@ -2464,11 +3151,11 @@ func (m *ClusterConsumerGroup_Binding_HeadersAll) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.HeadersAll.Size()))
-		n4, err := m.HeadersAll.MarshalTo(dAtA[i:])
+		n5, err := m.HeadersAll.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	return i, nil
 }
@@ -2478,11 +3165,11 @@ func (m *ClusterConsumerGroup_Binding_HeadersAny) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.HeadersAny.Size()))
-		n5, err := m.HeadersAny.MarshalTo(dAtA[i:])
+		n6, err := m.HeadersAny.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	return i, nil
 }
@@ -2564,27 +3251,27 @@ func (m *ClusterSegment) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	n7, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt)))
-	n7, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i:])
+	n8, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	dAtA[i] = 0x4a
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(m.Nodes.Size()))
-	n8, err := m.Nodes.MarshalTo(dAtA[i:])
+	n9, err := m.Nodes.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	if m.Size_ != 0 {
 		dAtA[i] = 0x50
 		i++
@@ -2596,6 +3283,24 @@ func (m *ClusterSegment) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintClusterState(dAtA, i, uint64(len(m.Sha1)))
 		i += copy(dAtA[i:], m.Sha1)
 	}
+	if m.Compacted {
+		dAtA[i] = 0x60
+		i++
+		if m.Compacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	dAtA[i] = 0x6a
+	i++
+	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.OffloadedAt)))
+	n10, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OffloadedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
 	return i, nil
 }
 
@@ -2620,38 +3325,38 @@ func (m *ClusterSegment_Nodes) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintClusterState(dAtA, i, uint64(m.PrimaryNodeID))
 	}
 	if len(m.DoneNodeIDs) > 0 {
-		dAtA10 := make([]byte, len(m.DoneNodeIDs)*10)
-		var j9 int
+		dAtA12 := make([]byte, len(m.DoneNodeIDs)*10)
+		var j11 int
 		for _, num := range m.DoneNodeIDs {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j11++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintClusterState(dAtA, i, uint64(j9))
-		i += copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintClusterState(dAtA, i, uint64(j11))
+		i += copy(dAtA[i:], dAtA12[:j11])
 	}
 	if len(m.ReplicatingNodeIDs) > 0 {
-		dAtA12 := make([]byte, len(m.ReplicatingNodeIDs)*10)
-		var j11 int
+		dAtA14 := make([]byte, len(m.ReplicatingNodeIDs)*10)
+		var j13 int
 		for _, num := range m.ReplicatingNodeIDs {
 			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j11++
+				j13++
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintClusterState(dAtA, i, uint64(j11))
-		i += copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintClusterState(dAtA, i, uint64(j13))
+		i += copy(dAtA[i:], dAtA14[:j13])
 	}
 	return i, nil
 }
@@ -2691,11 +3396,32 @@ func (m *ClusterNode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAlive)))
-		n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAlive, dAtA[i:])
+		n15, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAlive, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
+	}
+	if m.AdminState != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.AdminState))
+	}
+	if len(m.Zone) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(len(m.Zone)))
+		i += copy(dAtA[i:], m.Zone)
+	}
+	if m.DiskTotal != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.DiskFree))
 	}
 	return i, nil
 }
@@ -2721,6 +3447,11 @@ func (m *ClusterCommandNamespaceCreate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintClusterState(dAtA, i, uint64(len(m.Namespace)))
 		i += copy(dAtA[i:], m.Namespace)
 	}
+	if m.RetentionBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.RetentionBytes))
+	}
 	return i, nil
 }
 
@@ -2773,11 +3504,11 @@ func (m *ClusterCommandTopicCreate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.Topic.Size()))
-		n14, err := m.Topic.MarshalTo(dAtA[i:])
+		n16, err := m.Topic.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
 	}
 	return i, nil
 }
@@ -2837,11 +3568,11 @@ func (m *ClusterCommandConsumerGroupCreate) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.ConsumerGroup.Size()))
-		n15, err := m.ConsumerGroup.MarshalTo(dAtA[i:])
+		n17, err := m.ConsumerGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	return i, nil
 }
@@ -2926,32 +3657,32 @@ func (m *ClusterCommandSegmentCreate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.OpenedAt)))
-	n16, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OpenedAt, dAtA[i:])
+	n18, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OpenedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n18
 	if m.PrimaryNodeID != 0 {
 		dAtA[i] = 0x40
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.PrimaryNodeID))
 	}
 	if len(m.ReplicatingNodeIDs) > 0 {
-		dAtA18 := make([]byte, len(m.ReplicatingNodeIDs)*10)
-		var j17 int
+		dAtA20 := make([]byte, len(m.ReplicatingNodeIDs)*10)
+		var j19 int
 		for _, num := range m.ReplicatingNodeIDs {
 			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j17++
+				j19++
 			}
-			dAtA18[j17] = uint8(num)
-			j17++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
 		dAtA[i] = 0x4a
 		i++
-		i = encodeVarintClusterState(dAtA, i, uint64(j17))
-		i += copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintClusterState(dAtA, i, uint64(j19))
+		i += copy(dAtA[i:], dAtA20[:j19])
 	}
 	return i, nil
 }
@@ -2984,11 +3715,11 @@ func (m *ClusterCommandSegmentClose) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt)))
-	n19, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i:])
+	n21, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n21
 	if m.Size_ != 0 {
 		dAtA[i] = 0x28
 		i++
@@ -3038,11 +3769,55 @@ func (m *ClusterCommandNodeUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAlive)))
-		n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAlive, dAtA[i:])
+		n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAlive, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
+	}
+	if len(m.Zone) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(len(m.Zone)))
+		i += copy(dAtA[i:], m.Zone)
+	}
+	if m.DiskTotal != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.DiskFree))
+	}
+	return i, nil
+}
+
+func (m *ClusterCommandNodeAdminStateUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCommandNodeAdminStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.ID))
+	}
+	if m.AdminState != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.AdminState))
 	}
 	return i, nil
 }
@@ -3075,11 +3850,11 @@ func (m *ClusterCommandSegmentNodesUpdate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintClusterState(dAtA, i, uint64(m.Nodes.Size()))
-	n21, err := m.Nodes.MarshalTo(dAtA[i:])
+	n23, err := m.Nodes.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n23
 	return i, nil
 }
 
@@ -3150,6 +3925,136 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) MarshalTo(dAtA []byte)
 			i += n
 		}
 	}
+	if m.Imported {
+		dAtA[i] = 0x20
+		i++
+		if m.Imported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ClusterCommandSegmentOffload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCommandSegmentOffload) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.ID))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintClusterState(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.OffloadedAt)))
+	n24, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OffloadedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	return i, nil
+}
+
+func (m *ClusterCommandSegmentsReplacingCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCommandSegmentsReplacingCreate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for _, msg := range m.Segments {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintClusterState(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ClusterCommandSegmentsReplace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCommandSegmentsReplace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReplacedSegmentIDs) > 0 {
+		dAtA26 := make([]byte, len(m.ReplacedSegmentIDs)*10)
+		var j25 int
+		for _, num := range m.ReplacedSegmentIDs {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(j25))
+		i += copy(dAtA[i:], dAtA26[:j25])
+	}
+	if len(m.Segments) > 0 {
+		for _, msg := range m.Segments {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintClusterState(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OffsetCommitsUpdates) > 0 {
+		for _, msg := range m.OffsetCommitsUpdates {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintClusterState(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -3169,11 +4074,11 @@ func (m *ClusterCommand) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Command != nil {
-		nn22, err := m.Command.MarshalTo(dAtA[i:])
+		nn27, err := m.Command.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn22
+		i += nn27
 	}
 	return i, nil
 }
@@ -3184,11 +4089,11 @@ func (m *ClusterCommand_CreateNamespace) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.CreateNamespace.Size()))
-		n23, err := m.CreateNamespace.MarshalTo(dAtA[i:])
+		n28, err := m.CreateNamespace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n28
 	}
 	return i, nil
 }
@@ -3198,11 +4103,11 @@ func (m *ClusterCommand_DeleteNamespace) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.DeleteNamespace.Size()))
-		n24, err := m.DeleteNamespace.MarshalTo(dAtA[i:])
+		n29, err := m.DeleteNamespace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n29
 	}
 	return i, nil
 }
@@ -3214,11 +4119,11 @@ func (m *ClusterCommand_CreateTopic) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.CreateTopic.Size()))
-		n25, err := m.CreateTopic.MarshalTo(dAtA[i:])
+		n30, err := m.CreateTopic.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n30
 	}
 	return i, nil
 }
@@ -3230,11 +4135,11 @@ func (m *ClusterCommand_DeleteTopic) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.DeleteTopic.Size()))
-		n26, err := m.DeleteTopic.MarshalTo(dAtA[i:])
+		n31, err := m.DeleteTopic.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n31
 	}
 	return i, nil
 }
@@ -3246,11 +4151,11 @@ func (m *ClusterCommand_CreateConsumerGroup) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.CreateConsumerGroup.Size()))
-		n27, err := m.CreateConsumerGroup.MarshalTo(dAtA[i:])
+		n32, err := m.CreateConsumerGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n32
 	}
 	return i, nil
 }
@@ -3262,11 +4167,11 @@ func (m *ClusterCommand_DeleteConsumerGroup) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.DeleteConsumerGroup.Size()))
-		n28, err := m.DeleteConsumerGroup.MarshalTo(dAtA[i:])
+		n33, err := m.DeleteConsumerGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n33
 	}
 	return i, nil
 }
@@ -3278,11 +4183,11 @@ func (m *ClusterCommand_UpdateConsumerGroupOffsetCommits) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.UpdateConsumerGroupOffsetCommits.Size()))
-		n29, err := m.UpdateConsumerGroupOffsetCommits.MarshalTo(dAtA[i:])
+		n34, err := m.UpdateConsumerGroupOffsetCommits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n34
 	}
 	return i, nil
 }
@@ -3294,11 +4199,11 @@ func (m *ClusterCommand_CreateSegment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.CreateSegment.Size()))
-		n30, err := m.CreateSegment.MarshalTo(dAtA[i:])
+		n35, err := m.CreateSegment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n35
 	}
 	return i, nil
 }
@@ -3310,11 +4215,11 @@ func (m *ClusterCommand_DeleteSegment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.DeleteSegment.Size()))
-		n31, err := m.DeleteSegment.MarshalTo(dAtA[i:])
+		n36, err := m.DeleteSegment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n36
 	}
 	return i, nil
 }
@@ -3326,11 +4231,11 @@ func (m *ClusterCommand_CloseSegment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.CloseSegment.Size()))
-		n32, err := m.CloseSegment.MarshalTo(dAtA[i:])
+		n37, err := m.CloseSegment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n37
 	}
 	return i, nil
 }
@@ -3342,11 +4247,59 @@ func (m *ClusterCommand_UpdateSegmentNodes) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.UpdateSegmentNodes.Size()))
-		n33, err := m.UpdateSegmentNodes.MarshalTo(dAtA[i:])
+		n38, err := m.UpdateSegmentNodes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n38
+	}
+	return i, nil
+}
+func (m *ClusterCommand_ReplaceSegments) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ReplaceSegments != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.ReplaceSegments.Size()))
+		n39, err := m.ReplaceSegments.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	return i, nil
+}
+func (m *ClusterCommand_OffloadSegment) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.OffloadSegment != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.OffloadSegment.Size()))
+		n40, err := m.OffloadSegment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+func (m *ClusterCommand_CreateReplacingSegments) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CreateReplacingSegments != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.CreateReplacingSegments.Size()))
+		n41, err := m.CreateReplacingSegments.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
 	}
 	return i, nil
 }
@@ -3358,11 +4311,27 @@ func (m *ClusterCommand_UpdateNode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintClusterState(dAtA, i, uint64(m.UpdateNode.Size()))
-		n34, err := m.UpdateNode.MarshalTo(dAtA[i:])
+		n42, err := m.UpdateNode.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n42
+	}
+	return i, nil
+}
+func (m *ClusterCommand_UpdateNodeAdminState) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UpdateNodeAdminState != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintClusterState(dAtA, i, uint64(m.UpdateNodeAdminState.Size()))
+		n43, err := m.UpdateNodeAdminState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
 	}
 	return i, nil
 }
@@ -3408,6 +4377,12 @@ func (m *ClusterState) Size() (n int) {
 			n += 1 + l + sovClusterState(uint64(l))
 		}
 	}
+	if len(m.ReplacingSegments) > 0 {
+		for _, e := range m.ReplacingSegments {
+			l = e.Size()
+			n += 1 + l + sovClusterState(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3430,6 +4405,9 @@ func (m *ClusterNamespace) Size() (n int) {
 			n += 1 + l + sovClusterState(uint64(l))
 		}
 	}
+	if m.RetentionBytes != 0 {
+		n += 1 + sovClusterState(uint64(m.RetentionBytes))
+	}
 	return n
 }
 
@@ -3452,6 +4430,28 @@ func (m *ClusterTopic) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClusterState(uint64(l))
 	}
+	if len(m.IndexedHeaders) > 0 {
+		for _, s := range m.IndexedHeaders {
+			l = len(s)
+			n += 1 + l + sovClusterState(uint64(l))
+		}
+	}
+	l = len(m.PartitionKey)
+	if l > 0 {
+		n += 1 + l + sovClusterState(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeduplicationWindow)
+	n += 1 + l + sovClusterState(uint64(l))
+	l = len(m.CompactionKey)
+	if l > 0 {
+		n += 1 + l + sovClusterState(uint64(l))
+	}
+	if m.RetentionBytes != 0 {
+		n += 1 + sovClusterState(uint64(m.RetentionBytes))
+	}
+	if m.ForceRetentionBytes {
+		n += 2
+	}
 	return n
 }
 
@@ -3479,6 +4479,18 @@ func (m *ClusterConsumerGroup) Size() (n int) {
 			n += 1 + l + sovClusterState(uint64(l))
 		}
 	}
+	if m.OffsetCommitsGeneration != 0 {
+		n += 1 + sovClusterState(uint64(m.OffsetCommitsGeneration))
+	}
+	if m.OffsetCommitsImportPending {
+		n += 2
+	}
+	if m.MaxPriority != 0 {
+		n += 1 + sovClusterState(uint64(m.MaxPriority))
+	}
+	if m.SingleActiveConsumer {
+		n += 2
+	}
 	return n
 }
 
@@ -3496,6 +4508,10 @@ func (m *ClusterConsumerGroup_Binding) Size() (n int) {
 	if m.By != nil {
 		n += m.By.Size()
 	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovClusterState(uint64(l))
+	}
 	return n
 }
 
@@ -3572,6 +4588,11 @@ func (m *ClusterSegment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClusterState(uint64(l))
 	}
+	if m.Compacted {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OffloadedAt)
+	n += 1 + l + sovClusterState(uint64(l))
 	return n
 }
 
@@ -3615,6 +4636,19 @@ func (m *ClusterNode) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAlive)
 		n += 1 + l + sovClusterState(uint64(l))
 	}
+	if m.AdminState != 0 {
+		n += 1 + sovClusterState(uint64(m.AdminState))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovClusterState(uint64(l))
+	}
+	if m.DiskTotal != 0 {
+		n += 1 + sovClusterState(uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		n += 1 + sovClusterState(uint64(m.DiskFree))
+	}
 	return n
 }
 
@@ -3625,6 +4659,9 @@ func (m *ClusterCommandNamespaceCreate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClusterState(uint64(l))
 	}
+	if m.RetentionBytes != 0 {
+		n += 1 + sovClusterState(uint64(m.RetentionBytes))
+	}
 	return n
 }
 
@@ -3770,6 +4807,28 @@ func (m *ClusterCommandNodeUpdate) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAlive)
 		n += 1 + l + sovClusterState(uint64(l))
 	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovClusterState(uint64(l))
+	}
+	if m.DiskTotal != 0 {
+		n += 1 + sovClusterState(uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		n += 1 + sovClusterState(uint64(m.DiskFree))
+	}
+	return n
+}
+
+func (m *ClusterCommandNodeAdminStateUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovClusterState(uint64(m.ID))
+	}
+	if m.AdminState != 0 {
+		n += 1 + sovClusterState(uint64(m.AdminState))
+	}
 	return n
 }
 
@@ -3816,6 +4875,57 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Size() (n int) {
 			n += 1 + l + sovClusterState(uint64(l))
 		}
 	}
+	if m.Imported {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClusterCommandSegmentOffload) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovClusterState(uint64(m.ID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OffloadedAt)
+	n += 1 + l + sovClusterState(uint64(l))
+	return n
+}
+
+func (m *ClusterCommandSegmentsReplacingCreate) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovClusterState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterCommandSegmentsReplace) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ReplacedSegmentIDs) > 0 {
+		l = 0
+		for _, e := range m.ReplacedSegmentIDs {
+			l += sovClusterState(uint64(e))
+		}
+		n += 1 + sovClusterState(uint64(l)) + l
+	}
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovClusterState(uint64(l))
+		}
+	}
+	if len(m.OffsetCommitsUpdates) > 0 {
+		for _, e := range m.OffsetCommitsUpdates {
+			l = e.Size()
+			n += 1 + l + sovClusterState(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3927,6 +5037,33 @@ func (m *ClusterCommand_UpdateSegmentNodes) Size() (n int) {
 	}
 	return n
 }
+func (m *ClusterCommand_ReplaceSegments) Size() (n int) {
+	var l int
+	_ = l
+	if m.ReplaceSegments != nil {
+		l = m.ReplaceSegments.Size()
+		n += 2 + l + sovClusterState(uint64(l))
+	}
+	return n
+}
+func (m *ClusterCommand_OffloadSegment) Size() (n int) {
+	var l int
+	_ = l
+	if m.OffloadSegment != nil {
+		l = m.OffloadSegment.Size()
+		n += 2 + l + sovClusterState(uint64(l))
+	}
+	return n
+}
+func (m *ClusterCommand_CreateReplacingSegments) Size() (n int) {
+	var l int
+	_ = l
+	if m.CreateReplacingSegments != nil {
+		l = m.CreateReplacingSegments.Size()
+		n += 2 + l + sovClusterState(uint64(l))
+	}
+	return n
+}
 func (m *ClusterCommand_UpdateNode) Size() (n int) {
 	var l int
 	_ = l
@@ -3936,6 +5073,15 @@ func (m *ClusterCommand_UpdateNode) Size() (n int) {
 	}
 	return n
 }
+func (m *ClusterCommand_UpdateNodeAdminState) Size() (n int) {
+	var l int
+	_ = l
+	if m.UpdateNodeAdminState != nil {
+		l = m.UpdateNodeAdminState.Size()
+		n += 2 + l + sovClusterState(uint64(l))
+	}
+	return n
+}
 
 func sovClusterState(x uint64) (n int) {
 	for {
@@ -4141,6 +5287,37 @@ func (m *ClusterState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacingSegments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacingSegments = append(m.ReplacingSegments, &ClusterSegment{})
+			if err := m.ReplacingSegments[len(m.ReplacingSegments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -4282,6 +5459,25 @@ func (m *ClusterNamespace) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBytes", wireType)
+			}
+			m.RetentionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -4458,59 +5654,38 @@ func (m *ClusterTopic) Unmarshal(dAtA []byte) error {
 			}
 			m.DefaultExchangeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClusterState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthClusterState
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeaders", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClusterState
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConsumerGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IndexedHeaders = append(m.IndexedHeaders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4535,11 +5710,11 @@ func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PartitionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4563,16 +5738,15 @@ func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bindings = append(m.Bindings, &ClusterConsumerGroup_Binding{})
-			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DeduplicationWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionKey", wireType)
 			}
-			m.Size_ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4582,16 +5756,26 @@ func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompactionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBytes", wireType)
+			}
+			m.RetentionBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4601,27 +5785,16 @@ func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RetentionBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommits", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRetentionBytes", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4631,23 +5804,12 @@ func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OffsetCommits = append(m.OffsetCommits, &ClusterConsumerGroup_OffsetCommit{})
-			if err := m.OffsetCommits[len(m.OffsetCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ForceRetentionBytes = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -4669,7 +5831,7 @@ func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
+func (m *ClusterConsumerGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4692,15 +5854,15 @@ func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConsumerGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4725,13 +5887,13 @@ func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4741,26 +5903,28 @@ func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeType = string(dAtA[iNdEx:postIndex])
+			m.Bindings = append(m.Bindings, &ClusterConsumerGroup_Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4770,24 +5934,14 @@ func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Size_ |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.By = &ClusterConsumerGroup_Binding_RoutingKey{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4811,15 +5965,13 @@ func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.Struct{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.By = &ClusterConsumerGroup_Binding_HeadersAll{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAny", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4843,67 +5995,55 @@ func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.Struct{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OffsetCommits = append(m.OffsetCommits, &ClusterConsumerGroup_OffsetCommit{})
+			if err := m.OffsetCommits[len(m.OffsetCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.By = &ClusterConsumerGroup_Binding_HeadersAny{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClusterState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommitsGeneration", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterConsumerGroup_OffsetCommit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClusterState
+			m.OffsetCommitsGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetCommitsGeneration |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommitsImportPending", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OffsetCommit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OffsetCommit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OffsetCommitsImportPending = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriority", wireType)
 			}
-			m.SegmentID = 0
+			m.MaxPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4913,16 +6053,16 @@ func (m *ClusterConsumerGroup_OffsetCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				m.MaxPriority |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleActiveConsumer", wireType)
 			}
-			m.Offset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -4932,11 +6072,12 @@ func (m *ClusterConsumerGroup_OffsetCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SingleActiveConsumer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -4958,7 +6099,7 @@ func (m *ClusterConsumerGroup_OffsetCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
+func (m *ClusterConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4981,53 +6122,15 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterSegment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (ClusterSegment_Type(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5052,11 +6155,11 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerNamespace = string(dAtA[iNdEx:postIndex])
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5081,13 +6184,13 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerName = string(dAtA[iNdEx:postIndex])
+			m.ExchangeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
 			}
-			m.Generation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -5097,33 +6200,24 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Generation |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
 			}
-			m.Shard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Shard |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			m.By = &ClusterConsumerGroup_Binding_RoutingKey{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAll", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5147,13 +6241,15 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			v := &types.Struct{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.By = &ClusterConsumerGroup_Binding_HeadersAll{v}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAny", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5177,15 +6273,17 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+			v := &types.Struct{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.By = &ClusterConsumerGroup_Binding_HeadersAny{v}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -5195,46 +6293,95 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterState(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthClusterState
 			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterConsumerGroup_OffsetCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffsetCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffsetCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (int64(b) & 0x7F) << shift
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var byteLen int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -5244,23 +6391,11 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sha1 == nil {
-				m.Sha1 = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -5282,7 +6417,7 @@ func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterSegment_Nodes) Unmarshal(dAtA []byte) error {
+func (m *ClusterSegment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5305,17 +6440,17 @@ func (m *ClusterSegment_Nodes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Nodes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterSegment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Nodes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterSegment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.PrimaryNodeID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -5325,134 +6460,744 @@ func (m *ClusterSegment_Nodes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterState
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
 				}
-				m.DoneNodeIDs = append(m.DoneNodeIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterState
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClusterState
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ClusterSegment_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha1 == nil {
+				m.Sha1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compacted = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffloadedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OffloadedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterSegment_Nodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+			}
+			m.PrimaryNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DoneNodeIDs = append(m.DoneNodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClusterState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DoneNodeIDs = append(m.DoneNodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneNodeIDs", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClusterState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatingNodeIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (ClusterNode_State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAlive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSeenAlive == nil {
+				m.LastSeenAlive = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAlive, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminState", wireType)
+			}
+			m.AdminState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminState |= (ClusterNode_AdminState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClusterState
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DoneNodeIDs = append(m.DoneNodeIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoneNodeIDs", wireType)
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterState
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskTotal", wireType)
+			}
+			m.DiskTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
 				}
-				m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterState
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClusterState
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskTotal |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskFree", wireType)
+			}
+			m.DiskFree = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClusterState
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskFree |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatingNodeIDs", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5475,7 +7220,7 @@ func (m *ClusterSegment_Nodes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterNode) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandNamespaceCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5498,34 +7243,15 @@ func (m *ClusterNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandNamespaceCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandNamespaceCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5550,13 +7276,13 @@ func (m *ClusterNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBytes", wireType)
 			}
-			m.State = 0
+			m.RetentionBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -5566,16 +7292,66 @@ func (m *ClusterNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (ClusterNode_State(b) & 0x7F) << shift
+				m.RetentionBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCommandNamespaceDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCommandNamespaceDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCommandNamespaceDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAlive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -5585,24 +7361,20 @@ func (m *ClusterNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastSeenAlive == nil {
-				m.LastSeenAlive = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAlive, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5625,7 +7397,7 @@ func (m *ClusterNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandNamespaceCreate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandTopicCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5648,10 +7420,10 @@ func (m *ClusterCommandNamespaceCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandNamespaceCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandTopicCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandNamespaceCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandTopicCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5677,11 +7449,44 @@ func (m *ClusterCommandNamespaceCreate) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Topic == nil {
+				m.Topic = &ClusterTopic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5704,7 +7509,7 @@ func (m *ClusterCommandNamespaceCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandNamespaceDelete) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandTopicDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5727,10 +7532,10 @@ func (m *ClusterCommandNamespaceDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandNamespaceDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandTopicDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandNamespaceDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandTopicDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5762,6 +7567,35 @@ func (m *ClusterCommandNamespaceDelete) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -5783,7 +7617,7 @@ func (m *ClusterCommandNamespaceDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandTopicCreate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandConsumerGroupCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5806,10 +7640,10 @@ func (m *ClusterCommandTopicCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandTopicCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandConsumerGroupCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandTopicCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandConsumerGroupCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5843,7 +7677,7 @@ func (m *ClusterCommandTopicCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5867,10 +7701,10 @@ func (m *ClusterCommandTopicCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &ClusterTopic{}
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &ClusterConsumerGroup{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5895,7 +7729,7 @@ func (m *ClusterCommandTopicCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandTopicDelete) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandConsumerGroupDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5918,10 +7752,10 @@ func (m *ClusterCommandTopicDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandTopicDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandConsumerGroupDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandTopicDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandConsumerGroupDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6003,7 +7837,7 @@ func (m *ClusterCommandTopicDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandConsumerGroupCreate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6026,15 +7860,53 @@ func (m *ClusterCommandConsumerGroupCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandConsumerGroupCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandSegmentCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandConsumerGroupCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandSegmentCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ClusterSegment_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6059,11 +7931,78 @@ func (m *ClusterCommandConsumerGroupCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.OwnerNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6087,13 +8026,91 @@ func (m *ClusterCommandConsumerGroupCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerGroup == nil {
-				m.ConsumerGroup = &ClusterConsumerGroup{}
-			}
-			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OpenedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+			}
+			m.PrimaryNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClusterState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatingNodeIDs", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -6115,7 +8132,7 @@ func (m *ClusterCommandConsumerGroupCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandConsumerGroupDelete) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6138,17 +8155,55 @@ func (m *ClusterCommandConsumerGroupDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandConsumerGroupDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandSegmentClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandConsumerGroupDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandSegmentClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneNodeID", wireType)
+			}
+			m.DoneNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoneNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6158,26 +8213,46 @@ func (m *ClusterCommandConsumerGroupDelete) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6187,20 +8262,22 @@ func (m *ClusterCommandConsumerGroupDelete) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha1 == nil {
+				m.Sha1 = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6223,7 +8300,7 @@ func (m *ClusterCommandConsumerGroupDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6246,10 +8323,10 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandSegmentCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandNodeUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandSegmentCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandNodeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6272,10 +8349,10 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6285,16 +8362,45 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (ClusterSegment_Type(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (ClusterNode_State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAlive", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6304,24 +8410,28 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerNamespace = string(dAtA[iNdEx:postIndex])
+			if m.LastSeenAlive == nil {
+				m.LastSeenAlive = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAlive, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6346,13 +8456,13 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerName = string(dAtA[iNdEx:postIndex])
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskTotal", wireType)
 			}
-			m.Generation = 0
+			m.DiskTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6362,16 +8472,16 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Generation |= (uint32(b) & 0x7F) << shift
+				m.DiskTotal |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskFree", wireType)
 			}
-			m.Shard = 0
+			m.DiskFree = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6381,16 +8491,66 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Shard |= (uint32(b) & 0x7F) << shift
+				m.DiskFree |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCommandNodeAdminStateUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCommandNodeAdminStateUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCommandNodeAdminStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6400,27 +8560,16 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OpenedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminState", wireType)
 			}
-			m.PrimaryNodeID = 0
+			m.AdminState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6430,73 +8579,11 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				m.AdminState |= (ClusterNode_AdminState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterState
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClusterState
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClusterState
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClusterState
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatingNodeIDs", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -6518,7 +8605,7 @@ func (m *ClusterCommandSegmentCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandSegmentNodesUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6541,10 +8628,10 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandSegmentClose: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandSegmentNodesUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandSegmentClose: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandSegmentNodesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6568,9 +8655,9 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoneNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Which", wireType)
 			}
-			m.DoneNodeID = 0
+			m.Which = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6580,14 +8667,14 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DoneNodeID |= (uint64(b) & 0x7F) << shift
+				m.Which |= (ClusterCommandSegmentNodesUpdate_Which(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6611,15 +8698,65 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCommandSegmentDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCommandSegmentDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCommandSegmentDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Size_ = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6629,16 +8766,16 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (int64(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Which", wireType)
 			}
-			var byteLen int
+			m.Which = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6648,23 +8785,11 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Which |= (ClusterCommandSegmentDelete_Which(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sha1 == nil {
-				m.Sha1 = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -6686,7 +8811,7 @@ func (m *ClusterCommandSegmentClose) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6709,17 +8834,17 @@ func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandNodeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandConsumerGroupOffsetCommitsUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandNodeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandConsumerGroupOffsetCommitsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6729,14 +8854,24 @@ func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6761,13 +8896,13 @@ func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommits", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6777,16 +8912,28 @@ func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (ClusterNode_State(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffsetCommits = append(m.OffsetCommits, &ClusterConsumerGroup_OffsetCommit{})
+			if err := m.OffsetCommits[len(m.OffsetCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAlive", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6796,25 +8943,12 @@ func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastSeenAlive == nil {
-				m.LastSeenAlive = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAlive, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Imported = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -6836,7 +8970,7 @@ func (m *ClusterCommandNodeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandSegmentNodesUpdate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandSegmentOffload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6859,36 +8993,17 @@ func (m *ClusterCommandSegmentNodesUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandSegmentNodesUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandSegmentOffload: wiretype end group for non-group")
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandSegmentNodesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCommandSegmentOffload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Which", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Which = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6898,14 +9013,14 @@ func (m *ClusterCommandSegmentNodesUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Which |= (ClusterCommandSegmentNodesUpdate_Which(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffloadedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6929,7 +9044,7 @@ func (m *ClusterCommandSegmentNodesUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OffloadedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6954,7 +9069,7 @@ func (m *ClusterCommandSegmentNodesUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandSegmentDelete) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandSegmentsReplacingCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6977,17 +9092,17 @@ func (m *ClusterCommandSegmentDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandSegmentDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandSegmentsReplacingCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandSegmentDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandSegmentsReplacingCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -6997,30 +9112,23 @@ func (m *ClusterCommandSegmentDelete) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Which", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
 			}
-			m.Which = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Which |= (ClusterCommandSegmentDelete_Which(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, &ClusterSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -7042,7 +9150,7 @@ func (m *ClusterCommandSegmentDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Unmarshal(dAtA []byte) error {
+func (m *ClusterCommandSegmentsReplace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7065,46 +9173,79 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCommandConsumerGroupOffsetCommitsUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCommandSegmentsReplace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCommandConsumerGroupOffsetCommitsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCommandSegmentsReplace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClusterState
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ReplacedSegmentIDs = append(m.ReplacedSegmentIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClusterState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReplacedSegmentIDs = append(m.ReplacedSegmentIDs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedSegmentIDs", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthClusterState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterState
@@ -7114,24 +9255,26 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClusterState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Segments = append(m.Segments, &ClusterSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommitsUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7155,8 +9298,8 @@ func (m *ClusterCommandConsumerGroupOffsetCommitsUpdate) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OffsetCommits = append(m.OffsetCommits, &ClusterConsumerGroup_OffsetCommit{})
-			if err := m.OffsetCommits[len(m.OffsetCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OffsetCommitsUpdates = append(m.OffsetCommitsUpdates, &ClusterCommandConsumerGroupOffsetCommitsUpdate{})
+			if err := m.OffsetCommitsUpdates[len(m.OffsetCommitsUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7562,6 +9705,102 @@ func (m *ClusterCommand) Unmarshal(dAtA []byte) error {
 			}
 			m.Command = &ClusterCommand_UpdateSegmentNodes{v}
 			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceSegments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClusterCommandSegmentsReplace{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &ClusterCommand_ReplaceSegments{v}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffloadSegment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClusterCommandSegmentOffload{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &ClusterCommand_OffloadSegment{v}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateReplacingSegments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClusterCommandSegmentsReplacingCreate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &ClusterCommand_CreateReplacingSegments{v}
+			iNdEx = postIndex
 		case 50:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpdateNode", wireType)
@@ -7594,6 +9833,38 @@ func (m *ClusterCommand) Unmarshal(dAtA []byte) error {
 			}
 			m.Command = &ClusterCommand_UpdateNode{v}
 			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateNodeAdminState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClusterCommandNodeAdminStateUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &ClusterCommand_UpdateNodeAdminState{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterState(dAtA[iNdEx:])
@@ -7720,117 +9991,155 @@ var (
 	ErrIntOverflowClusterState   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("cluster_state.proto", fileDescriptor_cluster_state_1049bb77208945e4) }
-
-var fileDescriptor_cluster_state_1049bb77208945e4 = []byte{
-	// 1732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x93, 0x1a, 0xc7,
-	0x15, 0x67, 0x58, 0x60, 0x99, 0xc7, 0xc7, 0xe2, 0x16, 0x52, 0xc6, 0x6b, 0x09, 0x10, 0x56, 0x55,
-	0xd6, 0xb2, 0x8d, 0xac, 0x75, 0x2a, 0xae, 0xb8, 0x2a, 0x29, 0xf3, 0xb1, 0x12, 0x94, 0xb4, 0x8b,
-	0xd2, 0x20, 0x27, 0xa5, 0xcb, 0xd4, 0xec, 0x4c, 0x2f, 0x3b, 0x15, 0x98, 0xc1, 0x33, 0x83, 0x6d,
-	0xf2, 0x27, 0xe4, 0x90, 0xd2, 0x31, 0x7f, 0x43, 0x72, 0xcd, 0x31, 0x97, 0xdc, 0x7c, 0x4b, 0x72,
-	0xcb, 0x89, 0xb8, 0x48, 0xe5, 0x94, 0xaa, 0x1c, 0x73, 0x4e, 0xf5, 0xc7, 0x7c, 0x61, 0x60, 0x41,
-	0x95, 0x43, 0x2a, 0x27, 0xe8, 0xee, 0xf7, 0xfb, 0xf5, 0x7b, 0xaf, 0x5f, 0xff, 0x5e, 0x0f, 0xdc,
-	0xd2, 0xc7, 0x33, 0xd7, 0x23, 0x8e, 0xea, 0x7a, 0x9a, 0x47, 0x1a, 0x53, 0xc7, 0xf6, 0x6c, 0x54,
-	0x34, 0xed, 0x06, 0xf9, 0x92, 0x58, 0x9e, 0x47, 0x9c, 0xc6, 0xe4, 0x8b, 0xe3, 0xf2, 0xc8, 0x1e,
-	0xd9, 0x6c, 0xe9, 0x11, 0xfd, 0xc7, 0xad, 0x8e, 0x2b, 0x23, 0xdb, 0x1e, 0x8d, 0xc9, 0x23, 0x36,
-	0xba, 0x9c, 0x5d, 0x3d, 0x32, 0x66, 0x8e, 0xe6, 0x99, 0xb6, 0x25, 0xd6, 0xef, 0xae, 0xae, 0xbb,
-	0x9e, 0x33, 0xd3, 0x3d, 0xb1, 0x5a, 0x5d, 0x5d, 0xf5, 0xcc, 0x09, 0x71, 0x3d, 0x6d, 0x32, 0xe5,
-	0x06, 0xf5, 0x7f, 0x26, 0x21, 0xdf, 0xe6, 0xce, 0x0d, 0xa8, 0x6f, 0xa8, 0x0c, 0x69, 0xd3, 0x32,
-	0xc8, 0xd7, 0x8a, 0x54, 0x93, 0x4e, 0x52, 0x98, 0x0f, 0x50, 0x0b, 0x90, 0x3e, 0x73, 0x1c, 0x62,
-	0x79, 0xaa, 0x4b, 0x46, 0x13, 0xfa, 0x6b, 0x1a, 0x4a, 0x92, 0x9a, 0xb4, 0xca, 0xcb, 0x45, 0xb5,
-	0xd4, 0xe6, 0xab, 0x03, 0xbe, 0xd8, 0xeb, 0xe0, 0x92, 0x1e, 0x9f, 0x31, 0xd0, 0x67, 0x00, 0x96,
-	0x36, 0x21, 0xee, 0x54, 0xd3, 0x89, 0xab, 0x1c, 0xd4, 0x0e, 0x4e, 0x72, 0xa7, 0xb5, 0x46, 0x3c,
-	0x09, 0x0d, 0xe1, 0xcb, 0x85, 0x6f, 0x88, 0x23, 0x18, 0xd4, 0x86, 0x82, 0x3d, 0x25, 0x96, 0xef,
-	0x82, 0xab, 0xa4, 0x18, 0x49, 0x65, 0x03, 0x89, 0xd8, 0x1a, 0xe7, 0x29, 0x48, 0x0c, 0x5c, 0xf4,
-	0x14, 0x8e, 0xf4, 0xb1, 0xed, 0x12, 0x23, 0xa4, 0x49, 0xef, 0x44, 0x53, 0xe4, 0xb0, 0x80, 0xe8,
-	0x31, 0xa4, 0x2d, 0xdb, 0x20, 0xae, 0x92, 0x61, 0xf0, 0x77, 0x36, 0x85, 0x62, 0x1b, 0x04, 0x73,
-	0xcb, 0xfa, 0xef, 0x24, 0x28, 0xad, 0x46, 0x88, 0x10, 0xa4, 0x68, 0x8c, 0x2c, 0xe1, 0x32, 0x66,
-	0xff, 0xd1, 0x0f, 0x20, 0xe3, 0xd9, 0x53, 0x53, 0x77, 0x95, 0x24, 0x23, 0xbf, 0xbb, 0x81, 0x7c,
-	0x48, 0x8d, 0xb0, 0xb0, 0x45, 0xe7, 0x70, 0xa4, 0xdb, 0x96, 0x3b, 0x9b, 0x10, 0x47, 0x1d, 0x39,
-	0xf6, 0x6c, 0xea, 0xa7, 0xf9, 0xc1, 0x06, 0x78, 0x5b, 0x58, 0x3f, 0xa5, 0xc6, 0xb8, 0xa8, 0x47,
-	0x87, 0x6e, 0xfd, 0x5b, 0x29, 0xa8, 0x0d, 0xb6, 0xcf, 0x5a, 0x4f, 0xef, 0x40, 0xc6, 0xbd, 0xd6,
-	0x1c, 0xc3, 0x65, 0xd5, 0x50, 0xc0, 0x62, 0x84, 0x3e, 0x04, 0xe4, 0x90, 0xe9, 0xd8, 0xd4, 0x59,
-	0xb1, 0xaa, 0x57, 0x9a, 0xee, 0xd9, 0x8e, 0x72, 0xc0, 0x6c, 0xde, 0x8a, 0xac, 0x3c, 0x61, 0x0b,
-	0xa8, 0x09, 0xb2, 0x43, 0x3c, 0x62, 0xd1, 0x29, 0x25, 0x55, 0x93, 0x4e, 0x72, 0xa7, 0x6f, 0x37,
-	0x78, 0xf1, 0x36, 0xfc, 0xe2, 0x6d, 0x74, 0x44, 0xe9, 0xb7, 0xb2, 0xdf, 0x2c, 0xaa, 0x89, 0xdf,
-	0xfc, 0xad, 0x2a, 0xe1, 0x10, 0x85, 0x4e, 0xe1, 0xb6, 0x41, 0xae, 0xb4, 0xd9, 0xd8, 0x53, 0xc9,
-	0xd7, 0xfa, 0xb5, 0x66, 0x8d, 0x88, 0xea, 0xcd, 0xa7, 0x44, 0x49, 0x33, 0x77, 0x6f, 0x89, 0xc5,
-	0x33, 0xb1, 0x36, 0x9c, 0x4f, 0x49, 0xfd, 0x1f, 0x29, 0x28, 0xaf, 0xcb, 0xc5, 0xda, 0x50, 0xbb,
-	0x90, 0xbd, 0x34, 0x2d, 0xc3, 0xb4, 0x46, 0xfe, 0xb1, 0x7c, 0xb0, 0x4b, 0x5e, 0x1b, 0x2d, 0x0e,
-	0xc2, 0x01, 0x9a, 0xb2, 0xbb, 0xe6, 0x2f, 0x89, 0x48, 0x07, 0xfb, 0x8f, 0x3e, 0x85, 0xb4, 0x6b,
-	0x5a, 0x3a, 0x11, 0xd1, 0x1f, 0x7f, 0x27, 0xfa, 0xa1, 0x7f, 0x75, 0x79, 0xf8, 0xaf, 0x69, 0xf8,
-	0x1c, 0x82, 0x7e, 0x0e, 0x45, 0xfb, 0xea, 0xca, 0x25, 0x9e, 0xaa, 0xdb, 0x93, 0x89, 0x19, 0x94,
-	0xf4, 0xe3, 0x9d, 0xfc, 0xeb, 0x33, 0x68, 0x9b, 0x21, 0x71, 0xc1, 0x8e, 0x8c, 0xdc, 0xe3, 0x7f,
-	0x49, 0x70, 0x28, 0xfc, 0x47, 0xf7, 0x00, 0x58, 0xa1, 0xa9, 0x91, 0xcc, 0xc8, 0x6c, 0x86, 0x16,
-	0x33, 0x7a, 0x17, 0x0a, 0xf1, 0xbc, 0x27, 0x99, 0x45, 0x9e, 0x44, 0x12, 0x8e, 0xee, 0x43, 0xce,
-	0xb1, 0x67, 0x9e, 0x69, 0x8d, 0xd4, 0x5f, 0x90, 0x39, 0x4b, 0x80, 0xdc, 0x4d, 0x60, 0x10, 0x93,
-	0xcf, 0xc8, 0x1c, 0x7d, 0x0a, 0xb9, 0x6b, 0xa2, 0x19, 0xc4, 0x71, 0x55, 0x6d, 0x3c, 0x16, 0xe9,
-	0xf8, 0xde, 0x77, 0xd2, 0x31, 0x60, 0x3a, 0x47, 0xb1, 0xc2, 0xba, 0x39, 0x1e, 0xc7, 0xb0, 0xd6,
-	0x9c, 0x9d, 0xfc, 0x6e, 0x58, 0x6b, 0xde, 0x4a, 0x41, 0xf2, 0x72, 0x7e, 0x3c, 0x84, 0x7c, 0x34,
-	0x1f, 0xe8, 0x03, 0x80, 0x88, 0xe2, 0x31, 0x51, 0x6c, 0x15, 0x96, 0x8b, 0xaa, 0x1c, 0x4a, 0x9d,
-	0xec, 0x06, 0x1a, 0x77, 0x07, 0x32, 0x3c, 0x7f, 0x2c, 0xf8, 0x03, 0x2c, 0x46, 0xf5, 0xbf, 0xa6,
-	0xa1, 0x18, 0x97, 0x13, 0x74, 0x07, 0x92, 0x01, 0x61, 0x66, 0xb9, 0xa8, 0x26, 0x7b, 0x1d, 0x9c,
-	0x34, 0x0d, 0xf4, 0x09, 0xa4, 0x82, 0xec, 0x15, 0x4f, 0xdf, 0xdd, 0x2e, 0x4a, 0x0d, 0x9a, 0x54,
-	0xcc, 0x00, 0xe8, 0xfb, 0x70, 0x64, 0x7f, 0x65, 0x11, 0x47, 0x0d, 0x14, 0x93, 0xa7, 0x17, 0x17,
-	0xd9, 0x74, 0x28, 0x38, 0xf7, 0x00, 0x42, 0x43, 0x96, 0x5f, 0x19, 0xcb, 0x81, 0x0d, 0xaa, 0x00,
-	0x8c, 0x88, 0x45, 0xf8, 0x55, 0x63, 0x29, 0x2c, 0xe0, 0xc8, 0x0c, 0xed, 0x10, 0xec, 0x8e, 0x2b,
-	0x19, 0xb6, 0xc4, 0x07, 0xa8, 0x0d, 0xa0, 0x3b, 0x44, 0xf3, 0x88, 0xa1, 0x6a, 0x9e, 0x72, 0xb8,
-	0x47, 0x0d, 0xcb, 0x02, 0xd7, 0xf4, 0xa8, 0x0a, 0x08, 0x6d, 0xd6, 0x3c, 0x25, 0xbb, 0x07, 0x47,
-	0x96, 0xc3, 0x9a, 0x1e, 0xfa, 0xcc, 0x57, 0x65, 0x99, 0xc1, 0x1f, 0xdc, 0x90, 0x3f, 0xaa, 0xce,
-	0x6e, 0x2b, 0x45, 0x89, 0x84, 0x48, 0x07, 0x97, 0x13, 0xd8, 0x09, 0xf2, 0xcb, 0x49, 0xe7, 0xae,
-	0xb5, 0xc7, 0x4a, 0xae, 0x26, 0x9d, 0xe4, 0x31, 0xfb, 0x7f, 0xfc, 0x47, 0x09, 0xd2, 0x0c, 0x8e,
-	0x7e, 0x04, 0x47, 0x53, 0xc7, 0x9c, 0x68, 0xce, 0x5c, 0xa5, 0x14, 0x61, 0xa1, 0xbc, 0xb5, 0x5c,
-	0x54, 0x0b, 0x2f, 0xf8, 0x12, 0x35, 0xed, 0x75, 0x70, 0x61, 0x1a, 0x19, 0x1a, 0xe8, 0x63, 0x28,
-	0x18, 0xb6, 0x45, 0x7c, 0x1c, 0x17, 0x96, 0x54, 0xeb, 0x68, 0xb9, 0xa8, 0xe6, 0x3a, 0xb6, 0x45,
-	0x38, 0xca, 0xc5, 0x39, 0xc3, 0x1f, 0x18, 0x2e, 0xea, 0x42, 0x39, 0x50, 0x50, 0x6b, 0x14, 0x62,
-	0x0f, 0x18, 0xf6, 0xce, 0x72, 0x51, 0x45, 0x38, 0x5c, 0xf7, 0x29, 0x90, 0xb3, 0x32, 0x67, 0xb8,
-	0xf5, 0x26, 0xa4, 0xd8, 0xb5, 0xcc, 0xc1, 0x61, 0xef, 0xe2, 0xf3, 0xe6, 0xf3, 0x5e, 0xa7, 0x94,
-	0x40, 0x32, 0xa4, 0x87, 0xfd, 0x17, 0xbd, 0x76, 0x49, 0x42, 0xf7, 0xe1, 0x5e, 0xbb, 0x7f, 0x31,
-	0x78, 0x79, 0x7e, 0x86, 0xd5, 0xa7, 0xb8, 0xff, 0xf2, 0x85, 0xda, 0x7f, 0xf2, 0x64, 0x70, 0x36,
-	0x54, 0xdb, 0xfd, 0xf3, 0xf3, 0xde, 0x70, 0x50, 0x4a, 0xd2, 0x2e, 0x91, 0x8b, 0xb4, 0xba, 0x8d,
-	0x75, 0xad, 0xc0, 0xa1, 0x66, 0x18, 0x0e, 0x71, 0x5d, 0x21, 0x0c, 0xfe, 0x10, 0x7d, 0x02, 0x69,
-	0xf6, 0x2e, 0x62, 0xe5, 0x5a, 0x3c, 0xbd, 0xbf, 0xa5, 0x91, 0x36, 0xd8, 0x23, 0x05, 0x73, 0x7b,
-	0xd4, 0x85, 0xa3, 0xb1, 0xe6, 0xd2, 0x27, 0x09, 0xb1, 0x54, 0x6d, 0x6c, 0x7e, 0xb9, 0x8b, 0x78,
-	0xa6, 0x58, 0xc1, 0x14, 0x28, 0x70, 0x40, 0x88, 0xd5, 0xa4, 0xb0, 0xfa, 0x5d, 0x48, 0xf3, 0xe7,
-	0x4f, 0x16, 0x52, 0x9d, 0xb3, 0xa6, 0xc8, 0x42, 0xf3, 0x79, 0xef, 0xf3, 0xb3, 0x92, 0x54, 0xff,
-	0x31, 0xdc, 0x0b, 0x84, 0x73, 0x32, 0xd1, 0x2c, 0x23, 0xb8, 0x4b, 0x6d, 0x56, 0xba, 0xe8, 0x2e,
-	0xc8, 0xe1, 0xa5, 0x13, 0xc2, 0x18, 0x4c, 0x6c, 0x81, 0x77, 0xc8, 0x98, 0xdc, 0x08, 0x9f, 0xc0,
-	0xdb, 0x71, 0x38, 0x6b, 0xc6, 0xbb, 0xec, 0x8c, 0x4e, 0x21, 0xcd, 0xf4, 0x99, 0x65, 0xfc, 0xa6,
-	0x57, 0x04, 0x37, 0xad, 0x9f, 0xaf, 0xdd, 0x6e, 0x17, 0x4f, 0x83, 0xa6, 0x99, 0x0c, 0x9b, 0x66,
-	0xfd, 0xd7, 0x12, 0xdc, 0x8f, 0xf3, 0xc5, 0x9a, 0xcf, 0x4e, 0x61, 0x3c, 0x83, 0x62, 0xfc, 0x5d,
-	0x23, 0xe2, 0xd9, 0xed, 0x59, 0x53, 0x88, 0x3d, 0x6b, 0xea, 0x2f, 0xb7, 0xfa, 0xf3, 0xc6, 0x71,
-	0xfe, 0xfe, 0x00, 0xde, 0x89, 0xf3, 0x0a, 0x89, 0x11, 0x11, 0xfe, 0x9f, 0xc9, 0x7d, 0x13, 0x64,
-	0xfa, 0xaa, 0xde, 0x5f, 0xed, 0xb3, 0x1c, 0xd6, 0xf4, 0xd6, 0xa9, 0x66, 0x76, 0x47, 0xd5, 0xdc,
-	0x24, 0x80, 0xf2, 0xde, 0x02, 0xf8, 0x17, 0x09, 0x8e, 0xd7, 0x1f, 0x1b, 0x6d, 0x28, 0x1b, 0x4f,
-	0xed, 0x23, 0xc8, 0x47, 0x65, 0x5b, 0x7c, 0x09, 0x15, 0x97, 0x8b, 0x2a, 0x84, 0xaa, 0x8d, 0x21,
-	0x14, 0xed, 0x78, 0x6b, 0x4b, 0xbd, 0x51, 0x6b, 0xf3, 0x1b, 0x53, 0x7a, 0x4d, 0x63, 0xca, 0x84,
-	0x8d, 0xa9, 0xfe, 0x27, 0x09, 0x94, 0x15, 0xc1, 0xb1, 0x0d, 0xf2, 0x72, 0x6a, 0x6c, 0xab, 0xc3,
-	0xff, 0x69, 0x79, 0xfe, 0xb7, 0x04, 0xb5, 0xb5, 0xa7, 0xc4, 0xfa, 0xef, 0x0d, 0x91, 0x3d, 0x87,
-	0xf4, 0x57, 0xd7, 0xa6, 0x7e, 0x2d, 0xae, 0xd8, 0x0f, 0x37, 0x8a, 0xc6, 0x06, 0xe2, 0xc6, 0xcf,
-	0x28, 0x1a, 0x73, 0x92, 0xf0, 0x7d, 0x71, 0xf0, 0x86, 0xef, 0x8b, 0xfa, 0x43, 0x48, 0x33, 0xc6,
-	0x78, 0xd3, 0xcd, 0x42, 0xaa, 0xff, 0xe2, 0xec, 0xa2, 0x24, 0x21, 0x80, 0x4c, 0xfb, 0x79, 0x7f,
-	0x70, 0xd6, 0x29, 0x25, 0xeb, 0xbf, 0x95, 0x36, 0xa8, 0x8a, 0xd0, 0xa9, 0x4d, 0x31, 0x3f, 0x8d,
-	0xc7, 0xfc, 0x78, 0xa7, 0x98, 0x39, 0x67, 0x2c, 0xdc, 0xbd, 0x9c, 0xfd, 0x83, 0x04, 0x8d, 0x2d,
-	0xd2, 0x1a, 0x7d, 0x56, 0xfb, 0x67, 0xb6, 0xb7, 0xce, 0xae, 0xf9, 0xd4, 0x39, 0xf8, 0xef, 0x7c,
-	0xea, 0xd4, 0x7f, 0x25, 0x07, 0x6f, 0x74, 0xe1, 0x3e, 0x7a, 0x05, 0x25, 0xfe, 0x38, 0x8d, 0x88,
-	0x2c, 0xb0, 0x73, 0xff, 0x70, 0x7b, 0x46, 0x57, 0x1e, 0x08, 0xdd, 0x04, 0x3e, 0xe2, 0x44, 0xa1,
-	0x2c, 0xbf, 0x82, 0x92, 0xc1, 0x12, 0x1e, 0xe1, 0xce, 0xed, 0xc5, 0xcd, 0xcf, 0x8b, 0x72, 0x73,
-	0xa2, 0x90, 0xfb, 0x02, 0xf2, 0xc2, 0x6f, 0xde, 0xfe, 0xcb, 0x8c, 0xf7, 0xbd, 0xed, 0xbc, 0x91,
-	0x67, 0x45, 0x37, 0x81, 0x73, 0x9c, 0x80, 0x7f, 0xf8, 0x5f, 0x40, 0x5e, 0xf8, 0xca, 0xf9, 0x6e,
-	0xef, 0xcc, 0x17, 0xf8, 0x98, 0xe3, 0x04, 0x9c, 0x6f, 0x04, 0xb7, 0x85, 0x7f, 0x2b, 0x7d, 0xbd,
-	0xc2, 0x88, 0x6f, 0x28, 0xd7, 0x35, 0x0f, 0x88, 0x6e, 0x02, 0xdf, 0xe2, 0x8c, 0xf1, 0xcf, 0xf8,
-	0x11, 0xdc, 0x16, 0x8e, 0xaf, 0x6c, 0x54, 0xdd, 0x7b, 0xa3, 0x20, 0x92, 0x5b, 0x9c, 0x31, 0xbe,
-	0xd1, 0x6b, 0x09, 0x1e, 0xcc, 0x58, 0x4d, 0xaf, 0xec, 0xa4, 0xae, 0x54, 0x6b, 0x8d, 0x6d, 0xfc,
-	0x93, 0x3d, 0x36, 0x5e, 0x73, 0x6f, 0xba, 0x09, 0x5c, 0xe3, 0xbb, 0x6d, 0xb6, 0x44, 0x43, 0x28,
-	0x8a, 0x24, 0x8b, 0xef, 0x53, 0xe5, 0x84, 0xed, 0xfd, 0xfe, 0x4e, 0x62, 0x10, 0xe4, 0xb5, 0xc0,
-	0x49, 0xfc, 0xcf, 0xd6, 0x21, 0x14, 0x45, 0x46, 0x7d, 0xd6, 0xf7, 0xf6, 0x60, 0x0d, 0x92, 0x58,
-	0xe0, 0x24, 0x3e, 0xeb, 0x4f, 0xa1, 0xc0, 0xda, 0x5c, 0x40, 0xfa, 0x90, 0x91, 0x3e, 0xdc, 0xcd,
-	0x55, 0x8a, 0xec, 0x26, 0x70, 0x9e, 0x51, 0xf8, 0x94, 0x06, 0x94, 0xc5, 0x81, 0xf8, 0xdf, 0xef,
-	0x5c, 0xb7, 0xdf, 0x67, 0xcc, 0x1f, 0xed, 0xdb, 0x05, 0xba, 0x09, 0x8c, 0x38, 0x5f, 0x74, 0x0d,
-	0x3d, 0x83, 0x9c, 0xd8, 0x85, 0xb2, 0x2b, 0xa7, 0x8c, 0xfc, 0xe4, 0x86, 0x0b, 0x1c, 0x74, 0xe3,
-	0x6e, 0x02, 0x03, 0x87, 0xd3, 0xb9, 0x96, 0x0c, 0x87, 0x3a, 0x37, 0x69, 0x95, 0xbf, 0x59, 0x56,
-	0xa4, 0x3f, 0x2f, 0x2b, 0xd2, 0xb7, 0xcb, 0x8a, 0xf4, 0xfa, 0xef, 0x95, 0xc4, 0xab, 0xe4, 0xe4,
-	0x8b, 0xcb, 0x0c, 0x6b, 0x98, 0x1f, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x5a, 0xe6, 0x03,
-	0x50, 0x16, 0x00, 0x00,
+func init() { proto.RegisterFile("cluster_state.proto", fileDescriptor_cluster_state_889553f368bb7cbc) }
+
+var fileDescriptor_cluster_state_889553f368bb7cbc = []byte{
+	// 2348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4b, 0x6f, 0xdb, 0xd8,
+	0xf5, 0x17, 0xf5, 0xb2, 0x74, 0xf4, 0xb0, 0x72, 0xad, 0x38, 0x8c, 0x93, 0x58, 0x0a, 0xe7, 0xf1,
+	0xcf, 0xcc, 0x24, 0xca, 0xc4, 0x99, 0x7f, 0x07, 0x13, 0xa0, 0xc5, 0x48, 0xb2, 0x63, 0x09, 0x89,
+	0x1f, 0xbd, 0x76, 0x92, 0x62, 0x36, 0x04, 0x43, 0x5e, 0xc9, 0x44, 0x24, 0x52, 0x43, 0x52, 0x49,
+	0x3c, 0x9b, 0xae, 0xa6, 0x8b, 0x2e, 0x8a, 0x2c, 0x0b, 0x74, 0x51, 0xcc, 0x77, 0xe8, 0xa6, 0xdd,
+	0x14, 0xdd, 0xcd, 0xb2, 0x05, 0x5a, 0xa0, 0x2b, 0xb7, 0x70, 0xbf, 0x48, 0x71, 0x1f, 0x7c, 0x29,
+	0x92, 0x2c, 0x65, 0xba, 0x69, 0x57, 0xd6, 0x7d, 0x9c, 0xdf, 0x39, 0x3c, 0xaf, 0xdf, 0x21, 0x0d,
+	0x6b, 0xfa, 0x60, 0xec, 0x7a, 0xc4, 0x51, 0x5d, 0x4f, 0xf3, 0x48, 0x63, 0xe4, 0xd8, 0x9e, 0x8d,
+	0xca, 0xa6, 0xdd, 0x20, 0x2f, 0x89, 0xe5, 0x79, 0xc4, 0x69, 0x0c, 0xbf, 0xde, 0xa8, 0xf6, 0xed,
+	0xbe, 0xcd, 0x8e, 0xee, 0xd2, 0x5f, 0xfc, 0xd6, 0xc6, 0x66, 0xdf, 0xb6, 0xfb, 0x03, 0x72, 0x97,
+	0xad, 0x9e, 0x8f, 0x7b, 0x77, 0x8d, 0xb1, 0xa3, 0x79, 0xa6, 0x6d, 0x89, 0xf3, 0xeb, 0x93, 0xe7,
+	0xae, 0xe7, 0x8c, 0x75, 0x4f, 0x9c, 0xd6, 0x26, 0x4f, 0x3d, 0x73, 0x48, 0x5c, 0x4f, 0x1b, 0x8e,
+	0xf8, 0x05, 0xe5, 0x0f, 0x29, 0x28, 0xb6, 0xb9, 0x71, 0x47, 0xd4, 0x36, 0x54, 0x85, 0x8c, 0x69,
+	0x19, 0xe4, 0xb5, 0x2c, 0xd5, 0xa5, 0x5b, 0x69, 0xcc, 0x17, 0xa8, 0x05, 0x48, 0x1f, 0x3b, 0x0e,
+	0xb1, 0x3c, 0xd5, 0x25, 0xfd, 0x21, 0xfd, 0x6b, 0x1a, 0x72, 0x92, 0x5e, 0x69, 0x55, 0xcf, 0xcf,
+	0x6a, 0x95, 0x36, 0x3f, 0x3d, 0xe2, 0x87, 0xdd, 0x6d, 0x5c, 0xd1, 0xe3, 0x3b, 0x06, 0xfa, 0x12,
+	0xc0, 0xd2, 0x86, 0xc4, 0x1d, 0x69, 0x3a, 0x71, 0xe5, 0x54, 0x3d, 0x75, 0xab, 0xb0, 0x55, 0x6f,
+	0xc4, 0x9d, 0xd0, 0x10, 0xb6, 0xec, 0xfb, 0x17, 0x71, 0x44, 0x06, 0xb5, 0xa1, 0x64, 0x8f, 0x88,
+	0xe5, 0x9b, 0xe0, 0xca, 0x69, 0x06, 0xb2, 0x39, 0x03, 0x44, 0xa8, 0xc6, 0x45, 0x2a, 0x24, 0x16,
+	0x2e, 0xda, 0x85, 0x55, 0x7d, 0x60, 0xbb, 0xc4, 0x08, 0x61, 0x32, 0x0b, 0xc1, 0x94, 0xb9, 0x58,
+	0x00, 0x74, 0x0f, 0x32, 0x96, 0x6d, 0x10, 0x57, 0xce, 0x32, 0xf1, 0x6b, 0xb3, 0x1e, 0xc5, 0x36,
+	0x08, 0xe6, 0x37, 0xd1, 0x1e, 0x20, 0x87, 0x8c, 0x06, 0x9a, 0x6e, 0x5a, 0xfd, 0x50, 0xfd, 0xca,
+	0x42, 0xea, 0x2f, 0x05, 0x92, 0xbe, 0x05, 0xca, 0x5f, 0x25, 0xa8, 0x4c, 0x3a, 0x0c, 0x21, 0x48,
+	0x53, 0x97, 0xb1, 0xf8, 0xe5, 0x31, 0xfb, 0x8d, 0x3e, 0x83, 0xac, 0x67, 0x8f, 0x4c, 0xdd, 0x95,
+	0x93, 0x4c, 0xd7, 0xf5, 0x19, 0xba, 0x8e, 0xe9, 0x25, 0x2c, 0xee, 0xa2, 0x3d, 0x58, 0xd5, 0x6d,
+	0xcb, 0x1d, 0x0f, 0x89, 0xa3, 0xf6, 0x1d, 0x7b, 0x3c, 0xf2, 0xa3, 0xf6, 0xfe, 0x0c, 0xf1, 0xb6,
+	0xb8, 0xbd, 0x4b, 0x2f, 0xe3, 0xb2, 0x1e, 0x5d, 0xba, 0xe8, 0xff, 0x60, 0xd5, 0x21, 0x1e, 0xb1,
+	0x68, 0xf2, 0xaa, 0xcf, 0x4f, 0x3d, 0x42, 0xe3, 0x47, 0x73, 0xac, 0x1c, 0x6c, 0xb7, 0xe8, 0xae,
+	0xf2, 0xcb, 0x34, 0x14, 0xa3, 0x06, 0x4d, 0x7d, 0xa4, 0x75, 0xc8, 0xba, 0x27, 0x9a, 0x63, 0xb8,
+	0x2c, 0x0b, 0x4b, 0x58, 0xac, 0xd0, 0x1d, 0xee, 0x62, 0x53, 0x67, 0x45, 0xa2, 0xf6, 0x34, 0xdd,
+	0xb3, 0x1d, 0x39, 0xc5, 0xee, 0x5c, 0x8a, 0x9c, 0x3c, 0x64, 0x07, 0xa8, 0x09, 0xf9, 0x40, 0x3b,
+	0x33, 0xa7, 0xb0, 0x75, 0xb5, 0xc1, 0x8b, 0xa6, 0xe1, 0x17, 0x4d, 0x63, 0x5b, 0x94, 0x5c, 0x2b,
+	0xf7, 0xfd, 0x59, 0x2d, 0xf1, 0xeb, 0x7f, 0xd4, 0x24, 0x1c, 0x4a, 0xa1, 0x2d, 0xb8, 0x6c, 0x90,
+	0x9e, 0x36, 0x1e, 0x78, 0x2a, 0x79, 0xad, 0x9f, 0x68, 0x56, 0x9f, 0xa8, 0xde, 0xe9, 0x88, 0xc8,
+	0x19, 0x66, 0xee, 0x9a, 0x38, 0xdc, 0x11, 0x67, 0xc7, 0xa7, 0x23, 0x42, 0x7d, 0xc1, 0x0a, 0x8b,
+	0x18, 0xea, 0x09, 0xd1, 0x0c, 0xe2, 0xf0, 0x2c, 0xca, 0xe3, 0xb2, 0xd8, 0xee, 0xf0, 0x5d, 0xf4,
+	0x1e, 0x94, 0x46, 0x9a, 0xe3, 0x99, 0xec, 0x61, 0x5e, 0x90, 0x53, 0x79, 0x85, 0x81, 0x16, 0x83,
+	0xcd, 0x47, 0xe4, 0x14, 0x3d, 0x85, 0xaa, 0x41, 0x8c, 0x71, 0xf8, 0xd4, 0xaf, 0x4c, 0xcb, 0xb0,
+	0x5f, 0xc9, 0xb9, 0xc5, 0x9f, 0x67, 0x2d, 0x06, 0xf0, 0x8c, 0xc9, 0xa3, 0x0f, 0xa0, 0xac, 0xdb,
+	0xc3, 0x91, 0xa6, 0x07, 0xda, 0xf3, 0x4c, 0x7b, 0x29, 0xdc, 0xa5, 0xea, 0xa7, 0x04, 0x16, 0xa6,
+	0x05, 0x96, 0x7a, 0xaa, 0x67, 0x3b, 0x3a, 0x51, 0x27, 0xaf, 0x17, 0xea, 0xd2, 0xad, 0x1c, 0x5e,
+	0x63, 0x87, 0x38, 0x9e, 0x0c, 0x7f, 0xcb, 0x42, 0x75, 0x5a, 0x7a, 0x4d, 0x4d, 0x8a, 0x0e, 0xe4,
+	0x9e, 0x9b, 0x96, 0x61, 0x5a, 0x7d, 0x3f, 0xd3, 0x6f, 0x2f, 0x92, 0xaa, 0x8d, 0x16, 0x17, 0xc2,
+	0x81, 0x34, 0x45, 0x77, 0xcd, 0x6f, 0x88, 0x48, 0x1c, 0xf6, 0x1b, 0x3d, 0x80, 0x8c, 0x6b, 0x5a,
+	0x3a, 0x11, 0x79, 0xb2, 0xf1, 0x96, 0x5f, 0x8f, 0xfd, 0xe6, 0xca, 0x1d, 0xfb, 0x86, 0x3a, 0x96,
+	0x8b, 0xa0, 0x9f, 0x41, 0xd9, 0xee, 0xf5, 0x5c, 0xe2, 0xa9, 0xba, 0x3d, 0x1c, 0x9a, 0x41, 0xd3,
+	0xb9, 0xb7, 0x90, 0x7d, 0x07, 0x4c, 0xb4, 0xcd, 0x24, 0x71, 0xc9, 0x8e, 0xac, 0x5c, 0xf4, 0x00,
+	0xae, 0xc6, 0x91, 0xd5, 0x3e, 0xb1, 0x08, 0x0f, 0xb0, 0x9c, 0x65, 0x71, 0xb8, 0x12, 0x93, 0xd8,
+	0x0d, 0x8e, 0x51, 0x13, 0x6e, 0x4c, 0xc8, 0x9a, 0xc3, 0x91, 0xed, 0x78, 0xea, 0x88, 0x30, 0x3f,
+	0xb0, 0x6c, 0xcb, 0xe1, 0x8d, 0x98, 0x7c, 0x97, 0x5d, 0x39, 0xe4, 0x37, 0xd0, 0x4d, 0x28, 0x0e,
+	0xb5, 0xd7, 0xea, 0xc8, 0x31, 0x6d, 0xc7, 0xf4, 0x4e, 0x59, 0xce, 0x95, 0x70, 0x61, 0xa8, 0xbd,
+	0x3e, 0x14, 0x5b, 0xe8, 0x33, 0x58, 0x77, 0x4d, 0xab, 0x3f, 0x20, 0x2a, 0xcd, 0x99, 0x97, 0x44,
+	0xf5, 0x1b, 0x03, 0x4b, 0xa7, 0x1c, 0xae, 0xf2, 0xd3, 0x26, 0x3b, 0xf4, 0x1f, 0x7c, 0xe3, 0x17,
+	0x49, 0x58, 0x11, 0x71, 0x41, 0x37, 0x00, 0x58, 0x4f, 0x52, 0x23, 0x11, 0xcf, 0xb3, 0x1d, 0xda,
+	0xf7, 0x68, 0x91, 0xc4, 0x2b, 0x2f, 0xc9, 0x8b, 0x84, 0x44, 0x4b, 0xee, 0x26, 0x14, 0x1c, 0x7b,
+	0xec, 0xd1, 0xce, 0x4b, 0x33, 0x99, 0x06, 0x36, 0xdf, 0x49, 0x60, 0x10, 0x9b, 0x34, 0x91, 0x1f,
+	0x40, 0x41, 0x54, 0xa3, 0xaa, 0x0d, 0x06, 0x22, 0xcc, 0x57, 0xde, 0x0a, 0xf3, 0x11, 0x63, 0x58,
+	0x2a, 0x2b, 0x6e, 0x37, 0x07, 0x83, 0x98, 0xac, 0x75, 0x2a, 0x67, 0x16, 0x96, 0xb5, 0x4e, 0x69,
+	0x2f, 0xeb, 0x99, 0x03, 0x8f, 0x38, 0x2c, 0x5e, 0x79, 0x2c, 0x56, 0xad, 0x34, 0x24, 0x9f, 0x9f,
+	0x6e, 0x1c, 0x43, 0x31, 0x1a, 0x7f, 0x74, 0x1b, 0x20, 0xc2, 0xc1, 0x8c, 0xa6, 0x5b, 0xa5, 0xf3,
+	0xb3, 0x5a, 0x3e, 0x24, 0xdf, 0xbc, 0x1b, 0xb0, 0xee, 0x3a, 0x64, 0x79, 0xf4, 0x98, 0x53, 0x52,
+	0x58, 0xac, 0x94, 0x3f, 0x66, 0xa1, 0x1c, 0x67, 0x18, 0xb4, 0x0e, 0xc9, 0x00, 0x30, 0x7b, 0x7e,
+	0x56, 0x4b, 0x76, 0xb7, 0x71, 0xd2, 0x34, 0xd0, 0xe7, 0x90, 0x0e, 0xbc, 0x5a, 0xde, 0x7a, 0x6f,
+	0x3e, 0x4f, 0x35, 0xa8, 0xb3, 0x31, 0x13, 0xa0, 0x8d, 0xc1, 0x7e, 0x65, 0x11, 0x47, 0x0d, 0x38,
+	0x9c, 0xbb, 0x1d, 0x97, 0xd9, 0x76, 0xc8, 0x59, 0x37, 0x00, 0xc2, 0x8b, 0xcc, 0xef, 0x79, 0x9c,
+	0x0f, 0xee, 0xa0, 0x4d, 0x80, 0x48, 0x4e, 0x67, 0x58, 0x86, 0x45, 0x76, 0xe8, 0xcc, 0xc2, 0xba,
+	0x3f, 0x73, 0x5f, 0x09, 0xf3, 0x05, 0x6a, 0x03, 0xe8, 0x0e, 0xd1, 0x3c, 0x62, 0xa8, 0x9a, 0x27,
+	0xaf, 0x2c, 0x51, 0xb3, 0x79, 0x21, 0xd7, 0xf4, 0x28, 0x3f, 0x88, 0x69, 0x41, 0xf3, 0xe4, 0xdc,
+	0x12, 0x18, 0x39, 0x2e, 0xd6, 0xf4, 0xd0, 0x97, 0xfe, 0x9c, 0x90, 0xaf, 0x4b, 0x73, 0xc8, 0xd3,
+	0xf7, 0x1f, 0x9d, 0x17, 0xdc, 0x56, 0x9a, 0x02, 0xf9, 0x63, 0x83, 0xdf, 0x8c, 0x80, 0x45, 0x90,
+	0xfd, 0x66, 0x7b, 0x27, 0xda, 0x3d, 0xd6, 0x3a, 0x8b, 0x98, 0xfd, 0x46, 0xd7, 0x21, 0x2f, 0x3a,
+	0x33, 0x31, 0xe4, 0x22, 0xab, 0xad, 0x70, 0x03, 0xed, 0x42, 0xd1, 0xee, 0xf5, 0x06, 0xb6, 0x66,
+	0xf0, 0xa7, 0x29, 0x2d, 0xf1, 0x34, 0x85, 0x40, 0xb2, 0xe9, 0x6d, 0xfc, 0x49, 0x82, 0x0c, 0xb3,
+	0x12, 0x7d, 0x01, 0xab, 0x23, 0xc7, 0x1c, 0x6a, 0xce, 0xa9, 0x4a, 0x2d, 0x0d, 0xf3, 0xf1, 0xd2,
+	0xf9, 0x59, 0xad, 0x74, 0xc8, 0x8f, 0xe8, 0xd5, 0xee, 0x36, 0x2e, 0x8d, 0x22, 0x4b, 0x03, 0xdd,
+	0x87, 0x92, 0x61, 0x5b, 0xc4, 0x97, 0xe3, 0xfd, 0x3a, 0xdd, 0x5a, 0x3d, 0x3f, 0xab, 0x15, 0xb6,
+	0x6d, 0x8b, 0x70, 0x29, 0x17, 0x17, 0x0c, 0x7f, 0x61, 0xb8, 0xa8, 0x03, 0xd5, 0x80, 0xc2, 0xad,
+	0x7e, 0x28, 0x9b, 0x62, 0xb2, 0xeb, 0xe7, 0x67, 0x35, 0x84, 0xc3, 0x73, 0x1f, 0x02, 0x39, 0x13,
+	0x7b, 0x86, 0xab, 0x34, 0x21, 0xcd, 0xba, 0x42, 0x01, 0x56, 0xba, 0xfb, 0x4f, 0x9b, 0x8f, 0xbb,
+	0xdb, 0x95, 0x04, 0xca, 0x43, 0xe6, 0xf8, 0xe0, 0xb0, 0xdb, 0xae, 0x48, 0xe8, 0x26, 0xdc, 0x68,
+	0x1f, 0xec, 0x1f, 0x3d, 0xd9, 0xdb, 0xc1, 0xea, 0x2e, 0x3e, 0x78, 0x72, 0xa8, 0x1e, 0x3c, 0x7c,
+	0x78, 0xb4, 0x73, 0xac, 0xb6, 0x0f, 0xf6, 0xf6, 0xba, 0xc7, 0x47, 0x95, 0xa4, 0xf2, 0x5d, 0x0a,
+	0x0a, 0x91, 0x19, 0x6f, 0x66, 0xf9, 0xc8, 0xb0, 0xa2, 0x19, 0x86, 0x43, 0x5c, 0x57, 0xf4, 0x25,
+	0x7f, 0x89, 0x3e, 0x87, 0x0c, 0x7b, 0x21, 0x60, 0x55, 0x51, 0xde, 0xba, 0x39, 0x67, 0x82, 0x6c,
+	0xb0, 0xe9, 0x1c, 0xf3, 0xfb, 0xa8, 0x03, 0xab, 0x03, 0xcd, 0xa5, 0xb3, 0x38, 0xb1, 0x54, 0x6d,
+	0x60, 0xbe, 0x5c, 0x84, 0x93, 0xd2, 0x2c, 0x92, 0x25, 0x2a, 0x78, 0x44, 0x88, 0xd5, 0xa4, 0x62,
+	0x68, 0x17, 0x0a, 0x9a, 0x31, 0x34, 0x2d, 0xfe, 0x66, 0xc2, 0x6a, 0xab, 0xbc, 0xf5, 0xe1, 0x3c,
+	0x43, 0x9a, 0xf4, 0x3a, 0xb7, 0x06, 0xb4, 0xe0, 0x37, 0xcd, 0xc7, 0x6f, 0x6c, 0x8b, 0x88, 0x0e,
+	0xc6, 0x7e, 0xd3, 0xb2, 0x36, 0x4c, 0xf7, 0x85, 0xea, 0xd9, 0x9e, 0x36, 0x60, 0x15, 0x98, 0xc6,
+	0x79, 0xba, 0x73, 0x4c, 0x37, 0xd0, 0x35, 0x60, 0x0b, 0xb5, 0xe7, 0x10, 0xc2, 0x6a, 0x2b, 0x8d,
+	0x73, 0x74, 0xe3, 0xa1, 0x43, 0x88, 0x72, 0x1d, 0x32, 0x1c, 0x38, 0x07, 0xe9, 0xed, 0x9d, 0xa6,
+	0x08, 0x4f, 0xf3, 0x71, 0xf7, 0xe9, 0x4e, 0x45, 0x52, 0x3e, 0x04, 0x08, 0xed, 0x40, 0x00, 0xd9,
+	0x66, 0xfb, 0x98, 0x9e, 0x24, 0x50, 0x11, 0x72, 0xdb, 0xb8, 0xd9, 0xdd, 0xef, 0xee, 0xef, 0x56,
+	0x24, 0xa5, 0x07, 0x37, 0x02, 0x42, 0x1d, 0x0e, 0x35, 0xcb, 0x08, 0x7a, 0x4e, 0x9b, 0x95, 0x38,
+	0x2d, 0x99, 0xb0, 0x39, 0x09, 0x62, 0x09, 0x36, 0xa6, 0x4d, 0x36, 0xc9, 0xa9, 0x23, 0xeb, 0x8f,
+	0x67, 0xea, 0xd9, 0x26, 0x03, 0x72, 0x91, 0x1e, 0x65, 0x08, 0x57, 0xe3, 0xe2, 0x6c, 0xee, 0x5d,
+	0xc8, 0xc4, 0x2d, 0xc8, 0x30, 0x22, 0x64, 0x86, 0x5d, 0x34, 0xd9, 0xf3, 0xab, 0xca, 0xde, 0x54,
+	0x75, 0x8b, 0x58, 0x1a, 0x4c, 0x5d, 0xc9, 0x70, 0xea, 0x52, 0x7e, 0x25, 0xc1, 0xcd, 0x38, 0x5e,
+	0x6c, 0x7a, 0x59, 0xe8, 0x31, 0x1e, 0x41, 0x39, 0xfe, 0xae, 0x21, 0x27, 0xe7, 0x76, 0xcb, 0xf8,
+	0xab, 0x46, 0x29, 0xf6, 0xaa, 0xa1, 0x3c, 0x99, 0x6b, 0xcf, 0x3b, 0x3f, 0xe7, 0xef, 0x52, 0x70,
+	0x2d, 0x8e, 0x2b, 0x7a, 0xb6, 0x78, 0xc2, 0xff, 0x31, 0xfe, 0x6c, 0x42, 0xde, 0x1e, 0x11, 0x6b,
+	0x79, 0xfa, 0xcc, 0x71, 0xb1, 0xa6, 0x37, 0x8d, 0x1f, 0x72, 0x0b, 0xf2, 0xc3, 0xac, 0x56, 0x9f,
+	0x5f, 0xba, 0xd5, 0xff, 0x45, 0x82, 0x8d, 0xe9, 0x61, 0xa3, 0x0c, 0x3d, 0x33, 0x6a, 0x9f, 0x42,
+	0x31, 0x4a, 0x50, 0xe2, 0x63, 0x47, 0xf9, 0xfc, 0xac, 0x06, 0x21, 0x3f, 0x61, 0x08, 0xe9, 0x29,
+	0x3e, 0x2b, 0xa4, 0xdf, 0x69, 0x56, 0xf0, 0x99, 0x3e, 0x33, 0x85, 0xe9, 0xb3, 0x21, 0xd3, 0x2b,
+	0xbf, 0x4d, 0x82, 0x3c, 0xd1, 0x70, 0x6c, 0x83, 0x3c, 0x19, 0x19, 0x9a, 0xf7, 0x5f, 0x4a, 0x44,
+	0x3e, 0x7f, 0x64, 0x66, 0xf2, 0x47, 0x76, 0x2e, 0x7f, 0xac, 0x4c, 0xf0, 0xc7, 0xb7, 0x12, 0x28,
+	0x6f, 0x7b, 0x28, 0x24, 0x8d, 0x0b, 0x7c, 0x35, 0xc1, 0x8b, 0xc9, 0x77, 0xe5, 0x45, 0xe5, 0xdb,
+	0x24, 0xd4, 0xa7, 0x66, 0x1f, 0x15, 0x72, 0x2f, 0xb0, 0xe2, 0x31, 0x64, 0x5e, 0x9d, 0x98, 0xfa,
+	0x89, 0xd0, 0xff, 0xa3, 0x99, 0xcd, 0x70, 0x06, 0x70, 0xe3, 0x19, 0x95, 0xc6, 0x1c, 0x24, 0x1c,
+	0x44, 0x53, 0xef, 0x38, 0x88, 0x2a, 0x5f, 0x40, 0x86, 0x21, 0xc6, 0xc7, 0xa6, 0x1c, 0xa4, 0x0f,
+	0x0e, 0x77, 0xf6, 0x2b, 0x12, 0x25, 0xe2, 0xf6, 0xe3, 0x83, 0xa3, 0x9d, 0xed, 0x4a, 0x12, 0x95,
+	0x20, 0x8f, 0x77, 0x0e, 0x1f, 0x37, 0xdb, 0x94, 0x89, 0x53, 0xca, 0xef, 0xa5, 0x19, 0xcd, 0x53,
+	0xb4, 0xe3, 0xd9, 0x81, 0x88, 0xb9, 0xe0, 0xde, 0x42, 0x2e, 0xe0, 0x98, 0xb1, 0xa7, 0xff, 0x21,
+	0xb6, 0xff, 0x5d, 0x82, 0xc6, 0x1c, 0x42, 0x89, 0xbe, 0x9d, 0xf9, 0x11, 0x5d, 0x9a, 0x5d, 0xa6,
+	0x7c, 0x21, 0x48, 0xfd, 0x87, 0xbe, 0x10, 0x6c, 0x40, 0x8e, 0xbf, 0xd6, 0x13, 0x83, 0x55, 0x67,
+	0x0e, 0x07, 0x6b, 0xe5, 0xe7, 0x70, 0x7d, 0xaa, 0x07, 0x0f, 0xf8, 0xbc, 0x3f, 0x27, 0x2c, 0xf1,
+	0x97, 0x89, 0xe4, 0x3b, 0xbe, 0x4c, 0x28, 0x3a, 0x7c, 0x30, 0xd5, 0x00, 0x17, 0xfb, 0x9f, 0x3b,
+	0x05, 0xbb, 0x3e, 0x80, 0x5c, 0xf0, 0xc5, 0x54, 0x5a, 0xe8, 0x8b, 0x69, 0x70, 0x5f, 0xf9, 0x4d,
+	0x72, 0x72, 0x3e, 0x8b, 0x6b, 0x21, 0x3e, 0xdd, 0x68, 0x7a, 0xf8, 0x5d, 0x98, 0xd1, 0x8d, 0x14,
+	0xa7, 0x1b, 0x7a, 0x1e, 0xbc, 0x66, 0x0b, 0xba, 0x89, 0xee, 0x19, 0x6e, 0xcc, 0xce, 0xe4, 0x72,
+	0x76, 0x22, 0x0f, 0xd6, 0x27, 0xbe, 0xc7, 0x8c, 0x59, 0x3a, 0xf9, 0xb9, 0xf0, 0x93, 0xf9, 0xd9,
+	0x7f, 0x51, 0x56, 0xe2, 0xaa, 0xfd, 0xf6, 0xa6, 0xab, 0x7c, 0x57, 0x0c, 0x3e, 0x05, 0x08, 0x20,
+	0xf4, 0x15, 0x54, 0xf8, 0x3b, 0x70, 0x64, 0xf4, 0x00, 0x16, 0xe2, 0x3b, 0xf3, 0x4d, 0x98, 0x98,
+	0xaf, 0x3b, 0x09, 0xbc, 0xca, 0x81, 0x82, 0x03, 0x8a, 0x6d, 0xb0, 0xfa, 0x8c, 0x60, 0x17, 0x96,
+	0xc2, 0xe6, 0xe5, 0x4d, 0xb1, 0x39, 0x50, 0x88, 0xbd, 0x0f, 0x45, 0x61, 0x37, 0x1f, 0x8a, 0xab,
+	0x0c, 0xf7, 0xa3, 0xf9, 0xb8, 0x91, 0x61, 0xbb, 0x93, 0xc0, 0x05, 0x0e, 0xc0, 0x36, 0x29, 0x9e,
+	0xb0, 0x95, 0xe3, 0x5d, 0x5e, 0x18, 0x2f, 0xb0, 0xb1, 0xc0, 0x01, 0x38, 0x5e, 0x1f, 0x2e, 0x0b,
+	0xfb, 0x26, 0xa6, 0xdd, 0xcd, 0xba, 0x34, 0xb7, 0xd6, 0x67, 0x8d, 0xd5, 0x9d, 0x04, 0x5e, 0xe3,
+	0x88, 0xb1, 0x43, 0xaa, 0x48, 0x18, 0x3e, 0xa1, 0xa8, 0xb6, 0xb4, 0xa2, 0xe0, 0x49, 0xd6, 0x38,
+	0x62, 0x5c, 0xd1, 0x1b, 0x09, 0xde, 0xe7, 0x49, 0x3a, 0xa1, 0x49, 0x9d, 0xe8, 0x66, 0xf5, 0xba,
+	0xf4, 0xc3, 0x33, 0xb8, 0x93, 0xc0, 0x75, 0xae, 0x6d, 0xf6, 0x4d, 0x74, 0x0c, 0x65, 0xe1, 0x64,
+	0x51, 0x58, 0xf2, 0x2d, 0xa6, 0xfb, 0x93, 0x85, 0xb8, 0x23, 0xf0, 0x6b, 0x89, 0x83, 0x88, 0x6d,
+	0x8a, 0x2a, 0x3c, 0xea, 0xa3, 0x7e, 0xb4, 0x04, 0x6a, 0xe0, 0xc4, 0x12, 0x07, 0xf1, 0x51, 0x7f,
+	0x0a, 0x25, 0x36, 0xfc, 0x05, 0xa0, 0x1f, 0x33, 0xd0, 0x8f, 0x17, 0x33, 0x95, 0x4a, 0x76, 0x12,
+	0xb8, 0xc8, 0x20, 0x7c, 0x48, 0x03, 0xaa, 0x22, 0x20, 0x7e, 0x23, 0xe3, 0xac, 0xff, 0x09, 0x43,
+	0xfe, 0x74, 0xd9, 0x19, 0xa2, 0x93, 0xc0, 0x88, 0xe3, 0x45, 0xcf, 0x68, 0x15, 0x8b, 0xe6, 0x17,
+	0xfe, 0x23, 0xeb, 0xf6, 0x22, 0x55, 0x3c, 0xd1, 0x79, 0x69, 0x15, 0x0b, 0x20, 0xff, 0x04, 0x3d,
+	0x83, 0x55, 0x41, 0x11, 0x81, 0x5b, 0xee, 0xd4, 0xa5, 0xb9, 0x5f, 0xf3, 0xa7, 0x70, 0x57, 0x27,
+	0x81, 0xcb, 0x02, 0xc6, 0x77, 0x8d, 0x0b, 0x57, 0x45, 0x66, 0x4c, 0xf9, 0x37, 0x5c, 0x83, 0xa9,
+	0xf8, 0xff, 0x65, 0xac, 0x0f, 0xd8, 0xa9, 0x93, 0xc0, 0x57, 0x38, 0x32, 0x9e, 0xfc, 0x2f, 0x1d,
+	0x7a, 0x04, 0x05, 0x11, 0x0f, 0x1a, 0x07, 0x79, 0x8b, 0xa9, 0xb9, 0x75, 0x41, 0xab, 0x0b, 0xa6,
+	0x79, 0xfa, 0xa9, 0x98, 0x8b, 0xd3, 0x3d, 0xf4, 0x02, 0xae, 0x44, 0xc0, 0xd4, 0xe8, 0x8c, 0x7a,
+	0x9f, 0x01, 0x6f, 0x5d, 0x0c, 0x3c, 0x39, 0x04, 0x77, 0x12, 0xb8, 0x1a, 0xaa, 0x08, 0x4f, 0x5b,
+	0x79, 0x58, 0xd1, 0xb9, 0x58, 0xab, 0xfa, 0xfd, 0xf9, 0xa6, 0xf4, 0xe7, 0xf3, 0x4d, 0xe9, 0x9f,
+	0xe7, 0x9b, 0xd2, 0x9b, 0x7f, 0x6d, 0x26, 0xbe, 0x4a, 0x0e, 0xbf, 0x7e, 0x9e, 0x65, 0x3c, 0x7f,
+	0xff, 0xdf, 0x03, 0x00, 0xb7, 0x17, 0x52, 0x6c, 0xe0, 0x1e, 0x00, 0x00,
 }
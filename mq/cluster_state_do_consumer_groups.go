@@ -45,6 +45,8 @@ func (s *ClusterState) doCreateConsumerGroup(cmd *ClusterCommandConsumerGroupCre
 	nextConsumerGroup.Bindings = cmd.ConsumerGroup.Bindings
 	nextConsumerGroup.Size_ = cmd.ConsumerGroup.Size_
 	nextConsumerGroup.Since = cmd.ConsumerGroup.Since
+	nextConsumerGroup.MaxPriority = cmd.ConsumerGroup.MaxPriority
+	nextConsumerGroup.SingleActiveConsumer = cmd.ConsumerGroup.SingleActiveConsumer
 
 	return next
 }
@@ -117,6 +119,25 @@ func (s *ClusterState) doUpdateOffsetCommits(cmd *ClusterCommandConsumerGroupOff
 	if consumerGroupIndex == -1 {
 		return s
 	}
+	offsetCommits := cmd.OffsetCommits
+	if consumerGroup.OffsetCommitsImportPending && !cmd.Imported {
+		// update based on offsets committed before the import => imported offsets win, only offset commits of new
+		// segments are added
+		m := make(map[uint64]bool, len(consumerGroup.OffsetCommits))
+		offsetCommits = make([]*ClusterConsumerGroup_OffsetCommit, 0, len(consumerGroup.OffsetCommits)+len(cmd.OffsetCommits))
+		for _, commit := range consumerGroup.OffsetCommits {
+			m[commit.SegmentID] = true
+			offsetCommits = append(offsetCommits, commit)
+		}
+		for _, commit := range cmd.OffsetCommits {
+			if !m[commit.SegmentID] && s.GetSegment(commit.SegmentID) != nil {
+				offsetCommits = append(offsetCommits, commit)
+			}
+		}
+		if len(offsetCommits) == len(consumerGroup.OffsetCommits) {
+			return s
+		}
+	}
 
 	next := &ClusterState{}
 	*next = *s
@@ -133,7 +154,11 @@ func (s *ClusterState) doUpdateOffsetCommits(cmd *ClusterCommandConsumerGroupOff
 	copy(nextNamespace.ConsumerGroups, namespace.ConsumerGroups)
 	nextNamespace.ConsumerGroups[consumerGroupIndex] = nextConsumerGroup
 
-	nextConsumerGroup.OffsetCommits = cmd.OffsetCommits
+	nextConsumerGroup.OffsetCommits = offsetCommits
+	if cmd.Imported {
+		nextConsumerGroup.OffsetCommitsGeneration++
+		nextConsumerGroup.OffsetCommitsImportPending = true
+	}
 
 	sort.Slice(nextConsumerGroup.OffsetCommits, func(i, j int) bool {
 		return nextConsumerGroup.OffsetCommits[i].SegmentID < nextConsumerGroup.OffsetCommits[j].SegmentID
@@ -141,3 +166,34 @@ func (s *ClusterState) doUpdateOffsetCommits(cmd *ClusterCommandConsumerGroupOff
 
 	return next
 }
+
+func (s *ClusterState) doCompleteOffsetCommitsImport(namespaceName string, consumerGroupName string) *ClusterState {
+	namespace, namespaceIndex := s.FindNamespace(namespaceName)
+	if namespace == nil {
+		return s
+	}
+
+	consumerGroup, consumerGroupIndex := namespace.FindConsumerGroup(consumerGroupName)
+	if consumerGroup == nil || !consumerGroup.OffsetCommitsImportPending {
+		return s
+	}
+
+	next := &ClusterState{}
+	*next = *s
+
+	nextNamespace := &ClusterNamespace{}
+	*nextNamespace = *namespace
+	next.Namespaces = make([]*ClusterNamespace, len(s.Namespaces))
+	copy(next.Namespaces, s.Namespaces)
+	next.Namespaces[namespaceIndex] = nextNamespace
+
+	nextConsumerGroup := &ClusterConsumerGroup{}
+	*nextConsumerGroup = *consumerGroup
+	nextNamespace.ConsumerGroups = make([]*ClusterConsumerGroup, len(namespace.ConsumerGroups))
+	copy(nextNamespace.ConsumerGroups, namespace.ConsumerGroups)
+	nextNamespace.ConsumerGroups[consumerGroupIndex] = nextConsumerGroup
+
+	nextConsumerGroup.OffsetCommitsImportPending = false
+
+	return next
+}
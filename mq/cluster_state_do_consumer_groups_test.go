@@ -0,0 +1,79 @@
+package mq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterState_UpdateOffsetCommitsImportPending(t *testing.T) {
+	assert := require.New(t)
+
+	state := &ClusterState{
+		Namespaces: []*ClusterNamespace{
+			{
+				Name: "default",
+				ConsumerGroups: []*ClusterConsumerGroup{
+					{
+						Name: "cg",
+						OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+							{SegmentID: 1, Offset: 10},
+							{SegmentID: 2, Offset: 20},
+						},
+					},
+				},
+			},
+		},
+		OpenSegments: []*ClusterSegment{
+			{ID: 2},
+			{ID: 3},
+		},
+		ClosedSegments: []*ClusterSegment{
+			{ID: 1},
+		},
+	}
+
+	state = state.doUpdateOffsetCommits(&ClusterCommandConsumerGroupOffsetCommitsUpdate{
+		Namespace: "default",
+		Name:      "cg",
+		OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+			{SegmentID: 1, Offset: 0},
+			{SegmentID: 2, Offset: 0},
+		},
+		Imported: true,
+	})
+	consumerGroup := state.GetConsumerGroup("default", "cg")
+	assert.True(consumerGroup.OffsetCommitsImportPending)
+	assert.Equal(uint64(1), consumerGroup.OffsetCommitsGeneration)
+
+	// offsets committed before the import don't overwrite imported ones
+	next := state.doUpdateOffsetCommits(&ClusterCommandConsumerGroupOffsetCommitsUpdate{
+		Namespace: "default",
+		Name:      "cg",
+		OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+			{SegmentID: 1, Offset: 15},
+			{SegmentID: 2, Offset: 25},
+		},
+	})
+	assert.True(next == state)
+
+	// new segments are still registered (new segment 3 is added, deleted segment 4 isn't)
+	state = state.doUpdateOffsetCommits(&ClusterCommandConsumerGroupOffsetCommitsUpdate{
+		Namespace: "default",
+		Name:      "cg",
+		OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+			{SegmentID: 1, Offset: 15},
+			{SegmentID: 2, Offset: 25},
+			{SegmentID: 3, Offset: 0},
+			{SegmentID: 4, Offset: 0},
+		},
+	})
+	consumerGroup = state.GetConsumerGroup("default", "cg")
+	assert.True(consumerGroup.OffsetCommitsImportPending)
+	assert.Equal(uint64(1), consumerGroup.OffsetCommitsGeneration)
+	assert.Equal([]*ClusterConsumerGroup_OffsetCommit{
+		{SegmentID: 1, Offset: 0},
+		{SegmentID: 2, Offset: 0},
+		{SegmentID: 3, Offset: 0},
+	}, consumerGroup.OffsetCommits)
+}
@@ -1,16 +1,32 @@
 package mq
 
 func (s *ClusterState) doCreateNamespace(cmd *ClusterCommandNamespaceCreate) *ClusterState {
-	namespace, _ := s.FindNamespace(cmd.Namespace)
+	namespace, namespaceIndex := s.FindNamespace(cmd.Namespace)
 	if namespace != nil {
-		return s
+		if namespace.RetentionBytes == cmd.RetentionBytes {
+			return s
+		}
+
+		next := &ClusterState{}
+		*next = *s
+
+		nextNamespace := &ClusterNamespace{}
+		*nextNamespace = *namespace
+		nextNamespace.RetentionBytes = cmd.RetentionBytes
+
+		next.Namespaces = make([]*ClusterNamespace, len(s.Namespaces))
+		copy(next.Namespaces, s.Namespaces)
+		next.Namespaces[namespaceIndex] = nextNamespace
+
+		return next
 	}
 
 	next := &ClusterState{}
 	*next = *s
 
 	nextNamespace := &ClusterNamespace{
-		Name: cmd.Namespace,
+		Name:           cmd.Namespace,
+		RetentionBytes: cmd.RetentionBytes,
 	}
 
 	next.Namespaces = make([]*ClusterNamespace, len(s.Namespaces)+1)
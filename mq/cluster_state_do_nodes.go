@@ -34,6 +34,9 @@ func (s *ClusterState) doUpdateNode(cmd *ClusterCommandNodeUpdate) *ClusterState
 	nextNode.Address = cmd.Address
 	nextNode.State = cmd.State
 	nextNode.LastSeenAlive = cmd.LastSeenAlive
+	nextNode.Zone = cmd.Zone
+	nextNode.DiskTotal = cmd.DiskTotal
+	nextNode.DiskFree = cmd.DiskFree
 
 	sort.Slice(next.Nodes, func(i, j int) bool {
 		return next.Nodes[i].ID < next.Nodes[j].ID
@@ -41,3 +44,30 @@ func (s *ClusterState) doUpdateNode(cmd *ClusterCommandNodeUpdate) *ClusterState
 
 	return next
 }
+
+func (s *ClusterState) doUpdateNodeAdminState(cmd *ClusterCommandNodeAdminStateUpdate) *ClusterState {
+	nodeIndex := -1
+	for i, node := range s.Nodes {
+		if node.ID == cmd.ID {
+			nodeIndex = i
+			break
+		}
+	}
+
+	if nodeIndex == -1 {
+		return s
+	}
+
+	next := &ClusterState{}
+	*next = *s
+
+	nextNode := &ClusterNode{}
+	*nextNode = *s.Nodes[nodeIndex]
+	nextNode.AdminState = cmd.AdminState
+
+	next.Nodes = make([]*ClusterNode, len(s.Nodes))
+	copy(next.Nodes, s.Nodes)
+	next.Nodes[nodeIndex] = nextNode
+
+	return next
+}
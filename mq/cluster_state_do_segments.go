@@ -75,6 +75,11 @@ func (s *ClusterState) doCloseSegment(cmd *ClusterCommandSegmentClose) *ClusterS
 		return next.ClosedSegments[i].ID < next.ClosedSegments[j].ID
 	})
 
+	if nextSegment.Type == ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS {
+		// offsets committed before the import were written to the closed segment => they won't be read anymore
+		next = next.doCompleteOffsetCommitsImport(nextSegment.OwnerNamespace, nextSegment.OwnerName)
+	}
+
 	return next
 }
 
@@ -97,6 +102,15 @@ func (s *ClusterState) doUpdateSegmentNodes(cmd *ClusterCommandSegmentNodesUpdat
 			return next
 		}
 
+	} else if cmd.Which == ClusterCommandSegmentNodesUpdate_REPLACING {
+		i := sort.Search(len(s.ReplacingSegments), func(i int) bool { return s.ReplacingSegments[i].ID >= cmd.ID })
+		if i < len(s.ReplacingSegments) && s.ReplacingSegments[i].ID == cmd.ID {
+			next := &ClusterState{}
+			*next = *s
+			next.ReplacingSegments = s.doUpdateSegmentNodesIn(s.ReplacingSegments, i, cmd)
+			return next
+		}
+
 	} else {
 		panic("unhandled which: " + cmd.Which.String())
 	}
@@ -142,9 +156,108 @@ func (s *ClusterState) doDeleteSegment(cmd *ClusterCommandSegmentDelete) *Cluste
 			return next
 		}
 
+	} else if cmd.Which == ClusterCommandSegmentDelete_REPLACING {
+		i := sort.Search(len(s.ReplacingSegments), func(i int) bool { return s.ReplacingSegments[i].ID >= cmd.ID })
+		if i < len(s.ReplacingSegments) && s.ReplacingSegments[i].ID == cmd.ID {
+			next := &ClusterState{}
+			*next = *s
+			next.ReplacingSegments = make([]*ClusterSegment, len(s.ReplacingSegments)-1)
+			copy(next.ReplacingSegments[:i], s.ReplacingSegments[:i])
+			copy(next.ReplacingSegments[i:], s.ReplacingSegments[i+1:])
+
+			return next
+		}
+
 	} else {
 		panic("unhandled which: " + cmd.Which.String())
 	}
 
 	return s
 }
+
+func (s *ClusterState) doCreateReplacingSegments(cmd *ClusterCommandSegmentsReplacingCreate) *ClusterState {
+	next := &ClusterState{}
+	*next = *s
+
+	next.ReplacingSegments = make([]*ClusterSegment, 0, len(s.ReplacingSegments)+len(cmd.Segments))
+	next.ReplacingSegments = append(next.ReplacingSegments, s.ReplacingSegments...)
+	for _, segment := range cmd.Segments {
+		next.ReplacingSegments = append(next.ReplacingSegments, segment)
+		if segment.ID > next.CurrentSegmentID {
+			next.CurrentSegmentID = segment.ID
+		}
+	}
+
+	sort.Slice(next.ReplacingSegments, func(i, j int) bool {
+		return next.ReplacingSegments[i].ID < next.ReplacingSegments[j].ID
+	})
+
+	return next
+}
+
+func (s *ClusterState) doReplaceSegments(cmd *ClusterCommandSegmentsReplace) *ClusterState {
+	replaced := make(map[uint64]bool)
+	for _, id := range cmd.ReplacedSegmentIDs {
+		replaced[id] = true
+	}
+	replacing := make(map[uint64]bool)
+	for _, segment := range cmd.Segments {
+		replacing[segment.ID] = true
+	}
+
+	next := &ClusterState{}
+	*next = *s
+
+	if len(s.ReplacingSegments) > 0 {
+		next.ReplacingSegments = make([]*ClusterSegment, 0, len(s.ReplacingSegments))
+		for _, segment := range s.ReplacingSegments {
+			if !replacing[segment.ID] {
+				next.ReplacingSegments = append(next.ReplacingSegments, segment)
+			}
+		}
+	}
+
+	next.ClosedSegments = make([]*ClusterSegment, 0, len(s.ClosedSegments)+len(cmd.Segments))
+	for _, segment := range s.ClosedSegments {
+		if !replaced[segment.ID] {
+			next.ClosedSegments = append(next.ClosedSegments, segment)
+		}
+	}
+	for _, segment := range cmd.Segments {
+		next.ClosedSegments = append(next.ClosedSegments, segment)
+		if segment.ID > next.CurrentSegmentID {
+			next.CurrentSegmentID = segment.ID
+		}
+	}
+
+	sort.Slice(next.ClosedSegments, func(i, j int) bool {
+		return next.ClosedSegments[i].ID < next.ClosedSegments[j].ID
+	})
+
+	for _, update := range cmd.OffsetCommitsUpdates {
+		next = next.doUpdateOffsetCommits(update)
+	}
+
+	return next
+}
+
+func (s *ClusterState) doOffloadSegment(cmd *ClusterCommandSegmentOffload) *ClusterState {
+	i := sort.Search(len(s.ClosedSegments), func(i int) bool { return s.ClosedSegments[i].ID >= cmd.ID })
+	if i >= len(s.ClosedSegments) || s.ClosedSegments[i].ID != cmd.ID {
+		return s
+	}
+
+	next := &ClusterState{}
+	*next = *s
+	next.ClosedSegments = make([]*ClusterSegment, len(s.ClosedSegments))
+	copy(next.ClosedSegments, s.ClosedSegments)
+
+	nextSegment := &ClusterSegment{}
+	*nextSegment = *s.ClosedSegments[i]
+	nextSegment.OffloadedAt = cmd.OffloadedAt
+	// data are in tiered storage => no node holds the segment anymore & local copies get garbage collected
+	nextSegment.Nodes = ClusterSegment_Nodes{}
+	next.ClosedSegments[i] = nextSegment
+
+	return next
+}
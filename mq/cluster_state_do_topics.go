@@ -42,6 +42,12 @@ func (s *ClusterState) doCreateTopic(cmd *ClusterCommandTopicCreate) *ClusterSta
 	nextTopic.Shards = cmd.Topic.Shards
 	nextTopic.ReplicationFactor = cmd.Topic.ReplicationFactor
 	nextTopic.Retention = cmd.Topic.Retention
+	nextTopic.IndexedHeaders = cmd.Topic.IndexedHeaders
+	nextTopic.PartitionKey = cmd.Topic.PartitionKey
+	nextTopic.DeduplicationWindow = cmd.Topic.DeduplicationWindow
+	nextTopic.CompactionKey = cmd.Topic.CompactionKey
+	nextTopic.RetentionBytes = cmd.Topic.RetentionBytes
+	nextTopic.ForceRetentionBytes = cmd.Topic.ForceRetentionBytes
 
 	return next
 }
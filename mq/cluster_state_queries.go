@@ -126,6 +126,16 @@ func (s *ClusterState) GetClosedSegment(id uint64) *ClusterSegment {
 	return nil
 }
 
+func (s *ClusterState) GetReplacingSegment(id uint64) *ClusterSegment {
+	i := sort.Search(len(s.ReplacingSegments), func(i int) bool { return s.ReplacingSegments[i].ID >= id })
+
+	if i < len(s.ReplacingSegments) && s.ReplacingSegments[i].ID == id {
+		return s.ReplacingSegments[i]
+	}
+
+	return nil
+}
+
 func (s *ClusterState) GetSegment(id uint64) *ClusterSegment {
 	if segment := s.GetOpenSegment(id); segment != nil {
 		return segment
@@ -139,7 +149,7 @@ func (s *ClusterState) GetSegment(id uint64) *ClusterSegment {
 func (s *ClusterState) CountSegmentsPerNode() map[uint64]int {
 	m := make(map[uint64]int)
 
-	for _, segments := range [][]*ClusterSegment{s.OpenSegments, s.ClosedSegments} {
+	for _, segments := range [][]*ClusterSegment{s.OpenSegments, s.ClosedSegments, s.ReplacingSegments} {
 		for _, segment := range segments {
 			if segment.Nodes.PrimaryNodeID > 0 {
 				m[segment.Nodes.PrimaryNodeID]++
@@ -156,6 +166,32 @@ func (s *ClusterState) CountSegmentsPerNode() map[uint64]int {
 	return m
 }
 
+// Counts open & closed segments the node is primary, replica or done node for.
+func (s *ClusterState) CountSegmentsIn(nodeID uint64) (open int, closed int) {
+	for _, segment := range s.OpenSegments {
+		if segment.Nodes.Contains(nodeID) {
+			open++
+		}
+	}
+	for _, segment := range s.ClosedSegments {
+		if segment.Nodes.Contains(nodeID) {
+			closed++
+		}
+	}
+	return open, closed
+}
+
+// Counts nodes that are alive among the given ones.
+func (s *ClusterState) CountAliveNodes(nodeIDs ...uint64) int {
+	n := 0
+	for _, nodeID := range nodeIDs {
+		if node := s.GetNode(nodeID); node != nil && node.State == ClusterNode_ALIVE {
+			n++
+		}
+	}
+	return n
+}
+
 func (s *ClusterState) GetNode(nodeID uint64) *ClusterNode {
 	for _, node := range s.Nodes {
 		if node.ID == nodeID {
@@ -192,3 +228,34 @@ func (n *ClusterNamespace) FindConsumerGroup(name string) (*ClusterConsumerGroup
 	}
 	return nil, -1
 }
+
+func (n *ClusterSegment_Nodes) Contains(nodeID uint64) bool {
+	if n.PrimaryNodeID == nodeID {
+		return true
+	}
+	for _, id := range n.ReplicatingNodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	for _, id := range n.DoneNodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+// Node is active if it is alive and not being drained, i.e. it is eligible to hold segments.
+func (n *ClusterNode) IsActive() bool {
+	return n.State == ClusterNode_ALIVE && n.AdminState == ClusterNode_ACTIVE
+}
+
+// Node is considered full if fraction of used disk space is above the watermark. Nodes with unknown disk usage are
+// never full.
+func (n *ClusterNode) IsDiskFull(highWatermark float64) bool {
+	if n.DiskTotal == 0 {
+		return false
+	}
+	return 1-float64(n.DiskFree)/float64(n.DiskTotal) > highWatermark
+}
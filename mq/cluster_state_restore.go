@@ -0,0 +1,165 @@
+package mq
+
+import (
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+// Prepares cluster state from backup to be restored on a single fresh node. Nodes, open & replacing segments are
+// dropped, closed segments that were restored are placed on the node (other nodes get replicas after they join the
+// cluster), unless they're offloaded to tiered storage, & consumer group offset commits are limited to restored
+// segments.
+func (s *ClusterState) ForRestore(nodeID uint64, restoredSegmentIDs map[uint64]bool) *ClusterState {
+	next := &ClusterState{}
+	*next = *s
+
+	next.Nodes = nil
+	next.OpenSegments = nil
+	next.ReplacingSegments = nil
+
+	next.ClosedSegments = make([]*ClusterSegment, 0, len(s.ClosedSegments))
+	for _, segment := range s.ClosedSegments {
+		if !restoredSegmentIDs[segment.ID] {
+			continue
+		}
+
+		nextSegment := &ClusterSegment{}
+		*nextSegment = *segment
+		nextSegment.Nodes = ClusterSegment_Nodes{}
+		if segment.OffloadedAt.IsZero() {
+			nextSegment.Nodes.DoneNodeIDs = []uint64{nodeID}
+		}
+		next.ClosedSegments = append(next.ClosedSegments, nextSegment)
+	}
+
+	next.Namespaces = make([]*ClusterNamespace, len(s.Namespaces))
+	for i, namespace := range s.Namespaces {
+		nextNamespace := &ClusterNamespace{}
+		*nextNamespace = *namespace
+
+		nextNamespace.ConsumerGroups = make([]*ClusterConsumerGroup, len(namespace.ConsumerGroups))
+		for j, consumerGroup := range namespace.ConsumerGroups {
+			nextConsumerGroup := &ClusterConsumerGroup{}
+			*nextConsumerGroup = *consumerGroup
+
+			nextConsumerGroup.OffsetCommits = nil
+			for _, commit := range consumerGroup.OffsetCommits {
+				if restoredSegmentIDs[commit.SegmentID] {
+					nextConsumerGroup.OffsetCommits = append(nextConsumerGroup.OffsetCommits, commit)
+				}
+			}
+
+			nextNamespace.ConsumerGroups[j] = nextConsumerGroup
+		}
+
+		next.Namespaces[i] = nextNamespace
+	}
+
+	return next
+}
+
+// Writes Raft snapshot with given cluster state into the directory, so that node started with the directory forms
+// a single-node cluster with the state.
+func WriteRestoreSnapshot(raftDir string, nodeID uint64, address string, state *ClusterState) error {
+	snapshotStore, err := raft.NewFileSnapshotStore(raftDir, 2, ioutil.Discard)
+	if err != nil {
+		return errors.Wrap(err, "snapshot store failed")
+	}
+
+	snapshots, err := snapshotStore.List()
+	if err != nil {
+		return errors.Wrap(err, "list snapshots failed")
+	}
+	if len(snapshots) > 0 {
+		return errors.Errorf("directory %s already contains snapshot(s)", raftDir)
+	}
+
+	index := state.Index
+	if index == 0 {
+		index = 1
+	}
+
+	configuration := raft.Configuration{
+		Servers: []raft.Server{
+			{
+				Suffrage: raft.Voter,
+				ID:       raft.ServerID(NodeIDToString(nodeID)),
+				Address:  raft.ServerAddress(address),
+			},
+		},
+	}
+
+	sink, err := snapshotStore.Create(raft.SnapshotVersionMax, index, 1, configuration, index, &RaftRPCTransport{})
+	if err != nil {
+		return errors.Wrap(err, "snapshot create failed")
+	}
+
+	buf, err := proto.Marshal(state)
+	if err != nil {
+		sink.Cancel()
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	if _, err := sink.Write(buf); err != nil {
+		sink.Cancel()
+		return errors.Wrap(err, "snapshot write failed")
+	}
+
+	return errors.Wrap(sink.Close(), "snapshot close failed")
+}
+
+// Reads cluster state of a stopped node from its Raft directory: the latest snapshot is restored & entries from log
+// store following the snapshot are applied on top of it. Entries not committed yet by the cluster are applied as well,
+// therefore the state might be slightly ahead of what the cluster agreed on.
+func ReadClusterState(raftDir string, logs raft.LogStore) (*ClusterState, error) {
+	store := NewClusterStateStore()
+
+	snapshotStore, err := raft.NewFileSnapshotStore(raftDir, 2, ioutil.Discard)
+	if err != nil {
+		return nil, errors.Wrap(err, "snapshot store failed")
+	}
+
+	snapshots, err := snapshotStore.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "list snapshots failed")
+	}
+
+	snapshotIndex := uint64(0)
+	if len(snapshots) > 0 {
+		meta, r, err := snapshotStore.Open(snapshots[0].ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "snapshot open failed")
+		}
+		if err := store.Restore(r); err != nil {
+			return nil, errors.Wrap(err, "snapshot restore failed")
+		}
+		snapshotIndex = meta.Index
+	}
+
+	firstIndex, err := logs.FirstIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "first index failed")
+	}
+	lastIndex, err := logs.LastIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "last index failed")
+	}
+	if firstIndex <= snapshotIndex {
+		firstIndex = snapshotIndex + 1
+	}
+
+	for index := firstIndex; index != 0 && index <= lastIndex; index++ {
+		entry := &raft.Log{}
+		if err := logs.GetLog(index, entry); err != nil {
+			return nil, errors.Wrapf(err, "get log %d failed", index)
+		}
+		if entry.Type == raft.LogCommand {
+			store.Apply(entry)
+		}
+	}
+
+	return store.Current(), nil
+}
@@ -0,0 +1,93 @@
+package mq
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterState_ForRestore(t *testing.T) {
+	assert := require.New(t)
+
+	state := &ClusterState{
+		Index:            10,
+		CurrentSegmentID: 3,
+		Nodes:            []*ClusterNode{{ID: 100}, {ID: 200}},
+		Namespaces: []*ClusterNamespace{
+			{
+				Name: "default",
+				ConsumerGroups: []*ClusterConsumerGroup{
+					{
+						Name: "cg",
+						OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+							{SegmentID: 1, Offset: 10},
+							{SegmentID: 2, Offset: 20},
+						},
+					},
+				},
+			},
+		},
+		OpenSegments: []*ClusterSegment{
+			{ID: 3, Nodes: ClusterSegment_Nodes{PrimaryNodeID: 100}},
+		},
+		ClosedSegments: []*ClusterSegment{
+			{ID: 1, Nodes: ClusterSegment_Nodes{DoneNodeIDs: []uint64{100, 200}}},
+			{ID: 2, Nodes: ClusterSegment_Nodes{DoneNodeIDs: []uint64{200}}},
+		},
+	}
+
+	next := state.ForRestore(300, map[uint64]bool{1: true})
+
+	assert.Equal(uint64(10), next.Index)
+	assert.Equal(uint64(3), next.CurrentSegmentID)
+	assert.Empty(next.Nodes)
+	assert.Empty(next.OpenSegments)
+	assert.Len(next.ClosedSegments, 1)
+	assert.Equal(uint64(1), next.ClosedSegments[0].ID)
+	assert.Equal([]uint64{300}, next.ClosedSegments[0].Nodes.DoneNodeIDs)
+	assert.Len(next.Namespaces[0].ConsumerGroups[0].OffsetCommits, 1)
+	assert.Equal(uint64(1), next.Namespaces[0].ConsumerGroups[0].OffsetCommits[0].SegmentID)
+
+	// original state must not be modified
+	assert.Len(state.Nodes, 2)
+	assert.Len(state.ClosedSegments, 2)
+	assert.Equal([]uint64{100, 200}, state.ClosedSegments[0].Nodes.DoneNodeIDs)
+	assert.Len(state.Namespaces[0].ConsumerGroups[0].OffsetCommits, 2)
+}
+
+func TestWriteRestoreSnapshot(t *testing.T) {
+	assert := require.New(t)
+
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	state := &ClusterState{
+		Index:      10,
+		Namespaces: []*ClusterNamespace{{Name: "default"}},
+	}
+
+	assert.NoError(WriteRestoreSnapshot(dir, 300, "127.0.0.1:16000", state))
+	assert.Error(WriteRestoreSnapshot(dir, 300, "127.0.0.1:16000", state))
+
+	snapshotStore, err := raft.NewFileSnapshotStore(dir, 2, ioutil.Discard)
+	assert.NoError(err)
+	snapshots, err := snapshotStore.List()
+	assert.NoError(err)
+	assert.Len(snapshots, 1)
+	assert.Equal(uint64(10), snapshots[0].Index)
+	assert.Len(snapshots[0].Configuration.Servers, 1)
+	assert.Equal(raft.ServerID(NodeIDToString(300)), snapshots[0].Configuration.Servers[0].ID)
+
+	_, r, err := snapshotStore.Open(snapshots[0].ID)
+	assert.NoError(err)
+
+	store := NewClusterStateStore()
+	assert.NoError(store.Restore(r))
+	assert.Equal(uint64(10), store.Current().Index)
+	namespace, _ := store.Current().FindNamespace("default")
+	assert.NotNil(namespace)
+}
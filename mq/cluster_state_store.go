@@ -72,8 +72,16 @@ func (s *ClusterStateStore) Do(index uint64, cmd *ClusterCommand) {
 				next = state.doUpdateSegmentNodes(cmd.UpdateSegmentNodes)
 			case *ClusterCommand_DeleteSegment:
 				next = state.doDeleteSegment(cmd.DeleteSegment)
+			case *ClusterCommand_ReplaceSegments:
+				next = state.doReplaceSegments(cmd.ReplaceSegments)
+			case *ClusterCommand_OffloadSegment:
+				next = state.doOffloadSegment(cmd.OffloadSegment)
+			case *ClusterCommand_CreateReplacingSegments:
+				next = state.doCreateReplacingSegments(cmd.CreateReplacingSegments)
 			case *ClusterCommand_UpdateNode:
 				next = state.doUpdateNode(cmd.UpdateNode)
+			case *ClusterCommand_UpdateNodeAdminState:
+				next = state.doUpdateNodeAdminState(cmd.UpdateNodeAdminState)
 			default:
 				panic(errors.Errorf("unhandled command of type [%T]", cmd))
 			}
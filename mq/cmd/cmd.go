@@ -3,9 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"log"
 	"math/rand"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -17,17 +17,42 @@ import (
 	"eventter.io/mq/about"
 	"eventter.io/mq/amqp"
 	"eventter.io/mq/emq"
+	"eventter.io/mq/gateway"
+	"eventter.io/mq/logging"
 	"eventter.io/mq/sasl"
 	"eventter.io/mq/segments"
+	"eventter.io/mq/tiered"
+	"eventter.io/mq/tracing"
+	"eventter.io/mq/webui"
 	"github.com/bbva/raft-badger"
 	"github.com/hashicorp/memberlist"
 	"github.com/hashicorp/raft"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-var rootConfig = &mq.Config{}
+var (
+	rootConfig    = &mq.Config{}
+	rootLogLevel  string
+	rootLogFormat string
+)
+
+func newLogger() (logging.Logger, error) {
+	level, err := logging.ParseLevel(rootLogLevel)
+	if err != nil {
+		return nil, err
+	}
+	format, err := logging.ParseFormat(rootLogFormat)
+	if err != nil {
+		return nil, err
+	}
+	return logging.New(os.Stderr, level, format), nil
+}
 
 func newClient(ctx context.Context) (emq.Client, error) {
 	return emq.DialContext(ctx, fmt.Sprintf("%s:%d", rootConfig.BindHost, rootConfig.Port), grpc.WithInsecure())
@@ -39,6 +64,35 @@ func (*allowAllDirectory) Verify(ctx context.Context, username, password string)
 	return true, nil
 }
 
+func resolveAdvertiseIP(host string) (net.IP, error) {
+	advertiseIPs, err := net.LookupIP(host)
+	if err != nil {
+		return nil, errors.Wrap(err, "advertise host lookup failed")
+	}
+	for _, candidateIp := range advertiseIPs {
+		if ip4 := candidateIp.To4(); ip4 != nil {
+			return ip4, nil
+		}
+	}
+	return advertiseIPs[0], nil
+}
+
+// Opens tiered storage configured by flags, returns nil if it's not configured.
+func openTieredStorage() (tiered.Storage, error) {
+	if rootConfig.TieredStorageURL == "" {
+		return nil, nil
+	}
+	tieredStorage, err := tiered.Open(rootConfig.TieredStorageURL, tiered.Options{
+		Region:    rootConfig.TieredStorageRegion,
+		AccessKey: rootConfig.TieredStorageAccessKey,
+		SecretKey: rootConfig.TieredStorageSecretKey,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open tiered storage")
+	}
+	return tieredStorage, nil
+}
+
 func Cmd() *cobra.Command {
 	var join []string
 
@@ -51,39 +105,35 @@ func Cmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			rand.Seed(time.Now().UnixNano())
 
-			advertiseIPs, err := net.LookupIP(rootConfig.AdvertiseHost)
+			logger, err := newLogger()
 			if err != nil {
-				return errors.Wrap(err, "advertise host lookup failed")
-			}
-			var advertiseIP net.IP
-			for _, candidateIp := range advertiseIPs {
-				if ip4 := candidateIp.To4(); ip4 != nil {
-					advertiseIP = ip4
-					break
-				}
+				return err
 			}
-			if advertiseIP == nil {
-				advertiseIP = advertiseIPs[0]
+
+			advertiseIP, err := resolveAdvertiseIP(rootConfig.AdvertiseHost)
+			if err != nil {
+				return err
 			}
 
 			grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(64 * 1024 * 1024))
 
 			clientPool := mq.NewClientConnPool(30*time.Second, grpc.WithInsecure())
 
-			discoveryTransport, err := mq.NewDiscoveryRPCTransport(rootConfig.BindHost, rootConfig.Port, clientPool)
+			discoveryTransport, err := mq.NewDiscoveryRPCTransport(rootConfig.BindHost, rootConfig.Port, clientPool, logger)
 			if err != nil {
 				return errors.Wrap(err, "discovery transport failed")
 			}
 			defer discoveryTransport.Shutdown()
 			mq.RegisterDiscoveryRPCServer(grpcServer, discoveryTransport)
 
-			raftTransport := mq.NewRaftRPCTransport(advertiseIP, rootConfig.Port, clientPool)
+			raftTransport := mq.NewRaftRPCTransport(advertiseIP, rootConfig.Port, clientPool, logger)
 			mq.RegisterRaftRPCServer(grpcServer, raftTransport)
 
 			nodeName := mq.NodeIDToString(rootConfig.ID)
 
 			raftConfig := raft.DefaultConfig()
 			raftConfig.LocalID = raft.ServerID(nodeName)
+			raftConfig.Logger = logging.NewStdLogger(logger)
 
 			raftDir := filepath.Join(rootConfig.Dir, "raft")
 			if err := os.MkdirAll(raftDir, rootConfig.DirPerm); err != nil {
@@ -102,7 +152,7 @@ func Cmd() *cobra.Command {
 			}
 			defer raftStableStore.Close()
 
-			raftSnapshotStore, err := raft.NewFileSnapshotStore(raftDir, 2, os.Stdout)
+			raftSnapshotStore, err := raft.NewFileSnapshotStore(raftDir, 2, logging.NewWriter(logger, logging.InfoLevel))
 			if err != nil {
 				return errors.Wrap(err, "could not open raft snapshot store")
 			}
@@ -141,20 +191,78 @@ func Cmd() *cobra.Command {
 			listConfig.Transport = discoveryTransport
 			listConfig.AdvertiseAddr = advertiseIP.String()
 			listConfig.AdvertisePort = rootConfig.Port
+			listConfig.Logger = logging.NewStdLogger(logger)
 			memberEventC := make(chan memberlist.NodeEvent, 128)
 			listConfig.Events = &memberlist.ChannelEventDelegate{Ch: memberEventC}
+			discoveryDelegate, err := mq.NewDiscoveryDelegate(&mq.DiscoveryNodeMeta{Zone: rootConfig.Zone}, logger)
+			if err != nil {
+				return errors.Wrap(err, "could not create node discovery delegate")
+			}
+			listConfig.Delegate = discoveryDelegate
 			members, err := memberlist.Create(listConfig)
 			if err != nil {
 				return errors.Wrap(err, "could not start node discovery")
 			}
 			defer members.Shutdown()
 
-			server := mq.NewServer(rootConfig.ID, members, raftNode, clientPool, clusterState, segmentDir)
+			var exporters []tracing.Exporter
+			if rootConfig.TracingEndpoint != "" {
+				exporter, err := tracing.NewOTLPExporter(
+					rootConfig.TracingEndpoint,
+					map[string]interface{}{
+						"service.name":        about.Name,
+						"service.version":     about.Version,
+						"service.instance.id": nodeName,
+					},
+					func(err error) {
+						logger.Warn("could not export spans", logging.Error(err))
+					},
+				)
+				if err != nil {
+					return errors.Wrap(err, "tracing exporter failed")
+				}
+				exporters = append(exporters, exporter)
+			}
+			if rootConfig.TracingStdout {
+				exporters = append(exporters, tracing.NewWriterExporter(os.Stdout))
+			}
+			var tracer *tracing.Tracer
+			if len(exporters) > 0 {
+				tracer = tracing.NewTracer(tracing.NewMultiExporter(exporters...))
+				defer tracer.Close()
+			}
+
+			tieredStorage, err := openTieredStorage()
+			if err != nil {
+				return err
+			}
+
+			server := mq.NewServer(rootConfig, logger, tracer, members, discoveryDelegate, raftNode, clientPool, clusterState, segmentDir, tieredStorage)
 			go server.Loop(memberEventC)
 			defer server.Close()
 
+			if rootConfig.MetricsAddress != "" {
+				prometheus.MustRegister(server)
+				metricsListener, err := net.Listen("tcp", rootConfig.MetricsAddress)
+				if err != nil {
+					return errors.Wrap(err, "metrics listen failed")
+				}
+				defer metricsListener.Close()
+				metricsMux := http.NewServeMux()
+				metricsMux.Handle("/metrics", promhttp.Handler())
+				metricsMux.Handle("/healthz", server.HealthHandler())
+				metricsMux.Handle("/readyz", server.HealthHandler())
+				metricsServer := &http.Server{Handler: metricsMux}
+				go metricsServer.Serve(metricsListener)
+				defer metricsServer.Close()
+				logger.Info("metrics server started", logging.F("address", metricsListener.Addr().String()))
+			}
+
 			emq.RegisterEventterMQServer(grpcServer, server)
 			mq.RegisterNodeRPCServer(grpcServer, server)
+			healthServer := health.NewServer()
+			healthpb.RegisterHealthServer(grpcServer, healthServer)
+			go server.WatchHealth(healthServer)
 
 			grpcListener, err := net.Listen("tcp", rootConfig.BindHost+":"+strconv.Itoa(rootConfig.Port))
 			if err != nil {
@@ -163,7 +271,7 @@ func Cmd() *cobra.Command {
 			defer grpcListener.Close()
 			go grpcServer.Serve(grpcListener)
 			defer grpcServer.Stop()
-			log.Println("gRPC server started on", grpcListener.Addr())
+			logger.Info("gRPC server started", logging.F("address", grpcListener.Addr().String()))
 
 			if len(join) == 0 {
 				hasExistingState, err := raft.HasExistingState(raftLogStore, raftStableStore, raftSnapshotStore)
@@ -184,7 +292,7 @@ func Cmd() *cobra.Command {
 						return errors.Wrap(err, "bootstrap failed")
 					}
 
-					log.Printf("cluster bootstrapped")
+					logger.Info("cluster bootstrapped")
 				}
 			} else {
 				_, err = members.Join(join)
@@ -205,6 +313,7 @@ func Cmd() *cobra.Command {
 				CapabilitiesV0: []string{"basic.nack"},
 				HandlerV0:      server,
 				HandlerV1:      server,
+				Logger:         logger,
 				SASLProviders: []sasl.Provider{
 					sasl.NewANONYMOUS(),
 					sasl.NewPLAIN(allowAll),
@@ -213,13 +322,43 @@ func Cmd() *cobra.Command {
 			}
 			go amqpServer.Serve(amqpListener)
 			defer amqpServer.Close()
-			log.Println("AMQP server started at", amqpListener.Addr())
+			logger.Info("AMQP server started", logging.F("address", amqpListener.Addr().String()))
+
+			if rootConfig.HTTPPort > 0 {
+				gatewayHost := rootConfig.BindHost
+				if gatewayHost == "" || gatewayHost == "0.0.0.0" || gatewayHost == "[::]" {
+					gatewayHost = "localhost"
+				}
+				gatewayConn, err := grpc.Dial(gatewayHost+":"+strconv.Itoa(rootConfig.Port), grpc.WithInsecure())
+				if err != nil {
+					return errors.Wrap(err, "gateway dial failed")
+				}
+				defer gatewayConn.Close()
+				gatewayHandler, err := gateway.New(gatewayConn)
+				if err != nil {
+					return errors.Wrap(err, "gateway init failed")
+				}
+				httpListener, err := net.Listen("tcp", rootConfig.BindHost+":"+strconv.Itoa(rootConfig.HTTPPort))
+				if err != nil {
+					return errors.Wrap(err, "http listen failed")
+				}
+				defer httpListener.Close()
+				httpMux := http.NewServeMux()
+				httpMux.Handle("/", gatewayHandler)
+				httpMux.Handle("/_ui/", http.StripPrefix("/_ui", webui.New()))
+				httpMux.Handle("/healthz", server.HealthHandler())
+				httpMux.Handle("/readyz", server.HealthHandler())
+				httpServer := &http.Server{Handler: httpMux}
+				go httpServer.Serve(httpListener)
+				defer httpServer.Close()
+				logger.Info("HTTP gateway started", logging.F("address", httpListener.Addr().String()), logging.F("ui", "/_ui/"))
+			}
 
 			interrupt := make(chan os.Signal, 1)
 			signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
 			<-interrupt
 
-			log.Print("gracefully shutting down")
+			logger.Info("gracefully shutting down")
 
 			gracefulShutdownTimeout := 10 * time.Second
 			gracefulShutdownCtx, gracefulShutdownCancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
@@ -246,14 +385,27 @@ func Cmd() *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&rootConfig.BindHost, "host", "", "Node host.")
 	cmd.PersistentFlags().IntVar(&rootConfig.Port, "port", 16000, "Node port.")
+	cmd.PersistentFlags().StringVar(&rootLogLevel, "log-level", "info", "Log level (debug, info, warn, error).")
+	cmd.PersistentFlags().StringVar(&rootLogFormat, "log-format", "text", "Log format (text, json).")
 	cmd.Flags().Uint64Var(&rootConfig.ID, "id", 0, "Node ID. Must be unique across cluster & stable.")
 	cmd.Flags().StringVar(&rootConfig.AdvertiseHost, "advertise-host", "", "Host that will the node advertise to others.")
 	cmd.Flags().IntVar(&rootConfig.AMQPPort, "amqp-port", 0, "AMQP port. If not specified, defaults to `port + 1`.")
+	cmd.Flags().IntVar(&rootConfig.HTTPPort, "http-port", 0, "HTTP/JSON gateway, web UI & health probes (/healthz, /readyz) port. If not specified, defaults to `port + 2`. Negative value disables the gateway.")
 	cmd.Flags().StringVar(&rootConfig.Dir, "dir", "", "Persistent data directory.")
 	cmd.Flags().Uint32Var((*uint32)(&rootConfig.DirPerm), "dir-perm", 0755, "Persistent data directory permissions.")
+	cmd.Flags().StringVar(&rootConfig.Zone, "zone", "", "Zone (or rack) the node is located in. Segment replicas are spread across distinct zones.")
+	cmd.Flags().Float64Var(&rootConfig.DiskHighWatermark, "disk-high-watermark", 0.85, "Fraction of used disk space above which node does not receive new segments & segment replicas.")
+	cmd.Flags().Uint64Var(&rootConfig.DiskCriticalFree, "disk-critical-free", 256*1024*1024, "Free disk space (in bytes) below which node rejects publishing.")
+	cmd.Flags().StringVar(&rootConfig.MetricsAddress, "metrics-address", "", "Address (host:port) of HTTP server exposing Prometheus metrics at /metrics & health probes at /healthz & /readyz. If not specified, metrics are not exposed.")
+	cmd.Flags().StringVar(&rootConfig.TracingEndpoint, "tracing-endpoint", "", "OTLP/HTTP endpoint (e.g. http://localhost:4318) trace spans are exported to. If not specified, spans are not exported over OTLP.")
+	cmd.Flags().BoolVar(&rootConfig.TracingStdout, "tracing-stdout", false, "Write trace spans to stdout as JSON lines.")
+	cmd.Flags().StringVar(&rootConfig.TieredStorageURL, "tiered-storage", "", "URL of tiered storage closed segments are offloaded to - either S3-compatible object storage (http(s)://host:port/bucket[/prefix]), or directory (file:///path). All nodes must use the same tiered storage.")
+	addTieredStorageCredentialsFlags(cmd)
+	cmd.Flags().DurationVar(&rootConfig.TieredStorageAge, "tiered-storage-age", 0, "Age (since closing) after which closed segments are offloaded to tiered storage & deleted from local disks. Zero means segments aren't offloaded.")
 	cmd.Flags().StringSliceVar(&join, "join", nil, "Running peers to join.")
 
 	cmd.AddCommand(
+		backupCmd(),
 		createConsumerGroupCmd(),
 		createNamespaceCmd(),
 		createTopicCmd(),
@@ -261,11 +413,24 @@ func Cmd() *cobra.Command {
 		deleteConsumerGroupCmd(),
 		deleteNamespaceCmd(),
 		deleteTopicCmd(),
+		describeCmd(),
 		listConsumerGroupsCmd(),
 		listTopicsCmd(),
+		nodeCmd(),
+		offsetsCmd(),
 		publishCmd(),
+		rebalanceStatusCmd(),
+		restoreCmd(),
+		searchCmd(),
+		segmentCmd(),
 		subscribeCmd(),
 	)
 
 	return cmd
 }
+
+func addTieredStorageCredentialsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&rootConfig.TieredStorageRegion, "tiered-storage-region", "us-east-1", "Region of S3-compatible tiered storage.")
+	cmd.Flags().StringVar(&rootConfig.TieredStorageAccessKey, "tiered-storage-access-key", "", "Access key of S3-compatible tiered storage. If not specified, defaults to AWS_ACCESS_KEY_ID environment variable.")
+	cmd.Flags().StringVar(&rootConfig.TieredStorageSecretKey, "tiered-storage-secret-key", "", "Secret key of S3-compatible tiered storage. If not specified, defaults to AWS_SECRET_ACCESS_KEY environment variable.")
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"eventter.io/mq"
+	"eventter.io/mq/logging"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	backupClusterStateFile = "cluster_state.pb"
+	backupSegmentsDir      = "segments"
+)
+
+func backupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup <output>",
+		Short: "Back up cluster state & closed segments to directory, or tarball if output ends with .tar, .tar.gz or .tgz.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			logger, err := newLogger()
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			dialCtx, dialCancel := context.WithTimeout(ctx, 1*time.Minute)
+			defer dialCancel()
+			conn, err := grpc.DialContext(dialCtx, fmt.Sprintf("%s:%d", rootConfig.BindHost, rootConfig.Port), grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			response, err := mq.NewNodeRPCClient(conn).ClusterStateSnapshot(dialCtx, &mq.ClusterStateSnapshotRequest{})
+			if err != nil {
+				return err
+			}
+			state := response.ClusterState
+
+			output := args[0]
+			dir := output
+			if isTarball(output) {
+				dir, err = ioutil.TempDir("", "eventtermq-backup")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+			}
+
+			if err := os.MkdirAll(filepath.Join(dir, backupSegmentsDir), 0755); err != nil {
+				return err
+			}
+
+			conns := make(map[uint64]*grpc.ClientConn)
+			defer func() {
+				for _, conn := range conns {
+					conn.Close()
+				}
+			}()
+			nodeClient := func(nodeID uint64) (mq.NodeRPCClient, error) {
+				if conn, ok := conns[nodeID]; ok {
+					return mq.NewNodeRPCClient(conn), nil
+				}
+				node := state.GetNode(nodeID)
+				if node == nil {
+					return nil, errors.Errorf("node %d not found", nodeID)
+				}
+				dialCtx, dialCancel := context.WithTimeout(ctx, 1*time.Minute)
+				defer dialCancel()
+				conn, err := grpc.DialContext(dialCtx, node.Address, grpc.WithInsecure())
+				if err != nil {
+					return nil, errors.Wrapf(err, "dial node %d failed", nodeID)
+				}
+				conns[nodeID] = conn
+				return mq.NewNodeRPCClient(conn), nil
+			}
+
+			// offsets written to open consumer group segments since their last rotation are not in cluster state yet
+			for _, segment := range state.OpenSegments {
+				if segment.Type != mq.ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS {
+					continue
+				}
+				consumerGroup := state.GetConsumerGroup(segment.OwnerNamespace, segment.OwnerName)
+				if consumerGroup == nil {
+					continue
+				}
+				client, err := nodeClient(segment.Nodes.PrimaryNodeID)
+				if err != nil {
+					return err
+				}
+				if err := backupOffsetCommits(ctx, client, segment.ID, consumerGroup); err != nil {
+					return errors.Wrapf(err, "consumer group %s/%s offsets backup failed", segment.OwnerNamespace, segment.OwnerName)
+				}
+			}
+
+			n := 0
+			for _, segment := range state.ClosedSegments {
+				if segment.Type != mq.ClusterSegment_TOPIC || !segment.OffloadedAt.IsZero() {
+					// offloaded segments are kept by tiered storage, cluster state only references them
+					continue
+				}
+
+				path := filepath.Join(dir, backupSegmentsDir, backupSegmentFileName(segment.ID))
+				if err := verifySegmentFile(path, segment); err == nil {
+					n++
+					continue
+				}
+
+				var lastErr error
+				for _, nodeID := range segment.Nodes.DoneNodeIDs {
+					client, err := nodeClient(nodeID)
+					if err == nil {
+						err = backupSegment(ctx, client, segment, path)
+					}
+					if err == nil {
+						lastErr = nil
+						break
+					}
+					logger.Warn("segment backup failed", logging.SegmentID(segment.ID), logging.NodeID(nodeID), logging.Error(err))
+					lastErr = err
+				}
+				if lastErr != nil {
+					return errors.Wrapf(lastErr, "segment %d backup failed", segment.ID)
+				} else if len(segment.Nodes.DoneNodeIDs) == 0 {
+					return errors.Errorf("segment %d has no replica to back up from", segment.ID)
+				}
+				n++
+			}
+
+			buf, err := proto.Marshal(state)
+			if err != nil {
+				return errors.Wrap(err, "marshal failed")
+			}
+			if err := ioutil.WriteFile(filepath.Join(dir, backupClusterStateFile), buf, 0644); err != nil {
+				return err
+			}
+
+			if dir != output {
+				if err := writeTarball(dir, output); err != nil {
+					return errors.Wrap(err, "tarball failed")
+				}
+			}
+
+			logger.Info("backed up cluster state", logging.F("index", state.Index), logging.F("segments", n), logging.F("output", output))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func isTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar") || isGzipTarball(path)
+}
+
+func isGzipTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+func backupSegmentFileName(segmentID uint64) string {
+	return fmt.Sprintf("%016x.seg", segmentID)
+}
+
+func verifySegmentFile(path string, segment *mq.ClusterSegment) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	h := sha1.New()
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return err
+	}
+
+	if size != segment.Size_ {
+		return errors.Errorf("segment %d size mismatch, expected: %d, got: %d", segment.ID, segment.Size_, size)
+	}
+	if sum := h.Sum(nil); !bytes.Equal(sum, segment.Sha1) {
+		return errors.Errorf("segment %d SHA-1 mismatch, expected: %x, got: %x", segment.ID, segment.Sha1, sum)
+	}
+
+	return nil
+}
+
+func backupSegment(ctx context.Context, client mq.NodeRPCClient, segment *mq.ClusterSegment, path string) error {
+	tmpPath := path + ".tmp"
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	file, err := segments.Open(tmpPath, 0644, segment.Size_)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+	defer file.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.SegmentRead(ctx, &mq.SegmentReadRequest{SegmentID: segment.ID})
+	if err != nil {
+		return errors.Wrap(err, "read failed")
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrap(err, "receive failed")
+		}
+
+		if err := file.Write(response.Data); err != nil {
+			return errors.Wrap(err, "write failed")
+		}
+	}
+
+	if err := verifySegmentFile(tmpPath, segment); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func backupOffsetCommits(ctx context.Context, client mq.NodeRPCClient, segmentID uint64, consumerGroup *mq.ClusterConsumerGroup) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.SegmentRead(ctx, &mq.SegmentReadRequest{SegmentID: segmentID})
+	if err != nil {
+		return errors.Wrap(err, "read failed")
+	}
+
+	commit := mq.ClusterConsumerGroup_OffsetCommit{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "receive failed")
+		}
+
+		if err := proto.Unmarshal(response.Data, &commit); err != nil {
+			return errors.Wrapf(err, "unmarshal failed in segment %d at %d", segmentID, response.Offset)
+		}
+
+		for _, c := range consumerGroup.OffsetCommits {
+			if c.SegmentID == commit.SegmentID && c.Offset < commit.Offset {
+				c.Offset = commit.Offset
+			}
+		}
+	}
+}
+
+func writeTarball(dir string, output string) (err error) {
+	f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	var w io.Writer = f
+	if isGzipTarball(output) {
+		gz := gzip.NewWriter(f)
+		defer func() {
+			if closeErr := gz.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		w = gz
+	}
+
+	tw := tar.NewWriter(w)
+	defer func() {
+		if closeErr := tw.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	})
+}
@@ -14,7 +14,7 @@ import (
 
 func createConsumerGroupCmd() *cobra.Command {
 	request := &emq.ConsumerGroupCreateRequest{}
-	var fanoutBindings, directBindings, topicBindings []string
+	var fanoutBindings, directBindings, topicBindings, filters []string
 	var since time.Duration
 
 	cmd := &cobra.Command{
@@ -62,6 +62,28 @@ func createConsumerGroupCmd() *cobra.Command {
 				}
 			}
 
+			for i, f := range filters {
+				parts := strings.SplitN(f, ":", 2)
+				if len(parts) != 2 {
+					return errors.Errorf("filter %d does not contain colon", i)
+				}
+				bound := false
+				for _, binding := range request.ConsumerGroup.Bindings {
+					if binding.TopicName == parts[0] {
+						binding.Filter = parts[1]
+						bound = true
+					}
+				}
+				if !bound {
+					binding := &emq.ConsumerGroup_Binding{
+						TopicName:    parts[0],
+						ExchangeType: emq.ExchangeTypeFanout,
+						Filter:       parts[1],
+					}
+					request.ConsumerGroup.Bindings = append(request.ConsumerGroup.Bindings, binding)
+				}
+			}
+
 			request.ConsumerGroup.Name = args[0]
 
 			response, err := c.CreateConsumerGroup(ctx, request)
@@ -79,7 +101,10 @@ func createConsumerGroupCmd() *cobra.Command {
 	cmd.Flags().StringSliceVarP(&fanoutBindings, "bind", "b", nil, "Fanout bindings in form of <topic>.")
 	cmd.Flags().StringSliceVarP(&directBindings, "bind-direct", "d", nil, "Direct bindings in form of <topic>:<routing key>.")
 	cmd.Flags().StringSliceVarP(&topicBindings, "bind-topic", "t", nil, "Topic bindings in form of <topic>:<routing key>.")
+	cmd.Flags().StringArrayVar(&filters, "filter", nil, "Filters in form of <topic>:<expression> applied to all bindings of the topic (fanout binding is created if there is none), e.g. \"orders:priority > 5 AND region IN ('eu', 'us')\".")
 	cmd.Flags().Uint32VarP(&request.ConsumerGroup.Size_, "size", "s", 0, "Max count of in-flight messages. Zero means that the server chooses sensible defaults.")
+	cmd.Flags().BoolVar(&request.ConsumerGroup.SingleActiveConsumer, "single-active-consumer", false, "Only one subscription receives messages at the time, others stand by.")
+	cmd.Flags().Uint32Var(&request.ConsumerGroup.MaxPriority, "max-priority", 0, "Max message priority. If set, messages with higher priority are sent to consumers first. Zero means FIFO order.")
 	cmd.Flags().DurationVarP(&since, "since", "f", 0, "Time from which to consider messages eligible to be consumed by this consumer group.")
 
 	return cmd
@@ -43,5 +43,7 @@ func createNamespaceCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64Var(&request.RetentionBytes, "retention-bytes", 0, "Max size of closed segments of all namespace's topics (except compacted ones) in bytes, the oldest are deleted once exceeded.")
+
 	return cmd
 }
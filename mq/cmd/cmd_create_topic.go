@@ -48,6 +48,12 @@ func createTopicCmd() *cobra.Command {
 	cmd.Flags().Uint32VarP(&request.Topic.Shards, "shards", "s", 1, "# of shards.")
 	cmd.Flags().Uint32VarP(&request.Topic.ReplicationFactor, "replication-factor", "f", 0, "Replication factor.")
 	cmd.Flags().DurationVarP(&request.Topic.Retention, "retention", "r", 1, "Topic retention.")
+	cmd.Flags().StringSliceVar(&request.Topic.IndexedHeaders, "indexed-header", nil, "Header indexed in closed segments.")
+	cmd.Flags().StringVar(&request.Topic.PartitionKey, "partition-key", "", "Messages with the same key are published to the same shard & consumed in order. Either routing_key, or headers.<name>.")
+	cmd.Flags().DurationVar(&request.Topic.DeduplicationWindow, "deduplication-window", 0, "Duplicate publishes (by producer ID & sequence, or message ID) within the window are not written again.")
+	cmd.Flags().StringVar(&request.Topic.CompactionKey, "compaction-key", "", "Closed segments are compacted to keep only the latest message for each key. Either routing_key, or headers.<name>.")
+	cmd.Flags().Uint64Var(&request.Topic.RetentionBytes, "retention-bytes", 0, "Max size of closed segments in bytes, the oldest are deleted once exceeded (not applied to compacted topics).")
+	cmd.Flags().BoolVar(&request.Topic.ForceRetentionBytes, "force-retention-bytes", false, "Delete segments exceeding retention bytes even if consumer groups haven't consumed them yet.")
 
 	return cmd
 }
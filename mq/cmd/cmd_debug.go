@@ -47,6 +47,15 @@ func debugCmd() *cobra.Command {
 
 			fmt.Print("\n")
 
+			if len(response.UnderDiversifiedSegments) > 0 {
+				fmt.Println("=== Under-diversified segments ===")
+				for _, segment := range response.UnderDiversifiedSegments {
+					fmt.Println(segment)
+				}
+
+				fmt.Print("\n")
+			}
+
 			return nil
 		},
 	}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/spf13/cobra"
+)
+
+func describeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describe runtime state of topic or consumer group.",
+	}
+
+	cmd.AddCommand(
+		describeConsumerGroupCmd(),
+		describeTopicCmd(),
+	)
+
+	return cmd
+}
+
+func describeConsumerGroupCmd() *cobra.Command {
+	request := &emq.ConsumerGroupDescribeRequest{}
+
+	cmd := &cobra.Command{
+		Use:     "consumer-group <name>",
+		Short:   "Describe consumer group lag, subscriptions & in-flight messages.",
+		Aliases: []string{"cg"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+			c, err := newClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			request.Name = args[0]
+			response, err := c.DescribeConsumerGroup(ctx, request)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", emq.DefaultNamespace, "Consumer group namespace.")
+
+	return cmd
+}
+
+func describeTopicCmd() *cobra.Command {
+	request := &emq.TopicDescribeRequest{}
+
+	cmd := &cobra.Command{
+		Use:     "topic <name>",
+		Short:   "Describe topic segments, retained data & replication health.",
+		Aliases: []string{"tp"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+			c, err := newClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			request.Name = args[0]
+			response, err := c.DescribeTopic(ctx, request)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", emq.DefaultNamespace, "Topic namespace.")
+
+	return cmd
+}
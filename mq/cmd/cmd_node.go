@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"eventter.io/mq"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func nodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node",
+		Short: "Node administration.",
+	}
+
+	cmd.AddCommand(
+		nodeDrainCmd(),
+	)
+
+	return cmd
+}
+
+func nodeDrainCmd() *cobra.Command {
+	request := &mq.NodeDrainRequest{}
+	wait := false
+
+	cmd := &cobra.Command{
+		Use:   "drain <id>",
+		Short: "Drain node, i.e. move its segments & consumer groups to other nodes so it can be safely removed.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			nodeID, err := mq.NodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+			request.NodeID = nodeID
+
+			ctx := context.Background()
+			if !wait {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, 1*time.Minute)
+				defer cancel()
+			}
+			conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", rootConfig.BindHost, rootConfig.Port), grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			c := mq.NewNodeRPCClient(conn)
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+
+			for {
+				response, err := c.NodeDrain(ctx, request)
+				if err != nil {
+					return err
+				}
+
+				if !wait || request.Cancel || response.SafeToRemove {
+					return encoder.Encode(response)
+				}
+
+				fmt.Fprintf(
+					os.Stderr,
+					"waiting for node %s to drain: open segments=%d, closed segments=%d\n",
+					mq.NodeIDToString(response.NodeID),
+					response.OpenSegments,
+					response.ClosedSegments,
+				)
+
+				time.Sleep(1 * time.Second)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&request.Cancel, "cancel", false, "Cancel draining, node will be eligible for new segments & consumer groups again (open segments closed by draining stay closed).")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait until node is drained & safe to remove.")
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func offsetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offsets",
+		Short: "Export & import consumer group committed offsets.",
+	}
+
+	cmd.AddCommand(
+		exportOffsetsCmd(),
+		importOffsetsCmd(),
+	)
+
+	return cmd
+}
+
+func exportOffsetsCmd() *cobra.Command {
+	request := &emq.ConsumerGroupOffsetsExportRequest{}
+
+	cmd := &cobra.Command{
+		Use:   "export <consumer-group>",
+		Short: "Print consumer group committed offsets as JSON.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+			c, err := newClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			request.Name = args[0]
+			response, err := c.ExportConsumerGroupOffsets(ctx, request)
+			if err != nil {
+				return err
+			}
+
+			offsets := response.Offsets
+			if offsets == nil {
+				offsets = []*emq.ConsumerGroupOffset{}
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(offsets)
+		},
+	}
+
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", emq.DefaultNamespace, "Consumer group namespace.")
+
+	return cmd
+}
+
+func importOffsetsCmd() *cobra.Command {
+	request := &emq.ConsumerGroupOffsetsImportRequest{}
+
+	cmd := &cobra.Command{
+		Use:   "import <consumer-group> [file]",
+		Short: "Overwrite consumer group committed offsets with ones exported as JSON (read from stdin if file not set).",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			var r io.Reader = os.Stdin
+			if len(args) > 1 && args[1] != "-" {
+				f, err := os.Open(args[1])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			if err := json.NewDecoder(r).Decode(&request.Offsets); err != nil {
+				return errors.Wrap(err, "could not decode offsets")
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+			c, err := newClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			request.Name = args[0]
+			response, err := c.ImportConsumerGroupOffsets(ctx, request)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(response)
+		},
+	}
+
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", emq.DefaultNamespace, "Consumer group namespace.")
+
+	return cmd
+}
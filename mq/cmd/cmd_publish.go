@@ -59,6 +59,9 @@ func publishCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
+				if request.ProducerID != "" {
+					request.Sequence++
+				}
 
 				encoder := json.NewEncoder(os.Stdout)
 				encoder.SetIndent("", "  ")
@@ -73,10 +76,12 @@ func publishCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", emq.DefaultNamespace, "Topic namespace.")
 	cmd.Flags().StringVarP(&request.Message.RoutingKey, "routing-key", "k", "", "Routing key.")
+	cmd.Flags().StringVar(&request.ProducerID, "producer-id", "", "Producer ID for deduplication.")
+	cmd.Flags().Uint64Var(&request.Sequence, "sequence", 0, "Sequence number of the first message for deduplication, incremented with each message.")
 	cmd.Flags().StringVar(&properties.ContentType, "content-type", "", "Content type.")
 	cmd.Flags().StringVar(&properties.ContentEncoding, "content-encoding", "", "Content encoding.")
 	cmd.Flags().Int32Var(&properties.DeliveryMode, "delivery-mode", 0, "Delivery mode.")
-	cmd.Flags().Int32Var(&properties.Priority, "priority", 0, "Delivery mode.")
+	cmd.Flags().Int32Var(&properties.Priority, "priority", 0, "Priority.")
 	cmd.Flags().StringVar(&properties.CorrelationID, "correlation-id", "", "Correlation ID.")
 	cmd.Flags().StringVar(&properties.ReplyTo, "reply-to", "", "Reply to.")
 	cmd.Flags().StringVar(&properties.Expiration, "expiration", "", "Expiration.")
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"eventter.io/mq"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func rebalanceStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-status",
+		Short: "Show moves of closed segments between nodes & data stored on each node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", rootConfig.BindHost, rootConfig.Port), grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			c := mq.NewNodeRPCClient(conn)
+
+			response, err := c.SegmentRebalanceStatus(ctx, &mq.SegmentRebalanceStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(response)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"eventter.io/mq"
+	"eventter.io/mq/logging"
+	"eventter.io/mq/segments"
+	"eventter.io/mq/tiered"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func restoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <backup>",
+		Short: "Restore cluster from backup into data directory of a fresh node. Start the node afterwards to bootstrap the cluster.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger, err := newLogger()
+			if err != nil {
+				return err
+			}
+
+			if err := rootConfig.Init(); err != nil {
+				return err
+			}
+
+			raftDir := filepath.Join(rootConfig.Dir, "raft")
+			if entries, err := ioutil.ReadDir(raftDir); err != nil && !os.IsNotExist(err) {
+				return err
+			} else if len(entries) > 0 {
+				return errors.Errorf("raft directory %s is not empty, restore must be done into fresh node", raftDir)
+			}
+
+			backupDir := args[0]
+			if isTarball(backupDir) {
+				tmpDir, err := ioutil.TempDir("", "eventtermq-restore")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmpDir)
+
+				if err := extractTarball(backupDir, tmpDir); err != nil {
+					return errors.Wrap(err, "tarball extract failed")
+				}
+				backupDir = tmpDir
+			}
+
+			buf, err := ioutil.ReadFile(filepath.Join(backupDir, backupClusterStateFile))
+			if err != nil {
+				return errors.Wrap(err, "could not read cluster state")
+			}
+			state := &mq.ClusterState{}
+			if err := proto.Unmarshal(buf, state); err != nil {
+				return errors.Wrap(err, "could not unmarshal cluster state")
+			}
+
+			segmentDir, err := segments.NewDir(
+				filepath.Join(rootConfig.Dir, "segments"),
+				rootConfig.DirPerm,
+				0644,
+				math.MaxInt64,
+				0,
+			)
+			if err != nil {
+				return errors.Wrap(err, "could not open segments dir")
+			}
+			defer segmentDir.Close()
+
+			// offloaded segments aren't part of the backup, they're restored only if tiered storage still has them
+			// (objects of segments deleted from the cluster are garbage collected)
+			offloaded := false
+			for _, segment := range state.ClosedSegments {
+				if segment.Type == mq.ClusterSegment_TOPIC && !segment.OffloadedAt.IsZero() {
+					offloaded = true
+					break
+				}
+			}
+			var offloadedSegments map[uint64]*tiered.Object
+			if offloaded {
+				tieredStorage, err := openTieredStorage()
+				if err != nil {
+					return err
+				}
+				if tieredStorage == nil {
+					return errors.New("backup references segments offloaded to tiered storage, --tiered-storage must be set")
+				}
+				offloadedSegments, err = mq.ListOffloadedSegments(context.Background(), tieredStorage)
+				if err != nil {
+					return errors.Wrap(err, "could not list tiered storage")
+				}
+			}
+
+			restoredSegmentIDs := make(map[uint64]bool)
+			for _, segment := range state.ClosedSegments {
+				if segment.Type != mq.ClusterSegment_TOPIC {
+					continue
+				}
+				if !segment.OffloadedAt.IsZero() {
+					if object, ok := offloadedSegments[segment.ID]; !ok || object.Size != segment.Size_ {
+						logger.Warn("offloaded segment not restored, it's missing in tiered storage", logging.SegmentID(segment.ID), logging.Namespace(segment.OwnerNamespace), logging.Topic(segment.OwnerName))
+						continue
+					}
+					restoredSegmentIDs[segment.ID] = true
+					continue
+				}
+
+				path := filepath.Join(backupDir, backupSegmentsDir, backupSegmentFileName(segment.ID))
+				if err := verifySegmentFile(path, segment); err != nil {
+					logger.Warn("segment not restored", logging.SegmentID(segment.ID), logging.Namespace(segment.OwnerNamespace), logging.Topic(segment.OwnerName), logging.Error(err))
+					continue
+				}
+
+				if err := restoreSegment(segmentDir, path, segment); err != nil {
+					return errors.Wrapf(err, "segment %d restore failed", segment.ID)
+				}
+				restoredSegmentIDs[segment.ID] = true
+			}
+
+			advertiseIP, err := resolveAdvertiseIP(rootConfig.AdvertiseHost)
+			if err != nil {
+				return err
+			}
+
+			if err := os.MkdirAll(raftDir, rootConfig.DirPerm); err != nil {
+				return err
+			}
+			address := advertiseIP.String() + ":" + strconv.Itoa(rootConfig.Port)
+			if err := mq.WriteRestoreSnapshot(raftDir, rootConfig.ID, address, state.ForRestore(rootConfig.ID, restoredSegmentIDs)); err != nil {
+				return errors.Wrap(err, "could not write raft snapshot")
+			}
+
+			logger.Info(
+				"restored cluster state",
+				logging.F("index", state.Index),
+				logging.F("segments", len(restoredSegmentIDs)),
+				logging.NodeID(rootConfig.ID),
+				logging.F("address", address),
+			)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&rootConfig.ID, "id", 0, "Node ID. Must be unique across cluster & stable.")
+	cmd.Flags().StringVar(&rootConfig.AdvertiseHost, "advertise-host", "", "Host that will the node advertise to others.")
+	cmd.Flags().StringVar(&rootConfig.Dir, "dir", "", "Persistent data directory.")
+	cmd.Flags().Uint32Var((*uint32)(&rootConfig.DirPerm), "dir-perm", 0755, "Persistent data directory permissions.")
+	cmd.Flags().StringVar(&rootConfig.TieredStorageURL, "tiered-storage", "", "URL of tiered storage the cluster offloaded segments to. Required if the backup references offloaded segments, the ones missing in tiered storage aren't restored.")
+	addTieredStorageCredentialsFlags(cmd)
+
+	return cmd
+}
+
+func restoreSegment(segmentDir *segments.Dir, path string, segment *mq.ClusterSegment) error {
+	source, err := segments.Open(path, 0644, segment.Size_)
+	if err != nil {
+		return errors.Wrap(err, "backup open failed")
+	}
+	defer source.Close()
+
+	iterator, err := source.Read(false)
+	if err != nil {
+		return errors.Wrap(err, "backup read failed")
+	}
+
+	target, err := segmentDir.Open(segment.ID)
+	if err != nil {
+		return errors.Wrap(err, "segment open failed")
+	}
+	defer segmentDir.Release(target)
+
+	if err := target.Truncate(segments.TruncateAll); err != nil {
+		return errors.Wrap(err, "segment truncate failed")
+	}
+
+	for {
+		data, _, _, err := iterator.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrap(err, "backup next failed")
+		}
+
+		if err := target.Write(data); err != nil {
+			return errors.Wrap(err, "segment write failed")
+		}
+	}
+
+	sum, size, err := target.Sum(sha1.New(), segments.SumAll)
+	if err != nil {
+		return errors.Wrap(err, "segment sum failed")
+	}
+	if size != segment.Size_ || !bytes.Equal(sum, segment.Sha1) {
+		return errors.Errorf("restored segment mismatch, expected: %d/%x, got: %d/%x", segment.Size_, segment.Sha1, size, sum)
+	}
+
+	return nil
+}
+
+func extractTarball(path string, dir string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if isGzipTarball(path) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return errors.Errorf("invalid path %s in tarball", header.Name)
+		}
+		target := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(file, tr); err != nil {
+				file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
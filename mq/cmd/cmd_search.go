@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func searchCmd() *cobra.Command {
+	request := &emq.TopicSearchRequest{}
+	var since, until time.Duration
+	var headersAll, headersAny []string
+	var jsonValue string
+
+	cmd := &cobra.Command{
+		Use:   "search <topic>",
+		Short: "Search messages retained in topic.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rootConfig.BindHost == "" {
+				rootConfig.BindHost = "localhost"
+			}
+
+			now := time.Now()
+			if since != 0 {
+				t := now.Add(since)
+				request.Since = &t
+			}
+			if until != 0 {
+				t := now.Add(until)
+				request.Until = &t
+			}
+
+			var err error
+			if request.HeadersAll, err = parseHeaders(headersAll); err != nil {
+				return err
+			}
+			if request.HeadersAny, err = parseHeaders(headersAny); err != nil {
+				return err
+			}
+
+			if jsonValue != "" {
+				request.DataJSONValue = &types.Value{}
+				if err := jsonpb.UnmarshalString(jsonValue, request.DataJSONValue); err != nil {
+					return errors.Wrap(err, "could not parse JSON value")
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+			defer cancel()
+			c, err := newClient(ctx)
+			if err != nil {
+				return errors.Wrap(err, "dial failed")
+			}
+			defer c.Close()
+
+			request.Name = args[0]
+			stream, err := c.SearchTopic(ctx, request, grpc.MaxCallRecvMsgSize(math.MaxUint32))
+			if err != nil {
+				return errors.Wrap(err, "search failed")
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					return errors.Wrap(err, "receive failed")
+				}
+
+				if err := encoder.Encode(response); err != nil {
+					return errors.Wrap(err, "encode failed")
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", emq.DefaultNamespace, "Topic namespace.")
+	cmd.Flags().DurationVarP(&since, "since", "f", 0, "Search messages published after this time (relative to now, e.g. -1h).")
+	cmd.Flags().DurationVarP(&until, "until", "u", 0, "Search messages published before this time (relative to now, e.g. -30m).")
+	cmd.Flags().StringVarP(&request.RoutingKey, "routing-key", "k", "", "Routing key pattern.")
+	cmd.Flags().StringSliceVar(&headersAll, "header", nil, "Message must have all these headers in form of <name>=<value>.")
+	cmd.Flags().StringSliceVar(&headersAny, "any-header", nil, "Message must have at least one of these headers in form of <name>=<value>.")
+	cmd.Flags().StringVarP(&request.DataContains, "contains", "c", "", "Message data must contain this substring.")
+	cmd.Flags().StringVar(&request.DataJSONPath, "json-path", "", "Dot-separated path that must exist in message data parsed as JSON.")
+	cmd.Flags().StringVar(&jsonValue, "json-value", "", "JSON value that must be at JSON path.")
+	cmd.Flags().Uint32VarP(&request.Limit, "limit", "l", 0, "Max number of messages returned. Zero means there is no limit.")
+
+	return cmd
+}
+
+func parseHeaders(headers []string) (*types.Struct, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	s := &types.Struct{Fields: make(map[string]*types.Value)}
+	for i, header := range headers {
+		parts := strings.SplitN(header, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("header %d does not contain equals sign", i)
+		}
+		s.Fields[parts[0]] = &types.Value{Kind: &types.Value_StringValue{StringValue: parts[1]}}
+	}
+	return s, nil
+}
@@ -0,0 +1,503 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventter.io/mq"
+	"eventter.io/mq/logging"
+	"eventter.io/mq/segments"
+	"github.com/bbva/raft-badger"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	segmentTypeTopic                      = "topic"
+	segmentTypeConsumerGroupOffsetCommits = "consumer-group-offset-commits"
+)
+
+var segmentType string
+
+func segmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "segment",
+		Short: "Inspect & repair segment files in data directory of a stopped node.",
+	}
+
+	cmd.PersistentFlags().StringVar(&rootConfig.Dir, "dir", "", "Persistent data directory.")
+	cmd.PersistentFlags().StringVar(&segmentType, "type", "", "Segment type ("+segmentTypeTopic+", "+segmentTypeConsumerGroupOffsetCommits+"). If not specified, it's read from cluster state, falls back to "+segmentTypeTopic+".")
+
+	cmd.AddCommand(
+		segmentDumpCmd(),
+		segmentRepairCmd(),
+		segmentStatsCmd(),
+		segmentVerifyCmd(),
+	)
+
+	return cmd
+}
+
+func segmentDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <segment>",
+		Short: "Dump segment records as JSON lines. Segment is specified by its ID, or path of segment file.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := openSegment(args[0])
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			encoder := json.NewEncoder(os.Stdout)
+			_, err = s.scan(func(record *segmentRecord) error {
+				return encoder.Encode(record)
+			})
+			return err
+		},
+	}
+
+	return cmd
+}
+
+func segmentVerifyCmd() *cobra.Command {
+	var (
+		expectedSize int64
+		expectedSHA1 string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify <segment>",
+		Short: "Verify segment file against size & SHA-1 recorded in cluster state & check all its records can be read.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := openSegment(args[0])
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			result := struct {
+				SegmentID    uint64 `json:"segment_id"`
+				Path         string `json:"path"`
+				OK           bool   `json:"ok"`
+				Size         int64  `json:"size"`
+				ExpectedSize int64  `json:"expected_size,omitempty"`
+				SHA1         string `json:"sha1,omitempty"`
+				ExpectedSHA1 string `json:"expected_sha1,omitempty"`
+				Records      int    `json:"records"`
+				Error        string `json:"error,omitempty"`
+			}{
+				SegmentID:    s.id,
+				Path:         s.path,
+				Size:         s.file.Size(),
+				ExpectedSize: expectedSize,
+				ExpectedSHA1: expectedSHA1,
+			}
+
+			// checksum covers the size it was recorded with
+			sumSize := result.ExpectedSize
+			if s.segment != nil && result.ExpectedSHA1 == "" {
+				if s.open {
+					return errors.Errorf("segment %d is open, cluster state has no checksum of it", s.id)
+				}
+				sumSize = s.segment.Size_
+				result.ExpectedSHA1 = hex.EncodeToString(s.segment.Sha1)
+			}
+			if s.segment != nil && !s.open && result.ExpectedSize == 0 {
+				result.ExpectedSize = s.segment.Size_
+			}
+			if result.ExpectedSHA1 == "" {
+				return errors.Errorf("segment %d not found in cluster state, specify expected checksum with --sha1", s.id)
+			}
+
+			var errs []string
+
+			if result.ExpectedSize > 0 && result.Size != result.ExpectedSize {
+				errs = append(errs, "size mismatch")
+			}
+			sum, _, err := s.file.Sum(sha1.New(), sumSize)
+			if err != nil && err != io.EOF {
+				return errors.Wrap(err, "sum failed")
+			}
+			result.SHA1 = hex.EncodeToString(sum)
+			if !strings.EqualFold(result.SHA1, result.ExpectedSHA1) {
+				errs = append(errs, "sha1 mismatch")
+			}
+
+			if _, err := s.scan(func(record *segmentRecord) error {
+				result.Records++
+				return nil
+			}); err != nil {
+				errs = append(errs, err.Error())
+			}
+
+			result.OK = len(errs) == 0
+			result.Error = strings.Join(errs, "; ")
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(result); err != nil {
+				return err
+			}
+
+			if !result.OK {
+				return errors.Errorf("segment %d verification failed", s.id)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&expectedSize, "size", 0, "Expected size of the segment. Overrides size from cluster state.")
+	cmd.Flags().StringVar(&expectedSHA1, "sha1", "", "Expected SHA-1 (hex) of the segment. Overrides checksum from cluster state.")
+
+	return cmd
+}
+
+func segmentRepairCmd() *cobra.Command {
+	dryRun := false
+
+	cmd := &cobra.Command{
+		Use:   "repair <segment>",
+		Short: "Repair segment by truncating it at the first record that cannot be read.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger, err := newLogger()
+			if err != nil {
+				return err
+			}
+
+			s, err := openSegment(args[0])
+			if err != nil {
+				return err
+			}
+
+			records := 0
+			badOffset, scanErr := s.scan(func(record *segmentRecord) error {
+				records++
+				return nil
+			})
+			size := s.file.Size()
+
+			// file is closed before it's truncated
+			if err := s.Close(); err != nil {
+				return errors.Wrap(err, "close failed")
+			}
+
+			result := struct {
+				SegmentID   uint64 `json:"segment_id"`
+				Path        string `json:"path"`
+				Size        int64  `json:"size"`
+				Records     int    `json:"records"`
+				TruncatedAt int64  `json:"truncated_at,omitempty"`
+				Removed     int64  `json:"removed_bytes,omitempty"`
+				DryRun      bool   `json:"dry_run,omitempty"`
+				Error       string `json:"error,omitempty"`
+			}{
+				SegmentID: s.id,
+				Path:      s.path,
+				Size:      size,
+				Records:   records,
+				DryRun:    dryRun,
+			}
+
+			if scanErr != nil {
+				result.TruncatedAt = badOffset
+				result.Removed = result.Size - badOffset
+				result.Error = scanErr.Error()
+
+				if !dryRun {
+					if err := os.Truncate(s.path, badOffset); err != nil {
+						return errors.Wrap(err, "truncate failed")
+					}
+					logger.Warn(
+						"segment truncated",
+						logging.SegmentID(s.id),
+						logging.F("offset", badOffset),
+						logging.F("removed_bytes", result.Removed),
+					)
+					if s.segment != nil && !s.open {
+						logger.Warn(
+							"segment no longer matches checksum in cluster state, replace it with a copy from another replica",
+							logging.SegmentID(s.id),
+						)
+					}
+				}
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(result)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only report where the segment would be truncated.")
+
+	return cmd
+}
+
+func segmentStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats <segment>",
+		Short: "Print segment statistics.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := openSegment(args[0])
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+
+			stats := struct {
+				SegmentID       uint64     `json:"segment_id"`
+				Path            string     `json:"path"`
+				Type            string     `json:"type"`
+				Namespace       string     `json:"namespace,omitempty"`
+				Owner           string     `json:"owner,omitempty"`
+				Open            bool       `json:"open"`
+				Size            int64      `json:"size"`
+				Records         int        `json:"records"`
+				RecordBytes     int64      `json:"record_bytes"`
+				MinRecordSize   int        `json:"min_record_size"`
+				MaxRecordSize   int        `json:"max_record_size"`
+				AvgRecordSize   float64    `json:"avg_record_size"`
+				CreatedAt       *time.Time `json:"created_at,omitempty"`
+				ClosedAt        *time.Time `json:"closed_at,omitempty"`
+				FirstMessage    *time.Time `json:"first_message,omitempty"`
+				LastMessage     *time.Time `json:"last_message,omitempty"`
+				RoutingKeys     int        `json:"routing_keys,omitempty"`
+				CommitSegments  int        `json:"commit_segments,omitempty"`
+				CorruptedOffset int64      `json:"corrupted_offset,omitempty"`
+				Error           string     `json:"error,omitempty"`
+			}{
+				SegmentID: s.id,
+				Path:      s.path,
+				Type:      s.typ,
+				Size:      s.file.Size(),
+			}
+			if s.segment != nil {
+				stats.Namespace = s.segment.OwnerNamespace
+				stats.Owner = s.segment.OwnerName
+				stats.Open = s.open
+				stats.CreatedAt = &s.segment.CreatedAt
+				if !stats.Open {
+					stats.ClosedAt = &s.segment.ClosedAt
+				}
+			}
+
+			routingKeys := make(map[string]bool)
+			commitSegments := make(map[uint64]bool)
+
+			badOffset, err := s.scan(func(record *segmentRecord) error {
+				stats.Records++
+				stats.RecordBytes += int64(record.Size)
+				if stats.MinRecordSize == 0 || record.Size < stats.MinRecordSize {
+					stats.MinRecordSize = record.Size
+				}
+				if record.Size > stats.MaxRecordSize {
+					stats.MaxRecordSize = record.Size
+				}
+				if record.Time != nil {
+					if stats.FirstMessage == nil {
+						stats.FirstMessage = record.Time
+					}
+					stats.LastMessage = record.Time
+				}
+				if record.publishing != nil && record.publishing.Message != nil {
+					routingKeys[record.publishing.Message.RoutingKey] = true
+				}
+				if record.commit != nil {
+					commitSegments[record.commit.SegmentID] = true
+				}
+				return nil
+			})
+			if err != nil {
+				stats.CorruptedOffset = badOffset
+				stats.Error = err.Error()
+			}
+			if stats.Records > 0 {
+				stats.AvgRecordSize = float64(stats.RecordBytes) / float64(stats.Records)
+			}
+			stats.RoutingKeys = len(routingKeys)
+			stats.CommitSegments = len(commitSegments)
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(stats)
+		},
+	}
+
+	return cmd
+}
+
+type openedSegment struct {
+	id      uint64
+	path    string
+	typ     string
+	file    *segments.File
+	segment *mq.ClusterSegment
+	open    bool
+}
+
+type segmentRecord struct {
+	Offset       int64           `json:"offset"`
+	CommitOffset int64           `json:"commit_offset"`
+	Size         int             `json:"size"`
+	Time         *time.Time      `json:"time,omitempty"`
+	Publishing   json.RawMessage `json:"publishing,omitempty"`
+	OffsetCommit json.RawMessage `json:"offset_commit,omitempty"`
+
+	publishing *mq.Publishing
+	commit     *mq.ClusterConsumerGroup_OffsetCommit
+}
+
+// Opens segment file read-only. Segment may be specified by its ID, or path of the segment file.
+func openSegment(arg string) (*openedSegment, error) {
+	s := &openedSegment{}
+
+	if strings.ContainsRune(arg, filepath.Separator) || strings.HasSuffix(arg, ".seg") {
+		s.path = arg
+		s.id = segments.FileID(arg)
+		if s.id == 0 {
+			return nil, errors.Errorf("%s is not a segment file", arg)
+		}
+	} else {
+		if rootConfig.Dir == "" {
+			return nil, errors.New("dir not set")
+		}
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil || id == 0 {
+			return nil, errors.Errorf("invalid segment ID %q", arg)
+		}
+		s.id = id
+		s.path = segments.FilePath(filepath.Join(rootConfig.Dir, "segments"), id)
+	}
+
+	if rootConfig.Dir != "" {
+		state, err := readClusterState(filepath.Join(rootConfig.Dir, "raft"))
+		if err != nil {
+			return nil, err
+		}
+		if state != nil {
+			s.segment = state.GetSegment(s.id)
+			s.open = state.GetOpenSegment(s.id) != nil
+		}
+	}
+
+	switch segmentType {
+	case segmentTypeTopic, segmentTypeConsumerGroupOffsetCommits:
+		s.typ = segmentType
+	case "":
+		s.typ = segmentTypeTopic
+		if s.segment != nil && s.segment.Type == mq.ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS {
+			s.typ = segmentTypeConsumerGroupOffsetCommits
+		}
+	default:
+		return nil, errors.Errorf("unknown segment type %q", segmentType)
+	}
+
+	file, err := segments.OpenReadOnly(s.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open segment %d", s.id)
+	}
+	s.file = file
+
+	return s, nil
+}
+
+// Reads cluster state from Raft directory of a stopped node. Nil state is returned if the directory doesn't exist.
+func readClusterState(raftDir string) (*mq.ClusterState, error) {
+	if _, err := os.Stat(filepath.Join(raftDir, "logs")); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	logStore, err := raftbadger.NewBadgerStore(filepath.Join(raftDir, "logs"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open raft logs store (is the node stopped?)")
+	}
+	defer logStore.Close()
+
+	state, err := mq.ReadClusterState(raftDir, logStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read cluster state")
+	}
+	return state, nil
+}
+
+// Reads all records of the segment. If a record cannot be read, or decoded, offset of the record & error is returned.
+func (s *openedSegment) scan(fn func(record *segmentRecord) error) (int64, error) {
+	iterator, err := s.file.Read(false)
+	if err != nil {
+		return 1, errors.Wrap(err, "segment read failed")
+	}
+	defer iterator.Close()
+
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	buf := bytes.Buffer{}
+	lastOffset := int64(1)
+
+	for {
+		data, offset, commitOffset, err := iterator.Next()
+		if err == io.EOF {
+			if size := s.file.Size(); lastOffset < size {
+				return lastOffset, errors.Errorf("%d trailing bytes in segment %d at %d", size-lastOffset, s.id, lastOffset)
+			}
+			return 0, nil
+		} else if err != nil {
+			return lastOffset, errors.Wrapf(err, "read failed in segment %d at %d", s.id, lastOffset)
+		}
+
+		record := &segmentRecord{
+			Offset:       offset,
+			CommitOffset: commitOffset,
+			Size:         len(data),
+		}
+
+		var message proto.Message
+		if s.typ == segmentTypeConsumerGroupOffsetCommits {
+			record.commit = &mq.ClusterConsumerGroup_OffsetCommit{}
+			message = record.commit
+		} else {
+			record.publishing = &mq.Publishing{}
+			message = record.publishing
+		}
+		if err := proto.Unmarshal(data, message); err != nil {
+			return offset, errors.Wrapf(err, "unmarshal failed in segment %d at %d", s.id, offset)
+		}
+
+		buf.Reset()
+		if err := marshaler.Marshal(&buf, message); err != nil {
+			return offset, errors.Wrapf(err, "marshal failed in segment %d at %d", s.id, offset)
+		}
+		if record.publishing != nil {
+			record.Publishing = append(json.RawMessage(nil), buf.Bytes()...)
+			if s.segment != nil {
+				t := s.segment.CreatedAt.Add(time.Duration(record.publishing.Delta))
+				record.Time = &t
+			}
+		} else {
+			record.OffsetCommit = append(json.RawMessage(nil), buf.Bytes()...)
+		}
+
+		if err := fn(record); err != nil {
+			return offset, err
+		}
+
+		lastOffset = commitOffset
+	}
+}
+
+func (s *openedSegment) Close() error {
+	return s.file.Close()
+}
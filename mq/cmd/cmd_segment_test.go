@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"eventter.io/mq"
+	"eventter.io/mq/emq"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentScan(t *testing.T) {
+	assert := require.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	path := segments.FilePath(tmpDir, 1)
+	assert.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+
+	file, err := segments.Open(path, 0644, 1024*1024)
+	assert.NoError(err)
+	for i := 0; i < 3; i++ {
+		buf, err := proto.Marshal(&mq.Publishing{
+			Message: &emq.Message{RoutingKey: "key", Data: []byte("hello")},
+			Delta:   int64(i),
+		})
+		assert.NoError(err)
+		assert.NoError(file.Write(buf))
+	}
+	goodSize := file.Size()
+	assert.NoError(file.Close())
+
+	scan := func() (int, int64, error) {
+		s, err := openSegment(path)
+		assert.NoError(err)
+		defer s.Close()
+		assert.Equal(uint64(1), s.id)
+		assert.Equal(segmentTypeTopic, s.typ)
+
+		records := 0
+		badOffset, err := s.scan(func(record *segmentRecord) error {
+			records++
+			assert.NotNil(record.publishing)
+			assert.Equal("key", record.publishing.Message.RoutingKey)
+			assert.Contains(string(record.Publishing), `"routing_key":"key"`)
+			return nil
+		})
+		return records, badOffset, err
+	}
+
+	records, _, err := scan()
+	assert.NoError(err)
+	assert.Equal(3, records)
+
+	// message that is not a publishing
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	assert.NoError(err)
+	_, err = f.Write([]byte{3, 0xff, 0xff, 0xff})
+	assert.NoError(err)
+	assert.NoError(f.Close())
+
+	records, badOffset, err := scan()
+	assert.Error(err)
+	assert.Contains(err.Error(), "unmarshal failed in segment 1")
+	assert.Equal(3, records)
+	assert.Equal(goodSize, badOffset)
+
+	assert.NoError(os.Truncate(path, badOffset))
+
+	// incomplete trailing message
+	f, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	assert.NoError(err)
+	_, err = f.Write([]byte{0x80})
+	assert.NoError(err)
+	assert.NoError(f.Close())
+
+	records, badOffset, err = scan()
+	assert.Error(err)
+	assert.Equal(3, records)
+	assert.Equal(goodSize, badOffset)
+}
@@ -17,14 +17,39 @@ type Config struct {
 	AdvertiseHost string
 	Port          int
 	AMQPPort      int
+	HTTPPort      int
 	Dir           string
 	DirPerm       os.FileMode
+	Zone          string
+	// Fraction of used disk space above which node does not receive new segments & replicas.
+	DiskHighWatermark float64
+	// Free disk space (in bytes) below which node rejects publishing.
+	DiskCriticalFree uint64
+	// Address (host:port) of HTTP server exposing Prometheus metrics. Empty means metrics are not exposed.
+	MetricsAddress string
+	// OTLP/HTTP endpoint (e.g. `http://localhost:4318`) spans are exported to. Empty means spans are not exported
+	// over OTLP.
+	TracingEndpoint string
+	// Write spans to stdout.
+	TracingStdout bool
+	// URL of tiered storage closed segments are offloaded to (see tiered.Open). Empty means segments are kept on local
+	// disks only.
+	TieredStorageURL       string
+	TieredStorageRegion    string
+	TieredStorageAccessKey string
+	TieredStorageSecretKey string
+	// Age (since closing) after which closed segments are offloaded to tiered storage. Zero means segments aren't
+	// offloaded, however, already offloaded segments can be still read.
+	TieredStorageAge time.Duration
 }
 
 func (c *Config) Init() error {
 	if c.AMQPPort == 0 && c.Port != 0 {
 		c.AMQPPort = c.Port + 1
 	}
+	if c.HTTPPort == 0 && c.Port != 0 {
+		c.HTTPPort = c.Port + 2
+	}
 
 	if c.Dir == "" {
 		return errors.New("dir not set")
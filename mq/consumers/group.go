@@ -4,6 +4,7 @@ import (
 	"math"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -20,14 +21,18 @@ var (
 )
 
 type Group struct {
-	n        int
-	mutex    sync.Mutex
-	cond     sync.Cond
-	messages []Message
-	read     int
-	write    int
-	closed   uint32
-	Commits  chan Commit
+	n                    int
+	mutex                sync.Mutex
+	cond                 sync.Cond
+	messages             []Message
+	read                 int
+	write                int
+	closed               uint32
+	maxPriority          int32
+	singleActiveConsumer bool
+	activeSubscriptionID uint64
+	activeSince          time.Time
+	Commits              chan Commit
 }
 
 func NewGroup(n int) (*Group, error) {
@@ -71,6 +76,117 @@ func (g *Group) Offer(message *Message) error {
 	return nil
 }
 
+// Max priority enables priority ordering - subscriptions get ready messages with higher priority first (message
+// priority is capped to max priority). Messages with the same priority are leased in FIFO order. Zero means that the
+// group is strict FIFO.
+//
+// Only messages already offered to the group compete, therefore, urgent message may jump ahead of at most `n` other
+// messages.
+func (g *Group) SetMaxPriority(maxPriority uint8) {
+	g.mutex.Lock()
+	g.maxPriority = int32(maxPriority)
+	g.mutex.Unlock()
+}
+
+// If single active consumer is enabled, only one subscription (the first one asking for message) receives messages.
+// Other subscriptions wait until the active one is closed, then one of them takes over.
+func (g *Group) SetSingleActiveConsumer(singleActiveConsumer bool) {
+	g.mutex.Lock()
+	g.singleActiveConsumer = singleActiveConsumer
+	if !singleActiveConsumer {
+		g.activeSubscriptionID = 0
+		g.activeSince = time.Time{}
+	}
+	g.cond.Broadcast()
+	g.mutex.Unlock()
+}
+
+// ActiveSubscription returns ID of subscription receiving messages in single active consumer mode & time it became
+// active. Returned ID is zero if there is no active subscription.
+func (g *Group) ActiveSubscription() (uint64, time.Time) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.activeSubscriptionID, g.activeSince
+}
+
+// Returns true if subscription may lease messages, i.e. single active consumer is disabled, or the subscription is
+// (or has just become) the active one. Group mutex must be held.
+func (g *Group) claimActive(subscriptionID uint64) bool {
+	if !g.singleActiveConsumer {
+		return true
+	}
+	if g.activeSubscriptionID == 0 {
+		g.activeSubscriptionID = subscriptionID
+		g.activeSince = time.Now()
+	}
+	return g.activeSubscriptionID == subscriptionID
+}
+
+// Returns index of next message to be leased, or -1 if there is no ready message. Group mutex must be held.
+func (g *Group) nextReady() int {
+	i := -1
+	var best int32
+	var blockedKeys map[string]bool
+	for j := g.read; j != g.write; j = (j + 1) % len(g.messages) {
+		if g.messages[j].SubscriptionID == ack {
+			continue
+		}
+		if key := g.messages[j].PartitionKey; key != "" {
+			if blockedKeys[key] {
+				continue
+			}
+			if blockedKeys == nil {
+				blockedKeys = make(map[string]bool)
+			}
+			// following messages with the same key must wait until this one is acked
+			blockedKeys[key] = true
+		}
+		if g.messages[j].SubscriptionID != ready {
+			continue
+		}
+		if g.maxPriority == 0 {
+			return j
+		}
+		priority := g.messages[j].priority(g.maxPriority)
+		if i == -1 || priority > best {
+			i = j
+			best = priority
+			if best == g.maxPriority {
+				break
+			}
+		}
+	}
+	return i
+}
+
+type GroupStats struct {
+	// Messages waiting to be leased to subscriptions.
+	Waiting int
+	// Messages leased to subscriptions, not (n)acked yet.
+	InFlight int
+	// Messages acked, waiting for preceding messages to be acked before they can be committed.
+	AckPending int
+}
+
+func (g *Group) Stats() GroupStats {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	var stats GroupStats
+	for j := g.read; j != g.write; j = (j + 1) % len(g.messages) {
+		switch g.messages[j].SubscriptionID {
+		case ready:
+			stats.Waiting++
+		case ack:
+			stats.AckPending++
+		default:
+			stats.InFlight++
+		}
+	}
+
+	return stats
+}
+
 func (g *Group) Subscribe() *Subscription {
 	return &Subscription{
 		ID:       atomic.AddUint64(&currentSubscriptionID, 1),
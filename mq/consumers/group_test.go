@@ -71,6 +71,173 @@ func TestGroup_Subscribe(t *testing.T) {
 	}
 }
 
+func TestGroup_Stats(t *testing.T) {
+	g, err := NewGroup(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := g.Offer(&Message{Message: &emq.Message{Data: []byte(strconv.Itoa(i))}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subscription := g.Subscribe()
+	defer subscription.Close()
+
+	m1, err := subscription.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m2, err := subscription.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats := g.Stats(); stats != (GroupStats{Waiting: 1, InFlight: 2}) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	if err := subscription.Ack(m2.SeqNo); err != nil {
+		t.Fatal(err)
+	}
+
+	if stats := g.Stats(); stats != (GroupStats{Waiting: 1, InFlight: 1, AckPending: 1}) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	if err := subscription.Ack(m1.SeqNo); err != nil {
+		t.Fatal(err)
+	}
+
+	if stats := g.Stats(); stats != (GroupStats{Waiting: 1}) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestGroup_SetMaxPriority(t *testing.T) {
+	g, err := NewGroup(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	g.SetMaxPriority(10)
+	g.Commits = make(chan Commit, 8)
+
+	priorities := []int32{1, 5, 3, 5, 0, 20}
+	for i, priority := range priorities {
+		err := g.Offer(&Message{
+			SegmentID:    1,
+			CommitOffset: int64(i + 1),
+			Message: &emq.Message{
+				Properties: &emq.Message_Properties{Priority: priority},
+				Data:       []byte(strconv.Itoa(i)),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subscription := g.Subscribe()
+	defer subscription.Close()
+
+	var leased []*Message
+	for _, expected := range []string{"5", "1", "3", "2", "0", "4"} {
+		m, err := subscription.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(m.Message.Data) != expected {
+			t.Fatalf("expected %s, got %s", expected, m.Message.Data)
+		}
+		leased = append(leased, m)
+	}
+
+	// commits advance only past contiguously acked messages, i.e. not past message 4 leased last
+	for _, m := range leased[:5] {
+		if err := subscription.Ack(m.SeqNo); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(g.Commits); n != 4 {
+		t.Fatalf("expected %d commits, got %d", 4, n)
+	}
+
+	if err := subscription.Ack(leased[5].SeqNo); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(g.Commits); n != len(priorities) {
+		t.Fatalf("expected %d commits, got %d", len(priorities), n)
+	}
+	for i := range priorities {
+		if commit := <-g.Commits; commit.CommitOffset != int64(i+1) {
+			t.Fatalf("expected commit offset %d, got %d", i+1, commit.CommitOffset)
+		}
+	}
+}
+
+func TestGroup_PartitionKey(t *testing.T) {
+	g, err := NewGroup(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	for i, key := range []string{"a", "a", "b", "", "b", ""} {
+		if err := g.Offer(&Message{PartitionKey: key, Message: &emq.Message{Data: []byte(strconv.Itoa(i))}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subscription := g.Subscribe()
+	defer subscription.Close()
+	subscription.SetBlocking(false)
+
+	// second message of each key is held back until the first one is acked
+	var leased []*Message
+	for _, expected := range []string{"0", "2", "3", "5"} {
+		m, err := subscription.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(m.Message.Data) != expected {
+			t.Fatalf("expected %s, got %s", expected, m.Message.Data)
+		}
+		leased = append(leased, m)
+	}
+
+	if err := subscription.Nack(leased[0].SeqNo); err != nil {
+		t.Fatal(err)
+	}
+	if m, err := subscription.Next(); err != nil {
+		t.Fatal(err)
+	} else if string(m.Message.Data) != "0" {
+		t.Fatalf("expected nacked message %s, got %s", "0", m.Message.Data)
+	} else {
+		leased[0] = m
+	}
+
+	for _, m := range leased {
+		if err := subscription.Ack(m.SeqNo); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, expected := range []string{"1", "4"} {
+		m, err := subscription.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(m.Message.Data) != expected {
+			t.Fatalf("expected %s, got %s", expected, m.Message.Data)
+		}
+	}
+}
+
 func BenchmarkGroup(b *testing.B) {
 	approx1MB := 1024 * 1024 / int(unsafe.Sizeof(Message{}))
 
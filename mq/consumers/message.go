@@ -13,6 +13,8 @@ type Message struct {
 	CommitOffset   int64
 	Time           time.Time
 	Message        *emq.Message
+	// Message won't be leased while there is preceding message with the same (non-empty) partition key not acked yet.
+	PartitionKey   string
 	SubscriptionID uint64
 	SeqNo          uint64
 }
@@ -21,6 +23,16 @@ func (m *Message) Reset() {
 	*m = Message{}
 }
 
+func (m *Message) priority(maxPriority int32) int32 {
+	if m.Message == nil || m.Message.Properties == nil || m.Message.Properties.Priority < 0 {
+		return 0
+	}
+	if m.Message.Properties.Priority > maxPriority {
+		return maxPriority
+	}
+	return m.Message.Properties.Priority
+}
+
 type Commit struct {
 	SegmentID    uint64
 	CommitOffset int64
@@ -3,6 +3,7 @@ package consumers
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -51,6 +52,17 @@ func (s *Subscription) SetBlocking(blocking bool) {
 	s.group.mutex.Unlock()
 }
 
+func (s *Subscription) Group() *Group {
+	return s.group
+}
+
+// Stats returns subscription size & number of messages leased to the subscription, not (n)acked yet.
+func (s *Subscription) Stats() (size uint32, inflight uint32) {
+	s.group.mutex.Lock()
+	defer s.group.mutex.Unlock()
+	return s.size, s.inflight
+}
+
 func (s *Subscription) Next() (*Message, error) {
 	if atomic.LoadUint32(&s.closed) == 1 {
 		return nil, ErrSubscriptionClosed
@@ -61,20 +73,17 @@ func (s *Subscription) Next() (*Message, error) {
 	var i int
 	for {
 		if (s.size == 0 || s.inflight < s.size) && (s.maxMessages == 0 || s.seq < s.maxMessages) {
-			i = -1
-			for j := s.group.read; j != s.group.write; j = (j + 1) % len(s.group.messages) {
-				if s.group.messages[j].SubscriptionID == ready {
-					i = j
-					break
-				}
-			}
-			if i != -1 {
-				break
-			}
+			// check closed before claiming, closed subscription must not become the active one
 			if atomic.LoadUint32(&s.closed) == 1 {
 				s.group.mutex.Unlock()
 				return nil, ErrSubscriptionClosed
 			}
+			if s.group.claimActive(s.ID) {
+				i = s.group.nextReady()
+				if i != -1 {
+					break
+				}
+			}
 			if s.group.read == s.group.write && atomic.LoadUint32(&s.group.closed) == 1 {
 				s.group.mutex.Unlock()
 				return nil, ErrGroupClosed
@@ -198,6 +207,12 @@ func (s *Subscription) Close() error {
 
 	atomic.StoreUint32(&s.closed, 1)
 
+	if s.group.activeSubscriptionID == s.ID {
+		// let standing by subscription take over
+		s.group.activeSubscriptionID = 0
+		s.group.activeSince = time.Time{}
+	}
+
 	s.group.cond.Broadcast()
 	s.group.mutex.Unlock()
 
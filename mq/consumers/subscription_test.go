@@ -191,3 +191,55 @@ func TestSubscription_Nack(t *testing.T) {
 		t.Fatalf("expected read to point to %d, got %d", 0, g.read)
 	}
 }
+
+func TestSubscription_SingleActiveConsumer(t *testing.T) {
+	g, err := NewGroup(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	g.SetSingleActiveConsumer(true)
+
+	for _, data := range []string{"1", "2"} {
+		if err := g.Offer(&Message{Message: &emq.Message{Data: []byte(data)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s1 := g.Subscribe()
+	defer s1.Close()
+	s2 := g.Subscribe()
+	defer s2.Close()
+	s2.SetBlocking(false)
+
+	m1, err := s1.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(m1.Message.Data); got != "1" {
+		t.Fatalf("expected %s, got %s", "1", got)
+	}
+	if id, _ := g.ActiveSubscription(); id != s1.ID {
+		t.Fatalf("expected active subscription %d, got %d", s1.ID, id)
+	}
+
+	// s2 stands by even though there is ready message
+	if _, err := s2.Next(); err != ErrEmpty {
+		t.Fatalf("expected %v, got %v", ErrEmpty, err)
+	}
+
+	// closing active subscription => s2 takes over & receives message s1 didn't ack
+	if err := s1.Close(); err != nil {
+		t.Fatal(err)
+	}
+	m2, err := s2.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(m2.Message.Data); got != "1" {
+		t.Fatalf("expected %s, got %s", "1", got)
+	}
+	if id, _ := g.ActiveSubscription(); id != s2.ID {
+		t.Fatalf("expected active subscription %d, got %d", s2.ID, id)
+	}
+}
@@ -0,0 +1,230 @@
+package mq
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/logging"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// Deduplication state of single shard of a topic. It's rebuilt from shard's segments on demand, therefore, it survives
+// segment rotation & change of shard's primary node.
+type deduplicationIndex struct {
+	mutex      sync.Mutex
+	state      *ClusterState // state the index was last refreshed with
+	indexed    map[uint64]bool
+	producers  map[string]deduplicationSequence
+	messageIDs map[string]time.Time
+	prunedAt   time.Time
+}
+
+type deduplicationSequence struct {
+	sequence uint64
+	time     time.Time
+}
+
+func newDeduplicationIndex() *deduplicationIndex {
+	return &deduplicationIndex{
+		indexed:    make(map[uint64]bool),
+		producers:  make(map[string]deduplicationSequence),
+		messageIDs: make(map[string]time.Time),
+	}
+}
+
+// Returns key used to route message to shard on deduplicated topic, or empty string if publishing cannot be deduplicated.
+func deduplicationKey(request *emq.TopicPublishRequest) string {
+	if request.ProducerID != "" {
+		return request.ProducerID
+	}
+	if request.Message != nil && request.Message.Properties != nil {
+		return request.Message.Properties.MessageID
+	}
+	return ""
+}
+
+// Returns true if publishing was already seen within the window. Producer ID & sequence take precedence over message ID.
+func (i *deduplicationIndex) isDuplicate(producerID string, sequence uint64, messageID string, now time.Time, window time.Duration) bool {
+	if producerID != "" {
+		last, ok := i.producers[producerID]
+		return ok && sequence <= last.sequence && now.Sub(last.time) <= window
+	}
+	if messageID != "" {
+		t, ok := i.messageIDs[messageID]
+		return ok && now.Sub(t) <= window
+	}
+	return false
+}
+
+func (i *deduplicationIndex) add(producerID string, sequence uint64, messageID string, t time.Time) {
+	if producerID != "" {
+		last := i.producers[producerID]
+		if sequence > last.sequence {
+			last.sequence = sequence
+		}
+		if t.After(last.time) {
+			last.time = t
+		}
+		i.producers[producerID] = last
+	} else if messageID != "" {
+		if last, ok := i.messageIDs[messageID]; !ok || t.After(last) {
+			i.messageIDs[messageID] = t
+		}
+	}
+}
+
+func (i *deduplicationIndex) prune(now time.Time, window time.Duration) {
+	if now.Sub(i.prunedAt) < window {
+		return
+	}
+	i.prunedAt = now
+	for producerID, last := range i.producers {
+		if now.Sub(last.time) > window {
+			delete(i.producers, producerID)
+		}
+	}
+	for messageID, t := range i.messageIDs {
+		if now.Sub(t) > window {
+			delete(i.messageIDs, messageID)
+		}
+	}
+}
+
+func (s *Server) makeDeduplicationMapKey(namespace string, name string, shard uint32) string {
+	return namespace + "/" + name + "/" + strconv.FormatUint(uint64(shard), 10)
+}
+
+// Returns deduplication index of segment's shard, refreshed with all segments of the shard within topic's
+// deduplication window. Index is returned locked, caller must unlock it. If some segment cannot be read, error is
+// returned & index is left unlocked.
+func (s *Server) lockDeduplicationIndex(ctx context.Context, state *ClusterState, topic *ClusterTopic, segment *ClusterSegment) (*deduplicationIndex, error) {
+	key := s.makeDeduplicationMapKey(segment.OwnerNamespace, segment.OwnerName, segment.Shard)
+
+	s.deduplicationMutex.Lock()
+	index, ok := s.deduplicationIndexes[key]
+	if !ok {
+		index = newDeduplicationIndex()
+		s.deduplicationIndexes[key] = index
+	}
+	s.deduplicationMutex.Unlock()
+
+	index.mutex.Lock()
+
+	if index.state == state {
+		return index, nil
+	}
+
+	since := time.Now().Add(-topic.DeduplicationWindow)
+	inWindow := make(map[uint64]bool)
+	for _, segments := range [][]*ClusterSegment{state.ClosedSegments, state.OpenSegments} {
+		for _, candidate := range segments {
+			if candidate.Type != ClusterSegment_TOPIC || candidate.OwnerNamespace != segment.OwnerNamespace ||
+				candidate.OwnerName != segment.OwnerName || candidate.Shard != segment.Shard {
+				continue
+			}
+
+			open := candidate.ClosedAt.IsZero()
+			if !open && candidate.ClosedAt.Before(since) {
+				continue
+			}
+
+			inWindow[candidate.ID] = true
+
+			// segment written by another node might have got new messages since it was indexed
+			stable := !open || candidate.Nodes.PrimaryNodeID == s.nodeID
+			if index.indexed[candidate.ID] {
+				if !stable {
+					delete(index.indexed, candidate.ID)
+				}
+				continue
+			}
+
+			err := s.readSegmentPublishings(ctx, state, candidate, func(publishing *Publishing, _ int64) {
+				messageID := ""
+				if publishing.Message != nil && publishing.Message.Properties != nil {
+					messageID = publishing.Message.Properties.MessageID
+				}
+				index.add(publishing.ProducerID, publishing.Sequence, messageID, candidate.CreatedAt.Add(time.Duration(publishing.Delta)))
+			})
+			if err != nil {
+				// duplicates from the segment couldn't be recognized => publishing is rejected, client retries & index is
+				// refreshed again (segments indexed so far stay indexed)
+				s.logger.Warn("deduplication could not index segment", append(segmentFields(candidate), logging.Error(err))...)
+				index.mutex.Unlock()
+				return nil, errDeduplicationUnavailable
+			}
+
+			if stable {
+				index.indexed[candidate.ID] = true
+			}
+		}
+	}
+
+	for segmentID := range index.indexed {
+		if !inWindow[segmentID] {
+			// segment fell off the window, or was deleted
+			delete(index.indexed, segmentID)
+		}
+	}
+
+	index.state = state
+
+	return index, nil
+}
+
+// Reads all publishings in segment, either from local segment file (fetched from tiered storage if segment is
+// offloaded), or from other nodes.
+func (s *Server) readSegmentPublishings(ctx context.Context, state *ClusterState, segment *ClusterSegment, fn func(publishing *Publishing, commitOffset int64)) error {
+	local := s.holdsSegment(segment)
+	if !segment.OffloadedAt.IsZero() {
+		if err := s.fetchOffloadedSegment(ctx, segment); err != nil {
+			return err
+		}
+		local = true
+	}
+
+	publishing := Publishing{}
+
+	if local {
+		segmentHandle, err := s.segmentDir.Open(segment.ID)
+		if err != nil {
+			return errors.Wrap(err, "segment open failed")
+		}
+		defer s.segmentDir.Release(segmentHandle)
+
+		iterator, err := segmentHandle.Read(false)
+		if err != nil {
+			return errors.Wrap(err, "segment read failed")
+		}
+		defer iterator.Close()
+
+		for {
+			data, _, commitOffset, err := iterator.Next()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return errors.Wrap(err, "iterator next failed")
+			}
+
+			publishing.Reset()
+			if err := proto.Unmarshal(data, &publishing); err != nil {
+				return errors.Wrap(err, "unmarshal failed")
+			}
+			fn(&publishing, commitOffset)
+		}
+	}
+
+	return s.readRemoteSegment(ctx, segmentReadNodes(state, segment), segment.ID, func(response *SegmentReadResponse) error {
+		publishing.Reset()
+		if err := proto.Unmarshal(response.Data, &publishing); err != nil {
+			return errors.Wrap(err, "unmarshal failed")
+		}
+		fn(&publishing, response.CommitOffset)
+		return nil
+	})
+}
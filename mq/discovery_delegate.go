@@ -0,0 +1,87 @@
+package mq
+
+import (
+	"sync"
+
+	"eventter.io/mq/logging"
+	"github.com/hashicorp/memberlist"
+	"github.com/pkg/errors"
+)
+
+// DiscoveryDelegate propagates node metadata (e.g. its zone & disk usage) to other members of the cluster.
+type DiscoveryDelegate struct {
+	logger logging.Logger
+	mutex  sync.Mutex
+	meta   DiscoveryNodeMeta
+	buf    []byte
+}
+
+var _ memberlist.Delegate = (*DiscoveryDelegate)(nil)
+
+func NewDiscoveryDelegate(meta *DiscoveryNodeMeta, logger logging.Logger) (*DiscoveryDelegate, error) {
+	d := &DiscoveryDelegate{logger: logger}
+	if err := d.SetMeta(meta); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *DiscoveryDelegate) Meta() DiscoveryNodeMeta {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.meta
+}
+
+// Sets new metadata. Other members are notified only after memberlist.Memberlist.UpdateNode is called.
+func (d *DiscoveryDelegate) SetMeta(meta *DiscoveryNodeMeta) error {
+	buf, err := meta.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+	if len(buf) > memberlist.MetaMaxSize {
+		return errors.Errorf("node meta too big: %d bytes, max %d bytes", len(buf), memberlist.MetaMaxSize)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.meta = *meta
+	d.buf = buf
+
+	return nil
+}
+
+func (d *DiscoveryDelegate) NodeMeta(limit int) []byte {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.buf) > limit {
+		d.logger.Error("node meta exceeds size limit", logging.F("size", len(d.buf)), logging.F("limit", limit))
+		return nil
+	}
+	return d.buf
+}
+
+func (d *DiscoveryDelegate) NotifyMsg([]byte) {}
+
+func (d *DiscoveryDelegate) GetBroadcasts(overhead, limit int) [][]byte {
+	return nil
+}
+
+func (d *DiscoveryDelegate) LocalState(join bool) []byte {
+	return nil
+}
+
+func (d *DiscoveryDelegate) MergeRemoteState(buf []byte, join bool) {}
+
+// Decodes metadata member gossips about itself. Members with missing or malformed metadata get empty one.
+func decodeDiscoveryNodeMeta(node *memberlist.Node, logger logging.Logger) *DiscoveryNodeMeta {
+	meta := &DiscoveryNodeMeta{}
+	if len(node.Meta) == 0 {
+		return meta
+	}
+	if err := meta.Unmarshal(node.Meta); err != nil {
+		logger.Warn("could not unmarshal node meta", logging.F("node", node.Name), logging.Error(err))
+		return &DiscoveryNodeMeta{}
+	}
+	return meta
+}
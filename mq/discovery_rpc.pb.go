@@ -33,7 +33,7 @@ func (m *DiscoveryTunnelledData) Reset()         { *m = DiscoveryTunnelledData{}
 func (m *DiscoveryTunnelledData) String() string { return proto.CompactTextString(m) }
 func (*DiscoveryTunnelledData) ProtoMessage()    {}
 func (*DiscoveryTunnelledData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_discovery_rpc_95fade601b414461, []int{0}
+	return fileDescriptor_discovery_rpc_6520b8d82a9bae9a, []int{0}
 }
 func (m *DiscoveryTunnelledData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -69,8 +69,74 @@ func (m *DiscoveryTunnelledData) GetData() []byte {
 	return nil
 }
 
+// Metadata node gossips to other members of the cluster.
+type DiscoveryNodeMeta struct {
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	// Capacity of volume with segments in bytes.
+	DiskTotal uint64 `protobuf:"varint,2,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	// Free space on volume with segments in bytes.
+	DiskFree             uint64   `protobuf:"varint,3,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoveryNodeMeta) Reset()         { *m = DiscoveryNodeMeta{} }
+func (m *DiscoveryNodeMeta) String() string { return proto.CompactTextString(m) }
+func (*DiscoveryNodeMeta) ProtoMessage()    {}
+func (*DiscoveryNodeMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_discovery_rpc_6520b8d82a9bae9a, []int{1}
+}
+func (m *DiscoveryNodeMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiscoveryNodeMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiscoveryNodeMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DiscoveryNodeMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveryNodeMeta.Merge(dst, src)
+}
+func (m *DiscoveryNodeMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiscoveryNodeMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveryNodeMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveryNodeMeta proto.InternalMessageInfo
+
+func (m *DiscoveryNodeMeta) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *DiscoveryNodeMeta) GetDiskTotal() uint64 {
+	if m != nil {
+		return m.DiskTotal
+	}
+	return 0
+}
+
+func (m *DiscoveryNodeMeta) GetDiskFree() uint64 {
+	if m != nil {
+		return m.DiskFree
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DiscoveryTunnelledData)(nil), "io.eventter.mq.DiscoveryTunnelledData")
+	proto.RegisterType((*DiscoveryNodeMeta)(nil), "io.eventter.mq.DiscoveryNodeMeta")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -201,6 +267,40 @@ func (m *DiscoveryTunnelledData) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DiscoveryNodeMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiscoveryNodeMeta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zone) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDiscoveryRpc(dAtA, i, uint64(len(m.Zone)))
+		i += copy(dAtA[i:], m.Zone)
+	}
+	if m.DiskTotal != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDiscoveryRpc(dAtA, i, uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDiscoveryRpc(dAtA, i, uint64(m.DiskFree))
+	}
+	return i, nil
+}
+
 func encodeVarintDiscoveryRpc(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -220,6 +320,22 @@ func (m *DiscoveryTunnelledData) Size() (n int) {
 	return n
 }
 
+func (m *DiscoveryNodeMeta) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovDiscoveryRpc(uint64(l))
+	}
+	if m.DiskTotal != 0 {
+		n += 1 + sovDiscoveryRpc(uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		n += 1 + sovDiscoveryRpc(uint64(m.DiskFree))
+	}
+	return n
+}
+
 func sovDiscoveryRpc(x uint64) (n int) {
 	for {
 		n++
@@ -314,6 +430,123 @@ func (m *DiscoveryTunnelledData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DiscoveryNodeMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDiscoveryRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveryNodeMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveryNodeMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiscoveryRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDiscoveryRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskTotal", wireType)
+			}
+			m.DiskTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiscoveryRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskTotal |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskFree", wireType)
+			}
+			m.DiskFree = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDiscoveryRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskFree |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDiscoveryRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDiscoveryRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDiscoveryRpc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -419,18 +652,23 @@ var (
 	ErrIntOverflowDiscoveryRpc   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("discovery_rpc.proto", fileDescriptor_discovery_rpc_95fade601b414461) }
+func init() { proto.RegisterFile("discovery_rpc.proto", fileDescriptor_discovery_rpc_6520b8d82a9bae9a) }
 
-var fileDescriptor_discovery_rpc_95fade601b414461 = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_discovery_rpc_6520b8d82a9bae9a = []byte{
+	// 226 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0xc9, 0x2c, 0x4e,
 	0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0x8c, 0x2f, 0x2a, 0x48, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0xe2, 0xcb, 0xcc, 0xd7, 0x4b, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0x49, 0x2d, 0xd2, 0xcb, 0x2d, 0x54,
 	0xd2, 0xe1, 0x12, 0x73, 0x81, 0x29, 0x0b, 0x29, 0xcd, 0xcb, 0x4b, 0xcd, 0xc9, 0x49, 0x4d, 0x71,
 	0x49, 0x2c, 0x49, 0x14, 0x12, 0xe2, 0x62, 0x49, 0x49, 0x2c, 0x49, 0x94, 0x60, 0x54, 0x60, 0xd4,
-	0xe0, 0x09, 0x02, 0xb3, 0x8d, 0x72, 0xb8, 0x78, 0xe0, 0xaa, 0x83, 0x02, 0x9c, 0x85, 0x62, 0xb8,
-	0xd8, 0x20, 0x9a, 0x84, 0xd4, 0xf4, 0x50, 0x0d, 0xd6, 0xc3, 0x6e, 0xaa, 0x14, 0x91, 0xea, 0x34,
-	0x18, 0x0d, 0x18, 0x9d, 0x44, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0xa2, 0x98, 0x72, 0x0b, 0x93, 0xd8, 0xc0, 0x1e, 0x31, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x64, 0xf5, 0xc4, 0x40, 0xdf, 0x00, 0x00, 0x00,
+	0xe0, 0x09, 0x02, 0xb3, 0x95, 0x92, 0xb9, 0x04, 0xe1, 0xaa, 0xfd, 0xf2, 0x53, 0x52, 0x7d, 0x53,
+	0x21, 0x0a, 0xab, 0xf2, 0xf3, 0x52, 0xc1, 0x0a, 0x39, 0x83, 0xc0, 0x6c, 0x21, 0x59, 0x2e, 0xae,
+	0x94, 0xcc, 0xe2, 0xec, 0xf8, 0x92, 0xfc, 0x92, 0xc4, 0x1c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x96,
+	0x20, 0x4e, 0x90, 0x48, 0x08, 0x48, 0x40, 0x48, 0x9a, 0x0b, 0xcc, 0x89, 0x4f, 0x2b, 0x4a, 0x4d,
+	0x95, 0x60, 0x06, 0xcb, 0x72, 0x80, 0x04, 0xdc, 0x8a, 0x52, 0x53, 0x8d, 0x72, 0xb8, 0x78, 0xe0,
+	0x96, 0x04, 0x05, 0x38, 0x0b, 0xc5, 0x70, 0xb1, 0x41, 0x5c, 0x26, 0xa4, 0xa6, 0x87, 0xea, 0x7a,
+	0x3d, 0xec, 0x4e, 0x97, 0x22, 0x52, 0x9d, 0x06, 0xa3, 0x01, 0xa3, 0x93, 0xc8, 0x89, 0x47, 0x72,
+	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0x43, 0x14, 0x53,
+	0x6e, 0x61, 0x12, 0x1b, 0x38, 0xb4, 0x8c, 0x01, 0x03, 0x00, 0xf1, 0x93, 0x85, 0xdd, 0x44, 0x01,
+	0x00, 0x00,
 }
@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"eventter.io/mq/logging"
 	"github.com/hashicorp/memberlist"
 	"github.com/pkg/errors"
 )
@@ -18,6 +19,7 @@ const (
 )
 
 type DiscoveryRPCTransport struct {
+	logger       logging.Logger
 	pool         *ClientConnPool
 	packetCh     chan *memberlist.Packet
 	streamCh     chan net.Conn
@@ -26,8 +28,9 @@ type DiscoveryRPCTransport struct {
 	wg           sync.WaitGroup
 }
 
-func NewDiscoveryRPCTransport(host string, port int, pool *ClientConnPool) (transport *DiscoveryRPCTransport, err error) {
+func NewDiscoveryRPCTransport(host string, port int, pool *ClientConnPool, logger logging.Logger) (transport *DiscoveryRPCTransport, err error) {
 	t := &DiscoveryRPCTransport{
+		logger:   logger,
 		pool:     pool,
 		packetCh: make(chan *memberlist.Packet),
 		streamCh: make(chan net.Conn),
@@ -78,6 +81,7 @@ func (t *DiscoveryRPCTransport) listenUDP(conn *net.UDPConn) {
 			if s := atomic.LoadInt32(&t.shutdown); s == 1 {
 				break
 			}
+			t.logger.Warn("UDP read failed", logging.Error(err))
 			continue
 		}
 		if n < 1 {
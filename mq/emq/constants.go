@@ -10,3 +10,13 @@ const (
 const (
 	DefaultNamespace = "default"
 )
+
+const (
+	PartitionKeyRoutingKey   = "routing_key"
+	PartitionKeyHeaderPrefix = "headers."
+)
+
+const (
+	// Full name of the gRPC service, e.g. for health checking.
+	ServiceName = "io.eventter.mq.EventterMQ"
+)
@@ -33,8 +33,11 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type NamespaceCreateRequest struct {
 	// If true and node is not a leader, request will fail.
-	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LeaderOnly bool   `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// If set, the oldest closed segments of namespace's topics are deleted once their total size exceeds the limit
+	// (in bytes). Consumer group rules are the same as for topic's retention bytes.
+	RetentionBytes       uint64   `protobuf:"varint,2,opt,name=retention_bytes,json=retentionBytes,proto3" json:"retention_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -43,7 +46,7 @@ func (m *NamespaceCreateRequest) Reset()         { *m = NamespaceCreateRequest{}
 func (m *NamespaceCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*NamespaceCreateRequest) ProtoMessage()    {}
 func (*NamespaceCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{0}
+	return fileDescriptor_emq_73a58150386249d1, []int{0}
 }
 func (m *NamespaceCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -86,6 +89,13 @@ func (m *NamespaceCreateRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *NamespaceCreateRequest) GetRetentionBytes() uint64 {
+	if m != nil {
+		return m.RetentionBytes
+	}
+	return 0
+}
+
 type NamespaceCreateResponse struct {
 	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -97,7 +107,7 @@ func (m *NamespaceCreateResponse) Reset()         { *m = NamespaceCreateResponse
 func (m *NamespaceCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*NamespaceCreateResponse) ProtoMessage()    {}
 func (*NamespaceCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{1}
+	return fileDescriptor_emq_73a58150386249d1, []int{1}
 }
 func (m *NamespaceCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +162,7 @@ func (m *NamespaceDeleteRequest) Reset()         { *m = NamespaceDeleteRequest{}
 func (m *NamespaceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*NamespaceDeleteRequest) ProtoMessage()    {}
 func (*NamespaceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{2}
+	return fileDescriptor_emq_73a58150386249d1, []int{2}
 }
 func (m *NamespaceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +216,7 @@ func (m *NamespaceDeleteResponse) Reset()         { *m = NamespaceDeleteResponse
 func (m *NamespaceDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*NamespaceDeleteResponse) ProtoMessage()    {}
 func (*NamespaceDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{3}
+	return fileDescriptor_emq_73a58150386249d1, []int{3}
 }
 func (m *NamespaceDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,6 +259,115 @@ func (m *NamespaceDeleteResponse) GetIndex() uint64 {
 	return 0
 }
 
+type NamespaceListRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceListRequest) Reset()         { *m = NamespaceListRequest{} }
+func (m *NamespaceListRequest) String() string { return proto.CompactTextString(m) }
+func (*NamespaceListRequest) ProtoMessage()    {}
+func (*NamespaceListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{4}
+}
+func (m *NamespaceListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *NamespaceListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceListRequest.Merge(dst, src)
+}
+func (m *NamespaceListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceListRequest proto.InternalMessageInfo
+
+func (m *NamespaceListRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
+	}
+	return false
+}
+
+type NamespaceListResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Namespaces           []string `protobuf:"bytes,3,rep,name=namespaces" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceListResponse) Reset()         { *m = NamespaceListResponse{} }
+func (m *NamespaceListResponse) String() string { return proto.CompactTextString(m) }
+func (*NamespaceListResponse) ProtoMessage()    {}
+func (*NamespaceListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{5}
+}
+func (m *NamespaceListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *NamespaceListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceListResponse.Merge(dst, src)
+}
+func (m *NamespaceListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceListResponse proto.InternalMessageInfo
+
+func (m *NamespaceListResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
+	}
+	return false
+}
+
+func (m *NamespaceListResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *NamespaceListResponse) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 type TopicCreateRequest struct {
 	// If true and node is not a leader, request will fail.
 	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
@@ -261,7 +380,7 @@ func (m *TopicCreateRequest) Reset()         { *m = TopicCreateRequest{} }
 func (m *TopicCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TopicCreateRequest) ProtoMessage()    {}
 func (*TopicCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{4}
+	return fileDescriptor_emq_73a58150386249d1, []int{6}
 }
 func (m *TopicCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +434,7 @@ func (m *TopicCreateResponse) Reset()         { *m = TopicCreateResponse{} }
 func (m *TopicCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*TopicCreateResponse) ProtoMessage()    {}
 func (*TopicCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{5}
+	return fileDescriptor_emq_73a58150386249d1, []int{7}
 }
 func (m *TopicCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +489,28 @@ type Topic struct {
 	// that segments WON'T be automatically deleted. For segments to be deleted ASAP, use very low retention, i.e. 1 nanosecond.
 	Retention time.Duration `protobuf:"bytes,5,opt,name=retention,stdduration" json:"retention"`
 	// Default exchange type for AMQP bindings.
-	DefaultExchangeType  string   `protobuf:"bytes,6,opt,name=default_exchange_type,json=defaultExchangeType,proto3" json:"default_exchange_type,omitempty"`
+	DefaultExchangeType string `protobuf:"bytes,6,opt,name=default_exchange_type,json=defaultExchangeType,proto3" json:"default_exchange_type,omitempty"`
+	// Headers indexed (besides routing key) when segment gets closed. Searches & consumer groups skip segments that
+	// cannot contain matching messages.
+	IndexedHeaders []string `protobuf:"bytes,7,rep,name=indexed_headers,json=indexedHeaders" json:"indexed_headers,omitempty"`
+	// If set, messages with the same partition key are always published to the same shard & consumer groups don't send
+	// message to consumers until preceding message with the same key is acked. Either `routing_key`, or header name
+	// prefixed by `headers.`. Messages without the key are spread across shards. Requires shards to be set, changing
+	// number of shards (or the key) breaks ordering of messages published before & after the change.
+	PartitionKey string `protobuf:"bytes,8,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	// If set, publishes with producer ID & sequence number, or message ID, seen within the window are acknowledged, but
+	// not written again. Requires shards to be set, messages are routed to shards by partition key, or by producer ID
+	// (message ID) if there's none.
+	DeduplicationWindow time.Duration `protobuf:"bytes,9,opt,name=deduplication_window,json=deduplicationWindow,stdduration" json:"deduplication_window"`
+	// If set, closed segments are compacted - only the latest message for each key is kept. Either `routing_key`, or
+	// header name prefixed by `headers.`. Message with the key & empty data is a tombstone, it deletes the key & is
+	// itself removed once it falls off retention period. Segments of compacted topics aren't deleted after retention.
+	CompactionKey string `protobuf:"bytes,10,opt,name=compaction_key,json=compactionKey,proto3" json:"compaction_key,omitempty"`
+	// If set, the oldest closed segments are deleted once their total size exceeds the limit (in bytes), regardless of
+	// retention period. Segments that haven't been consumed by all consumer groups yet are kept, unless
+	// force_retention_bytes is set.
+	RetentionBytes       uint64   `protobuf:"varint,11,opt,name=retention_bytes,json=retentionBytes,proto3" json:"retention_bytes,omitempty"`
+	ForceRetentionBytes  bool     `protobuf:"varint,12,opt,name=force_retention_bytes,json=forceRetentionBytes,proto3" json:"force_retention_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -379,7 +519,7 @@ func (m *Topic) Reset()         { *m = Topic{} }
 func (m *Topic) String() string { return proto.CompactTextString(m) }
 func (*Topic) ProtoMessage()    {}
 func (*Topic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{6}
+	return fileDescriptor_emq_73a58150386249d1, []int{8}
 }
 func (m *Topic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +590,48 @@ func (m *Topic) GetDefaultExchangeType() string {
 	return ""
 }
 
+func (m *Topic) GetIndexedHeaders() []string {
+	if m != nil {
+		return m.IndexedHeaders
+	}
+	return nil
+}
+
+func (m *Topic) GetPartitionKey() string {
+	if m != nil {
+		return m.PartitionKey
+	}
+	return ""
+}
+
+func (m *Topic) GetDeduplicationWindow() time.Duration {
+	if m != nil {
+		return m.DeduplicationWindow
+	}
+	return 0
+}
+
+func (m *Topic) GetCompactionKey() string {
+	if m != nil {
+		return m.CompactionKey
+	}
+	return ""
+}
+
+func (m *Topic) GetRetentionBytes() uint64 {
+	if m != nil {
+		return m.RetentionBytes
+	}
+	return 0
+}
+
+func (m *Topic) GetForceRetentionBytes() bool {
+	if m != nil {
+		return m.ForceRetentionBytes
+	}
+	return false
+}
+
 type TopicListRequest struct {
 	// If true and node is not a leader, request will fail.
 	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
@@ -463,7 +645,7 @@ func (m *TopicListRequest) Reset()         { *m = TopicListRequest{} }
 func (m *TopicListRequest) String() string { return proto.CompactTextString(m) }
 func (*TopicListRequest) ProtoMessage()    {}
 func (*TopicListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{7}
+	return fileDescriptor_emq_73a58150386249d1, []int{9}
 }
 func (m *TopicListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +707,7 @@ func (m *TopicListResponse) Reset()         { *m = TopicListResponse{} }
 func (m *TopicListResponse) String() string { return proto.CompactTextString(m) }
 func (*TopicListResponse) ProtoMessage()    {}
 func (*TopicListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{8}
+	return fileDescriptor_emq_73a58150386249d1, []int{10}
 }
 func (m *TopicListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,29 +757,27 @@ func (m *TopicListResponse) GetTopics() []*Topic {
 	return nil
 }
 
-type TopicDeleteRequest struct {
+type TopicDescribeRequest struct {
 	// If true and node is not a leader, request will fail.
-	LeaderOnly bool   `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
-	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// If true, topic be deleted only if there are no consumer groups using it.
-	IfUnused             bool     `protobuf:"varint,3,opt,name=if_unused,json=ifUnused,proto3" json:"if_unused,omitempty"`
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TopicDeleteRequest) Reset()         { *m = TopicDeleteRequest{} }
-func (m *TopicDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*TopicDeleteRequest) ProtoMessage()    {}
-func (*TopicDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{9}
+func (m *TopicDescribeRequest) Reset()         { *m = TopicDescribeRequest{} }
+func (m *TopicDescribeRequest) String() string { return proto.CompactTextString(m) }
+func (*TopicDescribeRequest) ProtoMessage()    {}
+func (*TopicDescribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{11}
 }
-func (m *TopicDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *TopicDescribeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicDescribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicDescribeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -607,65 +787,68 @@ func (m *TopicDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *TopicDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicDeleteRequest.Merge(dst, src)
+func (dst *TopicDescribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicDescribeRequest.Merge(dst, src)
 }
-func (m *TopicDeleteRequest) XXX_Size() int {
+func (m *TopicDescribeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicDeleteRequest.DiscardUnknown(m)
+func (m *TopicDescribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicDescribeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_TopicDescribeRequest proto.InternalMessageInfo
 
-func (m *TopicDeleteRequest) GetLeaderOnly() bool {
+func (m *TopicDescribeRequest) GetLeaderOnly() bool {
 	if m != nil {
 		return m.LeaderOnly
 	}
 	return false
 }
 
-func (m *TopicDeleteRequest) GetNamespace() string {
+func (m *TopicDescribeRequest) GetNamespace() string {
 	if m != nil {
 		return m.Namespace
 	}
 	return ""
 }
 
-func (m *TopicDeleteRequest) GetName() string {
+func (m *TopicDescribeRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *TopicDeleteRequest) GetIfUnused() bool {
-	if m != nil {
-		return m.IfUnused
-	}
-	return false
-}
-
-type TopicDeleteResponse struct {
-	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TopicDeleteResponse) Reset()         { *m = TopicDeleteResponse{} }
-func (m *TopicDeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*TopicDeleteResponse) ProtoMessage()    {}
-func (*TopicDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{10}
-}
-func (m *TopicDeleteResponse) XXX_Unmarshal(b []byte) error {
+type TopicDescribeResponse struct {
+	OK       bool                             `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index    uint64                           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Topic    *Topic                           `protobuf:"bytes,3,opt,name=topic" json:"topic,omitempty"`
+	Segments []*TopicDescribeResponse_Segment `protobuf:"bytes,4,rep,name=segments" json:"segments,omitempty"`
+	// Total size of all topic's segments.
+	RetainedBytes int64 `protobuf:"varint,5,opt,name=retained_bytes,json=retainedBytes,proto3" json:"retained_bytes,omitempty"`
+	// Time of the oldest retained message (approximated by creation time of the oldest segment).
+	OldestMessage *time.Time `protobuf:"bytes,6,opt,name=oldest_message,json=oldestMessage,stdtime" json:"oldest_message,omitempty"`
+	// Time of the newest message (approximated by close time of the newest closed segment, or current time if there
+	// is non-empty open segment).
+	NewestMessage           *time.Time `protobuf:"bytes,7,opt,name=newest_message,json=newestMessage,stdtime" json:"newest_message,omitempty"`
+	UnderReplicatedSegments uint32     `protobuf:"varint,8,opt,name=under_replicated_segments,json=underReplicatedSegments,proto3" json:"under_replicated_segments,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}   `json:"-"`
+	XXX_sizecache           int32      `json:"-"`
+}
+
+func (m *TopicDescribeResponse) Reset()         { *m = TopicDescribeResponse{} }
+func (m *TopicDescribeResponse) String() string { return proto.CompactTextString(m) }
+func (*TopicDescribeResponse) ProtoMessage()    {}
+func (*TopicDescribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{12}
+}
+func (m *TopicDescribeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicDescribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicDeleteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicDescribeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -675,121 +858,108 @@ func (m *TopicDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *TopicDeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicDeleteResponse.Merge(dst, src)
+func (dst *TopicDescribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicDescribeResponse.Merge(dst, src)
 }
-func (m *TopicDeleteResponse) XXX_Size() int {
+func (m *TopicDescribeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicDeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicDeleteResponse.DiscardUnknown(m)
+func (m *TopicDescribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicDescribeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicDeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_TopicDescribeResponse proto.InternalMessageInfo
 
-func (m *TopicDeleteResponse) GetOK() bool {
+func (m *TopicDescribeResponse) GetOK() bool {
 	if m != nil {
 		return m.OK
 	}
 	return false
 }
 
-func (m *TopicDeleteResponse) GetIndex() uint64 {
+func (m *TopicDescribeResponse) GetIndex() uint64 {
 	if m != nil {
 		return m.Index
 	}
 	return 0
 }
 
-type TopicPublishRequest struct {
-	// If true and node cannot write message to segment, request will fail.
-	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Message              *Message `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *TopicPublishRequest) Reset()         { *m = TopicPublishRequest{} }
-func (m *TopicPublishRequest) String() string { return proto.CompactTextString(m) }
-func (*TopicPublishRequest) ProtoMessage()    {}
-func (*TopicPublishRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{11}
-}
-func (m *TopicPublishRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *TopicPublishRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TopicPublishRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *TopicDescribeResponse) GetTopic() *Topic {
+	if m != nil {
+		return m.Topic
 	}
+	return nil
 }
-func (dst *TopicPublishRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicPublishRequest.Merge(dst, src)
-}
-func (m *TopicPublishRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *TopicPublishRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicPublishRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TopicPublishRequest proto.InternalMessageInfo
 
-func (m *TopicPublishRequest) GetDoNotForward() bool {
+func (m *TopicDescribeResponse) GetSegments() []*TopicDescribeResponse_Segment {
 	if m != nil {
-		return m.DoNotForward
+		return m.Segments
 	}
-	return false
+	return nil
 }
 
-func (m *TopicPublishRequest) GetNamespace() string {
+func (m *TopicDescribeResponse) GetRetainedBytes() int64 {
 	if m != nil {
-		return m.Namespace
+		return m.RetainedBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *TopicPublishRequest) GetName() string {
+func (m *TopicDescribeResponse) GetOldestMessage() *time.Time {
 	if m != nil {
-		return m.Name
+		return m.OldestMessage
 	}
-	return ""
+	return nil
 }
 
-func (m *TopicPublishRequest) GetMessage() *Message {
+func (m *TopicDescribeResponse) GetNewestMessage() *time.Time {
 	if m != nil {
-		return m.Message
+		return m.NewestMessage
 	}
 	return nil
 }
 
-type TopicPublishResponse struct {
-	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TopicDescribeResponse) GetUnderReplicatedSegments() uint32 {
+	if m != nil {
+		return m.UnderReplicatedSegments
+	}
+	return 0
 }
 
-func (m *TopicPublishResponse) Reset()         { *m = TopicPublishResponse{} }
-func (m *TopicPublishResponse) String() string { return proto.CompactTextString(m) }
-func (*TopicPublishResponse) ProtoMessage()    {}
-func (*TopicPublishResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{12}
-}
-func (m *TopicPublishResponse) XXX_Unmarshal(b []byte) error {
+type TopicDescribeResponse_Segment struct {
+	ID    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Open  bool   `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Shard uint32 `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// Node messages are published to. Set only for open segments.
+	PrimaryNodeID uint64 `protobuf:"varint,4,opt,name=primary_node_id,json=primaryNodeId,proto3" json:"primary_node_id,omitempty"`
+	// Nodes holding complete copy of closed segment.
+	DoneNodeIDs []uint64 `protobuf:"varint,5,rep,packed,name=done_node_ids,json=doneNodeIds" json:"done_node_ids,omitempty"`
+	// Nodes replicating the segment.
+	ReplicatingNodeIDs []uint64 `protobuf:"varint,6,rep,packed,name=replicating_node_ids,json=replicatingNodeIds" json:"replicating_node_ids,omitempty"`
+	// Size of the segment. For open segment it is current size reported by primary node (zero if unavailable).
+	Size_     int64      `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt time.Time  `protobuf:"bytes,8,opt,name=created_at,json=createdAt,stdtime" json:"created_at"`
+	ClosedAt  *time.Time `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,stdtime" json:"closed_at,omitempty"`
+	// True if segment has fewer copies than is topic's replication factor.
+	UnderReplicated bool `protobuf:"varint,10,opt,name=under_replicated,json=underReplicated,proto3" json:"under_replicated,omitempty"`
+	// Time segment was offloaded to tiered storage. Offloaded segment isn't held by any node.
+	OffloadedAt          *time.Time `protobuf:"bytes,11,opt,name=offloaded_at,json=offloadedAt,stdtime" json:"offloaded_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TopicDescribeResponse_Segment) Reset()         { *m = TopicDescribeResponse_Segment{} }
+func (m *TopicDescribeResponse_Segment) String() string { return proto.CompactTextString(m) }
+func (*TopicDescribeResponse_Segment) ProtoMessage()    {}
+func (*TopicDescribeResponse_Segment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{12, 0}
+}
+func (m *TopicDescribeResponse_Segment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicPublishResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicDescribeResponse_Segment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicPublishResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicDescribeResponse_Segment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -799,99 +969,132 @@ func (m *TopicPublishResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (dst *TopicPublishResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicPublishResponse.Merge(dst, src)
+func (dst *TopicDescribeResponse_Segment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicDescribeResponse_Segment.Merge(dst, src)
 }
-func (m *TopicPublishResponse) XXX_Size() int {
+func (m *TopicDescribeResponse_Segment) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicPublishResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicPublishResponse.DiscardUnknown(m)
+func (m *TopicDescribeResponse_Segment) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicDescribeResponse_Segment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicPublishResponse proto.InternalMessageInfo
+var xxx_messageInfo_TopicDescribeResponse_Segment proto.InternalMessageInfo
 
-func (m *TopicPublishResponse) GetOK() bool {
+func (m *TopicDescribeResponse_Segment) GetID() uint64 {
 	if m != nil {
-		return m.OK
+		return m.ID
 	}
-	return false
+	return 0
 }
 
-type ConsumerGroupCreateRequest struct {
-	// If true and node is not a leader, request will fail.
-	LeaderOnly           bool          `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
-	ConsumerGroup        ConsumerGroup `protobuf:"bytes,1,opt,name=consumer_group,json=consumerGroup" json:"consumer_group"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *TopicDescribeResponse_Segment) GetOpen() bool {
+	if m != nil {
+		return m.Open
+	}
+	return false
 }
 
-func (m *ConsumerGroupCreateRequest) Reset()         { *m = ConsumerGroupCreateRequest{} }
-func (m *ConsumerGroupCreateRequest) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupCreateRequest) ProtoMessage()    {}
-func (*ConsumerGroupCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{13}
+func (m *TopicDescribeResponse_Segment) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
 }
-func (m *ConsumerGroupCreateRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *TopicDescribeResponse_Segment) GetPrimaryNodeID() uint64 {
+	if m != nil {
+		return m.PrimaryNodeID
+	}
+	return 0
 }
-func (m *ConsumerGroupCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ConsumerGroupCreateRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *TopicDescribeResponse_Segment) GetDoneNodeIDs() []uint64 {
+	if m != nil {
+		return m.DoneNodeIDs
 	}
+	return nil
 }
-func (dst *ConsumerGroupCreateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupCreateRequest.Merge(dst, src)
+
+func (m *TopicDescribeResponse_Segment) GetReplicatingNodeIDs() []uint64 {
+	if m != nil {
+		return m.ReplicatingNodeIDs
+	}
+	return nil
 }
-func (m *ConsumerGroupCreateRequest) XXX_Size() int {
-	return m.Size()
+
+func (m *TopicDescribeResponse_Segment) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
 }
-func (m *ConsumerGroupCreateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupCreateRequest.DiscardUnknown(m)
+
+func (m *TopicDescribeResponse_Segment) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
 }
 
-var xxx_messageInfo_ConsumerGroupCreateRequest proto.InternalMessageInfo
+func (m *TopicDescribeResponse_Segment) GetClosedAt() *time.Time {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return nil
+}
 
-func (m *ConsumerGroupCreateRequest) GetLeaderOnly() bool {
+func (m *TopicDescribeResponse_Segment) GetUnderReplicated() bool {
 	if m != nil {
-		return m.LeaderOnly
+		return m.UnderReplicated
 	}
 	return false
 }
 
-func (m *ConsumerGroupCreateRequest) GetConsumerGroup() ConsumerGroup {
+func (m *TopicDescribeResponse_Segment) GetOffloadedAt() *time.Time {
 	if m != nil {
-		return m.ConsumerGroup
+		return m.OffloadedAt
 	}
-	return ConsumerGroup{}
+	return nil
 }
 
-type ConsumerGroupCreateResponse struct {
-	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+type TopicSearchRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Only messages published at or after this time are returned. Not set means from the oldest retained message.
+	Since *time.Time `protobuf:"bytes,3,opt,name=since,stdtime" json:"since,omitempty"`
+	// Only messages published before this time are returned. Not set means up to the newest message.
+	Until *time.Time `protobuf:"bytes,4,opt,name=until,stdtime" json:"until,omitempty"`
+	// Routing key pattern (`*` matches exactly one word, `#` zero or more words). Empty means any routing key.
+	RoutingKey string `protobuf:"bytes,5,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// Message must have all these headers with given values.
+	HeadersAll *types.Struct `protobuf:"bytes,6,opt,name=headers_all,json=headersAll" json:"headers_all,omitempty"`
+	// Message must have at least one of these headers with given value.
+	HeadersAny *types.Struct `protobuf:"bytes,7,opt,name=headers_any,json=headersAny" json:"headers_any,omitempty"`
+	// Message data must contain this substring.
+	DataContains string `protobuf:"bytes,8,opt,name=data_contains,json=dataContains,proto3" json:"data_contains,omitempty"`
+	// Dot-separated path (e.g. `order.items.0.sku`) that must exist in message data parsed as JSON.
+	DataJSONPath string `protobuf:"bytes,9,opt,name=data_json_path,json=dataJsonPath,proto3" json:"data_json_path,omitempty"`
+	// If set, value at JSON path must be equal to this value.
+	DataJSONValue *types.Value `protobuf:"bytes,10,opt,name=data_json_value,json=dataJsonValue" json:"data_json_value,omitempty"`
+	// Max number of messages returned. Zero means no limit.
+	Limit                uint32   `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupCreateResponse) Reset()         { *m = ConsumerGroupCreateResponse{} }
-func (m *ConsumerGroupCreateResponse) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupCreateResponse) ProtoMessage()    {}
-func (*ConsumerGroupCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{14}
+func (m *TopicSearchRequest) Reset()         { *m = TopicSearchRequest{} }
+func (m *TopicSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*TopicSearchRequest) ProtoMessage()    {}
+func (*TopicSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{13}
 }
-func (m *ConsumerGroupCreateResponse) XXX_Unmarshal(b []byte) error {
+func (m *TopicSearchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupCreateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicSearchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -901,57 +1104,118 @@ func (m *ConsumerGroupCreateResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupCreateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupCreateResponse.Merge(dst, src)
+func (dst *TopicSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicSearchRequest.Merge(dst, src)
 }
-func (m *ConsumerGroupCreateResponse) XXX_Size() int {
+func (m *TopicSearchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupCreateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupCreateResponse.DiscardUnknown(m)
+func (m *TopicSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicSearchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupCreateResponse proto.InternalMessageInfo
+var xxx_messageInfo_TopicSearchRequest proto.InternalMessageInfo
 
-func (m *ConsumerGroupCreateResponse) GetOK() bool {
+func (m *TopicSearchRequest) GetNamespace() string {
 	if m != nil {
-		return m.OK
+		return m.Namespace
 	}
-	return false
+	return ""
 }
 
-func (m *ConsumerGroupCreateResponse) GetIndex() uint64 {
+func (m *TopicSearchRequest) GetName() string {
 	if m != nil {
-		return m.Index
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TopicSearchRequest) GetSince() *time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *TopicSearchRequest) GetUntil() *time.Time {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *TopicSearchRequest) GetRoutingKey() string {
+	if m != nil {
+		return m.RoutingKey
+	}
+	return ""
+}
+
+func (m *TopicSearchRequest) GetHeadersAll() *types.Struct {
+	if m != nil {
+		return m.HeadersAll
+	}
+	return nil
+}
+
+func (m *TopicSearchRequest) GetHeadersAny() *types.Struct {
+	if m != nil {
+		return m.HeadersAny
+	}
+	return nil
+}
+
+func (m *TopicSearchRequest) GetDataContains() string {
+	if m != nil {
+		return m.DataContains
+	}
+	return ""
+}
+
+func (m *TopicSearchRequest) GetDataJSONPath() string {
+	if m != nil {
+		return m.DataJSONPath
+	}
+	return ""
+}
+
+func (m *TopicSearchRequest) GetDataJSONValue() *types.Value {
+	if m != nil {
+		return m.DataJSONValue
+	}
+	return nil
+}
+
+func (m *TopicSearchRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
 	return 0
 }
 
-type ConsumerGroup struct {
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Bindings determine what messages will be visible to consumer group.
-	Bindings []*ConsumerGroup_Binding `protobuf:"bytes,3,rep,name=bindings" json:"bindings,omitempty"`
-	// Max count of in-flight messages across all consumers. Not specified / zero means that the server will choose sensible defaults.
-	Size_ uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	// Time from which to consider messages eligible to be consumed by this consumer group.
-	Since                time.Time `protobuf:"bytes,5,opt,name=since,stdtime" json:"since"`
+// Messages are streamed segment by segment, i.e. they are ordered by time only within a topic shard.
+type TopicSearchResponse struct {
+	SegmentID uint64 `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Time when message was published.
+	Time                 time.Time `protobuf:"bytes,3,opt,name=time,stdtime" json:"time"`
+	Message              *Message  `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ConsumerGroup) Reset()         { *m = ConsumerGroup{} }
-func (m *ConsumerGroup) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroup) ProtoMessage()    {}
-func (*ConsumerGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{15}
+func (m *TopicSearchResponse) Reset()         { *m = TopicSearchResponse{} }
+func (m *TopicSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*TopicSearchResponse) ProtoMessage()    {}
+func (*TopicSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{14}
 }
-func (m *ConsumerGroup) XXX_Unmarshal(b []byte) error {
+func (m *TopicSearchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicSearchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -961,77 +1225,69 @@ func (m *ConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroup.Merge(dst, src)
+func (dst *TopicSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicSearchResponse.Merge(dst, src)
 }
-func (m *ConsumerGroup) XXX_Size() int {
+func (m *TopicSearchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroup.DiscardUnknown(m)
+func (m *TopicSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicSearchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroup proto.InternalMessageInfo
-
-func (m *ConsumerGroup) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
-}
+var xxx_messageInfo_TopicSearchResponse proto.InternalMessageInfo
 
-func (m *ConsumerGroup) GetName() string {
+func (m *TopicSearchResponse) GetSegmentID() uint64 {
 	if m != nil {
-		return m.Name
+		return m.SegmentID
 	}
-	return ""
+	return 0
 }
 
-func (m *ConsumerGroup) GetBindings() []*ConsumerGroup_Binding {
+func (m *TopicSearchResponse) GetOffset() int64 {
 	if m != nil {
-		return m.Bindings
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-func (m *ConsumerGroup) GetSize_() uint32 {
+func (m *TopicSearchResponse) GetTime() time.Time {
 	if m != nil {
-		return m.Size_
+		return m.Time
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *ConsumerGroup) GetSince() time.Time {
+func (m *TopicSearchResponse) GetMessage() *Message {
 	if m != nil {
-		return m.Since
+		return m.Message
 	}
-	return time.Time{}
+	return nil
 }
 
-type ConsumerGroup_Binding struct {
-	TopicName    string `protobuf:"bytes,1,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
-	ExchangeType string `protobuf:"bytes,2,opt,name=exchange_type,json=exchangeType,proto3" json:"exchange_type,omitempty"`
-	// Types that are valid to be assigned to By:
-	//	*ConsumerGroup_Binding_RoutingKey
-	//	*ConsumerGroup_Binding_HeadersAll
-	//	*ConsumerGroup_Binding_HeadersAny
-	By                   isConsumerGroup_Binding_By `protobuf_oneof:"by"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+type TopicDeleteRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly bool   `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// If true, topic be deleted only if there are no consumer groups using it.
+	IfUnused             bool     `protobuf:"varint,3,opt,name=if_unused,json=ifUnused,proto3" json:"if_unused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroup_Binding) Reset()         { *m = ConsumerGroup_Binding{} }
-func (m *ConsumerGroup_Binding) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroup_Binding) ProtoMessage()    {}
-func (*ConsumerGroup_Binding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{15, 0}
+func (m *TopicDeleteRequest) Reset()         { *m = TopicDeleteRequest{} }
+func (m *TopicDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*TopicDeleteRequest) ProtoMessage()    {}
+func (*TopicDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{15}
 }
-func (m *ConsumerGroup_Binding) XXX_Unmarshal(b []byte) error {
+func (m *TopicDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroup_Binding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroup_Binding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicDeleteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1041,190 +1297,65 @@ func (m *ConsumerGroup_Binding) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroup_Binding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroup_Binding.Merge(dst, src)
+func (dst *TopicDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicDeleteRequest.Merge(dst, src)
 }
-func (m *ConsumerGroup_Binding) XXX_Size() int {
+func (m *TopicDeleteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroup_Binding) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroup_Binding.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ConsumerGroup_Binding proto.InternalMessageInfo
-
-type isConsumerGroup_Binding_By interface {
-	isConsumerGroup_Binding_By()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type ConsumerGroup_Binding_RoutingKey struct {
-	RoutingKey string `protobuf:"bytes,3,opt,name=routing_key,json=routingKey,proto3,oneof"`
-}
-type ConsumerGroup_Binding_HeadersAll struct {
-	HeadersAll *types.Struct `protobuf:"bytes,4,opt,name=headers_all,json=headersAll,oneof"`
-}
-type ConsumerGroup_Binding_HeadersAny struct {
-	HeadersAny *types.Struct `protobuf:"bytes,5,opt,name=headers_any,json=headersAny,oneof"`
+func (m *TopicDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicDeleteRequest.DiscardUnknown(m)
 }
 
-func (*ConsumerGroup_Binding_RoutingKey) isConsumerGroup_Binding_By() {}
-func (*ConsumerGroup_Binding_HeadersAll) isConsumerGroup_Binding_By() {}
-func (*ConsumerGroup_Binding_HeadersAny) isConsumerGroup_Binding_By() {}
+var xxx_messageInfo_TopicDeleteRequest proto.InternalMessageInfo
 
-func (m *ConsumerGroup_Binding) GetBy() isConsumerGroup_Binding_By {
+func (m *TopicDeleteRequest) GetLeaderOnly() bool {
 	if m != nil {
-		return m.By
+		return m.LeaderOnly
 	}
-	return nil
+	return false
 }
 
-func (m *ConsumerGroup_Binding) GetTopicName() string {
+func (m *TopicDeleteRequest) GetNamespace() string {
 	if m != nil {
-		return m.TopicName
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *ConsumerGroup_Binding) GetExchangeType() string {
+func (m *TopicDeleteRequest) GetName() string {
 	if m != nil {
-		return m.ExchangeType
+		return m.Name
 	}
 	return ""
 }
 
-func (m *ConsumerGroup_Binding) GetRoutingKey() string {
-	if x, ok := m.GetBy().(*ConsumerGroup_Binding_RoutingKey); ok {
-		return x.RoutingKey
+func (m *TopicDeleteRequest) GetIfUnused() bool {
+	if m != nil {
+		return m.IfUnused
 	}
-	return ""
-}
-
-func (m *ConsumerGroup_Binding) GetHeadersAll() *types.Struct {
-	if x, ok := m.GetBy().(*ConsumerGroup_Binding_HeadersAll); ok {
-		return x.HeadersAll
-	}
-	return nil
-}
-
-func (m *ConsumerGroup_Binding) GetHeadersAny() *types.Struct {
-	if x, ok := m.GetBy().(*ConsumerGroup_Binding_HeadersAny); ok {
-		return x.HeadersAny
-	}
-	return nil
-}
-
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*ConsumerGroup_Binding) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _ConsumerGroup_Binding_OneofMarshaler, _ConsumerGroup_Binding_OneofUnmarshaler, _ConsumerGroup_Binding_OneofSizer, []interface{}{
-		(*ConsumerGroup_Binding_RoutingKey)(nil),
-		(*ConsumerGroup_Binding_HeadersAll)(nil),
-		(*ConsumerGroup_Binding_HeadersAny)(nil),
-	}
-}
-
-func _ConsumerGroup_Binding_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*ConsumerGroup_Binding)
-	// by
-	switch x := m.By.(type) {
-	case *ConsumerGroup_Binding_RoutingKey:
-		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
-		_ = b.EncodeStringBytes(x.RoutingKey)
-	case *ConsumerGroup_Binding_HeadersAll:
-		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.HeadersAll); err != nil {
-			return err
-		}
-	case *ConsumerGroup_Binding_HeadersAny:
-		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.HeadersAny); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("ConsumerGroup_Binding.By has unexpected type %T", x)
-	}
-	return nil
-}
-
-func _ConsumerGroup_Binding_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*ConsumerGroup_Binding)
-	switch tag {
-	case 3: // by.routing_key
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeStringBytes()
-		m.By = &ConsumerGroup_Binding_RoutingKey{x}
-		return true, err
-	case 4: // by.headers_all
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(types.Struct)
-		err := b.DecodeMessage(msg)
-		m.By = &ConsumerGroup_Binding_HeadersAll{msg}
-		return true, err
-	case 5: // by.headers_any
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(types.Struct)
-		err := b.DecodeMessage(msg)
-		m.By = &ConsumerGroup_Binding_HeadersAny{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ConsumerGroup_Binding_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ConsumerGroup_Binding)
-	// by
-	switch x := m.By.(type) {
-	case *ConsumerGroup_Binding_RoutingKey:
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.RoutingKey)))
-		n += len(x.RoutingKey)
-	case *ConsumerGroup_Binding_HeadersAll:
-		s := proto.Size(x.HeadersAll)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ConsumerGroup_Binding_HeadersAny:
-		s := proto.Size(x.HeadersAny)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
+	return false
 }
 
-type ConsumerGroupListRequest struct {
-	// If true and node is not a leader, request will fail.
-	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type TopicDeleteResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupListRequest) Reset()         { *m = ConsumerGroupListRequest{} }
-func (m *ConsumerGroupListRequest) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupListRequest) ProtoMessage()    {}
-func (*ConsumerGroupListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{16}
+func (m *TopicDeleteResponse) Reset()         { *m = TopicDeleteResponse{} }
+func (m *TopicDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*TopicDeleteResponse) ProtoMessage()    {}
+func (*TopicDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{16}
 }
-func (m *ConsumerGroupListRequest) XXX_Unmarshal(b []byte) error {
+func (m *TopicDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicDeleteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1234,59 +1365,59 @@ func (m *ConsumerGroupListRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupListRequest.Merge(dst, src)
+func (dst *TopicDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicDeleteResponse.Merge(dst, src)
 }
-func (m *ConsumerGroupListRequest) XXX_Size() int {
+func (m *TopicDeleteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupListRequest.DiscardUnknown(m)
+func (m *TopicDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicDeleteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupListRequest proto.InternalMessageInfo
+var xxx_messageInfo_TopicDeleteResponse proto.InternalMessageInfo
 
-func (m *ConsumerGroupListRequest) GetLeaderOnly() bool {
+func (m *TopicDeleteResponse) GetOK() bool {
 	if m != nil {
-		return m.LeaderOnly
+		return m.OK
 	}
 	return false
 }
 
-func (m *ConsumerGroupListRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
-}
-
-func (m *ConsumerGroupListRequest) GetName() string {
+func (m *TopicDeleteResponse) GetIndex() uint64 {
 	if m != nil {
-		return m.Name
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-type ConsumerGroupListResponse struct {
-	OK                   bool             `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Index                uint64           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	ConsumerGroups       []*ConsumerGroup `protobuf:"bytes,3,rep,name=consumer_groups,json=consumerGroups" json:"consumer_groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type TopicPublishRequest struct {
+	// If true and node cannot write message to segment, request will fail.
+	DoNotForward bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	Namespace    string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message      *Message `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+	// Producer ID & sequence number are used for deduplication if topic has deduplication window set. Sequence numbers
+	// must increase with each message of the producer, message with sequence number not higher than the last one
+	// written is considered a duplicate.
+	ProducerID           string   `protobuf:"bytes,4,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	Sequence             uint64   `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupListResponse) Reset()         { *m = ConsumerGroupListResponse{} }
-func (m *ConsumerGroupListResponse) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupListResponse) ProtoMessage()    {}
-func (*ConsumerGroupListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{17}
+func (m *TopicPublishRequest) Reset()         { *m = TopicPublishRequest{} }
+func (m *TopicPublishRequest) String() string { return proto.CompactTextString(m) }
+func (*TopicPublishRequest) ProtoMessage()    {}
+func (*TopicPublishRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{17}
 }
-func (m *ConsumerGroupListResponse) XXX_Unmarshal(b []byte) error {
+func (m *TopicPublishRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicPublishRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicPublishRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1296,60 +1427,80 @@ func (m *ConsumerGroupListResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupListResponse.Merge(dst, src)
+func (dst *TopicPublishRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicPublishRequest.Merge(dst, src)
 }
-func (m *ConsumerGroupListResponse) XXX_Size() int {
+func (m *TopicPublishRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupListResponse.DiscardUnknown(m)
+func (m *TopicPublishRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicPublishRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupListResponse proto.InternalMessageInfo
+var xxx_messageInfo_TopicPublishRequest proto.InternalMessageInfo
 
-func (m *ConsumerGroupListResponse) GetOK() bool {
+func (m *TopicPublishRequest) GetDoNotForward() bool {
 	if m != nil {
-		return m.OK
+		return m.DoNotForward
 	}
 	return false
 }
 
-func (m *ConsumerGroupListResponse) GetIndex() uint64 {
+func (m *TopicPublishRequest) GetNamespace() string {
 	if m != nil {
-		return m.Index
+		return m.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (m *ConsumerGroupListResponse) GetConsumerGroups() []*ConsumerGroup {
+func (m *TopicPublishRequest) GetName() string {
 	if m != nil {
-		return m.ConsumerGroups
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TopicPublishRequest) GetMessage() *Message {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-type ConsumerGroupDeleteRequest struct {
-	// If true and node is not a leader, request will fail.
-	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (m *TopicPublishRequest) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+func (m *TopicPublishRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type TopicPublishResponse struct {
+	OK bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// True if message was a duplicate & therefore wasn't written again.
+	Duplicate            bool     `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupDeleteRequest) Reset()         { *m = ConsumerGroupDeleteRequest{} }
-func (m *ConsumerGroupDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupDeleteRequest) ProtoMessage()    {}
-func (*ConsumerGroupDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{18}
+func (m *TopicPublishResponse) Reset()         { *m = TopicPublishResponse{} }
+func (m *TopicPublishResponse) String() string { return proto.CompactTextString(m) }
+func (*TopicPublishResponse) ProtoMessage()    {}
+func (*TopicPublishResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{18}
 }
-func (m *ConsumerGroupDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *TopicPublishResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicPublishResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicPublishResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1359,58 +1510,52 @@ func (m *ConsumerGroupDeleteRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupDeleteRequest.Merge(dst, src)
+func (dst *TopicPublishResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicPublishResponse.Merge(dst, src)
 }
-func (m *ConsumerGroupDeleteRequest) XXX_Size() int {
+func (m *TopicPublishResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupDeleteRequest.DiscardUnknown(m)
+func (m *TopicPublishResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicPublishResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_TopicPublishResponse proto.InternalMessageInfo
 
-func (m *ConsumerGroupDeleteRequest) GetLeaderOnly() bool {
+func (m *TopicPublishResponse) GetOK() bool {
 	if m != nil {
-		return m.LeaderOnly
+		return m.OK
 	}
 	return false
 }
 
-func (m *ConsumerGroupDeleteRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
-}
-
-func (m *ConsumerGroupDeleteRequest) GetName() string {
+func (m *TopicPublishResponse) GetDuplicate() bool {
 	if m != nil {
-		return m.Name
+		return m.Duplicate
 	}
-	return ""
+	return false
 }
 
-type ConsumerGroupDeleteResponse struct {
-	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ConsumerGroupCreateRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool          `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	ConsumerGroup        ConsumerGroup `protobuf:"bytes,1,opt,name=consumer_group,json=consumerGroup" json:"consumer_group"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ConsumerGroupDeleteResponse) Reset()         { *m = ConsumerGroupDeleteResponse{} }
-func (m *ConsumerGroupDeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupDeleteResponse) ProtoMessage()    {}
-func (*ConsumerGroupDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{19}
+func (m *ConsumerGroupCreateRequest) Reset()         { *m = ConsumerGroupCreateRequest{} }
+func (m *ConsumerGroupCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupCreateRequest) ProtoMessage()    {}
+func (*ConsumerGroupCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{19}
 }
-func (m *ConsumerGroupDeleteResponse) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupDeleteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupCreateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1420,53 +1565,51 @@ func (m *ConsumerGroupDeleteResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupDeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupDeleteResponse.Merge(dst, src)
+func (dst *ConsumerGroupCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupCreateRequest.Merge(dst, src)
 }
-func (m *ConsumerGroupDeleteResponse) XXX_Size() int {
+func (m *ConsumerGroupCreateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupDeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupDeleteResponse.DiscardUnknown(m)
+func (m *ConsumerGroupCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupCreateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupDeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupCreateRequest proto.InternalMessageInfo
 
-func (m *ConsumerGroupDeleteResponse) GetOK() bool {
+func (m *ConsumerGroupCreateRequest) GetLeaderOnly() bool {
 	if m != nil {
-		return m.OK
+		return m.LeaderOnly
 	}
 	return false
 }
 
-func (m *ConsumerGroupDeleteResponse) GetIndex() uint64 {
+func (m *ConsumerGroupCreateRequest) GetConsumerGroup() ConsumerGroup {
 	if m != nil {
-		return m.Index
+		return m.ConsumerGroup
 	}
-	return 0
+	return ConsumerGroup{}
 }
 
-type Message struct {
-	RoutingKey           string              `protobuf:"bytes,1,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
-	Properties           *Message_Properties `protobuf:"bytes,2,opt,name=properties" json:"properties,omitempty"`
-	Headers              *types.Struct       `protobuf:"bytes,3,opt,name=headers" json:"headers,omitempty"`
-	Data                 []byte              `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type ConsumerGroupCreateResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Message) Reset()         { *m = Message{} }
-func (m *Message) String() string { return proto.CompactTextString(m) }
-func (*Message) ProtoMessage()    {}
-func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{20}
+func (m *ConsumerGroupCreateResponse) Reset()         { *m = ConsumerGroupCreateResponse{} }
+func (m *ConsumerGroupCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupCreateResponse) ProtoMessage()    {}
+func (*ConsumerGroupCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{20}
 }
-func (m *Message) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupCreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupCreateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1476,79 +1619,63 @@ func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Message) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Message.Merge(dst, src)
+func (dst *ConsumerGroupCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupCreateResponse.Merge(dst, src)
 }
-func (m *Message) XXX_Size() int {
+func (m *ConsumerGroupCreateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Message) XXX_DiscardUnknown() {
-	xxx_messageInfo_Message.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Message proto.InternalMessageInfo
-
-func (m *Message) GetRoutingKey() string {
-	if m != nil {
-		return m.RoutingKey
-	}
-	return ""
+func (m *ConsumerGroupCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupCreateResponse.DiscardUnknown(m)
 }
 
-func (m *Message) GetProperties() *Message_Properties {
-	if m != nil {
-		return m.Properties
-	}
-	return nil
-}
+var xxx_messageInfo_ConsumerGroupCreateResponse proto.InternalMessageInfo
 
-func (m *Message) GetHeaders() *types.Struct {
+func (m *ConsumerGroupCreateResponse) GetOK() bool {
 	if m != nil {
-		return m.Headers
+		return m.OK
 	}
-	return nil
+	return false
 }
 
-func (m *Message) GetData() []byte {
+func (m *ConsumerGroupCreateResponse) GetIndex() uint64 {
 	if m != nil {
-		return m.Data
+		return m.Index
 	}
-	return nil
+	return 0
 }
 
-type Message_Properties struct {
-	ContentType          string    `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	ContentEncoding      string    `protobuf:"bytes,2,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
-	DeliveryMode         int32     `protobuf:"varint,3,opt,name=delivery_mode,json=deliveryMode,proto3" json:"delivery_mode,omitempty"`
-	Priority             int32     `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	CorrelationID        string    `protobuf:"bytes,5,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	ReplyTo              string    `protobuf:"bytes,6,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
-	Expiration           string    `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	MessageID            string    `protobuf:"bytes,8,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	Timestamp            time.Time `protobuf:"bytes,9,opt,name=timestamp,stdtime" json:"timestamp"`
-	Type                 string    `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
-	UserID               string    `protobuf:"bytes,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AppID                string    `protobuf:"bytes,12,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	To                   string    `protobuf:"bytes,13,opt,name=to,proto3" json:"to,omitempty"`
-	GroupID              string    `protobuf:"bytes,14,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	GroupSequence        uint32    `protobuf:"varint,15,opt,name=group_sequence,json=groupSequence,proto3" json:"group_sequence,omitempty"`
-	ReplyToGroupID       string    `protobuf:"bytes,16,opt,name=reply_to_group_id,json=replyToGroupId,proto3" json:"reply_to_group_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ConsumerGroup struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Bindings determine what messages will be visible to consumer group.
+	Bindings []*ConsumerGroup_Binding `protobuf:"bytes,3,rep,name=bindings" json:"bindings,omitempty"`
+	// Max count of in-flight messages across all consumers. Not specified / zero means that the server will choose sensible defaults.
+	Size_ uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Time from which to consider messages eligible to be consumed by this consumer group.
+	Since time.Time `protobuf:"bytes,5,opt,name=since,stdtime" json:"since"`
+	// If set, messages with higher priority (message property, capped to this value) are sent to consumers first.
+	// Not specified / zero means that messages are sent in FIFO order. Max allowed value is 255.
+	MaxPriority uint32 `protobuf:"varint,6,opt,name=max_priority,json=maxPriority,proto3" json:"max_priority,omitempty"`
+	// If true, only one subscription (the active one) receives messages at the time, other subscriptions stand by. When
+	// the active subscription is closed, one of the standing by subscriptions takes over.
+	SingleActiveConsumer bool     `protobuf:"varint,7,opt,name=single_active_consumer,json=singleActiveConsumer,proto3" json:"single_active_consumer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Message_Properties) Reset()         { *m = Message_Properties{} }
-func (m *Message_Properties) String() string { return proto.CompactTextString(m) }
-func (*Message_Properties) ProtoMessage()    {}
-func (*Message_Properties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{20, 0}
+func (m *ConsumerGroup) Reset()         { *m = ConsumerGroup{} }
+func (m *ConsumerGroup) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroup) ProtoMessage()    {}
+func (*ConsumerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{21}
 }
-func (m *Message_Properties) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Message_Properties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Message_Properties.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1558,159 +1685,294 @@ func (m *Message_Properties) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *Message_Properties) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Message_Properties.Merge(dst, src)
+func (dst *ConsumerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroup.Merge(dst, src)
 }
-func (m *Message_Properties) XXX_Size() int {
+func (m *ConsumerGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *Message_Properties) XXX_DiscardUnknown() {
-	xxx_messageInfo_Message_Properties.DiscardUnknown(m)
+func (m *ConsumerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Message_Properties proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroup proto.InternalMessageInfo
 
-func (m *Message_Properties) GetContentType() string {
+func (m *ConsumerGroup) GetNamespace() string {
 	if m != nil {
-		return m.ContentType
+		return m.Namespace
 	}
 	return ""
 }
 
-func (m *Message_Properties) GetContentEncoding() string {
+func (m *ConsumerGroup) GetName() string {
 	if m != nil {
-		return m.ContentEncoding
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Message_Properties) GetDeliveryMode() int32 {
+func (m *ConsumerGroup) GetBindings() []*ConsumerGroup_Binding {
 	if m != nil {
-		return m.DeliveryMode
+		return m.Bindings
 	}
-	return 0
+	return nil
 }
 
-func (m *Message_Properties) GetPriority() int32 {
+func (m *ConsumerGroup) GetSize_() uint32 {
 	if m != nil {
-		return m.Priority
+		return m.Size_
 	}
 	return 0
 }
 
-func (m *Message_Properties) GetCorrelationID() string {
+func (m *ConsumerGroup) GetSince() time.Time {
 	if m != nil {
-		return m.CorrelationID
+		return m.Since
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *Message_Properties) GetReplyTo() string {
+func (m *ConsumerGroup) GetMaxPriority() uint32 {
 	if m != nil {
-		return m.ReplyTo
+		return m.MaxPriority
 	}
-	return ""
+	return 0
 }
 
-func (m *Message_Properties) GetExpiration() string {
+func (m *ConsumerGroup) GetSingleActiveConsumer() bool {
 	if m != nil {
-		return m.Expiration
+		return m.SingleActiveConsumer
 	}
-	return ""
+	return false
 }
 
-func (m *Message_Properties) GetMessageID() string {
-	if m != nil {
-		return m.MessageID
-	}
-	return ""
+type ConsumerGroup_Binding struct {
+	TopicName    string `protobuf:"bytes,1,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
+	ExchangeType string `protobuf:"bytes,2,opt,name=exchange_type,json=exchangeType,proto3" json:"exchange_type,omitempty"`
+	// Types that are valid to be assigned to By:
+	//	*ConsumerGroup_Binding_RoutingKey
+	//	*ConsumerGroup_Binding_HeadersAll
+	//	*ConsumerGroup_Binding_HeadersAny
+	By isConsumerGroup_Binding_By `protobuf_oneof:"by"`
+	// SQL-like expression over message headers & properties, e.g. `priority > 5 AND region IN ('eu', 'us')`.
+	// If set, message must match both binding & filter.
+	Filter               string   `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Message_Properties) GetTimestamp() time.Time {
-	if m != nil {
-		return m.Timestamp
-	}
-	return time.Time{}
+func (m *ConsumerGroup_Binding) Reset()         { *m = ConsumerGroup_Binding{} }
+func (m *ConsumerGroup_Binding) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroup_Binding) ProtoMessage()    {}
+func (*ConsumerGroup_Binding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{21, 0}
 }
-
-func (m *Message_Properties) GetType() string {
-	if m != nil {
-		return m.Type
+func (m *ConsumerGroup_Binding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroup_Binding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroup_Binding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *Message_Properties) GetUserID() string {
-	if m != nil {
-		return m.UserID
+func (dst *ConsumerGroup_Binding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroup_Binding.Merge(dst, src)
+}
+func (m *ConsumerGroup_Binding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroup_Binding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroup_Binding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGroup_Binding proto.InternalMessageInfo
+
+type isConsumerGroup_Binding_By interface {
+	isConsumerGroup_Binding_By()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ConsumerGroup_Binding_RoutingKey struct {
+	RoutingKey string `protobuf:"bytes,3,opt,name=routing_key,json=routingKey,proto3,oneof"`
+}
+type ConsumerGroup_Binding_HeadersAll struct {
+	HeadersAll *types.Struct `protobuf:"bytes,4,opt,name=headers_all,json=headersAll,oneof"`
+}
+type ConsumerGroup_Binding_HeadersAny struct {
+	HeadersAny *types.Struct `protobuf:"bytes,5,opt,name=headers_any,json=headersAny,oneof"`
+}
+
+func (*ConsumerGroup_Binding_RoutingKey) isConsumerGroup_Binding_By() {}
+func (*ConsumerGroup_Binding_HeadersAll) isConsumerGroup_Binding_By() {}
+func (*ConsumerGroup_Binding_HeadersAny) isConsumerGroup_Binding_By() {}
+
+func (m *ConsumerGroup_Binding) GetBy() isConsumerGroup_Binding_By {
+	if m != nil {
+		return m.By
 	}
-	return ""
+	return nil
 }
 
-func (m *Message_Properties) GetAppID() string {
+func (m *ConsumerGroup_Binding) GetTopicName() string {
 	if m != nil {
-		return m.AppID
+		return m.TopicName
 	}
 	return ""
 }
 
-func (m *Message_Properties) GetTo() string {
+func (m *ConsumerGroup_Binding) GetExchangeType() string {
 	if m != nil {
-		return m.To
+		return m.ExchangeType
 	}
 	return ""
 }
 
-func (m *Message_Properties) GetGroupID() string {
-	if m != nil {
-		return m.GroupID
+func (m *ConsumerGroup_Binding) GetRoutingKey() string {
+	if x, ok := m.GetBy().(*ConsumerGroup_Binding_RoutingKey); ok {
+		return x.RoutingKey
 	}
 	return ""
 }
 
-func (m *Message_Properties) GetGroupSequence() uint32 {
-	if m != nil {
-		return m.GroupSequence
+func (m *ConsumerGroup_Binding) GetHeadersAll() *types.Struct {
+	if x, ok := m.GetBy().(*ConsumerGroup_Binding_HeadersAll); ok {
+		return x.HeadersAll
 	}
-	return 0
+	return nil
 }
 
-func (m *Message_Properties) GetReplyToGroupID() string {
+func (m *ConsumerGroup_Binding) GetHeadersAny() *types.Struct {
+	if x, ok := m.GetBy().(*ConsumerGroup_Binding_HeadersAny); ok {
+		return x.HeadersAny
+	}
+	return nil
+}
+
+func (m *ConsumerGroup_Binding) GetFilter() string {
 	if m != nil {
-		return m.ReplyToGroupID
+		return m.Filter
 	}
 	return ""
 }
 
-type ConsumerGroupSubscribeRequest struct {
-	// If true and node does not manage consumer group, request will fail.
-	DoNotForward bool   `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
-	Namespace    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Max number of messages in-flight. Zero means there is no limit.
-	Size_ uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// If true, messages will be acked immediately after being sent.
-	AutoAck bool `protobuf:"varint,4,opt,name=auto_ack,json=autoAck,proto3" json:"auto_ack,omitempty"`
-	// If true, response stream will be closed as soon as there are no waiting messages (either to be consumed, or acked).
-	DoNotBlock bool `protobuf:"varint,5,opt,name=do_not_block,json=doNotBlock,proto3" json:"do_not_block,omitempty"`
-	// If not zero, response stream will be closed as soon as there are no
-	MaxMessages          uint64   `protobuf:"varint,6,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ConsumerGroup_Binding) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ConsumerGroup_Binding_OneofMarshaler, _ConsumerGroup_Binding_OneofUnmarshaler, _ConsumerGroup_Binding_OneofSizer, []interface{}{
+		(*ConsumerGroup_Binding_RoutingKey)(nil),
+		(*ConsumerGroup_Binding_HeadersAll)(nil),
+		(*ConsumerGroup_Binding_HeadersAny)(nil),
+	}
+}
+
+func _ConsumerGroup_Binding_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ConsumerGroup_Binding)
+	// by
+	switch x := m.By.(type) {
+	case *ConsumerGroup_Binding_RoutingKey:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		_ = b.EncodeStringBytes(x.RoutingKey)
+	case *ConsumerGroup_Binding_HeadersAll:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.HeadersAll); err != nil {
+			return err
+		}
+	case *ConsumerGroup_Binding_HeadersAny:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.HeadersAny); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ConsumerGroup_Binding.By has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ConsumerGroup_Binding_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ConsumerGroup_Binding)
+	switch tag {
+	case 3: // by.routing_key
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.By = &ConsumerGroup_Binding_RoutingKey{x}
+		return true, err
+	case 4: // by.headers_all
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.Struct)
+		err := b.DecodeMessage(msg)
+		m.By = &ConsumerGroup_Binding_HeadersAll{msg}
+		return true, err
+	case 5: // by.headers_any
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.Struct)
+		err := b.DecodeMessage(msg)
+		m.By = &ConsumerGroup_Binding_HeadersAny{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ConsumerGroup_Binding_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ConsumerGroup_Binding)
+	// by
+	switch x := m.By.(type) {
+	case *ConsumerGroup_Binding_RoutingKey:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.RoutingKey)))
+		n += len(x.RoutingKey)
+	case *ConsumerGroup_Binding_HeadersAll:
+		s := proto.Size(x.HeadersAll)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ConsumerGroup_Binding_HeadersAny:
+		s := proto.Size(x.HeadersAny)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ConsumerGroupListRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupSubscribeRequest) Reset()         { *m = ConsumerGroupSubscribeRequest{} }
-func (m *ConsumerGroupSubscribeRequest) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupSubscribeRequest) ProtoMessage()    {}
-func (*ConsumerGroupSubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{21}
+func (m *ConsumerGroupListRequest) Reset()         { *m = ConsumerGroupListRequest{} }
+func (m *ConsumerGroupListRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupListRequest) ProtoMessage()    {}
+func (*ConsumerGroupListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{22}
 }
-func (m *ConsumerGroupSubscribeRequest) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupSubscribeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1720,90 +1982,122 @@ func (m *ConsumerGroupSubscribeRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupSubscribeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupSubscribeRequest.Merge(dst, src)
+func (dst *ConsumerGroupListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupListRequest.Merge(dst, src)
 }
-func (m *ConsumerGroupSubscribeRequest) XXX_Size() int {
+func (m *ConsumerGroupListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupSubscribeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupSubscribeRequest.DiscardUnknown(m)
+func (m *ConsumerGroupListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupSubscribeRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupListRequest proto.InternalMessageInfo
 
-func (m *ConsumerGroupSubscribeRequest) GetDoNotForward() bool {
+func (m *ConsumerGroupListRequest) GetLeaderOnly() bool {
 	if m != nil {
-		return m.DoNotForward
+		return m.LeaderOnly
 	}
 	return false
 }
 
-func (m *ConsumerGroupSubscribeRequest) GetNamespace() string {
+func (m *ConsumerGroupListRequest) GetNamespace() string {
 	if m != nil {
 		return m.Namespace
 	}
 	return ""
 }
 
-func (m *ConsumerGroupSubscribeRequest) GetName() string {
+func (m *ConsumerGroupListRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ConsumerGroupSubscribeRequest) GetSize_() uint32 {
-	if m != nil {
-		return m.Size_
+type ConsumerGroupListResponse struct {
+	OK                   bool             `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	ConsumerGroups       []*ConsumerGroup `protobuf:"bytes,3,rep,name=consumer_groups,json=consumerGroups" json:"consumer_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ConsumerGroupListResponse) Reset()         { *m = ConsumerGroupListResponse{} }
+func (m *ConsumerGroupListResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupListResponse) ProtoMessage()    {}
+func (*ConsumerGroupListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{23}
+}
+func (m *ConsumerGroupListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (dst *ConsumerGroupListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupListResponse.Merge(dst, src)
+}
+func (m *ConsumerGroupListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupListResponse.DiscardUnknown(m)
 }
 
-func (m *ConsumerGroupSubscribeRequest) GetAutoAck() bool {
+var xxx_messageInfo_ConsumerGroupListResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupListResponse) GetOK() bool {
 	if m != nil {
-		return m.AutoAck
+		return m.OK
 	}
 	return false
 }
 
-func (m *ConsumerGroupSubscribeRequest) GetDoNotBlock() bool {
+func (m *ConsumerGroupListResponse) GetIndex() uint64 {
 	if m != nil {
-		return m.DoNotBlock
+		return m.Index
 	}
-	return false
+	return 0
 }
 
-func (m *ConsumerGroupSubscribeRequest) GetMaxMessages() uint64 {
+func (m *ConsumerGroupListResponse) GetConsumerGroups() []*ConsumerGroup {
 	if m != nil {
-		return m.MaxMessages
+		return m.ConsumerGroups
 	}
-	return 0
+	return nil
 }
 
-type ConsumerGroupSubscribeResponse struct {
-	NodeID               uint64   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	SubscriptionID       uint64   `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	SeqNo                uint64   `protobuf:"varint,3,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
-	TopicNamespace       string   `protobuf:"bytes,4,opt,name=topic_namespace,json=topicNamespace,proto3" json:"topic_namespace,omitempty"`
-	TopicName            string   `protobuf:"bytes,5,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
-	Message              *Message `protobuf:"bytes,6,opt,name=message" json:"message,omitempty"`
+type ConsumerGroupDescribeRequest struct {
+	// If true and node does not manage consumer group, request will fail.
+	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupSubscribeResponse) Reset()         { *m = ConsumerGroupSubscribeResponse{} }
-func (m *ConsumerGroupSubscribeResponse) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroupSubscribeResponse) ProtoMessage()    {}
-func (*ConsumerGroupSubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{22}
+func (m *ConsumerGroupDescribeRequest) Reset()         { *m = ConsumerGroupDescribeRequest{} }
+func (m *ConsumerGroupDescribeRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDescribeRequest) ProtoMessage()    {}
+func (*ConsumerGroupDescribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{24}
 }
-func (m *ConsumerGroupSubscribeResponse) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupDescribeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroupSubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupDescribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroupSubscribeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupDescribeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1813,82 +2107,200 @@ func (m *ConsumerGroupSubscribeResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (dst *ConsumerGroupSubscribeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroupSubscribeResponse.Merge(dst, src)
+func (dst *ConsumerGroupDescribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDescribeRequest.Merge(dst, src)
 }
-func (m *ConsumerGroupSubscribeResponse) XXX_Size() int {
+func (m *ConsumerGroupDescribeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroupSubscribeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroupSubscribeResponse.DiscardUnknown(m)
+func (m *ConsumerGroupDescribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDescribeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerGroupSubscribeResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupDescribeRequest proto.InternalMessageInfo
 
-func (m *ConsumerGroupSubscribeResponse) GetNodeID() uint64 {
+func (m *ConsumerGroupDescribeRequest) GetDoNotForward() bool {
+	if m != nil {
+		return m.DoNotForward
+	}
+	return false
+}
+
+func (m *ConsumerGroupDescribeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ConsumerGroupDescribeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ConsumerGroupDescribeResponse struct {
+	OK            bool           `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index         uint64         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	ConsumerGroup *ConsumerGroup `protobuf:"bytes,3,opt,name=consumer_group,json=consumerGroup" json:"consumer_group,omitempty"`
+	// Node that manages consumer group, i.e. reads bound topics & delivers messages to subscribers. Zero if consumer
+	// group is not assigned to any node yet.
+	NodeID        uint64                                        `protobuf:"varint,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Topics        []*ConsumerGroupDescribeResponse_TopicLag     `protobuf:"bytes,5,rep,name=topics" json:"topics,omitempty"`
+	Subscriptions []*ConsumerGroupDescribeResponse_Subscription `protobuf:"bytes,6,rep,name=subscriptions" json:"subscriptions,omitempty"`
+	// Messages read from topics waiting to be delivered to subscribers.
+	Waiting uint32 `protobuf:"varint,7,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	// Messages delivered to subscribers, not (n)acked yet.
+	InFlight uint32 `protobuf:"varint,8,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// Messages acked, waiting for preceding messages to be acked before offsets get committed.
+	AckPending uint32 `protobuf:"varint,9,opt,name=ack_pending,json=ackPending,proto3" json:"ack_pending,omitempty"`
+	// Subscription that receives messages if consumer group has single active consumer. Zero if there is none.
+	ActiveSubscriptionID uint64 `protobuf:"varint,10,opt,name=active_subscription_id,json=activeSubscriptionId,proto3" json:"active_subscription_id,omitempty"`
+	// Time when the active subscription took over.
+	ActiveSince          *time.Time `protobuf:"bytes,11,opt,name=active_since,json=activeSince,stdtime" json:"active_since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ConsumerGroupDescribeResponse) Reset()         { *m = ConsumerGroupDescribeResponse{} }
+func (m *ConsumerGroupDescribeResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDescribeResponse) ProtoMessage()    {}
+func (*ConsumerGroupDescribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{25}
+}
+func (m *ConsumerGroupDescribeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupDescribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupDescribeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConsumerGroupDescribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDescribeResponse.Merge(dst, src)
+}
+func (m *ConsumerGroupDescribeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupDescribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDescribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGroupDescribeResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupDescribeResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
+	}
+	return false
+}
+
+func (m *ConsumerGroupDescribeResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ConsumerGroupDescribeResponse) GetConsumerGroup() *ConsumerGroup {
+	if m != nil {
+		return m.ConsumerGroup
+	}
+	return nil
+}
+
+func (m *ConsumerGroupDescribeResponse) GetNodeID() uint64 {
 	if m != nil {
 		return m.NodeID
 	}
 	return 0
 }
 
-func (m *ConsumerGroupSubscribeResponse) GetSubscriptionID() uint64 {
+func (m *ConsumerGroupDescribeResponse) GetTopics() []*ConsumerGroupDescribeResponse_TopicLag {
 	if m != nil {
-		return m.SubscriptionID
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *ConsumerGroupDescribeResponse) GetSubscriptions() []*ConsumerGroupDescribeResponse_Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *ConsumerGroupDescribeResponse) GetWaiting() uint32 {
+	if m != nil {
+		return m.Waiting
 	}
 	return 0
 }
 
-func (m *ConsumerGroupSubscribeResponse) GetSeqNo() uint64 {
+func (m *ConsumerGroupDescribeResponse) GetInFlight() uint32 {
 	if m != nil {
-		return m.SeqNo
+		return m.InFlight
 	}
 	return 0
 }
 
-func (m *ConsumerGroupSubscribeResponse) GetTopicNamespace() string {
+func (m *ConsumerGroupDescribeResponse) GetAckPending() uint32 {
 	if m != nil {
-		return m.TopicNamespace
+		return m.AckPending
 	}
-	return ""
+	return 0
 }
 
-func (m *ConsumerGroupSubscribeResponse) GetTopicName() string {
+func (m *ConsumerGroupDescribeResponse) GetActiveSubscriptionID() uint64 {
 	if m != nil {
-		return m.TopicName
+		return m.ActiveSubscriptionID
 	}
-	return ""
+	return 0
 }
 
-func (m *ConsumerGroupSubscribeResponse) GetMessage() *Message {
+func (m *ConsumerGroupDescribeResponse) GetActiveSince() *time.Time {
 	if m != nil {
-		return m.Message
+		return m.ActiveSince
 	}
 	return nil
 }
 
-type MessageAckRequest struct {
-	// If true and node does not manage consumer group, request will fail.
-	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
-	NodeID               uint64   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	SubscriptionID       uint64   `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	SeqNo                uint64   `protobuf:"varint,3,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ConsumerGroupDescribeResponse_TopicLag struct {
+	TopicName string `protobuf:"bytes,1,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
+	// Bytes of messages published to the topic not consumed yet.
+	LagBytes int64 `protobuf:"varint,2,opt,name=lag_bytes,json=lagBytes,proto3" json:"lag_bytes,omitempty"`
+	// Estimate of messages not consumed yet (computed from size of sampled messages).
+	LagMessages int64 `protobuf:"varint,3,opt,name=lag_messages,json=lagMessages,proto3" json:"lag_messages,omitempty"`
+	// Time when the oldest message not consumed yet was published. Not set if consumer group caught up.
+	OldestUnconsumed *time.Time `protobuf:"bytes,4,opt,name=oldest_unconsumed,json=oldestUnconsumed,stdtime" json:"oldest_unconsumed,omitempty"`
+	// Time elapsed since the oldest message not consumed yet was published.
+	LagTime              time.Duration `protobuf:"bytes,5,opt,name=lag_time,json=lagTime,stdduration" json:"lag_time"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *MessageAckRequest) Reset()         { *m = MessageAckRequest{} }
-func (m *MessageAckRequest) String() string { return proto.CompactTextString(m) }
-func (*MessageAckRequest) ProtoMessage()    {}
-func (*MessageAckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{23}
+func (m *ConsumerGroupDescribeResponse_TopicLag) Reset() {
+	*m = ConsumerGroupDescribeResponse_TopicLag{}
 }
-func (m *MessageAckRequest) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupDescribeResponse_TopicLag) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDescribeResponse_TopicLag) ProtoMessage()    {}
+func (*ConsumerGroupDescribeResponse_TopicLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{25, 0}
+}
+func (m *ConsumerGroupDescribeResponse_TopicLag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageAckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupDescribeResponse_TopicLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageAckRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupDescribeResponse_TopicLag.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1898,64 +2310,80 @@ func (m *MessageAckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *MessageAckRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageAckRequest.Merge(dst, src)
+func (dst *ConsumerGroupDescribeResponse_TopicLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDescribeResponse_TopicLag.Merge(dst, src)
 }
-func (m *MessageAckRequest) XXX_Size() int {
+func (m *ConsumerGroupDescribeResponse_TopicLag) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageAckRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageAckRequest.DiscardUnknown(m)
+func (m *ConsumerGroupDescribeResponse_TopicLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDescribeResponse_TopicLag.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageAckRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupDescribeResponse_TopicLag proto.InternalMessageInfo
 
-func (m *MessageAckRequest) GetDoNotForward() bool {
+func (m *ConsumerGroupDescribeResponse_TopicLag) GetTopicName() string {
 	if m != nil {
-		return m.DoNotForward
+		return m.TopicName
 	}
-	return false
+	return ""
 }
 
-func (m *MessageAckRequest) GetNodeID() uint64 {
+func (m *ConsumerGroupDescribeResponse_TopicLag) GetLagBytes() int64 {
 	if m != nil {
-		return m.NodeID
+		return m.LagBytes
 	}
 	return 0
 }
 
-func (m *MessageAckRequest) GetSubscriptionID() uint64 {
+func (m *ConsumerGroupDescribeResponse_TopicLag) GetLagMessages() int64 {
 	if m != nil {
-		return m.SubscriptionID
+		return m.LagMessages
 	}
 	return 0
 }
 
-func (m *MessageAckRequest) GetSeqNo() uint64 {
+func (m *ConsumerGroupDescribeResponse_TopicLag) GetOldestUnconsumed() *time.Time {
 	if m != nil {
-		return m.SeqNo
+		return m.OldestUnconsumed
+	}
+	return nil
+}
+
+func (m *ConsumerGroupDescribeResponse_TopicLag) GetLagTime() time.Duration {
+	if m != nil {
+		return m.LagTime
 	}
 	return 0
 }
 
-type MessageAckResponse struct {
-	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+type ConsumerGroupDescribeResponse_Subscription struct {
+	SubscriptionID uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Max number of messages in-flight. Zero means there is no limit.
+	Size_    uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	InFlight uint32 `protobuf:"varint,3,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// Subscription receives messages of single-active-consumer consumer group.
+	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MessageAckResponse) Reset()         { *m = MessageAckResponse{} }
-func (m *MessageAckResponse) String() string { return proto.CompactTextString(m) }
-func (*MessageAckResponse) ProtoMessage()    {}
-func (*MessageAckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{24}
+func (m *ConsumerGroupDescribeResponse_Subscription) Reset() {
+	*m = ConsumerGroupDescribeResponse_Subscription{}
 }
-func (m *MessageAckResponse) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupDescribeResponse_Subscription) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ConsumerGroupDescribeResponse_Subscription) ProtoMessage() {}
+func (*ConsumerGroupDescribeResponse_Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{25, 1}
+}
+func (m *ConsumerGroupDescribeResponse_Subscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupDescribeResponse_Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageAckResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupDescribeResponse_Subscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1965,47 +2393,72 @@ func (m *MessageAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *MessageAckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageAckResponse.Merge(dst, src)
+func (dst *ConsumerGroupDescribeResponse_Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDescribeResponse_Subscription.Merge(dst, src)
 }
-func (m *MessageAckResponse) XXX_Size() int {
+func (m *ConsumerGroupDescribeResponse_Subscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageAckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageAckResponse.DiscardUnknown(m)
+func (m *ConsumerGroupDescribeResponse_Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDescribeResponse_Subscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageAckResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupDescribeResponse_Subscription proto.InternalMessageInfo
 
-func (m *MessageAckResponse) GetOK() bool {
+func (m *ConsumerGroupDescribeResponse_Subscription) GetSubscriptionID() uint64 {
 	if m != nil {
-		return m.OK
+		return m.SubscriptionID
+	}
+	return 0
+}
+
+func (m *ConsumerGroupDescribeResponse_Subscription) GetSize_() uint32 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *ConsumerGroupDescribeResponse_Subscription) GetInFlight() uint32 {
+	if m != nil {
+		return m.InFlight
+	}
+	return 0
+}
+
+func (m *ConsumerGroupDescribeResponse_Subscription) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
 	return false
 }
 
-type MessageNackRequest struct {
-	// If true and node does not manage consumer group, request will fail.
-	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
-	NodeID               uint64   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	SubscriptionID       uint64   `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	SeqNo                uint64   `protobuf:"varint,3,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ConsumerGroupOffset struct {
+	SegmentID uint64 `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	// Topic the segment belongs to.
+	TopicName string `protobuf:"bytes,2,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
+	// Offset in segment up to which messages were consumed. Zero means that no message from segment was consumed.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Time when segment was created.
+	SegmentCreatedAt time.Time `protobuf:"bytes,4,opt,name=segment_created_at,json=segmentCreatedAt,stdtime" json:"segment_created_at"`
+	// Time when segment was closed. Not set if segment is still open.
+	SegmentClosedAt      *time.Time `protobuf:"bytes,5,opt,name=segment_closed_at,json=segmentClosedAt,stdtime" json:"segment_closed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *MessageNackRequest) Reset()         { *m = MessageNackRequest{} }
-func (m *MessageNackRequest) String() string { return proto.CompactTextString(m) }
-func (*MessageNackRequest) ProtoMessage()    {}
-func (*MessageNackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{25}
+func (m *ConsumerGroupOffset) Reset()         { *m = ConsumerGroupOffset{} }
+func (m *ConsumerGroupOffset) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffset) ProtoMessage()    {}
+func (*ConsumerGroupOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{26}
 }
-func (m *MessageNackRequest) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupOffset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageNackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageNackRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupOffset.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2015,64 +2468,74 @@ func (m *MessageNackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *MessageNackRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageNackRequest.Merge(dst, src)
+func (dst *ConsumerGroupOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffset.Merge(dst, src)
 }
-func (m *MessageNackRequest) XXX_Size() int {
+func (m *ConsumerGroupOffset) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageNackRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageNackRequest.DiscardUnknown(m)
+func (m *ConsumerGroupOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffset.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageNackRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupOffset proto.InternalMessageInfo
 
-func (m *MessageNackRequest) GetDoNotForward() bool {
+func (m *ConsumerGroupOffset) GetSegmentID() uint64 {
 	if m != nil {
-		return m.DoNotForward
+		return m.SegmentID
 	}
-	return false
+	return 0
 }
 
-func (m *MessageNackRequest) GetNodeID() uint64 {
+func (m *ConsumerGroupOffset) GetTopicName() string {
 	if m != nil {
-		return m.NodeID
+		return m.TopicName
 	}
-	return 0
+	return ""
 }
 
-func (m *MessageNackRequest) GetSubscriptionID() uint64 {
+func (m *ConsumerGroupOffset) GetOffset() int64 {
 	if m != nil {
-		return m.SubscriptionID
+		return m.Offset
 	}
 	return 0
 }
 
-func (m *MessageNackRequest) GetSeqNo() uint64 {
+func (m *ConsumerGroupOffset) GetSegmentCreatedAt() time.Time {
 	if m != nil {
-		return m.SeqNo
+		return m.SegmentCreatedAt
 	}
-	return 0
+	return time.Time{}
 }
 
-type MessageNackResponse struct {
-	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+func (m *ConsumerGroupOffset) GetSegmentClosedAt() *time.Time {
+	if m != nil {
+		return m.SegmentClosedAt
+	}
+	return nil
+}
+
+type ConsumerGroupOffsetsExportRequest struct {
+	// If true and node does not manage consumer group, request will fail.
+	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MessageNackResponse) Reset()         { *m = MessageNackResponse{} }
-func (m *MessageNackResponse) String() string { return proto.CompactTextString(m) }
-func (*MessageNackResponse) ProtoMessage()    {}
-func (*MessageNackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_emq_5ee6d35c18ac9d01, []int{26}
+func (m *ConsumerGroupOffsetsExportRequest) Reset()         { *m = ConsumerGroupOffsetsExportRequest{} }
+func (m *ConsumerGroupOffsetsExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffsetsExportRequest) ProtoMessage()    {}
+func (*ConsumerGroupOffsetsExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{27}
 }
-func (m *MessageNackResponse) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerGroupOffsetsExportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageNackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerGroupOffsetsExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageNackResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerGroupOffsetsExportRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2082,2404 +2545,10104 @@ func (m *MessageNackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *MessageNackResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageNackResponse.Merge(dst, src)
+func (dst *ConsumerGroupOffsetsExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffsetsExportRequest.Merge(dst, src)
 }
-func (m *MessageNackResponse) XXX_Size() int {
+func (m *ConsumerGroupOffsetsExportRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageNackResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageNackResponse.DiscardUnknown(m)
+func (m *ConsumerGroupOffsetsExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffsetsExportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageNackResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerGroupOffsetsExportRequest proto.InternalMessageInfo
 
-func (m *MessageNackResponse) GetOK() bool {
+func (m *ConsumerGroupOffsetsExportRequest) GetDoNotForward() bool {
 	if m != nil {
-		return m.OK
+		return m.DoNotForward
 	}
 	return false
 }
 
-func init() {
-	proto.RegisterType((*NamespaceCreateRequest)(nil), "io.eventter.mq.NamespaceCreateRequest")
-	proto.RegisterType((*NamespaceCreateResponse)(nil), "io.eventter.mq.NamespaceCreateResponse")
-	proto.RegisterType((*NamespaceDeleteRequest)(nil), "io.eventter.mq.NamespaceDeleteRequest")
-	proto.RegisterType((*NamespaceDeleteResponse)(nil), "io.eventter.mq.NamespaceDeleteResponse")
-	proto.RegisterType((*TopicCreateRequest)(nil), "io.eventter.mq.TopicCreateRequest")
-	proto.RegisterType((*TopicCreateResponse)(nil), "io.eventter.mq.TopicCreateResponse")
-	proto.RegisterType((*Topic)(nil), "io.eventter.mq.Topic")
-	proto.RegisterType((*TopicListRequest)(nil), "io.eventter.mq.TopicListRequest")
-	proto.RegisterType((*TopicListResponse)(nil), "io.eventter.mq.TopicListResponse")
-	proto.RegisterType((*TopicDeleteRequest)(nil), "io.eventter.mq.TopicDeleteRequest")
-	proto.RegisterType((*TopicDeleteResponse)(nil), "io.eventter.mq.TopicDeleteResponse")
-	proto.RegisterType((*TopicPublishRequest)(nil), "io.eventter.mq.TopicPublishRequest")
-	proto.RegisterType((*TopicPublishResponse)(nil), "io.eventter.mq.TopicPublishResponse")
-	proto.RegisterType((*ConsumerGroupCreateRequest)(nil), "io.eventter.mq.ConsumerGroupCreateRequest")
-	proto.RegisterType((*ConsumerGroupCreateResponse)(nil), "io.eventter.mq.ConsumerGroupCreateResponse")
-	proto.RegisterType((*ConsumerGroup)(nil), "io.eventter.mq.ConsumerGroup")
-	proto.RegisterType((*ConsumerGroup_Binding)(nil), "io.eventter.mq.ConsumerGroup.Binding")
-	proto.RegisterType((*ConsumerGroupListRequest)(nil), "io.eventter.mq.ConsumerGroupListRequest")
-	proto.RegisterType((*ConsumerGroupListResponse)(nil), "io.eventter.mq.ConsumerGroupListResponse")
-	proto.RegisterType((*ConsumerGroupDeleteRequest)(nil), "io.eventter.mq.ConsumerGroupDeleteRequest")
-	proto.RegisterType((*ConsumerGroupDeleteResponse)(nil), "io.eventter.mq.ConsumerGroupDeleteResponse")
-	proto.RegisterType((*Message)(nil), "io.eventter.mq.Message")
-	proto.RegisterType((*Message_Properties)(nil), "io.eventter.mq.Message.Properties")
-	proto.RegisterType((*ConsumerGroupSubscribeRequest)(nil), "io.eventter.mq.ConsumerGroupSubscribeRequest")
-	proto.RegisterType((*ConsumerGroupSubscribeResponse)(nil), "io.eventter.mq.ConsumerGroupSubscribeResponse")
-	proto.RegisterType((*MessageAckRequest)(nil), "io.eventter.mq.MessageAckRequest")
-	proto.RegisterType((*MessageAckResponse)(nil), "io.eventter.mq.MessageAckResponse")
-	proto.RegisterType((*MessageNackRequest)(nil), "io.eventter.mq.MessageNackRequest")
-	proto.RegisterType((*MessageNackResponse)(nil), "io.eventter.mq.MessageNackResponse")
+func (m *ConsumerGroupOffsetsExportRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for EventterMQ service
-
-type EventterMQClient interface {
-	CreateNamespace(ctx context.Context, in *NamespaceCreateRequest, opts ...grpc.CallOption) (*NamespaceCreateResponse, error)
-	DeleteNamespace(ctx context.Context, in *NamespaceDeleteRequest, opts ...grpc.CallOption) (*NamespaceDeleteResponse, error)
-	CreateTopic(ctx context.Context, in *TopicCreateRequest, opts ...grpc.CallOption) (*TopicCreateResponse, error)
-	ListTopics(ctx context.Context, in *TopicListRequest, opts ...grpc.CallOption) (*TopicListResponse, error)
-	DeleteTopic(ctx context.Context, in *TopicDeleteRequest, opts ...grpc.CallOption) (*TopicDeleteResponse, error)
-	Publish(ctx context.Context, in *TopicPublishRequest, opts ...grpc.CallOption) (*TopicPublishResponse, error)
-	CreateConsumerGroup(ctx context.Context, in *ConsumerGroupCreateRequest, opts ...grpc.CallOption) (*ConsumerGroupCreateResponse, error)
-	ListConsumerGroups(ctx context.Context, in *ConsumerGroupListRequest, opts ...grpc.CallOption) (*ConsumerGroupListResponse, error)
-	DeleteConsumerGroup(ctx context.Context, in *ConsumerGroupDeleteRequest, opts ...grpc.CallOption) (*ConsumerGroupDeleteResponse, error)
-	Subscribe(ctx context.Context, in *ConsumerGroupSubscribeRequest, opts ...grpc.CallOption) (EventterMQ_SubscribeClient, error)
-	Ack(ctx context.Context, in *MessageAckRequest, opts ...grpc.CallOption) (*MessageAckResponse, error)
-	Nack(ctx context.Context, in *MessageNackRequest, opts ...grpc.CallOption) (*MessageNackResponse, error)
+func (m *ConsumerGroupOffsetsExportRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type eventterMQClient struct {
-	cc *grpc.ClientConn
+type ConsumerGroupOffsetsExportResponse struct {
+	OK                   bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Offsets              []*ConsumerGroupOffset `protobuf:"bytes,3,rep,name=offsets" json:"offsets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func NewEventterMQClient(cc *grpc.ClientConn) EventterMQClient {
-	return &eventterMQClient{cc}
+func (m *ConsumerGroupOffsetsExportResponse) Reset()         { *m = ConsumerGroupOffsetsExportResponse{} }
+func (m *ConsumerGroupOffsetsExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffsetsExportResponse) ProtoMessage()    {}
+func (*ConsumerGroupOffsetsExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{28}
 }
-
-func (c *eventterMQClient) CreateNamespace(ctx context.Context, in *NamespaceCreateRequest, opts ...grpc.CallOption) (*NamespaceCreateResponse, error) {
-	out := new(NamespaceCreateResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/CreateNamespace", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ConsumerGroupOffsetsExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *eventterMQClient) DeleteNamespace(ctx context.Context, in *NamespaceDeleteRequest, opts ...grpc.CallOption) (*NamespaceDeleteResponse, error) {
-	out := new(NamespaceDeleteResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DeleteNamespace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupOffsetsExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ConsumerGroupOffsetsExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffsetsExportResponse.Merge(dst, src)
+}
+func (m *ConsumerGroupOffsetsExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupOffsetsExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffsetsExportResponse.DiscardUnknown(m)
 }
 
-func (c *eventterMQClient) CreateTopic(ctx context.Context, in *TopicCreateRequest, opts ...grpc.CallOption) (*TopicCreateResponse, error) {
-	out := new(TopicCreateResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/CreateTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupOffsetsExportResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupOffsetsExportResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	return out, nil
+	return false
 }
 
-func (c *eventterMQClient) ListTopics(ctx context.Context, in *TopicListRequest, opts ...grpc.CallOption) (*TopicListResponse, error) {
-	out := new(TopicListResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListTopics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsExportResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *eventterMQClient) DeleteTopic(ctx context.Context, in *TopicDeleteRequest, opts ...grpc.CallOption) (*TopicDeleteResponse, error) {
-	out := new(TopicDeleteResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DeleteTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsExportResponse) GetOffsets() []*ConsumerGroupOffset {
+	if m != nil {
+		return m.Offsets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *eventterMQClient) Publish(ctx context.Context, in *TopicPublishRequest, opts ...grpc.CallOption) (*TopicPublishResponse, error) {
-	out := new(TopicPublishResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/Publish", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ConsumerGroupOffsetsImportRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly bool   `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Offsets to set. Segments of bound topics not listed keep their committed offsets. If topic name or segment
+	// creation time is set, it must match the segment. Messages published before consumer group's since time are not
+	// consumed regardless of the offsets. Consumer group is restarted after the import, i.e. its subscriptions are
+	// closed & messages in-flight are delivered again.
+	Offsets              []*ConsumerGroupOffset `protobuf:"bytes,3,rep,name=offsets" json:"offsets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ConsumerGroupOffsetsImportRequest) Reset()         { *m = ConsumerGroupOffsetsImportRequest{} }
+func (m *ConsumerGroupOffsetsImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffsetsImportRequest) ProtoMessage()    {}
+func (*ConsumerGroupOffsetsImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{29}
+}
+func (m *ConsumerGroupOffsetsImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupOffsetsImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupOffsetsImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ConsumerGroupOffsetsImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffsetsImportRequest.Merge(dst, src)
+}
+func (m *ConsumerGroupOffsetsImportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupOffsetsImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffsetsImportRequest.DiscardUnknown(m)
 }
 
-func (c *eventterMQClient) CreateConsumerGroup(ctx context.Context, in *ConsumerGroupCreateRequest, opts ...grpc.CallOption) (*ConsumerGroupCreateResponse, error) {
-	out := new(ConsumerGroupCreateResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/CreateConsumerGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupOffsetsImportRequest proto.InternalMessageInfo
+
+func (m *ConsumerGroupOffsetsImportRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *eventterMQClient) ListConsumerGroups(ctx context.Context, in *ConsumerGroupListRequest, opts ...grpc.CallOption) (*ConsumerGroupListResponse, error) {
-	out := new(ConsumerGroupListResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListConsumerGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsImportRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *eventterMQClient) DeleteConsumerGroup(ctx context.Context, in *ConsumerGroupDeleteRequest, opts ...grpc.CallOption) (*ConsumerGroupDeleteResponse, error) {
-	out := new(ConsumerGroupDeleteResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DeleteConsumerGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsImportRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *eventterMQClient) Subscribe(ctx context.Context, in *ConsumerGroupSubscribeRequest, opts ...grpc.CallOption) (EventterMQ_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_EventterMQ_serviceDesc.Streams[0], "/io.eventter.mq.EventterMQ/Subscribe", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &eventterMQSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsImportRequest) GetOffsets() []*ConsumerGroupOffset {
+	if m != nil {
+		return m.Offsets
 	}
-	return x, nil
+	return nil
 }
 
-type EventterMQ_SubscribeClient interface {
-	Recv() (*ConsumerGroupSubscribeResponse, error)
-	grpc.ClientStream
+type ConsumerGroupOffsetsImportResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type eventterMQSubscribeClient struct {
-	grpc.ClientStream
+func (m *ConsumerGroupOffsetsImportResponse) Reset()         { *m = ConsumerGroupOffsetsImportResponse{} }
+func (m *ConsumerGroupOffsetsImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffsetsImportResponse) ProtoMessage()    {}
+func (*ConsumerGroupOffsetsImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{30}
 }
-
-func (x *eventterMQSubscribeClient) Recv() (*ConsumerGroupSubscribeResponse, error) {
-	m := new(ConsumerGroupSubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsImportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupOffsetsImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupOffsetsImportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (dst *ConsumerGroupOffsetsImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffsetsImportResponse.Merge(dst, src)
+}
+func (m *ConsumerGroupOffsetsImportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupOffsetsImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffsetsImportResponse.DiscardUnknown(m)
 }
 
-func (c *eventterMQClient) Ack(ctx context.Context, in *MessageAckRequest, opts ...grpc.CallOption) (*MessageAckResponse, error) {
-	out := new(MessageAckResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/Ack", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupOffsetsImportResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupOffsetsImportResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	return out, nil
+	return false
 }
 
-func (c *eventterMQClient) Nack(ctx context.Context, in *MessageNackRequest, opts ...grpc.CallOption) (*MessageNackResponse, error) {
-	out := new(MessageNackResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/Nack", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupOffsetsImportResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-// Server API for EventterMQ service
-
-type EventterMQServer interface {
-	CreateNamespace(context.Context, *NamespaceCreateRequest) (*NamespaceCreateResponse, error)
-	DeleteNamespace(context.Context, *NamespaceDeleteRequest) (*NamespaceDeleteResponse, error)
-	CreateTopic(context.Context, *TopicCreateRequest) (*TopicCreateResponse, error)
-	ListTopics(context.Context, *TopicListRequest) (*TopicListResponse, error)
-	DeleteTopic(context.Context, *TopicDeleteRequest) (*TopicDeleteResponse, error)
-	Publish(context.Context, *TopicPublishRequest) (*TopicPublishResponse, error)
-	CreateConsumerGroup(context.Context, *ConsumerGroupCreateRequest) (*ConsumerGroupCreateResponse, error)
-	ListConsumerGroups(context.Context, *ConsumerGroupListRequest) (*ConsumerGroupListResponse, error)
-	DeleteConsumerGroup(context.Context, *ConsumerGroupDeleteRequest) (*ConsumerGroupDeleteResponse, error)
-	Subscribe(*ConsumerGroupSubscribeRequest, EventterMQ_SubscribeServer) error
-	Ack(context.Context, *MessageAckRequest) (*MessageAckResponse, error)
-	Nack(context.Context, *MessageNackRequest) (*MessageNackResponse, error)
+type ConsumerGroupDeleteRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterEventterMQServer(s *grpc.Server, srv EventterMQServer) {
-	s.RegisterService(&_EventterMQ_serviceDesc, srv)
+func (m *ConsumerGroupDeleteRequest) Reset()         { *m = ConsumerGroupDeleteRequest{} }
+func (m *ConsumerGroupDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDeleteRequest) ProtoMessage()    {}
+func (*ConsumerGroupDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{31}
 }
-
-func _EventterMQ_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NamespaceCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).CreateNamespace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/CreateNamespace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).CreateNamespace(ctx, req.(*NamespaceCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ConsumerGroupDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _EventterMQ_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NamespaceDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).DeleteNamespace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/DeleteNamespace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).DeleteNamespace(ctx, req.(*NamespaceDeleteRequest))
+func (m *ConsumerGroupDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _EventterMQ_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).CreateTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/CreateTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).CreateTopic(ctx, req.(*TopicCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (dst *ConsumerGroupDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDeleteRequest.Merge(dst, src)
+}
+func (m *ConsumerGroupDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDeleteRequest.DiscardUnknown(m)
 }
 
-func _EventterMQ_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).ListTopics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/ListTopics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).ListTopics(ctx, req.(*TopicListRequest))
+var xxx_messageInfo_ConsumerGroupDeleteRequest proto.InternalMessageInfo
+
+func (m *ConsumerGroupDeleteRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _EventterMQ_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).DeleteTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/DeleteTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).DeleteTopic(ctx, req.(*TopicDeleteRequest))
+func (m *ConsumerGroupDeleteRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EventterMQ_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicPublishRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).Publish(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/Publish",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).Publish(ctx, req.(*TopicPublishRequest))
+func (m *ConsumerGroupDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EventterMQ_CreateConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumerGroupCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).CreateConsumerGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/CreateConsumerGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).CreateConsumerGroup(ctx, req.(*ConsumerGroupCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ConsumerGroupDeleteResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _EventterMQ_ListConsumerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumerGroupListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).ListConsumerGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/ListConsumerGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).ListConsumerGroups(ctx, req.(*ConsumerGroupListRequest))
+func (m *ConsumerGroupDeleteResponse) Reset()         { *m = ConsumerGroupDeleteResponse{} }
+func (m *ConsumerGroupDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDeleteResponse) ProtoMessage()    {}
+func (*ConsumerGroupDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{32}
+}
+func (m *ConsumerGroupDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupDeleteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ConsumerGroupDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDeleteResponse.Merge(dst, src)
+}
+func (m *ConsumerGroupDeleteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDeleteResponse.DiscardUnknown(m)
 }
 
-func _EventterMQ_DeleteConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumerGroupDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).DeleteConsumerGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/DeleteConsumerGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).DeleteConsumerGroup(ctx, req.(*ConsumerGroupDeleteRequest))
+var xxx_messageInfo_ConsumerGroupDeleteResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupDeleteResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _EventterMQ_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ConsumerGroupSubscribeRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *ConsumerGroupDeleteResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return srv.(EventterMQServer).Subscribe(m, &eventterMQSubscribeServer{stream})
+	return 0
 }
 
-type EventterMQ_SubscribeServer interface {
-	Send(*ConsumerGroupSubscribeResponse) error
-	grpc.ServerStream
+type Message struct {
+	RoutingKey           string              `protobuf:"bytes,1,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	Properties           *Message_Properties `protobuf:"bytes,2,opt,name=properties" json:"properties,omitempty"`
+	Headers              *types.Struct       `protobuf:"bytes,3,opt,name=headers" json:"headers,omitempty"`
+	Data                 []byte              `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-type eventterMQSubscribeServer struct {
-	grpc.ServerStream
+func (m *Message) Reset()         { *m = Message{} }
+func (m *Message) String() string { return proto.CompactTextString(m) }
+func (*Message) ProtoMessage()    {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{33}
 }
-
-func (x *eventterMQSubscribeServer) Send(m *ConsumerGroupSubscribeResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Message) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _EventterMQ_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MessageAckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).Ack(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/Ack",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).Ack(ctx, req.(*MessageAckRequest))
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(dst, src)
+}
+func (m *Message) XXX_Size() int {
+	return m.Size()
+}
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
 }
 
-func _EventterMQ_Nack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MessageNackRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_Message proto.InternalMessageInfo
+
+func (m *Message) GetRoutingKey() string {
+	if m != nil {
+		return m.RoutingKey
 	}
-	if interceptor == nil {
-		return srv.(EventterMQServer).Nack(ctx, in)
+	return ""
+}
+
+func (m *Message) GetProperties() *Message_Properties {
+	if m != nil {
+		return m.Properties
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.EventterMQ/Nack",
+	return nil
+}
+
+func (m *Message) GetHeaders() *types.Struct {
+	if m != nil {
+		return m.Headers
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventterMQServer).Nack(ctx, req.(*MessageNackRequest))
+	return nil
+}
+
+func (m *Message) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _EventterMQ_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "io.eventter.mq.EventterMQ",
-	HandlerType: (*EventterMQServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateNamespace",
-			Handler:    _EventterMQ_CreateNamespace_Handler,
-		},
-		{
-			MethodName: "DeleteNamespace",
-			Handler:    _EventterMQ_DeleteNamespace_Handler,
-		},
-		{
-			MethodName: "CreateTopic",
-			Handler:    _EventterMQ_CreateTopic_Handler,
-		},
-		{
-			MethodName: "ListTopics",
-			Handler:    _EventterMQ_ListTopics_Handler,
-		},
-		{
-			MethodName: "DeleteTopic",
-			Handler:    _EventterMQ_DeleteTopic_Handler,
-		},
-		{
-			MethodName: "Publish",
-			Handler:    _EventterMQ_Publish_Handler,
-		},
-		{
-			MethodName: "CreateConsumerGroup",
-			Handler:    _EventterMQ_CreateConsumerGroup_Handler,
-		},
-		{
-			MethodName: "ListConsumerGroups",
-			Handler:    _EventterMQ_ListConsumerGroups_Handler,
-		},
-		{
-			MethodName: "DeleteConsumerGroup",
-			Handler:    _EventterMQ_DeleteConsumerGroup_Handler,
-		},
-		{
-			MethodName: "Ack",
-			Handler:    _EventterMQ_Ack_Handler,
-		},
-		{
-			MethodName: "Nack",
-			Handler:    _EventterMQ_Nack_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Subscribe",
-			Handler:       _EventterMQ_Subscribe_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "emq.proto",
+type Message_Properties struct {
+	ContentType          string    `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentEncoding      string    `protobuf:"bytes,2,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	DeliveryMode         int32     `protobuf:"varint,3,opt,name=delivery_mode,json=deliveryMode,proto3" json:"delivery_mode,omitempty"`
+	Priority             int32     `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	CorrelationID        string    `protobuf:"bytes,5,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ReplyTo              string    `protobuf:"bytes,6,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	Expiration           string    `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	MessageID            string    `protobuf:"bytes,8,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Timestamp            time.Time `protobuf:"bytes,9,opt,name=timestamp,stdtime" json:"timestamp"`
+	Type                 string    `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	UserID               string    `protobuf:"bytes,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppID                string    `protobuf:"bytes,12,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	To                   string    `protobuf:"bytes,13,opt,name=to,proto3" json:"to,omitempty"`
+	GroupID              string    `protobuf:"bytes,14,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupSequence        uint32    `protobuf:"varint,15,opt,name=group_sequence,json=groupSequence,proto3" json:"group_sequence,omitempty"`
+	ReplyToGroupID       string    `protobuf:"bytes,16,opt,name=reply_to_group_id,json=replyToGroupId,proto3" json:"reply_to_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *NamespaceCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Message_Properties) Reset()         { *m = Message_Properties{} }
+func (m *Message_Properties) String() string { return proto.CompactTextString(m) }
+func (*Message_Properties) ProtoMessage()    {}
+func (*Message_Properties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{33, 0}
+}
+func (m *Message_Properties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Message_Properties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Message_Properties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *Message_Properties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message_Properties.Merge(dst, src)
+}
+func (m *Message_Properties) XXX_Size() int {
+	return m.Size()
+}
+func (m *Message_Properties) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message_Properties.DiscardUnknown(m)
 }
 
-func (m *NamespaceCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+var xxx_messageInfo_Message_Properties proto.InternalMessageInfo
+
+func (m *Message_Properties) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	return i, nil
+	return ""
 }
 
-func (m *NamespaceCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Message_Properties) GetContentEncoding() string {
+	if m != nil {
+		return m.ContentEncoding
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NamespaceCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *Message_Properties) GetDeliveryMode() int32 {
+	if m != nil {
+		return m.DeliveryMode
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	return 0
+}
+
+func (m *Message_Properties) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
-	return i, nil
+	return 0
 }
 
-func (m *NamespaceDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Message_Properties) GetCorrelationID() string {
+	if m != nil {
+		return m.CorrelationID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NamespaceDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+func (m *Message_Properties) GetReplyTo() string {
+	if m != nil {
+		return m.ReplyTo
 	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return ""
+}
+
+func (m *Message_Properties) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return i, nil
+	return ""
 }
 
-func (m *NamespaceDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Message_Properties) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NamespaceDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *Message_Properties) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	return time.Time{}
+}
+
+func (m *Message_Properties) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return i, nil
+	return ""
 }
 
-func (m *TopicCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Message_Properties) GetUserID() string {
+	if m != nil {
+		return m.UserID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TopicCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintEmq(dAtA, i, uint64(m.Topic.Size()))
-	n1, err := m.Topic.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (m *Message_Properties) GetAppID() string {
+	if m != nil {
+		return m.AppID
 	}
-	i += n1
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return ""
+}
+
+func (m *Message_Properties) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	return i, nil
+	return ""
 }
 
-func (m *TopicCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Message_Properties) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TopicCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *Message_Properties) GetGroupSequence() uint32 {
+	if m != nil {
+		return m.GroupSequence
+	}
+	return 0
+}
+
+func (m *Message_Properties) GetReplyToGroupID() string {
+	if m != nil {
+		return m.ReplyToGroupID
+	}
+	return ""
+}
+
+type Node struct {
+	ID      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Zone    string `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	Alive   bool   `protobuf:"varint,4,opt,name=alive,proto3" json:"alive,omitempty"`
+	// Node is being drained, no new segments or consumer groups are placed on it.
+	Draining      bool       `protobuf:"varint,5,opt,name=draining,proto3" json:"draining,omitempty"`
+	Leader        bool       `protobuf:"varint,6,opt,name=leader,proto3" json:"leader,omitempty"`
+	LastSeenAlive *time.Time `protobuf:"bytes,7,opt,name=last_seen_alive,json=lastSeenAlive,stdtime" json:"last_seen_alive,omitempty"`
+	// Capacity of volume with segments in bytes, zero if unknown.
+	DiskTotal uint64 `protobuf:"varint,8,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	// Free space on volume with segments in bytes.
+	DiskFree             uint64   `protobuf:"varint,9,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{34}
+}
+func (m *Node) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+}
+func (dst *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(dst, src)
+}
+func (m *Node) XXX_Size() int {
+	return m.Size()
+}
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Node proto.InternalMessageInfo
+
+func (m *Node) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Topic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Node) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Topic) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+func (m *Node) GetZone() string {
+	if m != nil {
+		return m.Zone
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	return ""
+}
+
+func (m *Node) GetAlive() bool {
+	if m != nil {
+		return m.Alive
 	}
-	if m.Shards != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Shards))
+	return false
+}
+
+func (m *Node) GetDraining() bool {
+	if m != nil {
+		return m.Draining
 	}
-	if m.ReplicationFactor != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.ReplicationFactor))
+	return false
+}
+
+func (m *Node) GetLeader() bool {
+	if m != nil {
+		return m.Leader
 	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)))
-	n2, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Retention, dAtA[i:])
-	if err != nil {
-		return 0, err
+	return false
+}
+
+func (m *Node) GetLastSeenAlive() *time.Time {
+	if m != nil {
+		return m.LastSeenAlive
 	}
-	i += n2
-	if len(m.DefaultExchangeType) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.DefaultExchangeType)))
-		i += copy(dAtA[i:], m.DefaultExchangeType)
+	return nil
+}
+
+func (m *Node) GetDiskTotal() uint64 {
+	if m != nil {
+		return m.DiskTotal
 	}
-	return i, nil
+	return 0
 }
 
-func (m *TopicListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Node) GetDiskFree() uint64 {
+	if m != nil {
+		return m.DiskFree
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TopicListRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+type NodeListRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeListRequest) Reset()         { *m = NodeListRequest{} }
+func (m *NodeListRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeListRequest) ProtoMessage()    {}
+func (*NodeListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{35}
+}
+func (m *NodeListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *NodeListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeListRequest.Merge(dst, src)
+}
+func (m *NodeListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeListRequest.DiscardUnknown(m)
 }
 
-func (m *TopicListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NodeListRequest proto.InternalMessageInfo
+
+func (m *NodeListRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *TopicListResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
-	}
-	if len(m.Topics) > 0 {
-		for _, msg := range m.Topics {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+type NodeListResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Nodes                []*Node  `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeListResponse) Reset()         { *m = NodeListResponse{} }
+func (m *NodeListResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeListResponse) ProtoMessage()    {}
+func (*NodeListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{36}
+}
+func (m *NodeListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *NodeListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeListResponse.Merge(dst, src)
+}
+func (m *NodeListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeListResponse.DiscardUnknown(m)
 }
 
-func (m *TopicDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NodeListResponse proto.InternalMessageInfo
+
+func (m *NodeListResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *TopicDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+func (m *NodeListResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	return 0
+}
+
+func (m *NodeListResponse) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
 	}
-	if m.IfUnused {
-		dAtA[i] = 0x18
-		i++
-		if m.IfUnused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+type Connection struct {
+	NodeID uint64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Connection ID, unique within the node.
+	ID            uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Protocol      string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	RemoteAddress string `protobuf:"bytes,4,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	User          string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// Namespace (virtual host) the connection is bound to, empty for protocols not binding connections to namespace.
+	Namespace            string    `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ConnectedAt          time.Time `protobuf:"bytes,7,opt,name=connected_at,json=connectedAt,stdtime" json:"connected_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Connection) Reset()         { *m = Connection{} }
+func (m *Connection) String() string { return proto.CompactTextString(m) }
+func (*Connection) ProtoMessage()    {}
+func (*Connection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{37}
+}
+func (m *Connection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Connection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Connection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+}
+func (dst *Connection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Connection.Merge(dst, src)
+}
+func (m *Connection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Connection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Connection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Connection proto.InternalMessageInfo
+
+func (m *Connection) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return i, nil
+	return 0
 }
 
-func (m *TopicDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Connection) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TopicDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *Connection) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	return ""
+}
+
+func (m *Connection) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
 	}
-	return i, nil
+	return ""
 }
 
-func (m *TopicPublishRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Connection) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TopicPublishRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+func (m *Connection) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	return ""
+}
+
+func (m *Connection) GetConnectedAt() time.Time {
+	if m != nil {
+		return m.ConnectedAt
 	}
-	if m.Message != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Message.Size()))
-		n3, err := m.Message.MarshalTo(dAtA[i:])
+	return time.Time{}
+}
+
+type ConnectionListRequest struct {
+	// If true, only connections to the node handling the request will be listed, otherwise all alive nodes are asked.
+	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConnectionListRequest) Reset()         { *m = ConnectionListRequest{} }
+func (m *ConnectionListRequest) String() string { return proto.CompactTextString(m) }
+func (*ConnectionListRequest) ProtoMessage()    {}
+func (*ConnectionListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{38}
+}
+func (m *ConnectionListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if m.DoNotForward {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.DoNotForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ConnectionListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionListRequest.Merge(dst, src)
+}
+func (m *ConnectionListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionListRequest.DiscardUnknown(m)
 }
 
-func (m *TopicPublishResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConnectionListRequest proto.InternalMessageInfo
+
+func (m *ConnectionListRequest) GetDoNotForward() bool {
+	if m != nil {
+		return m.DoNotForward
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *TopicPublishResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+type ConnectionListResponse struct {
+	OK                   bool          `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Connections          []*Connection `protobuf:"bytes,2,rep,name=connections" json:"connections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ConnectionListResponse) Reset()         { *m = ConnectionListResponse{} }
+func (m *ConnectionListResponse) String() string { return proto.CompactTextString(m) }
+func (*ConnectionListResponse) ProtoMessage()    {}
+func (*ConnectionListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{39}
+}
+func (m *ConnectionListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ConnectionListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionListResponse.Merge(dst, src)
+}
+func (m *ConnectionListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionListResponse.DiscardUnknown(m)
 }
 
-func (m *ConsumerGroupCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConnectionListResponse proto.InternalMessageInfo
+
+func (m *ConnectionListResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ConsumerGroupCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintEmq(dAtA, i, uint64(m.ConsumerGroup.Size()))
-	n4, err := m.ConsumerGroup.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n4
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *ConnectionListResponse) GetConnections() []*Connection {
+	if m != nil {
+		return m.Connections
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ConsumerGroupCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ConsumerGroupSubscribeRequest struct {
+	// If true and node does not manage consumer group, request will fail.
+	DoNotForward bool   `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	Namespace    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Max number of messages in-flight. Zero means there is no limit.
+	Size_ uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// If true, messages will be acked immediately after being sent.
+	AutoAck bool `protobuf:"varint,4,opt,name=auto_ack,json=autoAck,proto3" json:"auto_ack,omitempty"`
+	// If true, response stream will be closed as soon as there are no waiting messages (either to be consumed, or acked).
+	DoNotBlock bool `protobuf:"varint,5,opt,name=do_not_block,json=doNotBlock,proto3" json:"do_not_block,omitempty"`
+	// If not zero, response stream will be closed as soon as there are no
+	MaxMessages          uint64   `protobuf:"varint,6,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConsumerGroupCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ConsumerGroupSubscribeRequest) Reset()         { *m = ConsumerGroupSubscribeRequest{} }
+func (m *ConsumerGroupSubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupSubscribeRequest) ProtoMessage()    {}
+func (*ConsumerGroupSubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{40}
+}
+func (m *ConsumerGroupSubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupSubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
-	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ConsumerGroupSubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupSubscribeRequest.Merge(dst, src)
+}
+func (m *ConsumerGroupSubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupSubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupSubscribeRequest.DiscardUnknown(m)
 }
 
-func (m *ConsumerGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupSubscribeRequest proto.InternalMessageInfo
+
+func (m *ConsumerGroupSubscribeRequest) GetDoNotForward() bool {
+	if m != nil {
+		return m.DoNotForward
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Bindings) > 0 {
-		for _, msg := range m.Bindings {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Size_))
+func (m *ConsumerGroupSubscribeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)))
-	n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i:])
-	if err != nil {
-		return 0, err
+	return ""
+}
+
+func (m *ConsumerGroupSubscribeRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i += n5
-	return i, nil
+	return ""
 }
 
-func (m *ConsumerGroup_Binding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupSubscribeRequest) GetSize_() uint32 {
+	if m != nil {
+		return m.Size_
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerGroup_Binding) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.TopicName) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicName)))
-		i += copy(dAtA[i:], m.TopicName)
+func (m *ConsumerGroupSubscribeRequest) GetAutoAck() bool {
+	if m != nil {
+		return m.AutoAck
 	}
-	if len(m.ExchangeType) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.ExchangeType)))
-		i += copy(dAtA[i:], m.ExchangeType)
+	return false
+}
+
+func (m *ConsumerGroupSubscribeRequest) GetDoNotBlock() bool {
+	if m != nil {
+		return m.DoNotBlock
 	}
-	if m.By != nil {
-		nn6, err := m.By.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn6
+	return false
+}
+
+func (m *ConsumerGroupSubscribeRequest) GetMaxMessages() uint64 {
+	if m != nil {
+		return m.MaxMessages
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ConsumerGroup_Binding_RoutingKey) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintEmq(dAtA, i, uint64(len(m.RoutingKey)))
-	i += copy(dAtA[i:], m.RoutingKey)
-	return i, nil
+type ConsumerGroupSubscribeResponse struct {
+	NodeID               uint64   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SubscriptionID       uint64   `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	SeqNo                uint64   `protobuf:"varint,3,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	TopicNamespace       string   `protobuf:"bytes,4,opt,name=topic_namespace,json=topicNamespace,proto3" json:"topic_namespace,omitempty"`
+	TopicName            string   `protobuf:"bytes,5,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
+	Message              *Message `protobuf:"bytes,6,opt,name=message" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ConsumerGroup_Binding_HeadersAll) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HeadersAll != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.HeadersAll.Size()))
-		n7, err := m.HeadersAll.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	return i, nil
+
+func (m *ConsumerGroupSubscribeResponse) Reset()         { *m = ConsumerGroupSubscribeResponse{} }
+func (m *ConsumerGroupSubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupSubscribeResponse) ProtoMessage()    {}
+func (*ConsumerGroupSubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{41}
 }
-func (m *ConsumerGroup_Binding_HeadersAny) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HeadersAny != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.HeadersAny.Size()))
-		n8, err := m.HeadersAny.MarshalTo(dAtA[i:])
+func (m *ConsumerGroupSubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupSubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupSubscribeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	return i, nil
 }
-func (m *ConsumerGroupListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *ConsumerGroupSubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupSubscribeResponse.Merge(dst, src)
+}
+func (m *ConsumerGroupSubscribeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroupSubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupSubscribeResponse.DiscardUnknown(m)
 }
 
-func (m *ConsumerGroupListRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+var xxx_messageInfo_ConsumerGroupSubscribeResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupSubscribeResponse) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ConsumerGroupListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupSubscribeResponse) GetSubscriptionID() uint64 {
+	if m != nil {
+		return m.SubscriptionID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerGroupListResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
-	}
-	if len(m.ConsumerGroups) > 0 {
-		for _, msg := range m.ConsumerGroups {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *ConsumerGroupSubscribeResponse) GetSeqNo() uint64 {
+	if m != nil {
+		return m.SeqNo
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ConsumerGroupDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupSubscribeResponse) GetTopicNamespace() string {
+	if m != nil {
+		return m.TopicNamespace
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConsumerGroupDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *ConsumerGroupSubscribeResponse) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ConsumerGroupDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupSubscribeResponse) GetMessage() *Message {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerGroupDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+type MessageAckRequest struct {
+	// If true and node does not manage consumer group, request will fail.
+	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	NodeID               uint64   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SubscriptionID       uint64   `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	SeqNo                uint64   `protobuf:"varint,3,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageAckRequest) Reset()         { *m = MessageAckRequest{} }
+func (m *MessageAckRequest) String() string { return proto.CompactTextString(m) }
+func (*MessageAckRequest) ProtoMessage()    {}
+func (*MessageAckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{42}
+}
+func (m *MessageAckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *MessageAckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAckRequest.Merge(dst, src)
+}
+func (m *MessageAckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAckRequest.DiscardUnknown(m)
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MessageAckRequest proto.InternalMessageInfo
+
+func (m *MessageAckRequest) GetDoNotForward() bool {
+	if m != nil {
+		return m.DoNotForward
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.RoutingKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.RoutingKey)))
-		i += copy(dAtA[i:], m.RoutingKey)
+func (m *MessageAckRequest) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	if m.Properties != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Properties.Size()))
-		n9, err := m.Properties.MarshalTo(dAtA[i:])
+	return 0
+}
+
+func (m *MessageAckRequest) GetSubscriptionID() uint64 {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return 0
+}
+
+func (m *MessageAckRequest) GetSeqNo() uint64 {
+	if m != nil {
+		return m.SeqNo
+	}
+	return 0
+}
+
+type MessageAckResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageAckResponse) Reset()         { *m = MessageAckResponse{} }
+func (m *MessageAckResponse) String() string { return proto.CompactTextString(m) }
+func (*MessageAckResponse) ProtoMessage()    {}
+func (*MessageAckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{43}
+}
+func (m *MessageAckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n9
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Headers.Size()))
-		n10, err := m.Headers.MarshalTo(dAtA[i:])
+}
+func (dst *MessageAckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAckResponse.Merge(dst, src)
+}
+func (m *MessageAckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageAckResponse proto.InternalMessageInfo
+
+func (m *MessageAckResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
+	}
+	return false
+}
+
+type MessageNackRequest struct {
+	// If true and node does not manage consumer group, request will fail.
+	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
+	NodeID               uint64   `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SubscriptionID       uint64   `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	SeqNo                uint64   `protobuf:"varint,3,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageNackRequest) Reset()         { *m = MessageNackRequest{} }
+func (m *MessageNackRequest) String() string { return proto.CompactTextString(m) }
+func (*MessageNackRequest) ProtoMessage()    {}
+func (*MessageNackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{44}
+}
+func (m *MessageNackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageNackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageNackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n10
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *MessageNackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageNackRequest.Merge(dst, src)
+}
+func (m *MessageNackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageNackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageNackRequest.DiscardUnknown(m)
 }
 
-func (m *Message_Properties) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MessageNackRequest proto.InternalMessageInfo
+
+func (m *MessageNackRequest) GetDoNotForward() bool {
+	if m != nil {
+		return m.DoNotForward
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Message_Properties) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContentType) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.ContentType)))
-		i += copy(dAtA[i:], m.ContentType)
+func (m *MessageNackRequest) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	if len(m.ContentEncoding) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.ContentEncoding)))
-		i += copy(dAtA[i:], m.ContentEncoding)
+	return 0
+}
+
+func (m *MessageNackRequest) GetSubscriptionID() uint64 {
+	if m != nil {
+		return m.SubscriptionID
 	}
-	if m.DeliveryMode != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.DeliveryMode))
+	return 0
+}
+
+func (m *MessageNackRequest) GetSeqNo() uint64 {
+	if m != nil {
+		return m.SeqNo
 	}
-	if m.Priority != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Priority))
+	return 0
+}
+
+type MessageNackResponse struct {
+	OK                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageNackResponse) Reset()         { *m = MessageNackResponse{} }
+func (m *MessageNackResponse) String() string { return proto.CompactTextString(m) }
+func (*MessageNackResponse) ProtoMessage()    {}
+func (*MessageNackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_emq_73a58150386249d1, []int{45}
+}
+func (m *MessageNackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageNackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageNackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CorrelationID) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.CorrelationID)))
-		i += copy(dAtA[i:], m.CorrelationID)
+}
+func (dst *MessageNackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageNackResponse.Merge(dst, src)
+}
+func (m *MessageNackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageNackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageNackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageNackResponse proto.InternalMessageInfo
+
+func (m *MessageNackResponse) GetOK() bool {
+	if m != nil {
+		return m.OK
 	}
-	if len(m.ReplyTo) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.ReplyTo)))
-		i += copy(dAtA[i:], m.ReplyTo)
+	return false
+}
+
+func init() {
+	proto.RegisterType((*NamespaceCreateRequest)(nil), "io.eventter.mq.NamespaceCreateRequest")
+	proto.RegisterType((*NamespaceCreateResponse)(nil), "io.eventter.mq.NamespaceCreateResponse")
+	proto.RegisterType((*NamespaceDeleteRequest)(nil), "io.eventter.mq.NamespaceDeleteRequest")
+	proto.RegisterType((*NamespaceDeleteResponse)(nil), "io.eventter.mq.NamespaceDeleteResponse")
+	proto.RegisterType((*NamespaceListRequest)(nil), "io.eventter.mq.NamespaceListRequest")
+	proto.RegisterType((*NamespaceListResponse)(nil), "io.eventter.mq.NamespaceListResponse")
+	proto.RegisterType((*TopicCreateRequest)(nil), "io.eventter.mq.TopicCreateRequest")
+	proto.RegisterType((*TopicCreateResponse)(nil), "io.eventter.mq.TopicCreateResponse")
+	proto.RegisterType((*Topic)(nil), "io.eventter.mq.Topic")
+	proto.RegisterType((*TopicListRequest)(nil), "io.eventter.mq.TopicListRequest")
+	proto.RegisterType((*TopicListResponse)(nil), "io.eventter.mq.TopicListResponse")
+	proto.RegisterType((*TopicDescribeRequest)(nil), "io.eventter.mq.TopicDescribeRequest")
+	proto.RegisterType((*TopicDescribeResponse)(nil), "io.eventter.mq.TopicDescribeResponse")
+	proto.RegisterType((*TopicDescribeResponse_Segment)(nil), "io.eventter.mq.TopicDescribeResponse.Segment")
+	proto.RegisterType((*TopicSearchRequest)(nil), "io.eventter.mq.TopicSearchRequest")
+	proto.RegisterType((*TopicSearchResponse)(nil), "io.eventter.mq.TopicSearchResponse")
+	proto.RegisterType((*TopicDeleteRequest)(nil), "io.eventter.mq.TopicDeleteRequest")
+	proto.RegisterType((*TopicDeleteResponse)(nil), "io.eventter.mq.TopicDeleteResponse")
+	proto.RegisterType((*TopicPublishRequest)(nil), "io.eventter.mq.TopicPublishRequest")
+	proto.RegisterType((*TopicPublishResponse)(nil), "io.eventter.mq.TopicPublishResponse")
+	proto.RegisterType((*ConsumerGroupCreateRequest)(nil), "io.eventter.mq.ConsumerGroupCreateRequest")
+	proto.RegisterType((*ConsumerGroupCreateResponse)(nil), "io.eventter.mq.ConsumerGroupCreateResponse")
+	proto.RegisterType((*ConsumerGroup)(nil), "io.eventter.mq.ConsumerGroup")
+	proto.RegisterType((*ConsumerGroup_Binding)(nil), "io.eventter.mq.ConsumerGroup.Binding")
+	proto.RegisterType((*ConsumerGroupListRequest)(nil), "io.eventter.mq.ConsumerGroupListRequest")
+	proto.RegisterType((*ConsumerGroupListResponse)(nil), "io.eventter.mq.ConsumerGroupListResponse")
+	proto.RegisterType((*ConsumerGroupDescribeRequest)(nil), "io.eventter.mq.ConsumerGroupDescribeRequest")
+	proto.RegisterType((*ConsumerGroupDescribeResponse)(nil), "io.eventter.mq.ConsumerGroupDescribeResponse")
+	proto.RegisterType((*ConsumerGroupDescribeResponse_TopicLag)(nil), "io.eventter.mq.ConsumerGroupDescribeResponse.TopicLag")
+	proto.RegisterType((*ConsumerGroupDescribeResponse_Subscription)(nil), "io.eventter.mq.ConsumerGroupDescribeResponse.Subscription")
+	proto.RegisterType((*ConsumerGroupOffset)(nil), "io.eventter.mq.ConsumerGroupOffset")
+	proto.RegisterType((*ConsumerGroupOffsetsExportRequest)(nil), "io.eventter.mq.ConsumerGroupOffsetsExportRequest")
+	proto.RegisterType((*ConsumerGroupOffsetsExportResponse)(nil), "io.eventter.mq.ConsumerGroupOffsetsExportResponse")
+	proto.RegisterType((*ConsumerGroupOffsetsImportRequest)(nil), "io.eventter.mq.ConsumerGroupOffsetsImportRequest")
+	proto.RegisterType((*ConsumerGroupOffsetsImportResponse)(nil), "io.eventter.mq.ConsumerGroupOffsetsImportResponse")
+	proto.RegisterType((*ConsumerGroupDeleteRequest)(nil), "io.eventter.mq.ConsumerGroupDeleteRequest")
+	proto.RegisterType((*ConsumerGroupDeleteResponse)(nil), "io.eventter.mq.ConsumerGroupDeleteResponse")
+	proto.RegisterType((*Message)(nil), "io.eventter.mq.Message")
+	proto.RegisterType((*Message_Properties)(nil), "io.eventter.mq.Message.Properties")
+	proto.RegisterType((*Node)(nil), "io.eventter.mq.Node")
+	proto.RegisterType((*NodeListRequest)(nil), "io.eventter.mq.NodeListRequest")
+	proto.RegisterType((*NodeListResponse)(nil), "io.eventter.mq.NodeListResponse")
+	proto.RegisterType((*Connection)(nil), "io.eventter.mq.Connection")
+	proto.RegisterType((*ConnectionListRequest)(nil), "io.eventter.mq.ConnectionListRequest")
+	proto.RegisterType((*ConnectionListResponse)(nil), "io.eventter.mq.ConnectionListResponse")
+	proto.RegisterType((*ConsumerGroupSubscribeRequest)(nil), "io.eventter.mq.ConsumerGroupSubscribeRequest")
+	proto.RegisterType((*ConsumerGroupSubscribeResponse)(nil), "io.eventter.mq.ConsumerGroupSubscribeResponse")
+	proto.RegisterType((*MessageAckRequest)(nil), "io.eventter.mq.MessageAckRequest")
+	proto.RegisterType((*MessageAckResponse)(nil), "io.eventter.mq.MessageAckResponse")
+	proto.RegisterType((*MessageNackRequest)(nil), "io.eventter.mq.MessageNackRequest")
+	proto.RegisterType((*MessageNackResponse)(nil), "io.eventter.mq.MessageNackResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for EventterMQ service
+
+type EventterMQClient interface {
+	CreateNamespace(ctx context.Context, in *NamespaceCreateRequest, opts ...grpc.CallOption) (*NamespaceCreateResponse, error)
+	DeleteNamespace(ctx context.Context, in *NamespaceDeleteRequest, opts ...grpc.CallOption) (*NamespaceDeleteResponse, error)
+	ListNamespaces(ctx context.Context, in *NamespaceListRequest, opts ...grpc.CallOption) (*NamespaceListResponse, error)
+	CreateTopic(ctx context.Context, in *TopicCreateRequest, opts ...grpc.CallOption) (*TopicCreateResponse, error)
+	ListTopics(ctx context.Context, in *TopicListRequest, opts ...grpc.CallOption) (*TopicListResponse, error)
+	DescribeTopic(ctx context.Context, in *TopicDescribeRequest, opts ...grpc.CallOption) (*TopicDescribeResponse, error)
+	DeleteTopic(ctx context.Context, in *TopicDeleteRequest, opts ...grpc.CallOption) (*TopicDeleteResponse, error)
+	Publish(ctx context.Context, in *TopicPublishRequest, opts ...grpc.CallOption) (*TopicPublishResponse, error)
+	SearchTopic(ctx context.Context, in *TopicSearchRequest, opts ...grpc.CallOption) (EventterMQ_SearchTopicClient, error)
+	CreateConsumerGroup(ctx context.Context, in *ConsumerGroupCreateRequest, opts ...grpc.CallOption) (*ConsumerGroupCreateResponse, error)
+	ListConsumerGroups(ctx context.Context, in *ConsumerGroupListRequest, opts ...grpc.CallOption) (*ConsumerGroupListResponse, error)
+	DescribeConsumerGroup(ctx context.Context, in *ConsumerGroupDescribeRequest, opts ...grpc.CallOption) (*ConsumerGroupDescribeResponse, error)
+	ExportConsumerGroupOffsets(ctx context.Context, in *ConsumerGroupOffsetsExportRequest, opts ...grpc.CallOption) (*ConsumerGroupOffsetsExportResponse, error)
+	ImportConsumerGroupOffsets(ctx context.Context, in *ConsumerGroupOffsetsImportRequest, opts ...grpc.CallOption) (*ConsumerGroupOffsetsImportResponse, error)
+	DeleteConsumerGroup(ctx context.Context, in *ConsumerGroupDeleteRequest, opts ...grpc.CallOption) (*ConsumerGroupDeleteResponse, error)
+	Subscribe(ctx context.Context, in *ConsumerGroupSubscribeRequest, opts ...grpc.CallOption) (EventterMQ_SubscribeClient, error)
+	Ack(ctx context.Context, in *MessageAckRequest, opts ...grpc.CallOption) (*MessageAckResponse, error)
+	Nack(ctx context.Context, in *MessageNackRequest, opts ...grpc.CallOption) (*MessageNackResponse, error)
+	ListNodes(ctx context.Context, in *NodeListRequest, opts ...grpc.CallOption) (*NodeListResponse, error)
+	ListConnections(ctx context.Context, in *ConnectionListRequest, opts ...grpc.CallOption) (*ConnectionListResponse, error)
+}
+
+type eventterMQClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventterMQClient(cc *grpc.ClientConn) EventterMQClient {
+	return &eventterMQClient{cc}
+}
+
+func (c *eventterMQClient) CreateNamespace(ctx context.Context, in *NamespaceCreateRequest, opts ...grpc.CallOption) (*NamespaceCreateResponse, error) {
+	out := new(NamespaceCreateResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/CreateNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Expiration) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Expiration)))
-		i += copy(dAtA[i:], m.Expiration)
+	return out, nil
+}
+
+func (c *eventterMQClient) DeleteNamespace(ctx context.Context, in *NamespaceDeleteRequest, opts ...grpc.CallOption) (*NamespaceDeleteResponse, error) {
+	out := new(NamespaceDeleteResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MessageID) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.MessageID)))
-		i += copy(dAtA[i:], m.MessageID)
+	return out, nil
+}
+
+func (c *eventterMQClient) ListNamespaces(ctx context.Context, in *NamespaceListRequest, opts ...grpc.CallOption) (*NamespaceListResponse, error) {
+	out := new(NamespaceListResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)))
-	n11, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i:])
+	return out, nil
+}
+
+func (c *eventterMQClient) CreateTopic(ctx context.Context, in *TopicCreateRequest, opts ...grpc.CallOption) (*TopicCreateResponse, error) {
+	out := new(TopicCreateResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/CreateTopic", in, out, opts...)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n11
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	return out, nil
+}
+
+func (c *eventterMQClient) ListTopics(ctx context.Context, in *TopicListRequest, opts ...grpc.CallOption) (*TopicListResponse, error) {
+	out := new(TopicListResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UserID) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.UserID)))
-		i += copy(dAtA[i:], m.UserID)
+	return out, nil
+}
+
+func (c *eventterMQClient) DescribeTopic(ctx context.Context, in *TopicDescribeRequest, opts ...grpc.CallOption) (*TopicDescribeResponse, error) {
+	out := new(TopicDescribeResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DescribeTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AppID) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.AppID)))
-		i += copy(dAtA[i:], m.AppID)
+	return out, nil
+}
+
+func (c *eventterMQClient) DeleteTopic(ctx context.Context, in *TopicDeleteRequest, opts ...grpc.CallOption) (*TopicDeleteResponse, error) {
+	out := new(TopicDeleteResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DeleteTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.To) > 0 {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.To)))
-		i += copy(dAtA[i:], m.To)
+	return out, nil
+}
+
+func (c *eventterMQClient) Publish(ctx context.Context, in *TopicPublishRequest, opts ...grpc.CallOption) (*TopicPublishResponse, error) {
+	out := new(TopicPublishResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.GroupID) > 0 {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.GroupID)))
-		i += copy(dAtA[i:], m.GroupID)
+	return out, nil
+}
+
+func (c *eventterMQClient) SearchTopic(ctx context.Context, in *TopicSearchRequest, opts ...grpc.CallOption) (EventterMQ_SearchTopicClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventterMQ_serviceDesc.Streams[0], "/io.eventter.mq.EventterMQ/SearchTopic", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupSequence != 0 {
-		dAtA[i] = 0x78
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.GroupSequence))
+	x := &eventterMQSearchTopicClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.ReplyToGroupID) > 0 {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.ReplyToGroupID)))
-		i += copy(dAtA[i:], m.ReplyToGroupID)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *ConsumerGroupSubscribeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+type EventterMQ_SearchTopicClient interface {
+	Recv() (*TopicSearchResponse, error)
+	grpc.ClientStream
+}
+
+type eventterMQSearchTopicClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventterMQSearchTopicClient) Recv() (*TopicSearchResponse, error) {
+	m := new(TopicSearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventterMQClient) CreateConsumerGroup(ctx context.Context, in *ConsumerGroupCreateRequest, opts ...grpc.CallOption) (*ConsumerGroupCreateResponse, error) {
+	out := new(ConsumerGroupCreateResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/CreateConsumerGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ConsumerGroupSubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+func (c *eventterMQClient) ListConsumerGroups(ctx context.Context, in *ConsumerGroupListRequest, opts ...grpc.CallOption) (*ConsumerGroupListResponse, error) {
+	out := new(ConsumerGroupListResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListConsumerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	return out, nil
+}
+
+func (c *eventterMQClient) DescribeConsumerGroup(ctx context.Context, in *ConsumerGroupDescribeRequest, opts ...grpc.CallOption) (*ConsumerGroupDescribeResponse, error) {
+	out := new(ConsumerGroupDescribeResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DescribeConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Size_))
-	}
-	if m.AutoAck {
-		dAtA[i] = 0x20
-		i++
-		if m.AutoAck {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.DoNotBlock {
-		dAtA[i] = 0x28
-		i++
-		if m.DoNotBlock {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.MaxMessages != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.MaxMessages))
-	}
-	if m.DoNotForward {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.DoNotForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ConsumerGroupSubscribeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *eventterMQClient) ExportConsumerGroupOffsets(ctx context.Context, in *ConsumerGroupOffsetsExportRequest, opts ...grpc.CallOption) (*ConsumerGroupOffsetsExportResponse, error) {
+	out := new(ConsumerGroupOffsetsExportResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ExportConsumerGroupOffsets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ConsumerGroupSubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
-	}
-	if m.SubscriptionID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
-	}
-	if m.SeqNo != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.SeqNo))
-	}
-	if len(m.TopicNamespace) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicNamespace)))
-		i += copy(dAtA[i:], m.TopicNamespace)
-	}
-	if len(m.TopicName) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicName)))
-		i += copy(dAtA[i:], m.TopicName)
-	}
-	if m.Message != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.Message.Size()))
-		n12, err := m.Message.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+func (c *eventterMQClient) ImportConsumerGroupOffsets(ctx context.Context, in *ConsumerGroupOffsetsImportRequest, opts ...grpc.CallOption) (*ConsumerGroupOffsetsImportResponse, error) {
+	out := new(ConsumerGroupOffsetsImportResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ImportConsumerGroupOffsets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *MessageAckRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *eventterMQClient) DeleteConsumerGroup(ctx context.Context, in *ConsumerGroupDeleteRequest, opts ...grpc.CallOption) (*ConsumerGroupDeleteResponse, error) {
+	out := new(ConsumerGroupDeleteResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/DeleteConsumerGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MessageAckRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
-	}
-	if m.SubscriptionID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
+func (c *eventterMQClient) Subscribe(ctx context.Context, in *ConsumerGroupSubscribeRequest, opts ...grpc.CallOption) (EventterMQ_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventterMQ_serviceDesc.Streams[1], "/io.eventter.mq.EventterMQ/Subscribe", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SeqNo != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.SeqNo))
+	x := &eventterMQSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.DoNotForward {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.DoNotForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *MessageAckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type EventterMQ_SubscribeClient interface {
+	Recv() (*ConsumerGroupSubscribeResponse, error)
+	grpc.ClientStream
 }
 
-func (m *MessageAckResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	return i, nil
+type eventterMQSubscribeClient struct {
+	grpc.ClientStream
 }
 
-func (m *MessageNackRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *eventterMQSubscribeClient) Recv() (*ConsumerGroupSubscribeResponse, error) {
+	m := new(ConsumerGroupSubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *MessageNackRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
-	}
-	if m.SubscriptionID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
-	}
-	if m.SeqNo != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintEmq(dAtA, i, uint64(m.SeqNo))
-	}
-	if m.DoNotForward {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.DoNotForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *eventterMQClient) Ack(ctx context.Context, in *MessageAckRequest, opts ...grpc.CallOption) (*MessageAckResponse, error) {
+	out := new(MessageAckResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/Ack", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *MessageNackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *eventterMQClient) Nack(ctx context.Context, in *MessageNackRequest, opts ...grpc.CallOption) (*MessageNackResponse, error) {
+	out := new(MessageNackResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/Nack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MessageNackResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OK {
-		dAtA[i] = 0x8
-		i++
-		if m.OK {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *eventterMQClient) ListNodes(ctx context.Context, in *NodeListRequest, opts ...grpc.CallOption) (*NodeListResponse, error) {
+	out := new(NodeListResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func encodeVarintEmq(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *eventterMQClient) ListConnections(ctx context.Context, in *ConnectionListRequest, opts ...grpc.CallOption) (*ConnectionListResponse, error) {
+	out := new(ConnectionListResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.EventterMQ/ListConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return out, nil
 }
-func (m *NamespaceCreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	if m.LeaderOnly {
-		n += 3
-	}
-	return n
+
+// Server API for EventterMQ service
+
+type EventterMQServer interface {
+	CreateNamespace(context.Context, *NamespaceCreateRequest) (*NamespaceCreateResponse, error)
+	DeleteNamespace(context.Context, *NamespaceDeleteRequest) (*NamespaceDeleteResponse, error)
+	ListNamespaces(context.Context, *NamespaceListRequest) (*NamespaceListResponse, error)
+	CreateTopic(context.Context, *TopicCreateRequest) (*TopicCreateResponse, error)
+	ListTopics(context.Context, *TopicListRequest) (*TopicListResponse, error)
+	DescribeTopic(context.Context, *TopicDescribeRequest) (*TopicDescribeResponse, error)
+	DeleteTopic(context.Context, *TopicDeleteRequest) (*TopicDeleteResponse, error)
+	Publish(context.Context, *TopicPublishRequest) (*TopicPublishResponse, error)
+	SearchTopic(*TopicSearchRequest, EventterMQ_SearchTopicServer) error
+	CreateConsumerGroup(context.Context, *ConsumerGroupCreateRequest) (*ConsumerGroupCreateResponse, error)
+	ListConsumerGroups(context.Context, *ConsumerGroupListRequest) (*ConsumerGroupListResponse, error)
+	DescribeConsumerGroup(context.Context, *ConsumerGroupDescribeRequest) (*ConsumerGroupDescribeResponse, error)
+	ExportConsumerGroupOffsets(context.Context, *ConsumerGroupOffsetsExportRequest) (*ConsumerGroupOffsetsExportResponse, error)
+	ImportConsumerGroupOffsets(context.Context, *ConsumerGroupOffsetsImportRequest) (*ConsumerGroupOffsetsImportResponse, error)
+	DeleteConsumerGroup(context.Context, *ConsumerGroupDeleteRequest) (*ConsumerGroupDeleteResponse, error)
+	Subscribe(*ConsumerGroupSubscribeRequest, EventterMQ_SubscribeServer) error
+	Ack(context.Context, *MessageAckRequest) (*MessageAckResponse, error)
+	Nack(context.Context, *MessageNackRequest) (*MessageNackResponse, error)
+	ListNodes(context.Context, *NodeListRequest) (*NodeListResponse, error)
+	ListConnections(context.Context, *ConnectionListRequest) (*ConnectionListResponse, error)
 }
 
-func (m *NamespaceCreateResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
-	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
-	}
-	return n
+func RegisterEventterMQServer(s *grpc.Server, srv EventterMQServer) {
+	s.RegisterService(&_EventterMQ_serviceDesc, srv)
 }
 
-func (m *NamespaceDeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LeaderOnly {
-		n += 3
+	if interceptor == nil {
+		return srv.(EventterMQServer).CreateNamespace(ctx, in)
 	}
-	return n
-}
-
-func (m *NamespaceDeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/CreateNamespace",
 	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).CreateNamespace(ctx, req.(*NamespaceCreateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicCreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Topic.Size()
-	n += 1 + l + sovEmq(uint64(l))
-	if m.LeaderOnly {
-		n += 3
+func _EventterMQ_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *TopicCreateResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+	if interceptor == nil {
+		return srv.(EventterMQServer).DeleteNamespace(ctx, in)
 	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/DeleteNamespace",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).DeleteNamespace(ctx, req.(*NamespaceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Topic) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Shards != 0 {
-		n += 1 + sovEmq(uint64(m.Shards))
+	if interceptor == nil {
+		return srv.(EventterMQServer).ListNamespaces(ctx, in)
 	}
-	if m.ReplicationFactor != 0 {
-		n += 1 + sovEmq(uint64(m.ReplicationFactor))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ListNamespaces",
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)
-	n += 1 + l + sovEmq(uint64(l))
-	l = len(m.DefaultExchangeType)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ListNamespaces(ctx, req.(*NamespaceListRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicListRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).CreateTopic(ctx, in)
 	}
-	if m.LeaderOnly {
-		n += 3
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/CreateTopic",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).CreateTopic(ctx, req.(*TopicCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicListResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+func _EventterMQ_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
+	if interceptor == nil {
+		return srv.(EventterMQServer).ListTopics(ctx, in)
 	}
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovEmq(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ListTopics",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ListTopics(ctx, req.(*TopicListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicDeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).DescribeTopic(ctx, in)
 	}
-	if m.IfUnused {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/DescribeTopic",
 	}
-	if m.LeaderOnly {
-		n += 3
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).DescribeTopic(ctx, req.(*TopicDescribeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicDeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+func _EventterMQ_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
+	if interceptor == nil {
+		return srv.(EventterMQServer).DeleteTopic(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/DeleteTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).DeleteTopic(ctx, req.(*TopicDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicPublishRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).Publish(ctx, in)
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovEmq(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/Publish",
 	}
-	if m.DoNotForward {
-		n += 3
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).Publish(ctx, req.(*TopicPublishRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TopicPublishResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+func _EventterMQ_SearchTopic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TopicSearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(EventterMQServer).SearchTopic(m, &eventterMQSearchTopicServer{stream})
 }
 
-func (m *ConsumerGroupCreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ConsumerGroup.Size()
-	n += 1 + l + sovEmq(uint64(l))
-	if m.LeaderOnly {
-		n += 3
-	}
-	return n
+type EventterMQ_SearchTopicServer interface {
+	Send(*TopicSearchResponse) error
+	grpc.ServerStream
 }
 
-func (m *ConsumerGroupCreateResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
-	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
-	}
-	return n
+type eventterMQSearchTopicServer struct {
+	grpc.ServerStream
 }
 
-func (m *ConsumerGroup) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func (x *eventterMQSearchTopicServer) Send(m *TopicSearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EventterMQ_CreateConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).CreateConsumerGroup(ctx, in)
 	}
-	if len(m.Bindings) > 0 {
-		for _, e := range m.Bindings {
-			l = e.Size()
-			n += 1 + l + sovEmq(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/CreateConsumerGroup",
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovEmq(uint64(m.Size_))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).CreateConsumerGroup(ctx, req.(*ConsumerGroupCreateRequest))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)
-	n += 1 + l + sovEmq(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroup_Binding) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.TopicName)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_ListConsumerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ExchangeType)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).ListConsumerGroups(ctx, in)
 	}
-	if m.By != nil {
-		n += m.By.Size()
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ListConsumerGroups",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ListConsumerGroups(ctx, req.(*ConsumerGroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroup_Binding_RoutingKey) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.RoutingKey)
-	n += 1 + l + sovEmq(uint64(l))
-	return n
-}
-func (m *ConsumerGroup_Binding_HeadersAll) Size() (n int) {
-	var l int
-	_ = l
-	if m.HeadersAll != nil {
-		l = m.HeadersAll.Size()
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_DescribeConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-func (m *ConsumerGroup_Binding_HeadersAny) Size() (n int) {
-	var l int
-	_ = l
-	if m.HeadersAny != nil {
-		l = m.HeadersAny.Size()
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).DescribeConsumerGroup(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/DescribeConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).DescribeConsumerGroup(ctx, req.(*ConsumerGroupDescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *ConsumerGroupListRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+
+func _EventterMQ_ExportConsumerGroupOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupOffsetsExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).ExportConsumerGroupOffsets(ctx, in)
 	}
-	if m.LeaderOnly {
-		n += 3
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ExportConsumerGroupOffsets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ExportConsumerGroupOffsets(ctx, req.(*ConsumerGroupOffsetsExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroupListResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+func _EventterMQ_ImportConsumerGroupOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupOffsetsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
+	if interceptor == nil {
+		return srv.(EventterMQServer).ImportConsumerGroupOffsets(ctx, in)
 	}
-	if len(m.ConsumerGroups) > 0 {
-		for _, e := range m.ConsumerGroups {
-			l = e.Size()
-			n += 1 + l + sovEmq(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ImportConsumerGroupOffsets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ImportConsumerGroupOffsets(ctx, req.(*ConsumerGroupOffsetsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroupDeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_DeleteConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).DeleteConsumerGroup(ctx, in)
 	}
-	if m.LeaderOnly {
-		n += 3
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/DeleteConsumerGroup",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).DeleteConsumerGroup(ctx, req.(*ConsumerGroupDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroupDeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
-	}
-	if m.Index != 0 {
-		n += 1 + sovEmq(uint64(m.Index))
+func _EventterMQ_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConsumerGroupSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(EventterMQServer).Subscribe(m, &eventterMQSubscribeServer{stream})
 }
 
-func (m *Message) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.RoutingKey)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+type EventterMQ_SubscribeServer interface {
+	Send(*ConsumerGroupSubscribeResponse) error
+	grpc.ServerStream
+}
+
+type eventterMQSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventterMQSubscribeServer) Send(m *ConsumerGroupSubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EventterMQ_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Properties != nil {
-		l = m.Properties.Size()
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).Ack(ctx, in)
 	}
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovEmq(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/Ack",
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).Ack(ctx, req.(*MessageAckRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Message_Properties) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.ContentEncoding)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+func _EventterMQ_Nack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageNackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DeliveryMode != 0 {
-		n += 1 + sovEmq(uint64(m.DeliveryMode))
+	if interceptor == nil {
+		return srv.(EventterMQServer).Nack(ctx, in)
 	}
-	if m.Priority != 0 {
-		n += 1 + sovEmq(uint64(m.Priority))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/Nack",
 	}
-	l = len(m.CorrelationID)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.ReplyTo)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.Expiration)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.MessageID)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovEmq(uint64(l))
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.UserID)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.AppID)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.GroupID)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	if m.GroupSequence != 0 {
-		n += 1 + sovEmq(uint64(m.GroupSequence))
-	}
-	l = len(m.ReplyToGroupID)
-	if l > 0 {
-		n += 2 + l + sovEmq(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).Nack(ctx, req.(*MessageNackRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroupSubscribeRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovEmq(uint64(m.Size_))
-	}
-	if m.AutoAck {
-		n += 2
+func _EventterMQ_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DoNotBlock {
-		n += 2
+	if interceptor == nil {
+		return srv.(EventterMQServer).ListNodes(ctx, in)
 	}
-	if m.MaxMessages != 0 {
-		n += 1 + sovEmq(uint64(m.MaxMessages))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ListNodes",
 	}
-	if m.DoNotForward {
-		n += 3
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ListNodes(ctx, req.(*NodeListRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConsumerGroupSubscribeResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovEmq(uint64(m.NodeID))
-	}
-	if m.SubscriptionID != 0 {
-		n += 1 + sovEmq(uint64(m.SubscriptionID))
-	}
-	if m.SeqNo != 0 {
-		n += 1 + sovEmq(uint64(m.SeqNo))
+func _EventterMQ_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TopicNamespace)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	if interceptor == nil {
+		return srv.(EventterMQServer).ListConnections(ctx, in)
 	}
-	l = len(m.TopicName)
-	if l > 0 {
-		n += 1 + l + sovEmq(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.EventterMQ/ListConnections",
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovEmq(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventterMQServer).ListConnections(ctx, req.(*ConnectionListRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MessageAckRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovEmq(uint64(m.NodeID))
-	}
-	if m.SubscriptionID != 0 {
-		n += 1 + sovEmq(uint64(m.SubscriptionID))
-	}
-	if m.SeqNo != 0 {
-		n += 1 + sovEmq(uint64(m.SeqNo))
-	}
-	if m.DoNotForward {
-		n += 3
-	}
-	return n
+var _EventterMQ_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "io.eventter.mq.EventterMQ",
+	HandlerType: (*EventterMQServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _EventterMQ_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _EventterMQ_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _EventterMQ_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "CreateTopic",
+			Handler:    _EventterMQ_CreateTopic_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _EventterMQ_ListTopics_Handler,
+		},
+		{
+			MethodName: "DescribeTopic",
+			Handler:    _EventterMQ_DescribeTopic_Handler,
+		},
+		{
+			MethodName: "DeleteTopic",
+			Handler:    _EventterMQ_DeleteTopic_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _EventterMQ_Publish_Handler,
+		},
+		{
+			MethodName: "CreateConsumerGroup",
+			Handler:    _EventterMQ_CreateConsumerGroup_Handler,
+		},
+		{
+			MethodName: "ListConsumerGroups",
+			Handler:    _EventterMQ_ListConsumerGroups_Handler,
+		},
+		{
+			MethodName: "DescribeConsumerGroup",
+			Handler:    _EventterMQ_DescribeConsumerGroup_Handler,
+		},
+		{
+			MethodName: "ExportConsumerGroupOffsets",
+			Handler:    _EventterMQ_ExportConsumerGroupOffsets_Handler,
+		},
+		{
+			MethodName: "ImportConsumerGroupOffsets",
+			Handler:    _EventterMQ_ImportConsumerGroupOffsets_Handler,
+		},
+		{
+			MethodName: "DeleteConsumerGroup",
+			Handler:    _EventterMQ_DeleteConsumerGroup_Handler,
+		},
+		{
+			MethodName: "Ack",
+			Handler:    _EventterMQ_Ack_Handler,
+		},
+		{
+			MethodName: "Nack",
+			Handler:    _EventterMQ_Nack_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _EventterMQ_ListNodes_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _EventterMQ_ListConnections_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchTopic",
+			Handler:       _EventterMQ_SearchTopic_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _EventterMQ_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "emq.proto",
 }
 
-func (m *MessageAckResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OK {
-		n += 2
+func (m *NamespaceCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MessageNackRequest) Size() (n int) {
+func (m *NamespaceCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovEmq(uint64(m.NodeID))
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
 	}
-	if m.SubscriptionID != 0 {
-		n += 1 + sovEmq(uint64(m.SubscriptionID))
+	if m.RetentionBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.RetentionBytes))
 	}
-	if m.SeqNo != 0 {
-		n += 1 + sovEmq(uint64(m.SeqNo))
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.DoNotForward {
-		n += 3
+	return i, nil
+}
+
+func (m *NamespaceCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MessageNackResponse) Size() (n int) {
+func (m *NamespaceCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.OK {
-		n += 2
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *NamespaceDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NamespaceDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *NamespaceListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NamespaceListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceListResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *TopicCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(m.Topic.Size()))
+	n1, err := m.Topic.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TopicCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *Topic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Topic) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Shards != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Shards))
+	}
+	if m.ReplicationFactor != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.ReplicationFactor))
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)))
+	n2, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Retention, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.DefaultExchangeType) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.DefaultExchangeType)))
+		i += copy(dAtA[i:], m.DefaultExchangeType)
+	}
+	if len(m.IndexedHeaders) > 0 {
+		for _, s := range m.IndexedHeaders {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PartitionKey) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.PartitionKey)))
+		i += copy(dAtA[i:], m.PartitionKey)
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeduplicationWindow)))
+	n3, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DeduplicationWindow, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.CompactionKey) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.CompactionKey)))
+		i += copy(dAtA[i:], m.CompactionKey)
+	}
+	if m.RetentionBytes != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.RetentionBytes))
+	}
+	if m.ForceRetentionBytes {
+		dAtA[i] = 0x60
+		i++
+		if m.ForceRetentionBytes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TopicListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TopicListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicListResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Topics) > 0 {
+		for _, msg := range m.Topics {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TopicDescribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicDescribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TopicDescribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicDescribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if m.Topic != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Topic.Size()))
+		n4, err := m.Topic.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Segments) > 0 {
+		for _, msg := range m.Segments {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.RetainedBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.RetainedBytes))
+	}
+	if m.OldestMessage != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.OldestMessage)))
+		n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.OldestMessage, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.NewestMessage != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.NewestMessage)))
+		n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NewestMessage, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.UnderReplicatedSegments != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.UnderReplicatedSegments))
+	}
+	return i, nil
+}
+
+func (m *TopicDescribeResponse_Segment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicDescribeResponse_Segment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.ID))
+	}
+	if m.Open {
+		dAtA[i] = 0x10
+		i++
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Shard))
+	}
+	if m.PrimaryNodeID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.PrimaryNodeID))
+	}
+	if len(m.DoneNodeIDs) > 0 {
+		dAtA8 := make([]byte, len(m.DoneNodeIDs)*10)
+		var j7 int
+		for _, num := range m.DoneNodeIDs {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
+	if len(m.ReplicatingNodeIDs) > 0 {
+		dAtA10 := make([]byte, len(m.ReplicatingNodeIDs)*10)
+		var j9 int
+		for _, num := range m.ReplicatingNodeIDs {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(j9))
+		i += copy(dAtA[i:], dAtA10[:j9])
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Size_))
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
+	n11, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n11
+	if m.ClosedAt != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClosedAt)))
+		n12, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ClosedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.UnderReplicated {
+		dAtA[i] = 0x50
+		i++
+		if m.UnderReplicated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.OffloadedAt != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.OffloadedAt)))
+		n13, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.OffloadedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+
+func (m *TopicSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Since != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.Since)))
+		n14, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Since, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Until != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.Until)))
+		n15, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Until, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.RoutingKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.RoutingKey)))
+		i += copy(dAtA[i:], m.RoutingKey)
+	}
+	if m.HeadersAll != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.HeadersAll.Size()))
+		n16, err := m.HeadersAll.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.HeadersAny != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.HeadersAny.Size()))
+		n17, err := m.HeadersAny.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.DataContains) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.DataContains)))
+		i += copy(dAtA[i:], m.DataContains)
+	}
+	if len(m.DataJSONPath) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.DataJSONPath)))
+		i += copy(dAtA[i:], m.DataJSONPath)
+	}
+	if m.DataJSONValue != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.DataJSONValue.Size()))
+		n18, err := m.DataJSONValue.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *TopicSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Offset))
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
+	n19, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	if m.Message != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Message.Size()))
+		n20, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+
+func (m *TopicDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.IfUnused {
+		dAtA[i] = 0x18
+		i++
+		if m.IfUnused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TopicDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *TopicPublishRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicPublishRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Message != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Message.Size()))
+		n21, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.ProducerID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.ProducerID)))
+		i += copy(dAtA[i:], m.ProducerID)
+	}
+	if m.Sequence != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Sequence))
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TopicPublishResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicPublishResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Duplicate {
+		dAtA[i] = 0x10
+		i++
+		if m.Duplicate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(m.ConsumerGroup.Size()))
+	n22, err := m.ConsumerGroup.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Bindings) > 0 {
+		for _, msg := range m.Bindings {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Size_))
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)))
+	n23, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
+	if m.MaxPriority != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.MaxPriority))
+	}
+	if m.SingleActiveConsumer {
+		dAtA[i] = 0x38
+		i++
+		if m.SingleActiveConsumer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroup_Binding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroup_Binding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopicName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicName)))
+		i += copy(dAtA[i:], m.TopicName)
+	}
+	if len(m.ExchangeType) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.ExchangeType)))
+		i += copy(dAtA[i:], m.ExchangeType)
+	}
+	if m.By != nil {
+		nn24, err := m.By.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn24
+	}
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroup_Binding_RoutingKey) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(len(m.RoutingKey)))
+	i += copy(dAtA[i:], m.RoutingKey)
+	return i, nil
+}
+func (m *ConsumerGroup_Binding_HeadersAll) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HeadersAll != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.HeadersAll.Size()))
+		n25, err := m.HeadersAll.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+func (m *ConsumerGroup_Binding_HeadersAny) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HeadersAny != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.HeadersAny.Size()))
+		n26, err := m.HeadersAny.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+func (m *ConsumerGroupListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupListResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if len(m.ConsumerGroups) > 0 {
+		for _, msg := range m.ConsumerGroups {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupDescribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDescribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupDescribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDescribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if m.ConsumerGroup != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.ConsumerGroup.Size()))
+		n27, err := m.ConsumerGroup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.NodeID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
+	}
+	if len(m.Topics) > 0 {
+		for _, msg := range m.Topics {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, msg := range m.Subscriptions {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Waiting != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Waiting))
+	}
+	if m.InFlight != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.InFlight))
+	}
+	if m.AckPending != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.AckPending))
+	}
+	if m.ActiveSubscriptionID != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.ActiveSubscriptionID))
+	}
+	if m.ActiveSince != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ActiveSince)))
+		n28, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ActiveSince, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupDescribeResponse_TopicLag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDescribeResponse_TopicLag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopicName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicName)))
+		i += copy(dAtA[i:], m.TopicName)
+	}
+	if m.LagBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.LagBytes))
+	}
+	if m.LagMessages != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.LagMessages))
+	}
+	if m.OldestUnconsumed != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.OldestUnconsumed)))
+		n29, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.OldestUnconsumed, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.LagTime)))
+	n30, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LagTime, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	return i, nil
+}
+
+func (m *ConsumerGroupDescribeResponse_Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDescribeResponse_Subscription) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Size_))
+	}
+	if m.InFlight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.InFlight))
+	}
+	if m.Active {
+		dAtA[i] = 0x20
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffset) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SegmentID))
+	}
+	if len(m.TopicName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicName)))
+		i += copy(dAtA[i:], m.TopicName)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Offset))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.SegmentCreatedAt)))
+	n31, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SegmentCreatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	if m.SegmentClosedAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.SegmentClosedAt)))
+		n32, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SegmentClosedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupOffsetsExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffsetsExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupOffsetsExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffsetsExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Offsets) > 0 {
+		for _, msg := range m.Offsets {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupOffsetsImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffsetsImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Offsets) > 0 {
+		for _, msg := range m.Offsets {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupOffsetsImportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffsetsImportResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoutingKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.RoutingKey)))
+		i += copy(dAtA[i:], m.RoutingKey)
+	}
+	if m.Properties != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Properties.Size()))
+		n33, err := m.Properties.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if m.Headers != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Headers.Size()))
+		n34, err := m.Headers.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *Message_Properties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message_Properties) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentType) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.ContentType)))
+		i += copy(dAtA[i:], m.ContentType)
+	}
+	if len(m.ContentEncoding) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.ContentEncoding)))
+		i += copy(dAtA[i:], m.ContentEncoding)
+	}
+	if m.DeliveryMode != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.DeliveryMode))
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.CorrelationID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.CorrelationID)))
+		i += copy(dAtA[i:], m.CorrelationID)
+	}
+	if len(m.ReplyTo) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.ReplyTo)))
+		i += copy(dAtA[i:], m.ReplyTo)
+	}
+	if len(m.Expiration) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Expiration)))
+		i += copy(dAtA[i:], m.Expiration)
+	}
+	if len(m.MessageID) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.MessageID)))
+		i += copy(dAtA[i:], m.MessageID)
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)))
+	n35, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.UserID) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.UserID)))
+		i += copy(dAtA[i:], m.UserID)
+	}
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.To) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.To)))
+		i += copy(dAtA[i:], m.To)
+	}
+	if len(m.GroupID) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.GroupID)))
+		i += copy(dAtA[i:], m.GroupID)
+	}
+	if m.GroupSequence != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.GroupSequence))
+	}
+	if len(m.ReplyToGroupID) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.ReplyToGroupID)))
+		i += copy(dAtA[i:], m.ReplyToGroupID)
+	}
+	return i, nil
+}
+
+func (m *Node) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Node) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.ID))
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Zone) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Zone)))
+		i += copy(dAtA[i:], m.Zone)
+	}
+	if m.Alive {
+		dAtA[i] = 0x20
+		i++
+		if m.Alive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Draining {
+		dAtA[i] = 0x28
+		i++
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Leader {
+		dAtA[i] = 0x30
+		i++
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LastSeenAlive != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAlive)))
+		n36, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAlive, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.DiskTotal != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.DiskFree))
+	}
+	return i, nil
+}
+
+func (m *NodeListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NodeListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeListResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Connection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Connection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
+	}
+	if m.ID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.ID))
+	}
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
+	}
+	if len(m.RemoteAddress) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.RemoteAddress)))
+		i += copy(dAtA[i:], m.RemoteAddress)
+	}
+	if len(m.User) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.User)))
+		i += copy(dAtA[i:], m.User)
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintEmq(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ConnectedAt)))
+	n37, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ConnectedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	return i, nil
+}
+
+func (m *ConnectionListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConnectionListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionListResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Connections) > 0 {
+		for _, msg := range m.Connections {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEmq(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupSubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupSubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Size_))
+	}
+	if m.AutoAck {
+		dAtA[i] = 0x20
+		i++
+		if m.AutoAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DoNotBlock {
+		dAtA[i] = 0x28
+		i++
+		if m.DoNotBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxMessages != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.MaxMessages))
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsumerGroupSubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupSubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SeqNo))
+	}
+	if len(m.TopicNamespace) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicNamespace)))
+		i += copy(dAtA[i:], m.TopicNamespace)
+	}
+	if len(m.TopicName) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(len(m.TopicName)))
+		i += copy(dAtA[i:], m.TopicName)
+	}
+	if m.Message != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.Message.Size()))
+		n38, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+
+func (m *MessageAckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAckRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SeqNo))
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MessageAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MessageNackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageNackRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SubscriptionID))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintEmq(dAtA, i, uint64(m.SeqNo))
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MessageNackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageNackResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OK {
+		dAtA[i] = 0x8
+		i++
+		if m.OK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func encodeVarintEmq(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *NamespaceCreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.RetentionBytes != 0 {
+		n += 1 + sovEmq(uint64(m.RetentionBytes))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *NamespaceCreateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *NamespaceDeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *NamespaceDeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *NamespaceListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *NamespaceListResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TopicCreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Topic.Size()
+	n += 1 + l + sovEmq(uint64(l))
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *TopicCreateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *Topic) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Shards != 0 {
+		n += 1 + sovEmq(uint64(m.Shards))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovEmq(uint64(m.ReplicationFactor))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)
+	n += 1 + l + sovEmq(uint64(l))
+	l = len(m.DefaultExchangeType)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if len(m.IndexedHeaders) > 0 {
+		for _, s := range m.IndexedHeaders {
+			l = len(s)
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	l = len(m.PartitionKey)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DeduplicationWindow)
+	n += 1 + l + sovEmq(uint64(l))
+	l = len(m.CompactionKey)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.RetentionBytes != 0 {
+		n += 1 + sovEmq(uint64(m.RetentionBytes))
+	}
+	if m.ForceRetentionBytes {
+		n += 2
+	}
+	return n
+}
+
+func (m *TopicListRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *TopicListResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TopicDescribeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *TopicDescribeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if m.Topic != nil {
+		l = m.Topic.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	if m.RetainedBytes != 0 {
+		n += 1 + sovEmq(uint64(m.RetainedBytes))
+	}
+	if m.OldestMessage != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.OldestMessage)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.NewestMessage != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NewestMessage)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.UnderReplicatedSegments != 0 {
+		n += 1 + sovEmq(uint64(m.UnderReplicatedSegments))
+	}
+	return n
+}
+
+func (m *TopicDescribeResponse_Segment) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovEmq(uint64(m.ID))
+	}
+	if m.Open {
+		n += 2
+	}
+	if m.Shard != 0 {
+		n += 1 + sovEmq(uint64(m.Shard))
+	}
+	if m.PrimaryNodeID != 0 {
+		n += 1 + sovEmq(uint64(m.PrimaryNodeID))
+	}
+	if len(m.DoneNodeIDs) > 0 {
+		l = 0
+		for _, e := range m.DoneNodeIDs {
+			l += sovEmq(uint64(e))
+		}
+		n += 1 + sovEmq(uint64(l)) + l
+	}
+	if len(m.ReplicatingNodeIDs) > 0 {
+		l = 0
+		for _, e := range m.ReplicatingNodeIDs {
+			l += sovEmq(uint64(e))
+		}
+		n += 1 + sovEmq(uint64(l)) + l
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovEmq(uint64(m.Size_))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovEmq(uint64(l))
+	if m.ClosedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ClosedAt)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.UnderReplicated {
+		n += 2
+	}
+	if m.OffloadedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.OffloadedAt)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicSearchRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Since != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Since)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Until != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Until)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.RoutingKey)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.HeadersAll != nil {
+		l = m.HeadersAll.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.HeadersAny != nil {
+		l = m.HeadersAny.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.DataContains)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.DataJSONPath)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.DataJSONValue != nil {
+		l = m.DataJSONValue.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEmq(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *TopicSearchResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovEmq(uint64(m.SegmentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovEmq(uint64(m.Offset))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEmq(uint64(l))
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicDeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.IfUnused {
+		n += 2
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *TopicDeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *TopicPublishRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEmq(uint64(m.Sequence))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *TopicPublishResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Duplicate {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsumerGroupCreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ConsumerGroup.Size()
+	n += 1 + l + sovEmq(uint64(l))
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupCreateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ConsumerGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovEmq(uint64(m.Size_))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovEmq(uint64(l))
+	if m.MaxPriority != 0 {
+		n += 1 + sovEmq(uint64(m.MaxPriority))
+	}
+	if m.SingleActiveConsumer {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsumerGroup_Binding) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TopicName)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.ExchangeType)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.By != nil {
+		n += m.By.Size()
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerGroup_Binding_RoutingKey) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RoutingKey)
+	n += 1 + l + sovEmq(uint64(l))
+	return n
+}
+func (m *ConsumerGroup_Binding_HeadersAll) Size() (n int) {
+	var l int
+	_ = l
+	if m.HeadersAll != nil {
+		l = m.HeadersAll.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+func (m *ConsumerGroup_Binding_HeadersAny) Size() (n int) {
+	var l int
+	_ = l
+	if m.HeadersAny != nil {
+		l = m.HeadersAny.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+func (m *ConsumerGroupListRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupListResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if len(m.ConsumerGroups) > 0 {
+		for _, e := range m.ConsumerGroups {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerGroupDescribeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupDescribeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if m.ConsumerGroup != nil {
+		l = m.ConsumerGroup.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovEmq(uint64(m.NodeID))
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	if m.Waiting != 0 {
+		n += 1 + sovEmq(uint64(m.Waiting))
+	}
+	if m.InFlight != 0 {
+		n += 1 + sovEmq(uint64(m.InFlight))
+	}
+	if m.AckPending != 0 {
+		n += 1 + sovEmq(uint64(m.AckPending))
+	}
+	if m.ActiveSubscriptionID != 0 {
+		n += 1 + sovEmq(uint64(m.ActiveSubscriptionID))
+	}
+	if m.ActiveSince != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ActiveSince)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerGroupDescribeResponse_TopicLag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TopicName)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.LagBytes != 0 {
+		n += 1 + sovEmq(uint64(m.LagBytes))
+	}
+	if m.LagMessages != 0 {
+		n += 1 + sovEmq(uint64(m.LagMessages))
+	}
+	if m.OldestUnconsumed != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.OldestUnconsumed)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LagTime)
+	n += 1 + l + sovEmq(uint64(l))
+	return n
+}
+
+func (m *ConsumerGroupDescribeResponse_Subscription) Size() (n int) {
+	var l int
+	_ = l
+	if m.SubscriptionID != 0 {
+		n += 1 + sovEmq(uint64(m.SubscriptionID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovEmq(uint64(m.Size_))
+	}
+	if m.InFlight != 0 {
+		n += 1 + sovEmq(uint64(m.InFlight))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsumerGroupOffset) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovEmq(uint64(m.SegmentID))
+	}
+	l = len(m.TopicName)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovEmq(uint64(m.Offset))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SegmentCreatedAt)
+	n += 1 + l + sovEmq(uint64(l))
+	if m.SegmentClosedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SegmentClosedAt)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerGroupOffsetsExportRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupOffsetsExportResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerGroupOffsetsImportRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupOffsetsImportResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ConsumerGroupDeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupDeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RoutingKey)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Properties != nil {
+		l = m.Properties.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *Message_Properties) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.ContentEncoding)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.DeliveryMode != 0 {
+		n += 1 + sovEmq(uint64(m.DeliveryMode))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovEmq(uint64(m.Priority))
+	}
+	l = len(m.CorrelationID)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.ReplyTo)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.MessageID)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEmq(uint64(l))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.UserID)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.GroupSequence != 0 {
+		n += 1 + sovEmq(uint64(m.GroupSequence))
+	}
+	l = len(m.ReplyToGroupID)
+	if l > 0 {
+		n += 2 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovEmq(uint64(m.ID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Alive {
+		n += 2
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.Leader {
+		n += 2
+	}
+	if m.LastSeenAlive != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAlive)
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.DiskTotal != 0 {
+		n += 1 + sovEmq(uint64(m.DiskTotal))
+	}
+	if m.DiskFree != 0 {
+		n += 1 + sovEmq(uint64(m.DiskFree))
+	}
+	return n
+}
+
+func (m *NodeListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *NodeListResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovEmq(uint64(m.Index))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Connection) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovEmq(uint64(m.NodeID))
+	}
+	if m.ID != 0 {
+		n += 1 + sovEmq(uint64(m.ID))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.RemoteAddress)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ConnectedAt)
+	n += 1 + l + sovEmq(uint64(l))
+	return n
+}
+
+func (m *ConnectionListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConnectionListResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovEmq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerGroupSubscribeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovEmq(uint64(m.Size_))
+	}
+	if m.AutoAck {
+		n += 2
+	}
+	if m.DoNotBlock {
+		n += 2
+	}
+	if m.MaxMessages != 0 {
+		n += 1 + sovEmq(uint64(m.MaxMessages))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupSubscribeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovEmq(uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		n += 1 + sovEmq(uint64(m.SubscriptionID))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovEmq(uint64(m.SeqNo))
+	}
+	l = len(m.TopicNamespace)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	l = len(m.TopicName)
+	if l > 0 {
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEmq(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageAckRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovEmq(uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		n += 1 + sovEmq(uint64(m.SubscriptionID))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovEmq(uint64(m.SeqNo))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *MessageAckResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	return n
+}
+
+func (m *MessageNackRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovEmq(uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		n += 1 + sovEmq(uint64(m.SubscriptionID))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovEmq(uint64(m.SeqNo))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *MessageNackResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OK {
+		n += 2
+	}
+	return n
+}
+
+func sovEmq(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozEmq(x uint64) (n int) {
+	return sovEmq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NamespaceCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBytes", wireType)
+			}
+			m.RetentionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Topic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			m.Shards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shards |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExchangeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultExchangeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeaders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedHeaders = append(m.IndexedHeaders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DeduplicationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompactionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBytes", wireType)
+			}
+			m.RetentionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRetentionBytes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceRetentionBytes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &Topic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDescribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicDescribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicDescribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDescribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicDescribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicDescribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, &TopicDescribeResponse_Segment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedBytes", wireType)
+			}
+			m.RetainedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetainedBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestMessage == nil {
+				m.OldestMessage = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.OldestMessage, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewestMessage == nil {
+				m.NewestMessage = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NewestMessage, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderReplicatedSegments", wireType)
+			}
+			m.UnderReplicatedSegments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnderReplicatedSegments |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDescribeResponse_Segment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Segment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Segment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+			}
+			m.PrimaryNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEmq
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DoneNodeIDs = append(m.DoneNodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEmq
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEmq
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEmq
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DoneNodeIDs = append(m.DoneNodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneNodeIDs", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEmq
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEmq
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEmq
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEmq
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReplicatingNodeIDs = append(m.ReplicatingNodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatingNodeIDs", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClosedAt == nil {
+				m.ClosedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderReplicated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnderReplicated = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffloadedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OffloadedAt == nil {
+				m.OffloadedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.OffloadedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicSearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicSearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Until == nil {
+				m.Until = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Until, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeadersAll == nil {
+				m.HeadersAll = &types.Struct{}
+			}
+			if err := m.HeadersAll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAny", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeadersAny == nil {
+				m.HeadersAny = &types.Struct{}
+			}
+			if err := m.HeadersAny.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataContains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataContains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataJSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataJSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataJSONValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataJSONValue == nil {
+				m.DataJSONValue = &types.Value{}
+			}
+			if err := m.DataJSONValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicSearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicSearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfUnused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfUnused = bool(v != 0)
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicPublishRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicPublishRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicPublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoNotForward = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicPublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicPublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicPublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Duplicate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, &ConsumerGroup_Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriority", wireType)
+			}
+			m.MaxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriority |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleActiveConsumer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleActiveConsumer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.By = &ConsumerGroup_Binding_RoutingKey{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.Struct{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.By = &ConsumerGroup_Binding_HeadersAll{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAny", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.Struct{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.By = &ConsumerGroup_Binding_HeadersAny{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroups = append(m.ConsumerGroups, &ConsumerGroup{})
+			if err := m.ConsumerGroups[len(m.ConsumerGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEmq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEmq(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozEmq(x uint64) (n int) {
-	return sovEmq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *NamespaceCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDescribeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4502,10 +12665,10 @@ func (m *NamespaceCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupDescribeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupDescribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4537,9 +12700,38 @@ func (m *NamespaceCreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4556,7 +12748,7 @@ func (m *NamespaceCreateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
+			m.DoNotForward = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -4578,7 +12770,7 @@ func (m *NamespaceCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDescribeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4588,30 +12780,221 @@ func (m *NamespaceCreateResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEmq
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupDescribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupDescribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OK = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &ConsumerGroup{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &ConsumerGroupDescribeResponse_TopicLag{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, &ConsumerGroupDescribeResponse_Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			m.Waiting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Waiting |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InFlight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InFlight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceCreateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPending", wireType)
 			}
-			var v int
+			m.AckPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -4621,17 +13004,16 @@ func (m *NamespaceCreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.AckPending |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OK = bool(v != 0)
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSubscriptionID", wireType)
 			}
-			m.Index = 0
+			m.ActiveSubscriptionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -4641,11 +13023,44 @@ func (m *NamespaceCreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				m.ActiveSubscriptionID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveSince == nil {
+				m.ActiveSince = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ActiveSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -4667,7 +13082,7 @@ func (m *NamespaceCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDescribeResponse_TopicLag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4690,15 +13105,15 @@ func (m *NamespaceDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicLag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicLag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4723,13 +13138,13 @@ func (m *NamespaceDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LagBytes", wireType)
 			}
-			var v int
+			m.LagBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -4739,67 +13154,16 @@ func (m *NamespaceDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.LagBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEmq(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEmq
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceDeleteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEmq
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceDeleteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LagMessages", wireType)
 			}
-			var v int
+			m.LagMessages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -4809,17 +13173,16 @@ func (m *NamespaceDeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.LagMessages |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OK = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestUnconsumed", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -4829,64 +13192,28 @@ func (m *NamespaceDeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEmq(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TopicCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEmq
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.OldestUnconsumed == nil {
+				m.OldestUnconsumed = new(time.Time)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.OldestUnconsumed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TopicCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LagTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4910,30 +13237,10 @@ func (m *TopicCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LagTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -4955,7 +13262,7 @@ func (m *TopicCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDescribeResponse_Subscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4978,17 +13285,55 @@ func (m *TopicCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlight", wireType)
 			}
-			var v int
+			m.InFlight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -4998,17 +13343,16 @@ func (m *TopicCreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.InFlight |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OK = bool(v != 0)
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			m.Index = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -5018,11 +13362,12 @@ func (m *TopicCreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -5044,7 +13389,7 @@ func (m *TopicCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Topic) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,17 +13412,17 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
 			}
-			var stringLen uint64
+			m.SegmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -5087,24 +13432,14 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5129,13 +13464,13 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.Shards = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -5145,33 +13480,14 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Shards |= (uint32(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
-			}
-			m.ReplicationFactor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReplicationFactor |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5195,15 +13511,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SegmentCreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExchangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentClosedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -5213,20 +13529,24 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultExchangeType = string(dAtA[iNdEx:postIndex])
+			if m.SegmentClosedAt == nil {
+				m.SegmentClosedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SegmentClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5249,7 +13569,7 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicListRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffsetsExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5272,10 +13592,10 @@ func (m *TopicListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffsetsExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffsetsExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5338,7 +13658,7 @@ func (m *TopicListRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5355,7 +13675,7 @@ func (m *TopicListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
+			m.DoNotForward = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -5377,7 +13697,7 @@ func (m *TopicListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicListResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffsetsExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5400,10 +13720,10 @@ func (m *TopicListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffsetsExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffsetsExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5447,7 +13767,7 @@ func (m *TopicListResponse) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5471,8 +13791,8 @@ func (m *TopicListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &Topic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offsets = append(m.Offsets, &ConsumerGroupOffset{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5497,7 +13817,7 @@ func (m *TopicListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffsetsImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5520,10 +13840,10 @@ func (m *TopicDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffsetsImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffsetsImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5585,10 +13905,10 @@ func (m *TopicDeleteRequest) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfUnused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -5598,12 +13918,23 @@ func (m *TopicDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfUnused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offsets = append(m.Offsets, &ConsumerGroupOffset{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
@@ -5645,7 +13976,7 @@ func (m *TopicDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffsetsImportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5668,10 +13999,10 @@ func (m *TopicDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffsetsImportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffsetsImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5734,7 +14065,7 @@ func (m *TopicDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicPublishRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5757,10 +14088,10 @@ func (m *TopicPublishRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicPublishRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicPublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5821,42 +14152,9 @@ func (m *TopicPublishRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &Message{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5873,7 +14171,7 @@ func (m *TopicPublishRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DoNotForward = bool(v != 0)
+			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -5895,7 +14193,7 @@ func (m *TopicPublishRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicPublishResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDeleteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5918,10 +14216,10 @@ func (m *TopicPublishResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicPublishResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupDeleteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicPublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5944,91 +14242,11 @@ func (m *TopicPublishResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.OK = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEmq(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEmq
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerGroupCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEmq
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6038,12 +14256,11 @@ func (m *ConsumerGroupCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Index |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -6065,7 +14282,7 @@ func (m *ConsumerGroupCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroupCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,17 +14305,17 @@ func (m *ConsumerGroupCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6108,17 +14325,26 @@ func (m *ConsumerGroupCreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OK = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6128,11 +14354,89 @@ func (m *ConsumerGroupCreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Properties == nil {
+				m.Properties = &Message_Properties{}
+			}
+			if err := m.Properties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &types.Struct{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -6154,7 +14458,7 @@ func (m *ConsumerGroupCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
+func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6177,15 +14481,15 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: Properties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Properties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6210,11 +14514,11 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6239,13 +14543,13 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ContentEncoding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryMode", wireType)
 			}
-			var msglen int
+			m.DeliveryMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6255,28 +14559,16 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DeliveryMode |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bindings = append(m.Bindings, &ConsumerGroup_Binding{})
-			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.Size_ = 0
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6286,16 +14578,16 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (uint32(b) & 0x7F) << shift
+				m.Priority |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6305,75 +14597,82 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CorrelationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEmq(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplyTo", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEmq
+			m.ReplyTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6398,13 +14697,13 @@ func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.MessageID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6414,24 +14713,25 @@ func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeType = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6456,13 +14756,13 @@ func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.By = &ConsumerGroup_Binding_RoutingKey{string(dAtA[iNdEx:postIndex])}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6472,29 +14772,26 @@ func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.Struct{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.By = &ConsumerGroup_Binding_HeadersAll{v}
+			m.UserID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeadersAny", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6504,77 +14801,24 @@ func (m *ConsumerGroup_Binding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.Struct{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.By = &ConsumerGroup_Binding_HeadersAny{v}
+			m.AppID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEmq(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEmq
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerGroupListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEmq
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6599,11 +14843,11 @@ func (m *ConsumerGroupListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6628,13 +14872,13 @@ func (m *ConsumerGroupListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.GroupID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSequence", wireType)
 			}
-			var v int
+			m.GroupSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6644,12 +14888,40 @@ func (m *ConsumerGroupListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.GroupSequence |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplyToGroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplyToGroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -6671,7 +14943,7 @@ func (m *ConsumerGroupListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroupListResponse) Unmarshal(dAtA []byte) error {
+func (m *Node) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6694,17 +14966,17 @@ func (m *ConsumerGroupListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6714,17 +14986,16 @@ func (m *ConsumerGroupListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OK = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6734,16 +15005,26 @@ func (m *ConsumerGroupListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6753,78 +15034,46 @@ func (m *ConsumerGroupListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroups = append(m.ConsumerGroups, &ConsumerGroup{})
-			if err := m.ConsumerGroups[len(m.ConsumerGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEmq(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEmq
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerGroupDeleteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEmq
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alive", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupDeleteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			m.Alive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6834,26 +15083,37 @@ func (m *ConsumerGroupDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
+			m.Draining = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Leader = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAlive", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6863,26 +15123,30 @@ func (m *ConsumerGroupDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.LastSeenAlive == nil {
+				m.LastSeenAlive = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAlive, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 99:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskTotal", wireType)
 			}
-			var v int
+			m.DiskTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -6892,12 +15156,30 @@ func (m *ConsumerGroupDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DiskTotal |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskFree", wireType)
+			}
+			m.DiskFree = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskFree |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -6919,7 +15201,7 @@ func (m *ConsumerGroupDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroupDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *NodeListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6942,15 +15224,15 @@ func (m *ConsumerGroupDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6967,26 +15249,7 @@ func (m *ConsumerGroupDeleteResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.OK = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -7008,7 +15271,7 @@ func (m *ConsumerGroupDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *NodeListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7031,17 +15294,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7051,26 +15314,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RoutingKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.OK = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7080,28 +15334,14 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Index |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Properties == nil {
-				m.Properties = &Message_Properties{}
-			}
-			if err := m.Properties.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7125,44 +15365,11 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &types.Struct{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEmq(dAtA[iNdEx:])
@@ -7184,7 +15391,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message_Properties) Unmarshal(dAtA []byte) error {
+func (m *Connection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7207,75 +15414,17 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Properties: wiretype end group for non-group")
+			return fmt.Errorf("proto: Connection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Properties: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Connection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentEncoding = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.DeliveryMode = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7285,16 +15434,16 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeliveryMode |= (int32(b) & 0x7F) << shift
+				m.NodeID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Priority = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7304,14 +15453,14 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= (int32(b) & 0x7F) << shift
+				m.ID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7336,11 +15485,11 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CorrelationID = string(dAtA[iNdEx:postIndex])
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplyTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7365,11 +15514,11 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplyTo = string(dAtA[iNdEx:postIndex])
+			m.RemoteAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7394,11 +15543,11 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expiration = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7423,11 +15572,11 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageID = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7451,102 +15600,65 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ConnectedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AppID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7556,55 +15668,67 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.DoNotForward = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEmq
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEmq
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmq
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OK", wireType)
 			}
-			m.GroupSequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7614,16 +15738,17 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupSequence |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			m.OK = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplyToGroupID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEmq
@@ -7633,20 +15758,22 @@ func (m *Message_Properties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEmq
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplyToGroupID = string(dAtA[iNdEx:postIndex])
+			m.Connections = append(m.Connections, &Connection{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8572,122 +16699,227 @@ var (
 	ErrIntOverflowEmq   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("emq.proto", fileDescriptor_emq_5ee6d35c18ac9d01) }
-
-var fileDescriptor_emq_5ee6d35c18ac9d01 = []byte{
-	// 1821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x52, 0xfc, 0x7c, 0xfc, 0x90, 0x35, 0xb2, 0x6c, 0x8a, 0xb6, 0x45, 0x79, 0x15, 0x3b,
-	0x8a, 0x1d, 0x93, 0x8d, 0x8a, 0xa2, 0x85, 0x8b, 0x1c, 0x44, 0xcb, 0x4e, 0x59, 0xd7, 0x4a, 0xba,
-	0x76, 0x50, 0xa0, 0x3d, 0x2c, 0x56, 0xbb, 0x23, 0x6a, 0x21, 0x72, 0x67, 0xb5, 0xb3, 0x4c, 0xc5,
-	0x18, 0x3e, 0xf4, 0x03, 0x05, 0x7a, 0x6a, 0x8a, 0xf6, 0xd0, 0x63, 0xef, 0x45, 0x2e, 0xcd, 0xdf,
-	0x50, 0x20, 0xc7, 0x02, 0xbd, 0xab, 0x05, 0xdb, 0x5b, 0x81, 0x5e, 0x7b, 0xe8, 0xa5, 0x98, 0x37,
-	0xb3, 0xe4, 0x2e, 0x4d, 0x51, 0x34, 0x8d, 0xa0, 0xe8, 0x8d, 0x33, 0xef, 0xcd, 0xfb, 0xfc, 0xbd,
-	0x8f, 0x25, 0x14, 0x68, 0xef, 0xa4, 0xe1, 0x07, 0x2c, 0x64, 0xa4, 0xe2, 0xb2, 0x06, 0xfd, 0x84,
-	0x7a, 0x61, 0x48, 0x83, 0x46, 0xef, 0xa4, 0x76, 0xa5, 0xc3, 0x3a, 0x0c, 0x49, 0x4d, 0xf1, 0x4b,
-	0x72, 0xd5, 0x6e, 0x74, 0x18, 0xeb, 0x74, 0x69, 0xd3, 0xf2, 0xdd, 0xa6, 0xe5, 0x79, 0x2c, 0xb4,
-	0x42, 0x97, 0x79, 0x5c, 0x51, 0x37, 0x14, 0x15, 0x4f, 0x07, 0xfd, 0xc3, 0xa6, 0xd3, 0x0f, 0x90,
-	0x61, 0xe2, 0xf5, 0x88, 0xce, 0xc3, 0xa0, 0x6f, 0x87, 0x8a, 0x5a, 0x9f, 0xa4, 0x86, 0x6e, 0x8f,
-	0xf2, 0xd0, 0xea, 0xf9, 0x92, 0x41, 0xff, 0x01, 0x5c, 0xdd, 0xb7, 0x7a, 0x94, 0xfb, 0x96, 0x4d,
-	0x1f, 0x06, 0xd4, 0x0a, 0xa9, 0x41, 0x4f, 0xfa, 0x94, 0x87, 0xe4, 0x06, 0x14, 0xbc, 0x88, 0x52,
-	0xd5, 0x36, 0xb5, 0xed, 0x82, 0x31, 0xbe, 0x20, 0x75, 0x28, 0x76, 0xa9, 0xe5, 0xd0, 0xc0, 0x64,
-	0x5e, 0x77, 0x50, 0xb5, 0x37, 0xb5, 0xed, 0xbc, 0x01, 0xf2, 0xea, 0x43, 0xaf, 0x3b, 0xd0, 0x3f,
-	0x80, 0x6b, 0xaf, 0x08, 0xe6, 0x3e, 0xf3, 0x38, 0x25, 0x57, 0x21, 0xc5, 0x8e, 0x51, 0x64, 0xbe,
-	0x95, 0x1d, 0x9e, 0xd5, 0x53, 0x1f, 0x3e, 0x31, 0x52, 0xec, 0x98, 0x5c, 0x81, 0x8c, 0xeb, 0x39,
-	0xf4, 0xb4, 0x9a, 0xda, 0xd4, 0xb6, 0xd3, 0x86, 0x3c, 0x24, 0x2c, 0xdc, 0xa3, 0x5d, 0xfa, 0x95,
-	0x58, 0x18, 0x09, 0x5e, 0xc8, 0xc2, 0x23, 0x20, 0xcf, 0x99, 0xef, 0xda, 0xc9, 0xf8, 0xbd, 0x07,
-	0x99, 0x50, 0xdc, 0xa2, 0x98, 0xe2, 0xce, 0x5a, 0x23, 0x09, 0x86, 0x06, 0x3e, 0x69, 0xa5, 0xbf,
-	0x3c, 0xab, 0x5f, 0x32, 0x24, 0xe7, 0xc5, 0x26, 0x3f, 0x84, 0xd5, 0x84, 0xa6, 0x85, 0xcc, 0xfd,
-	0xb7, 0x06, 0x19, 0x94, 0x72, 0x41, 0x00, 0x09, 0xa4, 0xc5, 0x01, 0x1f, 0x17, 0x0c, 0xfc, 0x4d,
-	0xae, 0x42, 0x96, 0x1f, 0x59, 0x81, 0xc3, 0xab, 0x4b, 0x9b, 0xda, 0x76, 0xd9, 0x50, 0x27, 0x72,
-	0x1f, 0x48, 0x40, 0xfd, 0xae, 0x6b, 0x23, 0x34, 0xcd, 0x43, 0xcb, 0x0e, 0x59, 0x50, 0x4d, 0x23,
-	0xcf, 0x4a, 0x8c, 0xf2, 0x18, 0x09, 0x64, 0x17, 0x0a, 0x01, 0x0d, 0xa9, 0x27, 0xae, 0xaa, 0x19,
-	0x8c, 0xcf, 0x7a, 0x43, 0x42, 0xb5, 0x11, 0x41, 0xb5, 0xb1, 0xa7, 0x80, 0xde, 0xca, 0x8b, 0x18,
-	0xfd, 0xee, 0xaf, 0x75, 0xcd, 0x18, 0xbf, 0x22, 0x3b, 0xb0, 0xe6, 0xd0, 0x43, 0xab, 0xdf, 0x0d,
-	0x4d, 0x7a, 0x6a, 0x1f, 0x59, 0x5e, 0x87, 0x9a, 0xe1, 0xc0, 0xa7, 0xd5, 0x2c, 0x9a, 0xbb, 0xaa,
-	0x88, 0x8f, 0x14, 0xed, 0xf9, 0xc0, 0xa7, 0x3a, 0x85, 0xcb, 0xe8, 0xf8, 0xf7, 0x5c, 0x1e, 0xce,
-	0x07, 0xa2, 0x69, 0x31, 0xb8, 0x30, 0x4b, 0x3e, 0xac, 0xc4, 0xd4, 0x2c, 0x92, 0x23, 0x72, 0x1f,
-	0xb2, 0x08, 0x09, 0x11, 0xe7, 0xa5, 0x73, 0xd1, 0x63, 0x28, 0x26, 0xfd, 0xe7, 0x9a, 0x82, 0xe0,
-	0xeb, 0x14, 0xc8, 0x34, 0xdf, 0xae, 0x43, 0xc1, 0x3d, 0x34, 0xfb, 0x5e, 0x9f, 0x53, 0x07, 0x53,
-	0x9c, 0x37, 0xf2, 0xee, 0xe1, 0xc7, 0x78, 0x9e, 0x1f, 0x9e, 0x6f, 0x54, 0x4d, 0xbf, 0xd7, 0x94,
-	0x94, 0x8f, 0xfa, 0x07, 0x5d, 0x97, 0x1f, 0x2d, 0xee, 0xcc, 0x7b, 0x90, 0xeb, 0x51, 0xce, 0xad,
-	0x0e, 0x45, 0x57, 0x8a, 0x3b, 0xd7, 0x26, 0xa3, 0xf8, 0x54, 0x92, 0x8d, 0x88, 0x8f, 0xbc, 0x05,
-	0x15, 0x87, 0x99, 0x1e, 0x0b, 0xcd, 0x43, 0x16, 0xfc, 0xd8, 0x0a, 0x1c, 0xe5, 0x65, 0xc9, 0x61,
-	0xfb, 0x2c, 0x7c, 0x2c, 0xef, 0xf4, 0x06, 0x5c, 0x49, 0x5a, 0x38, 0xdb, 0x51, 0xfd, 0x97, 0x1a,
-	0xd4, 0x1e, 0x32, 0x8f, 0xf7, 0x7b, 0x34, 0xf8, 0x20, 0x60, 0x7d, 0x3f, 0xd9, 0x29, 0xbe, 0x0b,
-	0x15, 0x5b, 0x51, 0xcd, 0x8e, 0x20, 0xab, 0x96, 0x71, 0x73, 0xd2, 0xdc, 0x84, 0x0c, 0xd5, 0x3a,
-	0xca, 0x76, 0xfc, 0xf2, 0xe2, 0x1c, 0x3d, 0x81, 0xeb, 0x53, 0x4d, 0x59, 0x28, 0x57, 0x7f, 0x5a,
-	0x82, 0x72, 0x42, 0xda, 0x02, 0x59, 0xda, 0x85, 0xfc, 0x81, 0xeb, 0x39, 0xae, 0xd7, 0x89, 0xc0,
-	0x7e, 0x7b, 0xa6, 0xdf, 0x8d, 0x96, 0xe4, 0x36, 0x46, 0xcf, 0x84, 0x58, 0xee, 0x7e, 0x4a, 0x55,
-	0xbf, 0xc1, 0xdf, 0xe4, 0x01, 0x64, 0xb8, 0xeb, 0xd9, 0x54, 0xb5, 0x97, 0xda, 0x2b, 0xed, 0xe5,
-	0x79, 0x34, 0x09, 0x65, 0x7f, 0xf9, 0x4c, 0xf4, 0x17, 0xf9, 0xa4, 0xf6, 0x2f, 0x0d, 0x72, 0x4a,
-	0x0b, 0xb9, 0x09, 0x80, 0x45, 0x66, 0xa2, 0xe1, 0xca, 0x23, 0xbc, 0x11, 0xc3, 0x83, 0x6c, 0x41,
-	0x39, 0xd9, 0x7e, 0xa4, 0x6b, 0x25, 0x1a, 0xeb, 0x3b, 0xe4, 0x16, 0x14, 0x03, 0xd6, 0x0f, 0x5d,
-	0xaf, 0x63, 0x1e, 0xd3, 0x01, 0x82, 0xb1, 0xf0, 0x9d, 0x4b, 0x06, 0xa8, 0xcb, 0x27, 0x74, 0x40,
-	0x1e, 0x40, 0xf1, 0x08, 0x93, 0xc4, 0x4d, 0xab, 0xdb, 0x45, 0x4f, 0x04, 0x5e, 0x27, 0x8d, 0x7e,
-	0x86, 0xc3, 0x5d, 0xbc, 0x55, 0xdc, 0xbb, 0xdd, 0x6e, 0xe2, 0xad, 0x37, 0x50, 0x0e, 0xcf, 0xf3,
-	0xd6, 0x1b, 0xb4, 0xd2, 0x90, 0x3a, 0x18, 0xe8, 0x3d, 0xa8, 0x26, 0x62, 0xfc, 0x15, 0x37, 0xc8,
-	0x5f, 0x6b, 0xb0, 0x3e, 0x45, 0xdf, 0x42, 0x9d, 0xf2, 0x31, 0x2c, 0x27, 0x8b, 0x27, 0x42, 0xd1,
-	0xec, 0xea, 0x31, 0x2a, 0x89, 0xba, 0xe1, 0x3a, 0x9b, 0x28, 0xd1, 0x37, 0xed, 0xa4, 0xaf, 0x5d,
-	0x88, 0x6f, 0xd4, 0x34, 0xbf, 0xc8, 0x42, 0x4e, 0x35, 0x33, 0xa1, 0x39, 0x8e, 0x36, 0x69, 0x6d,
-	0x1c, 0x6b, 0x2d, 0x00, 0x3f, 0x60, 0x3e, 0x0d, 0x42, 0x97, 0x72, 0x94, 0x53, 0xdc, 0xd1, 0xcf,
-	0x69, 0x8d, 0x8d, 0x8f, 0x46, 0x9c, 0x46, 0xec, 0x95, 0xe8, 0xad, 0x0a, 0x45, 0xa3, 0xde, 0x3a,
-	0x1d, 0x6f, 0x46, 0xc4, 0x27, 0xa2, 0xe4, 0x58, 0xa1, 0x85, 0xd8, 0x2e, 0x19, 0xf8, 0xbb, 0xf6,
-	0x9f, 0x34, 0xc0, 0x58, 0x03, 0xb9, 0x05, 0x25, 0x9b, 0x79, 0x62, 0xc4, 0xcb, 0x62, 0x92, 0xb6,
-	0x17, 0xd5, 0x1d, 0xd6, 0xd2, 0x3b, 0x70, 0x39, 0x62, 0xa1, 0x9e, 0xcd, 0x44, 0x8d, 0xaa, 0xb8,
-	0x2f, 0xab, 0xfb, 0x47, 0xea, 0x5a, 0xd4, 0xa6, 0x43, 0xbb, 0xee, 0x27, 0x34, 0x18, 0x98, 0x3d,
-	0xe6, 0xc8, 0x29, 0x90, 0x31, 0x4a, 0xd1, 0xe5, 0x53, 0xe6, 0x50, 0x52, 0x83, 0xbc, 0x1f, 0xb8,
-	0x2c, 0x70, 0xc3, 0x01, 0x5a, 0x96, 0x31, 0x46, 0x67, 0xf2, 0x2d, 0xd1, 0x98, 0x83, 0x80, 0x76,
-	0xe5, 0x56, 0xe3, 0x3a, 0x58, 0x5b, 0x85, 0xd6, 0xca, 0xf0, 0xac, 0x5e, 0x7e, 0x38, 0xa6, 0xb4,
-	0xf7, 0x44, 0x1b, 0x1e, 0x1f, 0x1d, 0xb2, 0x0e, 0x79, 0xb1, 0xf5, 0x0c, 0xcc, 0x90, 0xa9, 0x85,
-	0x24, 0x87, 0xe7, 0xe7, 0x8c, 0x6c, 0x00, 0xd0, 0x53, 0xdf, 0x95, 0xbb, 0x4d, 0x35, 0x27, 0xb3,
-	0x33, 0xbe, 0x21, 0xef, 0x02, 0xa8, 0x69, 0x24, 0x14, 0xe6, 0x51, 0x61, 0x79, 0x78, 0x56, 0x2f,
-	0xa8, 0x8c, 0xb4, 0xf7, 0x8c, 0x82, 0x62, 0x68, 0x3b, 0xa4, 0x05, 0x85, 0xd1, 0x4a, 0x5f, 0x2d,
-	0xbc, 0x46, 0xab, 0x1b, 0x3f, 0x13, 0x89, 0xc1, 0x68, 0x83, 0x84, 0xaf, 0xf8, 0x4d, 0xb6, 0x20,
-	0xd7, 0xe7, 0x34, 0x10, 0x26, 0x14, 0xd1, 0x04, 0x18, 0x9e, 0xd5, 0xb3, 0x1f, 0x73, 0x1a, 0xb4,
-	0xf7, 0x8c, 0xac, 0x20, 0xb5, 0x1d, 0xb2, 0x09, 0x59, 0xcb, 0xf7, 0x05, 0x4f, 0x09, 0x79, 0x0a,
-	0xc3, 0xb3, 0x7a, 0x66, 0xd7, 0xf7, 0xdb, 0x7b, 0x46, 0xc6, 0xf2, 0xfd, 0xb6, 0x43, 0x2a, 0x90,
-	0x0a, 0x59, 0xb5, 0x8c, 0x82, 0x53, 0x21, 0x23, 0x77, 0x20, 0x8f, 0x45, 0x2a, 0xde, 0x54, 0xf0,
-	0x4d, 0x71, 0x78, 0x56, 0xcf, 0x61, 0x01, 0xb4, 0xf7, 0x8c, 0x1c, 0x12, 0xdb, 0x0e, 0xb9, 0x0d,
-	0x15, 0xc9, 0xc7, 0x45, 0x01, 0x8a, 0x36, 0xbe, 0x8c, 0xbd, 0xbd, 0x8c, 0xb7, 0xcf, 0xd4, 0x25,
-	0x79, 0x1f, 0x56, 0xa2, 0x30, 0x9b, 0x23, 0xb9, 0x97, 0x51, 0x2e, 0x19, 0x9e, 0xd5, 0x2b, 0x86,
-	0x8c, 0x79, 0x24, 0xbe, 0x12, 0xc4, 0xcf, 0x8e, 0xfe, 0x4f, 0x0d, 0x6e, 0x26, 0x6a, 0xf0, 0x59,
-	0xff, 0x80, 0xdb, 0x81, 0x7b, 0xf0, 0x06, 0x75, 0x1f, 0xcd, 0xa2, 0xa5, 0xd8, 0x2c, 0x5a, 0x87,
-	0xbc, 0xd5, 0x0f, 0x99, 0x69, 0xd9, 0xc7, 0x88, 0xb1, 0xbc, 0x91, 0x13, 0xe7, 0x5d, 0xfb, 0x98,
-	0x6c, 0x42, 0x49, 0x2d, 0x1c, 0x07, 0x5d, 0x66, 0x1f, 0x23, 0xc0, 0xf2, 0x06, 0xe0, 0xba, 0xd1,
-	0x12, 0x37, 0xa2, 0x26, 0x7a, 0xd6, 0xa9, 0xa9, 0x52, 0xce, 0x11, 0x4e, 0x69, 0xa3, 0xd8, 0xb3,
-	0x4e, 0x15, 0x20, 0xf8, 0x9c, 0x5b, 0xcb, 0x6f, 0x53, 0xb0, 0x71, 0x9e, 0xb7, 0xaa, 0xe9, 0x6c,
-	0x41, 0xce, 0x63, 0x0e, 0x02, 0x4f, 0x38, 0x9b, 0x96, 0x59, 0xdf, 0x67, 0x8e, 0x40, 0x5d, 0x56,
-	0x90, 0xda, 0x0e, 0xf9, 0x36, 0x2c, 0x73, 0xf9, 0xd2, 0x8f, 0xca, 0x02, 0x7b, 0x91, 0x0c, 0xf9,
-	0xb3, 0x18, 0x49, 0x84, 0x3c, 0xce, 0xda, 0x76, 0xc8, 0x1a, 0x64, 0x39, 0x3d, 0x31, 0x3d, 0x86,
-	0x01, 0x4a, 0x1b, 0x19, 0x4e, 0x4f, 0xf6, 0x19, 0x79, 0x1b, 0x96, 0xc7, 0x53, 0x56, 0x46, 0x3b,
-	0x8d, 0x41, 0xad, 0x8c, 0x46, 0xad, 0x0c, 0x79, 0x72, 0x1c, 0x67, 0x26, 0xc7, 0x71, 0x6c, 0xe5,
-	0xcb, 0xce, 0xb7, 0xf2, 0xe9, 0x7f, 0xd4, 0x60, 0x45, 0x5d, 0xee, 0xda, 0xc7, 0x51, 0xe2, 0xff,
-	0x67, 0x91, 0x98, 0x2f, 0x97, 0xef, 0x02, 0x89, 0xdb, 0x7c, 0xc1, 0xfe, 0xf9, 0x85, 0x36, 0x62,
-	0xdf, 0xb7, 0xfe, 0x6f, 0x7c, 0xbc, 0x0f, 0xab, 0x09, 0xa3, 0x67, 0x3b, 0xb9, 0xf3, 0x79, 0x09,
-	0xe0, 0x91, 0x4a, 0xf4, 0xd3, 0xef, 0x93, 0x53, 0x58, 0x96, 0xab, 0xed, 0x18, 0x3b, 0x77, 0x26,
-	0xb1, 0x30, 0xfd, 0x9f, 0x8f, 0xda, 0xdb, 0x17, 0xf2, 0x49, 0x53, 0xf4, 0x2b, 0x3f, 0xfd, 0xcb,
-	0x3f, 0x7e, 0x93, 0xaa, 0xd4, 0x4a, 0xcd, 0x17, 0x23, 0xdc, 0xbe, 0x14, 0x9a, 0xe5, 0x2c, 0x9f,
-	0x47, 0x73, 0x62, 0xcd, 0x98, 0xa1, 0x39, 0xb9, 0x1d, 0x9c, 0xa3, 0xf9, 0x17, 0x1a, 0x14, 0xa5,
-	0x89, 0xf2, 0xfb, 0x5e, 0x9f, 0xfa, 0xd5, 0x98, 0x74, 0x76, 0x6b, 0x26, 0x8f, 0x52, 0xd7, 0x40,
-	0x75, 0xdb, 0xb5, 0x3b, 0xcd, 0x17, 0x58, 0x6b, 0x8d, 0xb1, 0xd2, 0xa6, 0xfc, 0x04, 0x8d, 0x13,
-	0x5e, 0x12, 0x0f, 0x40, 0xac, 0x74, 0x28, 0x8a, 0x93, 0xcd, 0xa9, 0x2a, 0x62, 0x3b, 0x66, 0xed,
-	0xd6, 0x0c, 0x0e, 0x65, 0xc2, 0x75, 0x34, 0x61, 0x8d, 0xac, 0xc6, 0x3d, 0x56, 0xca, 0xc9, 0xa7,
-	0x50, 0x94, 0x01, 0x9a, 0xe5, 0x77, 0x32, 0xd4, 0x5b, 0x33, 0x79, 0x94, 0x52, 0x1d, 0x95, 0xde,
-	0xb8, 0x5b, 0x9b, 0xa2, 0x54, 0x5e, 0xbd, 0x24, 0x3f, 0xd1, 0x20, 0xa7, 0x3e, 0x04, 0xc9, 0x74,
-	0xa1, 0xc9, 0x0f, 0xd9, 0xda, 0x5b, 0xb3, 0x99, 0x94, 0xea, 0x7b, 0xa8, 0xfa, 0xb6, 0x3e, 0x43,
-	0xf5, 0x83, 0xd1, 0x67, 0xeb, 0xe7, 0x1a, 0xac, 0xca, 0x94, 0x25, 0xbf, 0xc6, 0xee, 0xce, 0xdc,
-	0x81, 0x93, 0x40, 0xb8, 0x37, 0x17, 0xaf, 0xb2, 0xee, 0x7d, 0xb4, 0xee, 0x9b, 0xb5, 0x6f, 0x34,
-	0x5f, 0x24, 0xb7, 0xef, 0x38, 0x32, 0xec, 0x0e, 0x9f, 0x4a, 0x7e, 0x49, 0x7e, 0xa6, 0x01, 0x11,
-	0xd9, 0x4d, 0xa8, 0xe0, 0x64, 0x7b, 0xa6, 0x09, 0x71, 0xc0, 0xbc, 0x33, 0x07, 0xa7, 0x32, 0xb5,
-	0x8a, 0xa6, 0x12, 0x72, 0x39, 0x11, 0x48, 0xbb, 0xc3, 0xc9, 0xaf, 0x34, 0x58, 0x95, 0x09, 0x7f,
-	0x9d, 0xa8, 0x25, 0x61, 0x74, 0x6f, 0x2e, 0x5e, 0x65, 0x4a, 0x1d, 0x4d, 0x59, 0xbf, 0x7b, 0x6d,
-	0xd2, 0x94, 0x08, 0x4b, 0x7f, 0xd0, 0xa0, 0x30, 0x9a, 0xca, 0xe4, 0xfe, 0x4c, 0xd9, 0x93, 0xbb,
-	0x4a, 0xad, 0x31, 0x2f, 0x7b, 0x32, 0x87, 0xfa, 0x62, 0x39, 0xfc, 0x9a, 0x46, 0x7e, 0x04, 0x4b,
-	0x62, 0x85, 0xb9, 0x75, 0xce, 0x88, 0x1d, 0x4f, 0xd3, 0x9a, 0x3e, 0x8b, 0x45, 0x99, 0x53, 0x46,
-	0x73, 0x72, 0x7a, 0xa6, 0x29, 0xf6, 0x24, 0x62, 0x42, 0x5a, 0xb4, 0x7d, 0x72, 0xde, 0xd3, 0xd8,
-	0x20, 0x7b, 0xb5, 0x96, 0xa7, 0xcc, 0x0d, 0xbd, 0x82, 0xf2, 0xf3, 0x7a, 0xb6, 0x69, 0x7a, 0x96,
-	0x7d, 0xdc, 0x5a, 0xfb, 0x72, 0xb8, 0xa1, 0xfd, 0x79, 0xb8, 0xa1, 0xfd, 0x6d, 0xb8, 0xa1, 0x7d,
-	0xf6, 0xf7, 0x8d, 0x4b, 0x3f, 0x5c, 0xa2, 0xbd, 0x93, 0x83, 0x2c, 0x6e, 0xcd, 0x5f, 0xff, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x42, 0xe5, 0xb6, 0xc7, 0x17, 0x00, 0x00,
+func init() { proto.RegisterFile("emq.proto", fileDescriptor_emq_73a58150386249d1) }
+
+var fileDescriptor_emq_73a58150386249d1 = []byte{
+	// 3491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x6c, 0x1b, 0xd7,
+	0xb5, 0x19, 0xfe, 0x79, 0x28, 0x92, 0xd2, 0xd5, 0xc7, 0x34, 0xfd, 0xa1, 0x34, 0xf2, 0x57, 0xb6,
+	0xc5, 0x44, 0x79, 0xc9, 0x4b, 0xd5, 0xba, 0x80, 0x3e, 0xfe, 0x30, 0x89, 0x65, 0x67, 0xec, 0x24,
+	0x40, 0x17, 0x9d, 0x8e, 0x38, 0x57, 0xd4, 0x54, 0xc3, 0x19, 0x7a, 0x66, 0x68, 0x5b, 0x31, 0x0c,
+	0xa4, 0x1f, 0xa4, 0x68, 0x81, 0xa0, 0x09, 0x9a, 0xa2, 0x5d, 0x74, 0xd3, 0x7d, 0x81, 0x02, 0x0d,
+	0xda, 0x5d, 0x37, 0x5d, 0x65, 0x59, 0xa0, 0x7b, 0xb5, 0x50, 0xbb, 0x29, 0x8a, 0xee, 0x0b, 0x74,
+	0x53, 0xdc, 0x73, 0xef, 0x0c, 0x67, 0x28, 0x7e, 0x25, 0x04, 0x45, 0x77, 0xbc, 0xe7, 0x9e, 0x73,
+	0xcf, 0xb9, 0xe7, 0x7f, 0xee, 0x10, 0xb2, 0xb4, 0xf9, 0x78, 0xb9, 0xe5, 0xd8, 0x9e, 0x4d, 0x0a,
+	0x86, 0xbd, 0x4c, 0x9f, 0x50, 0xcb, 0xf3, 0xa8, 0xb3, 0xdc, 0x7c, 0x5c, 0x9e, 0x69, 0xd8, 0x0d,
+	0x1b, 0xb7, 0xaa, 0xec, 0x17, 0xc7, 0x2a, 0x9f, 0x6d, 0xd8, 0x76, 0xc3, 0xa4, 0x55, 0xad, 0x65,
+	0x54, 0x35, 0xcb, 0xb2, 0x3d, 0xcd, 0x33, 0x6c, 0xcb, 0x15, 0xbb, 0xe7, 0xc5, 0x2e, 0xae, 0xb6,
+	0xdb, 0x3b, 0x55, 0xbd, 0xed, 0x20, 0x42, 0x17, 0x75, 0xb0, 0xef, 0x7a, 0x4e, 0xbb, 0xee, 0x89,
+	0xdd, 0x4a, 0xf7, 0xae, 0x67, 0x34, 0xa9, 0xeb, 0x69, 0xcd, 0x16, 0x47, 0x90, 0x3f, 0x94, 0x60,
+	0x6e, 0x4b, 0x6b, 0x52, 0xb7, 0xa5, 0xd5, 0xe9, 0x86, 0x43, 0x35, 0x8f, 0x2a, 0xf4, 0x71, 0x9b,
+	0xba, 0x1e, 0x39, 0x0b, 0x59, 0xcb, 0xdf, 0x29, 0x49, 0xf3, 0xd2, 0x95, 0xac, 0xd2, 0x01, 0x90,
+	0xcb, 0x50, 0x74, 0xa8, 0x47, 0x2d, 0x26, 0x8a, 0xba, 0xbd, 0xef, 0x51, 0xb7, 0x14, 0x9b, 0x97,
+	0xae, 0x24, 0x94, 0x42, 0x00, 0x5e, 0x67, 0x50, 0x52, 0x81, 0x9c, 0x49, 0x35, 0x9d, 0x3a, 0xaa,
+	0x6d, 0x99, 0xfb, 0xa5, 0xfa, 0xbc, 0x74, 0x25, 0xa3, 0x00, 0x07, 0xdd, 0xb7, 0xcc, 0x7d, 0xf9,
+	0x0e, 0x9c, 0x3a, 0x22, 0x81, 0xdb, 0xb2, 0x2d, 0x97, 0x92, 0x39, 0x88, 0xd9, 0x7b, 0xc8, 0x3b,
+	0xb3, 0x9e, 0x3a, 0x3c, 0xa8, 0xc4, 0xee, 0xbf, 0xa5, 0xc4, 0xec, 0x3d, 0x32, 0x03, 0x49, 0xc3,
+	0xd2, 0xe9, 0x33, 0xc1, 0x92, 0x2f, 0xe4, 0xf7, 0x43, 0x57, 0xd9, 0xa4, 0x26, 0x1d, 0xf5, 0x2a,
+	0x63, 0x49, 0xe8, 0x1f, 0x7c, 0x2c, 0x09, 0xff, 0x1f, 0x66, 0x82, 0x83, 0xde, 0x36, 0x5c, 0xcf,
+	0x97, 0x6f, 0xa8, 0x04, 0x14, 0x66, 0xbb, 0x08, 0x8f, 0xc3, 0x9f, 0x9c, 0x07, 0x08, 0xae, 0xed,
+	0x96, 0xe2, 0xf3, 0xf1, 0x2b, 0x59, 0x25, 0x04, 0x91, 0x77, 0x81, 0x3c, 0xb2, 0x5b, 0x46, 0x3d,
+	0xea, 0x08, 0xaf, 0x40, 0xd2, 0x63, 0x50, 0x64, 0x93, 0x5b, 0x99, 0x5d, 0x8e, 0xba, 0xf5, 0x32,
+	0x92, 0xac, 0x27, 0xbe, 0x38, 0xa8, 0xbc, 0xa4, 0x70, 0xcc, 0xe1, 0x17, 0xda, 0x80, 0xe9, 0x08,
+	0xa7, 0x63, 0xa9, 0xf3, 0x97, 0x09, 0x48, 0xe2, 0x29, 0x43, 0x0c, 0x4c, 0x20, 0xc1, 0x16, 0x48,
+	0x9c, 0x55, 0xf0, 0x37, 0x99, 0x83, 0x94, 0xbb, 0xab, 0x39, 0x3a, 0x53, 0x83, 0x74, 0x25, 0xaf,
+	0x88, 0x15, 0xb9, 0x01, 0xc4, 0xa1, 0x2d, 0xd3, 0xa8, 0x63, 0x90, 0xa9, 0x3b, 0x5a, 0xdd, 0xb3,
+	0x9d, 0x52, 0x02, 0x71, 0xa6, 0x42, 0x3b, 0xb7, 0x71, 0x83, 0xac, 0x41, 0x36, 0xf0, 0xf7, 0x52,
+	0x12, 0xf5, 0x73, 0x7a, 0x99, 0x07, 0xdd, 0xb2, 0x1f, 0x74, 0xcb, 0x9b, 0x22, 0x64, 0xd7, 0x33,
+	0x4c, 0x47, 0x3f, 0xff, 0x73, 0x45, 0x52, 0x3a, 0x54, 0x64, 0x05, 0x66, 0x75, 0xba, 0xa3, 0xb5,
+	0x4d, 0x4f, 0xa5, 0xcf, 0xea, 0xbb, 0x9a, 0xd5, 0xa0, 0xaa, 0xb7, 0xdf, 0xa2, 0xa5, 0x14, 0x8a,
+	0x3b, 0x2d, 0x36, 0x6f, 0x89, 0xbd, 0x47, 0xfb, 0x2d, 0x8c, 0x3e, 0x54, 0x01, 0xd5, 0xd5, 0x5d,
+	0x54, 0xaa, 0x5b, 0x4a, 0xa3, 0x35, 0x0b, 0x02, 0x7c, 0x97, 0x43, 0xc9, 0x22, 0xe4, 0x5b, 0x9a,
+	0xe3, 0x19, 0x78, 0x99, 0x3d, 0xba, 0x5f, 0xca, 0xe0, 0xa1, 0x13, 0x01, 0xf0, 0x2d, 0xba, 0x4f,
+	0xde, 0x83, 0x19, 0x9d, 0xea, 0xed, 0xce, 0xad, 0x9f, 0x1a, 0x96, 0x6e, 0x3f, 0x2d, 0x65, 0x47,
+	0xbf, 0xcf, 0x74, 0xe4, 0x80, 0xf7, 0x91, 0x9e, 0x5c, 0x84, 0x42, 0xdd, 0x6e, 0xb6, 0xb4, 0x7a,
+	0xc0, 0x1d, 0x90, 0x7b, 0xbe, 0x03, 0x65, 0xec, 0x7b, 0xa4, 0x92, 0x5c, 0xcf, 0x54, 0xb2, 0x02,
+	0xb3, 0x3b, 0xb6, 0x53, 0xa7, 0x6a, 0x37, 0xfa, 0x04, 0xfa, 0xd7, 0x34, 0x6e, 0x2a, 0x11, 0x1a,
+	0x99, 0xc2, 0x24, 0xba, 0x48, 0x38, 0xdc, 0xc6, 0xf7, 0x96, 0xa1, 0xfe, 0xdc, 0x82, 0xa9, 0x10,
+	0x9b, 0x63, 0x05, 0xe7, 0x0d, 0x48, 0x61, 0xf0, 0xf0, 0xc0, 0xec, 0x17, 0x67, 0x8a, 0x40, 0x92,
+	0x0d, 0x98, 0x41, 0xc0, 0x26, 0x75, 0xeb, 0x8e, 0xb1, 0x4d, 0xbf, 0xc4, 0xcb, 0xfd, 0x21, 0x0d,
+	0xb3, 0x5d, 0xbc, 0x8e, 0x75, 0xc3, 0x6b, 0x7e, 0x22, 0x89, 0x0f, 0x48, 0x24, 0x7e, 0x0a, 0xa9,
+	0x41, 0xc6, 0xa5, 0x8d, 0x26, 0xb5, 0x3c, 0xb7, 0x94, 0x40, 0x85, 0xdc, 0xe8, 0x89, 0xdf, 0x2d,
+	0xd3, 0xf2, 0x43, 0x4e, 0xa5, 0x04, 0xe4, 0xcc, 0x0f, 0x1d, 0xea, 0x69, 0x86, 0x45, 0x75, 0xe1,
+	0x30, 0x2c, 0x52, 0xe3, 0x4a, 0xde, 0x87, 0x72, 0xf7, 0xba, 0x03, 0x05, 0xdb, 0xd4, 0xa9, 0xeb,
+	0xa9, 0x4d, 0xea, 0xba, 0x5a, 0x83, 0x47, 0x60, 0x6e, 0xa5, 0x7c, 0x24, 0x00, 0x1e, 0xf9, 0x55,
+	0x74, 0x3d, 0xf1, 0x09, 0xf3, 0xfe, 0x3c, 0xa7, 0xbb, 0xc7, 0xc9, 0xd8, 0x41, 0x16, 0x7d, 0x1a,
+	0x3e, 0x28, 0x3d, 0xea, 0x41, 0x9c, 0xce, 0x3f, 0x68, 0x15, 0x4e, 0xb7, 0x2d, 0x66, 0x18, 0x3f,
+	0xf1, 0x50, 0x5d, 0x0d, 0x94, 0x92, 0xc1, 0x9c, 0x74, 0x0a, 0x11, 0x94, 0x60, 0x5f, 0xdc, 0xde,
+	0x2d, 0x7f, 0x9c, 0x80, 0xb4, 0x58, 0x30, 0x33, 0x19, 0x3a, 0x9a, 0x29, 0xc1, 0xcd, 0x54, 0xdb,
+	0x54, 0x62, 0x86, 0xce, 0xbc, 0xc1, 0x6e, 0x51, 0x0b, 0xad, 0x94, 0x51, 0xf0, 0x37, 0x33, 0x1d,
+	0xa6, 0x42, 0x91, 0x17, 0xf9, 0x82, 0x7c, 0x05, 0x8a, 0x2d, 0xc7, 0x68, 0x6a, 0xce, 0xbe, 0x6a,
+	0xd9, 0x3a, 0x55, 0x0d, 0x1d, 0x73, 0x62, 0x62, 0x7d, 0xea, 0xf0, 0xa0, 0x92, 0x7f, 0xc0, 0xb7,
+	0xb6, 0x6c, 0x9d, 0xd6, 0x36, 0x95, 0x7c, 0x2b, 0xb4, 0xd4, 0xc9, 0xab, 0x90, 0xd7, 0x6d, 0x8b,
+	0xfa, 0x74, 0x4c, 0xf9, 0xf1, 0x2b, 0x89, 0xf5, 0xe2, 0xe1, 0x41, 0x25, 0xb7, 0x69, 0x5b, 0x94,
+	0x53, 0xb9, 0x4a, 0x4e, 0xf7, 0x17, 0xba, 0x4b, 0xee, 0xc2, 0x4c, 0x90, 0x6c, 0xad, 0x46, 0x87,
+	0x36, 0x85, 0xb4, 0x73, 0x87, 0x07, 0x15, 0xa2, 0x74, 0xf6, 0xfd, 0x23, 0x88, 0xd3, 0x05, 0xd3,
+	0x5d, 0x76, 0x47, 0xd7, 0xf8, 0x80, 0x9b, 0x20, 0xae, 0xe0, 0x6f, 0xb2, 0x01, 0x50, 0xc7, 0xc2,
+	0xa3, 0xab, 0x9a, 0x57, 0xca, 0x0c, 0x35, 0x0e, 0xe6, 0x39, 0x34, 0x50, 0x56, 0xd0, 0xad, 0x79,
+	0xe4, 0x26, 0x64, 0xeb, 0xa6, 0xed, 0xf2, 0x33, 0xb2, 0x23, 0x1a, 0x38, 0xc3, 0x49, 0xd6, 0x3c,
+	0x72, 0x15, 0x26, 0xbb, 0x6d, 0x8b, 0xe9, 0x31, 0xa3, 0x14, 0xbb, 0x4c, 0x4a, 0x36, 0x60, 0xc2,
+	0xde, 0xd9, 0x31, 0x6d, 0x4d, 0xe7, 0xcc, 0x72, 0x23, 0x32, 0xcb, 0x05, 0x54, 0x6b, 0x9e, 0xfc,
+	0x69, 0x42, 0x14, 0xf7, 0x87, 0x54, 0x73, 0xea, 0xbb, 0xc7, 0x4f, 0x17, 0xaf, 0x43, 0xd2, 0x35,
+	0xac, 0x3a, 0x2d, 0xc5, 0x47, 0x14, 0x83, 0xa3, 0x33, 0xba, 0xb6, 0xe5, 0x19, 0x66, 0x29, 0x31,
+	0x2a, 0x1d, 0xa2, 0xb3, 0xf4, 0xe4, 0xd8, 0x6d, 0x74, 0x03, 0x56, 0x42, 0x92, 0x28, 0x0a, 0x08,
+	0x10, 0xab, 0x1f, 0x6f, 0x40, 0x4e, 0x14, 0x41, 0x55, 0x33, 0x4d, 0x11, 0xb4, 0xa7, 0x8e, 0x1c,
+	0xff, 0x10, 0x1b, 0x63, 0x05, 0x04, 0xee, 0x9a, 0x69, 0x46, 0x28, 0xad, 0xfd, 0x52, 0x7a, 0x44,
+	0x4a, 0x6b, 0x9f, 0xd5, 0x55, 0x5d, 0xf3, 0x34, 0xb5, 0x6e, 0x5b, 0x2c, 0x85, 0xb8, 0x7e, 0x5d,
+	0x65, 0xc0, 0x0d, 0x01, 0x23, 0xaf, 0x43, 0x01, 0x91, 0xbe, 0xed, 0xda, 0x96, 0xda, 0xd2, 0xbc,
+	0x5d, 0x74, 0x93, 0xec, 0xfa, 0xe4, 0xe1, 0x41, 0x65, 0x62, 0x53, 0xf3, 0xb4, 0x37, 0x1f, 0xde,
+	0xdf, 0x7a, 0xa0, 0x79, 0xbb, 0x9c, 0xee, 0x4d, 0xd7, 0xb6, 0xd8, 0x8a, 0xbc, 0x03, 0xc5, 0x0e,
+	0xdd, 0x13, 0xcd, 0x6c, 0x53, 0xf4, 0x8c, 0xdc, 0xca, 0xdc, 0x11, 0xd1, 0xde, 0x63, 0xbb, 0x3c,
+	0x08, 0xfd, 0x03, 0x11, 0xa4, 0xe4, 0xfd, 0x13, 0x71, 0xc9, 0xa2, 0xda, 0x34, 0x9a, 0x06, 0xf7,
+	0x9d, 0xbc, 0xc2, 0x17, 0xf2, 0x17, 0x12, 0x4c, 0x47, 0x7c, 0x42, 0xa4, 0xf5, 0xeb, 0x00, 0x22,
+	0xcd, 0xa8, 0x41, 0xde, 0xc8, 0x1f, 0x1e, 0x54, 0xb2, 0x22, 0xa1, 0xd4, 0x36, 0x95, 0xac, 0x40,
+	0xa8, 0xe9, 0xac, 0x95, 0xb2, 0x77, 0x76, 0x5c, 0xea, 0xa1, 0x9b, 0xc4, 0x15, 0xb1, 0x22, 0x6f,
+	0x40, 0x82, 0x8d, 0x1b, 0xa5, 0xf8, 0x18, 0xf1, 0x85, 0x14, 0xe4, 0x15, 0x48, 0xfb, 0x99, 0x33,
+	0x21, 0x6c, 0xd2, 0x95, 0xfa, 0x45, 0x86, 0x54, 0x7c, 0x3c, 0xf9, 0xfb, 0x92, 0x70, 0xef, 0x71,
+	0x3a, 0xff, 0x5e, 0xee, 0x7d, 0x06, 0xb2, 0xc6, 0x8e, 0xda, 0xb6, 0xda, 0x2e, 0xe5, 0x39, 0x30,
+	0xa3, 0x64, 0x8c, 0x9d, 0x77, 0x71, 0x3d, 0x7a, 0x5f, 0x7b, 0xa2, 0x31, 0xe1, 0x9f, 0xbe, 0x59,
+	0x1e, 0xb4, 0xb7, 0x4d, 0xc3, 0x3d, 0x41, 0xac, 0x86, 0x14, 0x19, 0x1f, 0x4d, 0x91, 0xa4, 0x0a,
+	0xb9, 0x96, 0x63, 0xeb, 0xed, 0x3a, 0x75, 0xfc, 0x2c, 0x9f, 0x5d, 0x2f, 0x1c, 0x1e, 0x54, 0xe0,
+	0x81, 0x00, 0xd7, 0x36, 0x15, 0xf0, 0x51, 0x6a, 0x3a, 0x29, 0xb3, 0x42, 0xfd, 0xb8, 0x4d, 0x59,
+	0x4a, 0x48, 0xe2, 0x35, 0x82, 0x35, 0xb9, 0x00, 0x05, 0xdd, 0x56, 0x2d, 0xdb, 0x53, 0x77, 0x6c,
+	0xe7, 0x29, 0xab, 0x2a, 0x5c, 0x65, 0x13, 0xba, 0xbd, 0x65, 0x7b, 0xb7, 0x39, 0x4c, 0x7e, 0x1b,
+	0x66, 0xa2, 0xd7, 0x1d, 0xa2, 0xb5, 0xb3, 0x90, 0xf5, 0x9b, 0x4d, 0x2a, 0x6a, 0x57, 0x07, 0x20,
+	0xff, 0x50, 0x82, 0xf2, 0x86, 0x6d, 0xb9, 0xed, 0x26, 0x75, 0xee, 0x38, 0x76, 0xbb, 0x15, 0x9d,
+	0x66, 0xde, 0x64, 0x4d, 0x29, 0xdf, 0x55, 0x1b, 0x6c, 0x5b, 0x8c, 0x35, 0xe7, 0xba, 0x35, 0x13,
+	0x39, 0x43, 0x8c, 0x37, 0xf9, 0x7a, 0x18, 0x38, 0xdc, 0x1d, 0xde, 0x82, 0x33, 0x3d, 0x45, 0x39,
+	0x96, 0x5b, 0xfc, 0x2e, 0x01, 0xf9, 0xc8, 0x69, 0xc7, 0x70, 0x88, 0x35, 0xc8, 0x6c, 0x1b, 0x96,
+	0x6e, 0x58, 0x0d, 0xbf, 0xcd, 0xbc, 0x38, 0xf0, 0xde, 0xcb, 0xeb, 0x1c, 0x5b, 0x09, 0xc8, 0x82,
+	0x82, 0xca, 0x67, 0x22, 0xfc, 0x4d, 0x56, 0xfd, 0x9a, 0x90, 0x1c, 0x23, 0xd6, 0x39, 0x09, 0x59,
+	0x80, 0x89, 0xa6, 0xf6, 0x4c, 0x6d, 0x39, 0x86, 0xed, 0x18, 0xde, 0x3e, 0xe6, 0xef, 0xbc, 0x92,
+	0x6b, 0x6a, 0xcf, 0x1e, 0x08, 0x10, 0xf9, 0x3f, 0x98, 0x73, 0x0d, 0xab, 0x61, 0x52, 0x95, 0x4d,
+	0x0d, 0x4f, 0xa8, 0xea, 0x9b, 0x01, 0x53, 0x76, 0x46, 0x99, 0xe1, 0xbb, 0x6b, 0xb8, 0xe9, 0xcb,
+	0x5f, 0xfe, 0x28, 0x06, 0x69, 0x21, 0x3e, 0x39, 0x07, 0x80, 0x6d, 0xa5, 0x8a, 0x1a, 0x11, 0xaa,
+	0x42, 0x08, 0x9b, 0xab, 0x59, 0x3a, 0x8f, 0xce, 0x5e, 0x5c, 0x67, 0x13, 0x34, 0x3c, 0x74, 0x2d,
+	0x44, 0x0b, 0x11, 0x0b, 0xa8, 0xec, 0xdd, 0x97, 0x22, 0xa5, 0x68, 0x35, 0x5a, 0x8a, 0x12, 0x03,
+	0x0b, 0x0a, 0xa3, 0x0d, 0x15, 0xa3, 0xd5, 0x68, 0x31, 0x4a, 0x8e, 0x4c, 0x6b, 0xed, 0xb3, 0x14,
+	0xbc, 0x63, 0x98, 0x1e, 0x75, 0xc4, 0xd0, 0x28, 0x56, 0xeb, 0x09, 0x88, 0x6d, 0xef, 0xcb, 0x4d,
+	0x28, 0x45, 0x8c, 0xfa, 0x25, 0xcf, 0x42, 0x9f, 0x4a, 0x70, 0xba, 0x07, 0xbf, 0x63, 0x8d, 0x0c,
+	0xb7, 0xa1, 0x18, 0x8d, 0x56, 0xdf, 0x6d, 0x07, 0x87, 0xab, 0x52, 0x88, 0x04, 0xaa, 0x2b, 0x3f,
+	0x81, 0xb3, 0x11, 0x84, 0x93, 0x4f, 0x4d, 0xa3, 0xa5, 0xb6, 0xbf, 0xa7, 0xe1, 0x5c, 0x1f, 0xc6,
+	0xc7, 0xd2, 0xc7, 0xe6, 0x91, 0xec, 0x15, 0x1f, 0x21, 0x7b, 0x75, 0xe7, 0xad, 0x45, 0x48, 0x47,
+	0xbb, 0x78, 0x38, 0x3c, 0xa8, 0xa4, 0x44, 0xfb, 0x9e, 0xb2, 0x78, 0xdf, 0xbe, 0x15, 0xcc, 0xa3,
+	0x49, 0xd4, 0xf8, 0xeb, 0x03, 0x59, 0x1c, 0x19, 0xc3, 0xf8, 0x38, 0xac, 0x35, 0xfc, 0x81, 0x95,
+	0x7c, 0x0b, 0xf2, 0x6e, 0x7b, 0x9b, 0x61, 0xb5, 0xf0, 0x81, 0x13, 0x7b, 0xf9, 0xdc, 0xca, 0xea,
+	0x78, 0xc7, 0x3e, 0x0c, 0x1d, 0xa1, 0x44, 0x0f, 0x24, 0x25, 0x48, 0x3f, 0xd5, 0x0c, 0x16, 0x8b,
+	0x98, 0x17, 0xf2, 0x8a, 0xbf, 0xc4, 0xa2, 0x6e, 0xa9, 0x3b, 0xa6, 0xd1, 0xd8, 0xf5, 0xc4, 0xe0,
+	0x94, 0x31, 0xac, 0xdb, 0xb8, 0x66, 0x0e, 0xad, 0xd5, 0xf7, 0xd4, 0x16, 0xc5, 0x54, 0x81, 0x3d,
+	0x5a, 0x5e, 0x01, 0xad, 0xbe, 0xf7, 0x80, 0x43, 0xc8, 0x16, 0xcc, 0x89, 0xbc, 0x13, 0xe6, 0xc7,
+	0xb4, 0x07, 0xa8, 0xbd, 0xd2, 0xe1, 0x41, 0x65, 0x86, 0x27, 0x9f, 0xb0, 0x78, 0xb5, 0x4d, 0x65,
+	0x46, 0x3b, 0x0a, 0xc5, 0x7e, 0xde, 0x3f, 0x0f, 0x93, 0xe6, 0xc8, 0xfd, 0xbc, 0x38, 0x8d, 0x11,
+	0x95, 0xff, 0x25, 0x41, 0xc6, 0xd7, 0xf1, 0xb0, 0xf4, 0x76, 0x06, 0xb2, 0xa6, 0xd6, 0x08, 0x3d,
+	0xd3, 0xc6, 0x95, 0x8c, 0xa9, 0x35, 0xf8, 0xd8, 0xbb, 0x00, 0x13, 0x6c, 0x53, 0xd4, 0x7f, 0xfe,
+	0x1e, 0x16, 0x57, 0x72, 0xa6, 0xd6, 0x10, 0xbd, 0x81, 0x4b, 0xee, 0xc1, 0x94, 0x98, 0x8c, 0xdb,
+	0x96, 0xf0, 0x24, 0x7d, 0xe4, 0x36, 0x7e, 0x92, 0x93, 0xbe, 0x1b, 0x50, 0x92, 0xaf, 0x03, 0xe3,
+	0xae, 0x62, 0x73, 0x38, 0xc6, 0x9b, 0x59, 0xda, 0xd4, 0x1a, 0xec, 0xf0, 0xf2, 0x67, 0x12, 0x4c,
+	0x84, 0x55, 0x4a, 0xbe, 0x0a, 0xc5, 0x6e, 0xcb, 0xf0, 0xa6, 0x95, 0x1c, 0x1e, 0x54, 0x0a, 0x5d,
+	0x36, 0x29, 0xb8, 0x51, 0x6b, 0xf8, 0xf5, 0x2c, 0x16, 0xaa, 0x67, 0x11, 0x7f, 0x89, 0x77, 0xf9,
+	0xcb, 0x1c, 0xa4, 0xb8, 0x21, 0x50, 0x05, 0x19, 0x45, 0xac, 0xe4, 0x5f, 0xc4, 0x60, 0x3a, 0xe2,
+	0xbc, 0xf7, 0x79, 0x1f, 0x3c, 0x5e, 0x37, 0x1d, 0x35, 0x65, 0xac, 0xdb, 0x94, 0x9d, 0x66, 0x3b,
+	0x1e, 0x69, 0xb6, 0x15, 0x20, 0x3e, 0x93, 0xd0, 0x68, 0x9b, 0x18, 0xa3, 0x1c, 0x4f, 0x0a, 0xfa,
+	0x8d, 0x60, 0xc2, 0x7d, 0x1b, 0xa6, 0x82, 0x33, 0x83, 0x49, 0x37, 0x39, 0xa2, 0xd9, 0x8b, 0xfe,
+	0x71, 0x62, 0xe0, 0x95, 0x9f, 0xc3, 0x42, 0x0f, 0xed, 0xb8, 0xb7, 0x9e, 0xb5, 0x6c, 0xc7, 0xfb,
+	0xb2, 0xf3, 0xf0, 0xa7, 0x12, 0xc8, 0x83, 0xb8, 0x1f, 0x2b, 0x19, 0xdf, 0x84, 0x34, 0xd7, 0xbe,
+	0x5f, 0x94, 0x16, 0x07, 0xe6, 0x32, 0xce, 0x52, 0xf1, 0x69, 0xe4, 0xdf, 0x4a, 0xbd, 0x35, 0x52,
+	0x6b, 0x9e, 0x4c, 0x23, 0x27, 0x13, 0x6b, 0x78, 0x7d, 0x57, 0x40, 0x1e, 0x24, 0xf6, 0xb1, 0x7a,
+	0x5b, 0xbb, 0xab, 0x67, 0x3f, 0xe9, 0x14, 0x37, 0x76, 0x67, 0x7e, 0xa2, 0x81, 0xed, 0xf3, 0x14,
+	0xa4, 0xfd, 0x37, 0xbb, 0xae, 0xe7, 0x0a, 0xe9, 0xc8, 0x73, 0xc5, 0x3a, 0xb0, 0xe9, 0xa9, 0x45,
+	0x1d, 0xcf, 0x10, 0xd9, 0x38, 0xb7, 0x22, 0xf7, 0x19, 0xcb, 0x96, 0x1f, 0x04, 0x98, 0x4a, 0x88,
+	0x8a, 0xcd, 0x75, 0xfe, 0xbb, 0x7f, 0x7c, 0xf0, 0xa3, 0x85, 0x8f, 0xc7, 0xb4, 0xc4, 0x9e, 0x04,
+	0x30, 0x25, 0x4c, 0x28, 0xf8, 0xbb, 0xfc, 0xef, 0x04, 0x40, 0x87, 0x03, 0xab, 0x04, 0xec, 0x3d,
+	0x83, 0xc5, 0x3b, 0x36, 0xc1, 0x5c, 0xf6, 0x9c, 0x80, 0x61, 0x0f, 0x7c, 0x15, 0x26, 0x7d, 0x14,
+	0x6a, 0xd5, 0x6d, 0x2c, 0x98, 0x5c, 0xef, 0x45, 0x01, 0xbf, 0x25, 0xc0, 0xf8, 0x44, 0x42, 0x4d,
+	0xe3, 0x09, 0x75, 0xf6, 0xd5, 0xa6, 0xad, 0xf3, 0x09, 0x34, 0xa9, 0x4c, 0xf8, 0xc0, 0x7b, 0xb6,
+	0x4e, 0xd9, 0xf0, 0x18, 0x34, 0xfe, 0x09, 0xdc, 0x0f, 0xd6, 0xe4, 0x0d, 0xd6, 0xeb, 0x38, 0x0e,
+	0x35, 0x35, 0x3f, 0xa9, 0xe3, 0xdb, 0x0f, 0x7f, 0xed, 0xd8, 0xe8, 0xec, 0xb0, 0x27, 0xc7, 0x10,
+	0x62, 0x4d, 0x27, 0xa7, 0x21, 0xc3, 0x5e, 0xd5, 0xf6, 0x55, 0xcf, 0x16, 0x0d, 0x71, 0x1a, 0xd7,
+	0x8f, 0x6c, 0xf6, 0x09, 0x8c, 0x3e, 0x6b, 0x19, 0xbc, 0xb8, 0x60, 0x9b, 0x90, 0x55, 0x42, 0x10,
+	0x96, 0xac, 0x45, 0x25, 0x64, 0x0c, 0xf1, 0x55, 0x87, 0x27, 0x6b, 0x61, 0x11, 0x96, 0xac, 0x05,
+	0x42, 0x4d, 0x27, 0xeb, 0x90, 0x0d, 0xbe, 0xa8, 0x96, 0xb2, 0x63, 0x24, 0xdb, 0x0e, 0x19, 0x33,
+	0x0c, 0x6a, 0x9b, 0x7f, 0x1b, 0xc1, 0xdf, 0xac, 0x41, 0x6b, 0xbb, 0x7c, 0x00, 0xcf, 0xa1, 0x08,
+	0xd8, 0xa0, 0xbd, 0xeb, 0xe2, 0xf0, 0x9d, 0x62, 0x5b, 0x35, 0x9d, 0xcc, 0x43, 0x4a, 0x6b, 0xb5,
+	0x18, 0xce, 0x04, 0xe2, 0x64, 0x0f, 0x0f, 0x2a, 0xc9, 0xb5, 0x56, 0xab, 0xb6, 0xa9, 0x24, 0xb5,
+	0x56, 0xab, 0xa6, 0x93, 0x02, 0xc4, 0x3c, 0xbb, 0x94, 0xc7, 0x83, 0x63, 0x9e, 0x4d, 0x2e, 0x41,
+	0x06, 0x9b, 0x46, 0x46, 0x53, 0x40, 0x9a, 0xdc, 0xe1, 0x41, 0x25, 0x8d, 0x01, 0x50, 0xdb, 0x54,
+	0xd2, 0xb8, 0x59, 0xd3, 0xd9, 0x83, 0x39, 0xc7, 0x0b, 0x06, 0xfb, 0x22, 0xd6, 0xc0, 0x3c, 0x42,
+	0x1f, 0x0a, 0x20, 0xb9, 0x09, 0x53, 0xbe, 0x9a, 0xd5, 0xe0, 0xdc, 0x49, 0x3c, 0x17, 0x0b, 0xaf,
+	0xc2, 0x75, 0xee, 0x1f, 0x5f, 0x70, 0xc2, 0x6b, 0x9d, 0xd5, 0xcb, 0x04, 0xeb, 0x39, 0xfb, 0x3e,
+	0x4f, 0x97, 0x20, 0xad, 0xe9, 0xba, 0x43, 0x5d, 0x57, 0xf8, 0x98, 0xbf, 0x64, 0x3a, 0xfb, 0xc0,
+	0xb6, 0xb8, 0x4b, 0x65, 0x15, 0xfc, 0xcd, 0x42, 0x53, 0x33, 0x3b, 0x55, 0x99, 0x2f, 0x98, 0x83,
+	0xe9, 0x8e, 0x66, 0x58, 0xcc, 0x51, 0x93, 0xb8, 0x11, 0xac, 0x59, 0x2d, 0xe5, 0x19, 0x01, 0x9d,
+	0x24, 0xa3, 0x88, 0x15, 0xb9, 0x0b, 0x45, 0x53, 0x73, 0x3d, 0xd5, 0xa5, 0xd4, 0x52, 0xf9, 0x99,
+	0x23, 0x3f, 0xe0, 0x33, 0xc2, 0x87, 0x94, 0x5a, 0x6b, 0xc8, 0xfd, 0x1c, 0x80, 0x6e, 0xb8, 0x7b,
+	0xaa, 0x67, 0x7b, 0x9a, 0x89, 0xde, 0x94, 0x50, 0xb2, 0x0c, 0xf2, 0x88, 0x01, 0x58, 0x9b, 0x81,
+	0xdb, 0x3b, 0x0e, 0xa5, 0xe8, 0x3e, 0x09, 0x25, 0xc3, 0x00, 0xb7, 0x1d, 0x4a, 0xe5, 0x15, 0x28,
+	0x32, 0xed, 0x8c, 0xf5, 0x9d, 0xd8, 0x84, 0xc9, 0x0e, 0xcd, 0xb1, 0x6a, 0xda, 0x12, 0x24, 0x59,
+	0xff, 0xef, 0x97, 0x8e, 0x99, 0xee, 0xc4, 0xc4, 0x8e, 0x57, 0x38, 0x8a, 0xfc, 0x83, 0x18, 0xc0,
+	0x86, 0x6d, 0x59, 0x14, 0x3f, 0xe5, 0x85, 0xa7, 0x0a, 0xa9, 0xef, 0x54, 0xc1, 0x6d, 0x1d, 0x3b,
+	0x62, 0x6b, 0x4c, 0x04, 0xb6, 0x67, 0xd7, 0x6d, 0x53, 0x58, 0x35, 0x58, 0xf3, 0xef, 0x37, 0x4d,
+	0xdb, 0xa3, 0xaa, 0xef, 0x0e, 0x09, 0xfe, 0x1d, 0x91, 0x43, 0xd7, 0x3a, 0x4e, 0xc1, 0x22, 0x43,
+	0xbc, 0x10, 0xe3, 0xef, 0x68, 0xe5, 0x48, 0x75, 0x57, 0x8e, 0x3b, 0x98, 0xf0, 0x98, 0xfc, 0xbc,
+	0xb7, 0x49, 0x8f, 0x11, 0xc1, 0xb9, 0x80, 0x72, 0xcd, 0x93, 0x6f, 0xc2, 0x6c, 0x47, 0x11, 0x61,
+	0x8b, 0x8d, 0xd6, 0x9d, 0x58, 0x30, 0xd7, 0x4d, 0x3e, 0xc4, 0x78, 0x5f, 0x03, 0x9f, 0x3f, 0x8e,
+	0x52, 0x31, 0x34, 0x56, 0xb9, 0x47, 0x9d, 0x17, 0x28, 0x4a, 0x18, 0x5d, 0xfe, 0x87, 0xd4, 0x35,
+	0x95, 0x8a, 0x16, 0xf9, 0x24, 0xf3, 0xb0, 0xdf, 0x46, 0xc7, 0x43, 0x6d, 0xf4, 0x69, 0xc8, 0x68,
+	0x6d, 0xcf, 0x56, 0xb5, 0xfa, 0x9e, 0x88, 0xca, 0x34, 0x5b, 0xaf, 0xd5, 0xf7, 0xc8, 0x3c, 0x4c,
+	0x08, 0xc5, 0x6c, 0x9b, 0x76, 0x7d, 0x4f, 0xc4, 0x26, 0xa0, 0x5a, 0xd6, 0x19, 0xc4, 0x7f, 0x17,
+	0x0a, 0xe6, 0x92, 0x14, 0xba, 0x29, 0x7b, 0x17, 0x0a, 0xe6, 0x92, 0xd1, 0xb4, 0xfb, 0x59, 0x0c,
+	0xce, 0xf7, 0xbb, 0xad, 0x50, 0xf3, 0x48, 0xae, 0xdb, 0x63, 0xca, 0x88, 0x8d, 0x3c, 0x65, 0xcc,
+	0x42, 0xca, 0xa5, 0x8f, 0x55, 0xcb, 0x46, 0x05, 0x25, 0x94, 0xa4, 0x4b, 0x1f, 0x6f, 0xd9, 0xec,
+	0xdb, 0x77, 0xa7, 0xdb, 0xe7, 0xda, 0xe6, 0xbe, 0x5d, 0x08, 0x5a, 0x7e, 0xae, 0xf2, 0xe8, 0x58,
+	0x90, 0xec, 0x1e, 0x0b, 0x42, 0x0f, 0xbd, 0xa9, 0x11, 0x5f, 0xcc, 0x7f, 0x23, 0xc1, 0x94, 0x00,
+	0xae, 0xd5, 0xf7, 0x7c, 0xc3, 0xff, 0xd7, 0x34, 0x31, 0x9a, 0x2d, 0xaf, 0x03, 0x09, 0xcb, 0x3c,
+	0x38, 0x4a, 0xe4, 0xcf, 0xa5, 0x00, 0x7d, 0x4b, 0xfb, 0x9f, 0xb9, 0xe3, 0x0d, 0x98, 0x8e, 0x08,
+	0x3d, 0xf8, 0x92, 0x2b, 0x3f, 0x9a, 0x05, 0xb8, 0x25, 0x0c, 0x7d, 0xef, 0x1d, 0xf2, 0x0c, 0x8a,
+	0x7c, 0x82, 0xeb, 0xf8, 0xce, 0xa5, 0x23, 0x49, 0xbc, 0xe7, 0x3f, 0xbe, 0xca, 0x97, 0x87, 0xe2,
+	0x71, 0x51, 0xe4, 0x99, 0xef, 0xfe, 0xe9, 0x6f, 0x3f, 0x89, 0x15, 0xca, 0x13, 0xd5, 0xe7, 0x81,
+	0xdf, 0xbe, 0x60, 0x9c, 0x79, 0x17, 0x3d, 0x0a, 0xe7, 0x48, 0x83, 0x5f, 0xbe, 0x3c, 0x14, 0x2f,
+	0xca, 0x79, 0x29, 0xca, 0xd9, 0x85, 0x02, 0xcb, 0x9a, 0x01, 0x91, 0x4b, 0x2e, 0xf4, 0x3d, 0x30,
+	0x94, 0x9d, 0xcb, 0x17, 0x87, 0x60, 0x45, 0x99, 0x92, 0x89, 0x6a, 0x27, 0x4c, 0x5d, 0xf2, 0xb1,
+	0x04, 0x39, 0xae, 0x17, 0xfe, 0x1f, 0x24, 0xb9, 0xe7, 0xdf, 0x13, 0xa2, 0x1a, 0x5e, 0x1c, 0x88,
+	0x23, 0xd8, 0xbd, 0x86, 0xec, 0xaa, 0xe5, 0x4b, 0xd5, 0xe7, 0x18, 0xe0, 0xcb, 0x9d, 0x9b, 0x56,
+	0x11, 0xe0, 0x86, 0x37, 0x5e, 0xac, 0x8a, 0x3f, 0x4c, 0x58, 0x00, 0x4c, 0x6a, 0x3c, 0xd1, 0x25,
+	0xf3, 0x3d, 0x39, 0x85, 0x2f, 0xbf, 0x30, 0x00, 0x43, 0x48, 0x72, 0x06, 0x25, 0x99, 0x25, 0xd3,
+	0xd5, 0xe7, 0x47, 0x64, 0x20, 0x1f, 0x4a, 0x90, 0xf7, 0x9f, 0xe7, 0xb8, 0x06, 0x2e, 0x0c, 0xf9,
+	0x83, 0x46, 0x1f, 0xa5, 0xf7, 0xfc, 0x1b, 0x87, 0x2c, 0x23, 0xef, 0xb3, 0xa4, 0xdc, 0x83, 0x37,
+	0x07, 0xbd, 0x20, 0x1f, 0x40, 0x8e, 0xfb, 0xc7, 0x20, 0x0b, 0x44, 0x3d, 0x6d, 0x71, 0x20, 0x4e,
+	0x94, 0xf7, 0xd2, 0x20, 0xde, 0xdf, 0x91, 0x20, 0x2d, 0x3e, 0x58, 0x91, 0xde, 0x87, 0x46, 0xbf,
+	0xde, 0x95, 0x2f, 0x0c, 0x46, 0x12, 0xac, 0xaf, 0x21, 0xeb, 0x8b, 0xf2, 0x00, 0xd6, 0xab, 0xc1,
+	0xb7, 0xba, 0x8f, 0x24, 0xc8, 0xf1, 0x4f, 0xb7, 0x83, 0x14, 0x10, 0xf9, 0xe0, 0x5f, 0x5e, 0x1c,
+	0x88, 0x23, 0xa4, 0xb8, 0x8e, 0x52, 0x5c, 0x92, 0x17, 0xfa, 0x4b, 0x51, 0x75, 0x91, 0x64, 0x55,
+	0x5a, 0x7a, 0x59, 0x22, 0xbf, 0x97, 0x60, 0x9a, 0x7b, 0x71, 0xf4, 0x03, 0xd5, 0xd2, 0xc0, 0x97,
+	0x87, 0x68, 0x6c, 0x5c, 0x1b, 0x09, 0x57, 0x08, 0x78, 0x0f, 0x05, 0xbc, 0x53, 0x7e, 0xad, 0xfa,
+	0x3c, 0xfa, 0x1e, 0x1e, 0x0e, 0x96, 0x7a, 0xc3, 0xed, 0xb9, 0xfd, 0x62, 0xb5, 0xeb, 0x11, 0x9d,
+	0x7c, 0x4f, 0x02, 0xc2, 0x3c, 0x3f, 0xc2, 0xd2, 0x25, 0x57, 0x06, 0x8a, 0x14, 0x0e, 0xa6, 0xab,
+	0x23, 0x60, 0x0a, 0xd1, 0x4b, 0x28, 0x3a, 0x21, 0x93, 0x11, 0xdd, 0xd6, 0x1b, 0x2e, 0xf9, 0xa9,
+	0x04, 0xb3, 0x7e, 0x1c, 0x44, 0xf5, 0x78, 0x7d, 0xc4, 0x47, 0x72, 0x2e, 0xcc, 0x8d, 0xb1, 0x9e,
+	0xd4, 0xe5, 0x0a, 0x0a, 0x74, 0x9a, 0x9c, 0xea, 0x16, 0xc8, 0x77, 0xf5, 0x5f, 0x49, 0x50, 0xe6,
+	0x0f, 0x65, 0xbd, 0xde, 0x7d, 0xc8, 0x2b, 0x23, 0x3c, 0x2f, 0x45, 0x9f, 0xf9, 0xca, 0x2b, 0xe3,
+	0x90, 0x08, 0x31, 0x2f, 0xa3, 0x98, 0x0b, 0xa4, 0xd2, 0x47, 0xcc, 0xaa, 0xff, 0x80, 0xf5, 0x6b,
+	0x09, 0xca, 0xfc, 0x31, 0xea, 0xf8, 0xe2, 0xd6, 0x9a, 0x63, 0x8b, 0x1b, 0x7d, 0xff, 0x92, 0x97,
+	0x50, 0xdc, 0x0b, 0xe5, 0x61, 0xe2, 0xae, 0x4a, 0x4b, 0xe4, 0xc7, 0x12, 0x4c, 0xf3, 0x14, 0x34,
+	0x4e, 0xf8, 0x44, 0x13, 0xdb, 0xb5, 0x91, 0x70, 0xa3, 0x26, 0x5f, 0xea, 0x6b, 0xf2, 0x9f, 0x49,
+	0x90, 0x0d, 0xda, 0x64, 0x32, 0xd8, 0xa1, 0xba, 0x87, 0x87, 0xf2, 0xf2, 0xa8, 0xe8, 0x42, 0x9a,
+	0xab, 0x28, 0xcd, 0x22, 0x59, 0xe8, 0xa7, 0x2a, 0xd7, 0x27, 0x79, 0x59, 0x22, 0xdf, 0x84, 0x38,
+	0x9b, 0x1f, 0x16, 0xfa, 0xf4, 0xb7, 0x9d, 0x56, 0xb6, 0x2c, 0x0f, 0x42, 0x11, 0xac, 0x27, 0x91,
+	0x35, 0xc8, 0xc9, 0x2a, 0x1b, 0x52, 0x98, 0x2d, 0xb6, 0x21, 0xc1, 0xda, 0x2e, 0xd2, 0x8f, 0x3a,
+	0xd4, 0x48, 0x96, 0x17, 0x07, 0xe2, 0x08, 0x16, 0x53, 0xc8, 0x22, 0x27, 0xa7, 0x58, 0xf7, 0xc0,
+	0x79, 0xa8, 0x90, 0xc5, 0x7e, 0xc5, 0xd6, 0xa9, 0x4b, 0x2a, 0xbd, 0x46, 0xec, 0x70, 0x6e, 0x99,
+	0xef, 0x8f, 0x20, 0x58, 0x14, 0x91, 0x45, 0x96, 0xa4, 0xab, 0xf8, 0x4f, 0x39, 0x97, 0x3c, 0x85,
+	0xa2, 0x48, 0x67, 0xfe, 0xcc, 0x47, 0x2e, 0xf6, 0x1f, 0x0e, 0xc3, 0xcc, 0x2e, 0x0d, 0x43, 0x13,
+	0x2c, 0x67, 0x91, 0x65, 0x91, 0xe4, 0xab, 0x6a, 0x68, 0xb2, 0x5c, 0x9f, 0xfd, 0xe2, 0xf0, 0xbc,
+	0xf4, 0xc7, 0xc3, 0xf3, 0xd2, 0x5f, 0x0e, 0xcf, 0x4b, 0x9f, 0xfc, 0xf5, 0xfc, 0x4b, 0xdf, 0x88,
+	0xd3, 0xe6, 0xe3, 0xed, 0x14, 0x8e, 0xd2, 0xaf, 0xfe, 0x67, 0x00, 0xda, 0x4b, 0x02, 0xf6, 0x1d,
+	0x31, 0x00, 0x00,
 }
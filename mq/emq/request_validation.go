@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 
+	"eventter.io/mq/filter"
 	"github.com/pkg/errors"
 )
 
@@ -25,7 +26,9 @@ const (
 	stringLengthErrorFormat = "%s must be at most %d characters long"
 	listErrorFormat         = "%s %s is not valid"
 	negativeErrorFormat     = "%s must not be negative"
+	maxErrorFormat          = "%s must be at most %d"
 	nameMaxLength           = 64
+	maxPriority             = 255
 )
 
 type RequestValidationError struct {
@@ -115,6 +118,31 @@ func (r *TopicCreateRequest) Validate() error {
 		errs = append(errs, errors.Errorf(negativeErrorFormat, "retention"))
 	}
 
+	for i, header := range r.Topic.IndexedHeaders {
+		if header == "" {
+			errs = append(errs, errors.Wrapf(errors.Errorf(blankErrorFormat, "header name"), "indexed header %d", i))
+		}
+	}
+
+	if r.Topic.PartitionKey != "" {
+		if !isMessageKey(r.Topic.PartitionKey) {
+			errs = append(errs, errors.Errorf("partition key must be either %q, or header name prefixed by %q", PartitionKeyRoutingKey, PartitionKeyHeaderPrefix))
+		}
+		if r.Topic.Shards == 0 {
+			errs = append(errs, errors.New("partition key requires shards to be set"))
+		}
+	}
+
+	if r.Topic.DeduplicationWindow < 0 {
+		errs = append(errs, errors.Errorf(negativeErrorFormat, "deduplication window"))
+	} else if r.Topic.DeduplicationWindow > 0 && r.Topic.Shards == 0 {
+		errs = append(errs, errors.New("deduplication window requires shards to be set"))
+	}
+
+	if r.Topic.CompactionKey != "" && !isMessageKey(r.Topic.CompactionKey) {
+		errs = append(errs, errors.Errorf("compaction key must be either %q, or header name prefixed by %q", PartitionKeyRoutingKey, PartitionKeyHeaderPrefix))
+	}
+
 	if len(errs) > 0 {
 		return &RequestValidationError{errs}
 	}
@@ -152,6 +180,36 @@ func (r *TopicListRequest) Validate() error {
 	return nil
 }
 
+func (r *TopicDescribeRequest) Validate() error {
+	var errs []error
+
+	if r.Namespace == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "namespace"))
+	} else if !nameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "namespace"))
+	} else if reservedNameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "namespace"))
+	} else if len(r.Namespace) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "namespace", nameMaxLength))
+	}
+
+	if r.Name == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "topic name"))
+	} else if !nameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "topic name"))
+	} else if reservedNameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "topic name"))
+	} else if len(r.Name) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "topic name", nameMaxLength))
+	}
+
+	if len(errs) > 0 {
+		return &RequestValidationError{errs}
+	}
+
+	return nil
+}
+
 func (r *TopicDeleteRequest) Validate() error {
 	var errs []error
 
@@ -205,12 +263,21 @@ func (r *ConsumerGroupCreateRequest) Validate() error {
 		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "consumer group name", nameMaxLength))
 	}
 
+	if r.ConsumerGroup.MaxPriority > maxPriority {
+		errs = append(errs, errors.Errorf(maxErrorFormat, "max priority", maxPriority))
+	}
+
 	for i, clientBinding := range r.ConsumerGroup.Bindings {
 		if clientBinding.ExchangeType == "" {
 			errs = append(errs, errors.Wrapf(errors.Errorf(blankErrorFormat, "exchange type"), "binding %d", i))
 		} else if !validExchangeTypes[clientBinding.ExchangeType] {
 			errs = append(errs, errors.Wrapf(errors.Errorf(listErrorFormat, "exchange type", clientBinding.ExchangeType), "binding %d", i))
 		}
+		if clientBinding.Filter != "" {
+			if _, err := filter.Parse(clientBinding.Filter); err != nil {
+				errs = append(errs, errors.Wrapf(errors.Wrap(err, "filter is not valid"), "binding %d", i))
+			}
+		}
 	}
 
 	if len(errs) > 0 {
@@ -250,6 +317,36 @@ func (r *ConsumerGroupListRequest) Validate() error {
 	return nil
 }
 
+func (r *ConsumerGroupDescribeRequest) Validate() error {
+	var errs []error
+
+	if r.Namespace == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "namespace"))
+	} else if !nameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "namespace"))
+	} else if reservedNameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "namespace"))
+	} else if len(r.Namespace) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "namespace", nameMaxLength))
+	}
+
+	if r.Name == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "consumer group name"))
+	} else if !nameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "consumer group name"))
+	} else if reservedNameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "consumer group name"))
+	} else if len(r.Name) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "consumer group name", nameMaxLength))
+	}
+
+	if len(errs) > 0 {
+		return &RequestValidationError{errs}
+	}
+
+	return nil
+}
+
 func (r *ConsumerGroupDeleteRequest) Validate() error {
 	var errs []error
 
@@ -303,6 +400,10 @@ func (r *TopicPublishRequest) Validate() error {
 		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "topic name", nameMaxLength))
 	}
 
+	if r.ProducerID != "" && r.Sequence == 0 {
+		errs = append(errs, errors.New("sequence must be set with producer ID"))
+	}
+
 	if len(errs) > 0 {
 		return &RequestValidationError{errs}
 	}
@@ -339,3 +440,120 @@ func (r *ConsumerGroupSubscribeRequest) Validate() error {
 
 	return nil
 }
+
+func (r *ConsumerGroupOffsetsExportRequest) Validate() error {
+	var errs []error
+
+	if r.Namespace == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "namespace"))
+	} else if !nameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "namespace"))
+	} else if reservedNameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "namespace"))
+	} else if len(r.Namespace) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "namespace", nameMaxLength))
+	}
+
+	if r.Name == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "consumer group name"))
+	} else if !nameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "consumer group name"))
+	} else if reservedNameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "consumer group name"))
+	} else if len(r.Name) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "consumer group name", nameMaxLength))
+	}
+
+	if len(errs) > 0 {
+		return &RequestValidationError{errs}
+	}
+
+	return nil
+}
+
+func (r *ConsumerGroupOffsetsImportRequest) Validate() error {
+	var errs []error
+
+	if r.Namespace == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "namespace"))
+	} else if !nameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "namespace"))
+	} else if reservedNameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "namespace"))
+	} else if len(r.Namespace) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "namespace", nameMaxLength))
+	}
+
+	if r.Name == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "consumer group name"))
+	} else if !nameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "consumer group name"))
+	} else if reservedNameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "consumer group name"))
+	} else if len(r.Name) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "consumer group name", nameMaxLength))
+	}
+
+	segmentIDs := make(map[uint64]bool)
+	for i, offset := range r.Offsets {
+		if offset.SegmentID == 0 {
+			errs = append(errs, errors.Wrapf(errors.Errorf(blankErrorFormat, "segment ID"), "offset %d", i))
+		} else if segmentIDs[offset.SegmentID] {
+			errs = append(errs, errors.Wrapf(errors.Errorf("segment %d listed multiple times", offset.SegmentID), "offset %d", i))
+		}
+		segmentIDs[offset.SegmentID] = true
+
+		if offset.Offset < 0 {
+			errs = append(errs, errors.Wrapf(errors.Errorf(negativeErrorFormat, "offset"), "offset %d", i))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &RequestValidationError{errs}
+	}
+
+	return nil
+}
+
+func (r *TopicSearchRequest) Validate() error {
+	var errs []error
+
+	if r.Namespace == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "namespace"))
+	} else if !nameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "namespace"))
+	} else if reservedNameRegex.MatchString(r.Namespace) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "namespace"))
+	} else if len(r.Namespace) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "namespace", nameMaxLength))
+	}
+
+	if r.Name == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "topic name"))
+	} else if !nameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(nameInvalidErrorFormat, "topic name"))
+	} else if reservedNameRegex.MatchString(r.Name) {
+		errs = append(errs, errors.Errorf(reservedNameErrorFormat, "topic name"))
+	} else if len(r.Name) > nameMaxLength {
+		errs = append(errs, errors.Errorf(stringLengthErrorFormat, "topic name", nameMaxLength))
+	}
+
+	if r.Since != nil && r.Until != nil && r.Until.Before(*r.Since) {
+		errs = append(errs, errors.New("until must not be before since"))
+	}
+
+	if r.DataJSONValue != nil && r.DataJSONPath == "" {
+		errs = append(errs, errors.Errorf(blankErrorFormat, "data JSON path"))
+	}
+
+	if len(errs) > 0 {
+		return &RequestValidationError{errs}
+	}
+
+	return nil
+}
+
+// Returns true if key is either routing key, or header name prefixed by `headers.`.
+func isMessageKey(key string) bool {
+	return key == PartitionKeyRoutingKey || (strings.HasPrefix(key, PartitionKeyHeaderPrefix) && key != PartitionKeyHeaderPrefix)
+}
@@ -0,0 +1,254 @@
+package filter
+
+import (
+	"regexp"
+)
+
+// Evaluates to string, float64, bool or nil (unknown).
+type node interface {
+	eval(env Env) interface{}
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(env Env) interface{} {
+	return n.value
+}
+
+type identifierNode struct {
+	name string
+}
+
+func (n *identifierNode) eval(env Env) interface{} {
+	value, ok := env.Lookup(n.name)
+	if !ok {
+		return nil
+	}
+	return normalize(value)
+}
+
+type notNode struct {
+	x node
+}
+
+func (n *notNode) eval(env Env) interface{} {
+	x, ok := n.x.eval(env).(bool)
+	if !ok {
+		return nil
+	}
+	return !x
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n *andNode) eval(env Env) interface{} {
+	left, leftOk := n.left.eval(env).(bool)
+	if leftOk && !left {
+		return false
+	}
+	right, rightOk := n.right.eval(env).(bool)
+	if rightOk && !right {
+		return false
+	}
+	if leftOk && rightOk {
+		return true
+	}
+	return nil
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n *orNode) eval(env Env) interface{} {
+	left, leftOk := n.left.eval(env).(bool)
+	if leftOk && left {
+		return true
+	}
+	right, rightOk := n.right.eval(env).(bool)
+	if rightOk && right {
+		return true
+	}
+	if leftOk && rightOk {
+		return false
+	}
+	return nil
+}
+
+type comparisonNode struct {
+	op          string
+	left, right node
+}
+
+func (n *comparisonNode) eval(env Env) interface{} {
+	left, right := n.left.eval(env), n.right.eval(env)
+	switch n.op {
+	case "=", "<>":
+		eq, ok := equal(left, right)
+		if !ok {
+			return nil
+		}
+		return eq == (n.op == "=")
+	}
+	c, ok := compare(left, right)
+	if !ok {
+		return nil
+	}
+	switch n.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	default:
+		panic("unhandled operator " + n.op)
+	}
+}
+
+type inNode struct {
+	x      node
+	list   []node
+	negate bool
+}
+
+func (n *inNode) eval(env Env) interface{} {
+	x := n.x.eval(env)
+	if x == nil {
+		return nil
+	}
+	var unknown bool
+	for _, item := range n.list {
+		eq, ok := equal(x, item.eval(env))
+		if !ok {
+			unknown = true
+		} else if eq {
+			return !n.negate
+		}
+	}
+	if unknown {
+		return nil
+	}
+	return n.negate
+}
+
+type likeNode struct {
+	x       node
+	pattern *regexp.Regexp
+	negate  bool
+}
+
+func (n *likeNode) eval(env Env) interface{} {
+	x, ok := n.x.eval(env).(string)
+	if !ok {
+		return nil
+	}
+	return n.pattern.MatchString(x) != n.negate
+}
+
+type isNullNode struct {
+	x      node
+	negate bool
+}
+
+func (n *isNullNode) eval(env Env) interface{} {
+	return (n.x.eval(env) == nil) != n.negate
+}
+
+type betweenNode struct {
+	x, low, high node
+	negate       bool
+}
+
+func (n *betweenNode) eval(env Env) interface{} {
+	x := n.x.eval(env)
+	low, lowOk := compare(x, n.low.eval(env))
+	high, highOk := compare(x, n.high.eval(env))
+	if lowOk && low < 0 || highOk && high > 0 {
+		return n.negate
+	}
+	if lowOk && highOk {
+		return !n.negate
+	}
+	return nil
+}
+
+func equal(a, b interface{}) (bool, bool) {
+	if a, ok := a.(bool); ok {
+		b, ok := b.(bool)
+		return a == b, ok
+	}
+	c, ok := compare(a, b)
+	return c == 0, ok
+}
+
+// Returns -1, 0 or 1 if values are ordered, otherwise second return value is false.
+func compare(a, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case float64:
+		b, ok := b.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string, float64, bool:
+		return v
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	case int8:
+		return float64(v)
+	case int16:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint:
+		return float64(v)
+	case uint8:
+		return float64(v)
+	case uint16:
+		return float64(v)
+	case uint32:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return nil
+	}
+}
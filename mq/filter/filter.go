@@ -0,0 +1,273 @@
+// Package filter implements SQL-like filter expressions evaluated against message headers & properties, e.g.
+// `priority > 5 AND content_type = 'application/json' AND region IN ('eu', 'us')`.
+//
+// Supported are logical operators `AND`, `OR`, `NOT`, comparisons `=`, `<>` (`!=`), `<`, `<=`, `>`, `>=`, predicates
+// `[NOT] IN (...)`, `[NOT] LIKE '...'` (`%` matches any sequence of characters, `_` matches single character),
+// `[NOT] BETWEEN ... AND ...`, `IS [NOT] NULL` and parentheses. Literals are single-quoted strings (quote is escaped by
+// doubling it), numbers and `TRUE`, `FALSE` & `NULL`. Identifiers not matching `[a-zA-Z_][a-zA-Z0-9_.-]*` may be
+// double-quoted. Keywords are case-insensitive.
+//
+// Evaluation follows SQL three-valued logic - missing values and comparisons of values of different types are unknown,
+// expression matches only if it evaluates to true.
+package filter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Env resolves identifiers used in expression to values. Value must be string, bool, number (any integer type or
+// float64) or nil.
+type Env interface {
+	Lookup(name string) (interface{}, bool)
+}
+
+// Expr is compiled filter expression. It is safe for concurrent use.
+type Expr struct {
+	source string
+	root   node
+}
+
+// Parse compiles filter expression.
+func Parse(source string) (*Expr, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, errors.Errorf("unexpected %s at %d", t.describe(), t.pos)
+	}
+
+	return &Expr{source: source, root: root}, nil
+}
+
+func (e *Expr) String() string {
+	return e.source
+}
+
+// Matches returns true iff expression evaluates to true in given environment.
+func (e *Expr) Matches(env Env) bool {
+	return e.root.eval(env) == true
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) acceptKeyword(keyword string) bool {
+	if t := p.peek(); t.kind == tokenKeyword && t.text == keyword {
+		p.i++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	t := p.next()
+	if t.kind != kind || t.text != text {
+		return errors.Errorf("expected %q, got %s at %d", text, t.describe(), t.pos)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.acceptKeyword("NOT") {
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x}, nil
+	}
+	return p.parsePredicate()
+}
+
+func (p *parser) parsePredicate() (node, error) {
+	x, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	if t.kind == tokenOperator {
+		p.next()
+		y, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &comparisonNode{t.text, x, y}, nil
+	}
+
+	if p.acceptKeyword("IS") {
+		negate := p.acceptKeyword("NOT")
+		if !p.acceptKeyword("NULL") {
+			t := p.peek()
+			return nil, errors.Errorf("expected NULL, got %s at %d", t.describe(), t.pos)
+		}
+		return &isNullNode{x, negate}, nil
+	}
+
+	negate := p.acceptKeyword("NOT")
+
+	switch {
+	case p.acceptKeyword("IN"):
+		if err := p.expect(tokenLeftParen, "("); err != nil {
+			return nil, err
+		}
+		var list []node
+		for {
+			item, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+			if p.peek().kind != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return &inNode{x, list, negate}, nil
+
+	case p.acceptKeyword("LIKE"):
+		t := p.next()
+		if t.kind != tokenString {
+			return nil, errors.Errorf("expected string pattern, got %s at %d", t.describe(), t.pos)
+		}
+		return &likeNode{x, compileLike(t.text), negate}, nil
+
+	case p.acceptKeyword("BETWEEN"):
+		low, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptKeyword("AND") {
+			t := p.peek()
+			return nil, errors.Errorf("expected AND, got %s at %d", t.describe(), t.pos)
+		}
+		high, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &betweenNode{x, low, high, negate}, nil
+
+	case negate:
+		t := p.peek()
+		return nil, errors.Errorf("expected IN, LIKE or BETWEEN, got %s at %d", t.describe(), t.pos)
+	}
+
+	return x, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenIdentifier:
+		return &identifierNode{t.text}, nil
+	case tokenString:
+		return &literalNode{t.text}, nil
+	case tokenNumber:
+		return &literalNode{t.number}, nil
+	case tokenKeyword:
+		switch t.text {
+		case "TRUE":
+			return &literalNode{true}, nil
+		case "FALSE":
+			return &literalNode{false}, nil
+		case "NULL":
+			return &literalNode{nil}, nil
+		}
+	case tokenLeftParen:
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+	return nil, errors.Errorf("unexpected %s at %d", t.describe(), t.pos)
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return "string '" + strings.Replace(t.text, "'", "''", -1) + "'"
+	case tokenKeyword:
+		return t.text
+	default:
+		return "\"" + t.text + "\""
+	}
+}
+
+func compileLike(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^(?s:")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString(")$")
+	return regexp.MustCompile(b.String())
+}
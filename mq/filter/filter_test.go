@@ -0,0 +1,117 @@
+package filter
+
+import (
+	"testing"
+)
+
+type mapEnv map[string]interface{}
+
+func (e mapEnv) Lookup(name string) (interface{}, bool) {
+	value, ok := e[name]
+	return value, ok
+}
+
+func TestExpr_Matches(t *testing.T) {
+	env := mapEnv{
+		"priority":     uint32(7),
+		"content_type": "application/json",
+		"region":       "eu",
+		"retry":        true,
+		"amount":       12.5,
+		"x-trace.id":   "abc",
+		"with space":   "yes",
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{"priority > 5", true},
+		{"priority > 7", false},
+		{"priority >= 7", true},
+		{"priority <= 7 AND priority < 8", true},
+		{"priority = 7.0", true},
+		{"priority <> 7", false},
+		{"priority != 8", true},
+		{"priority > 5 AND content_type = 'application/json' AND region IN ('eu','us')", true},
+		{"priority > 5 and content_type = 'text/plain'", false},
+		{"priority > 5 OR content_type = 'text/plain'", true},
+		{"NOT priority > 5", false},
+		{"NOT (priority > 5 AND region = 'us')", true},
+		{"region IN ('us', 'ap')", false},
+		{"region NOT IN ('us', 'ap')", true},
+		{"content_type LIKE 'application/%'", true},
+		{"content_type LIKE 'application/_son'", true},
+		{"content_type LIKE 'application'", false},
+		{"content_type NOT LIKE 'text/%'", true},
+		{"region LIKE 'e.'", false},
+		{"amount BETWEEN 10 AND 20", true},
+		{"amount BETWEEN -10 AND 10", false},
+		{"amount NOT BETWEEN 10 AND 20", false},
+		{"retry", true},
+		{"retry = TRUE", true},
+		{"retry <> false", true},
+		{"retry > FALSE", false},
+		{"\"x-trace.id\" = 'abc'", true},
+		{"x-trace.id = 'abc'", true},
+		{"\"with space\" = 'yes'", true},
+		{"missing IS NULL", true},
+		{"region IS NOT NULL", true},
+		{"region IS NULL", false},
+		// unknown values
+		{"missing = 'eu'", false},
+		{"NOT missing = 'eu'", false},
+		{"missing <> 'eu'", false},
+		{"missing = 'eu' OR region = 'eu'", true},
+		{"missing = 'eu' AND region = 'eu'", false},
+		{"NOT (missing = 'eu' AND region = 'us')", true},
+		{"region IN ('us', NULL)", false},
+		{"region NOT IN ('us', NULL)", false},
+		{"missing IN ('eu')", false},
+		{"missing LIKE '%'", false},
+		// type mismatch
+		{"priority = '7'", false},
+		{"NOT priority = '7'", false},
+		{"region > 5", false},
+		{"region = TRUE", false},
+		{"'it''s' = 'it''s'", true},
+	}
+
+	for _, test := range tests {
+		expr, err := Parse(test.expr)
+		if err != nil {
+			t.Errorf("parse %q failed: %v", test.expr, err)
+			continue
+		}
+		if got := expr.Matches(env); got != test.expected {
+			t.Errorf("%q: expected %t, got %t", test.expr, test.expected, got)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"priority >",
+		"priority > 5 AND",
+		"(priority > 5",
+		"priority > 5)",
+		"region IN 'eu'",
+		"region IN ()",
+		"region LIKE region",
+		"region NOT = 'eu'",
+		"amount BETWEEN 1",
+		"region IS 'eu'",
+		"region = 'eu",
+		"region ! 'eu'",
+		"region = 'eu' region",
+		"priority > 1.2.3",
+		"priority > #",
+	}
+
+	for _, test := range tests {
+		if _, err := Parse(test); err == nil {
+			t.Errorf("parse %q expected to fail", test)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package filter
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenNumber
+	tokenKeyword
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	// Keywords are upper-cased, strings are unquoted.
+	text   string
+	number float64
+	pos    int
+}
+
+var keywords = map[string]bool{
+	"AND":     true,
+	"OR":      true,
+	"NOT":     true,
+	"IN":      true,
+	"LIKE":    true,
+	"IS":      true,
+	"NULL":    true,
+	"TRUE":    true,
+	"FALSE":   true,
+	"BETWEEN": true,
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(input); {
+		c := input[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", pos: i})
+			i++
+
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", pos: i})
+			i++
+
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+
+		case c == '=':
+			tokens = append(tokens, token{kind: tokenOperator, text: "=", pos: i})
+			i++
+
+		case c == '<' || c == '>' || c == '!':
+			op := string(c)
+			if i+1 < len(input) && (input[i+1] == '=' || c == '<' && input[i+1] == '>') {
+				op += string(input[i+1])
+			}
+			switch op {
+			case "!":
+				return nil, errors.Errorf("unexpected character %q at %d", c, i)
+			case "!=":
+				op = "<>"
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+			i += 2
+			if len(op) == 1 {
+				i--
+			}
+
+		case c == '\'' || c == '"':
+			// single quotes delimit strings, double quotes delimit identifiers; quote is escaped by doubling it
+			var b strings.Builder
+			j := i + 1
+			for {
+				if j >= len(input) {
+					return nil, errors.Errorf("unterminated quote starting at %d", i)
+				}
+				if input[j] == c {
+					if j+1 < len(input) && input[j+1] == c {
+						b.WriteByte(c)
+						j += 2
+						continue
+					}
+					break
+				}
+				b.WriteByte(input[j])
+				j++
+			}
+			kind := tokenString
+			if c == '"' {
+				kind = tokenIdentifier
+			}
+			tokens = append(tokens, token{kind: kind, text: b.String(), pos: i})
+			i = j + 1
+
+		case c >= '0' && c <= '9' || c == '.' || c == '-' || c == '+':
+			j := i + 1
+			for j < len(input) && (input[j] >= '0' && input[j] <= '9' || input[j] == '.' || input[j] == 'e' || input[j] == 'E' ||
+				(input[j] == '-' || input[j] == '+') && (input[j-1] == 'e' || input[j-1] == 'E')) {
+				j++
+			}
+			number, err := strconv.ParseFloat(input[i:j], 64)
+			if err != nil {
+				return nil, errors.Errorf("invalid number %q at %d", input[i:j], i)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[i:j], number: number, pos: i})
+			i = j
+
+		case isIdentifierStart(rune(c)):
+			j := i + 1
+			for j < len(input) && isIdentifierPart(rune(input[j])) {
+				j++
+			}
+			text := input[i:j]
+			if upper := strings.ToUpper(text); keywords[upper] {
+				tokens = append(tokens, token{kind: tokenKeyword, text: upper, pos: i})
+			} else {
+				tokens = append(tokens, token{kind: tokenIdentifier, text: text, pos: i})
+			}
+			i = j
+
+		default:
+			return nil, errors.Errorf("unexpected character %q at %d", c, i)
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(input)})
+
+	return tokens, nil
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentifierPart(r rune) bool {
+	return r == '_' || r == '-' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
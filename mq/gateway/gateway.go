@@ -0,0 +1,378 @@
+// Package gateway translates HTTP/JSON requests to calls of the public gRPC API according to `google.api.http`
+// annotations in emq.proto.
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"eventter.io/mq/tracing"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	contentTypeJSON        = "application/json"
+	contentTypeNDJSON      = "application/x-ndjson"
+	contentTypeEventStream = "text/event-stream"
+)
+
+type gateway struct {
+	conn      *grpc.ClientConn
+	rules     []*rule
+	types     messageTypes
+	marshaler *jsonpb.Marshaler
+}
+
+// New returns HTTP handler forwarding requests to gRPC server on other end of the connection.
+func New(conn *grpc.ClientConn) (http.Handler, error) {
+	rules, types, err := loadRules()
+	if err != nil {
+		return nil, errors.Wrap(err, "load rules failed")
+	}
+	return &gateway{
+		conn:      conn,
+		rules:     rules,
+		types:     types,
+		marshaler: &jsonpb.Marshaler{OrigName: true},
+	}, nil
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+
+	var (
+		matched *rule
+		vars    map[string]string
+	)
+	for _, rule := range g.rules {
+		if v, ok := rule.match(r.Method, path); ok {
+			matched, vars = rule, v
+			break
+		}
+	}
+	if matched == nil {
+		g.writeError(w, status.Errorf(codes.NotFound, "no method for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	request, err := g.buildRequest(matched, r, vars)
+	if err != nil {
+		g.writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	ctx := r.Context()
+	for _, key := range []string{tracing.TraceparentHeader, tracing.TracestateHeader} {
+		if value := r.Header.Get(key); value != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+		}
+	}
+
+	if matched.serverStreams {
+		g.serveStream(ctx, w, r, matched, request)
+	} else {
+		g.serveUnary(ctx, w, matched, request)
+	}
+}
+
+func (g *gateway) serveUnary(ctx context.Context, w http.ResponseWriter, rule *rule, request proto.Message) {
+	response := rule.newOutput()
+	if err := g.conn.Invoke(ctx, rule.fullMethod, request, response); err != nil {
+		g.writeError(w, err)
+		return
+	}
+
+	buf := bytes.Buffer{}
+	if err := g.marshaler.Marshal(&buf, response); err != nil {
+		g.writeError(w, status.Errorf(codes.Internal, "marshal response failed: %v", err))
+		return
+	}
+	buf.WriteByte('\n')
+
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+func (g *gateway) serveStream(ctx context.Context, w http.ResponseWriter, r *http.Request, rule *rule, request proto.Message) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		g.writeError(w, status.Error(codes.Unimplemented, "streaming not supported by connection"))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := g.conn.NewStream(ctx, &grpc.StreamDesc{StreamName: rule.streamName, ServerStreams: true}, rule.fullMethod)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	if err := stream.SendMsg(request); err != nil {
+		g.writeError(w, err)
+		return
+	}
+	if err := stream.CloseSend(); err != nil {
+		g.writeError(w, err)
+		return
+	}
+
+	eventStream := strings.Contains(r.Header.Get("Accept"), contentTypeEventStream)
+	if eventStream {
+		w.Header().Set("Content-Type", contentTypeEventStream)
+		w.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.Header().Set("Content-Type", contentTypeNDJSON)
+	}
+
+	// header is sent with the first message, or error, so that status code reflects errors before any message
+	headerSent := false
+	buf := bytes.Buffer{}
+
+	for {
+		response := rule.newOutput()
+		err := stream.RecvMsg(response)
+		if err == io.EOF {
+			if !headerSent {
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		} else if err != nil {
+			if !headerSent {
+				g.writeError(w, err)
+				return
+			}
+			if ctx.Err() != nil {
+				// client went away
+				return
+			}
+			buf.Reset()
+			writeStreamError(&buf, err, eventStream)
+			w.Write(buf.Bytes())
+			flusher.Flush()
+			return
+		}
+
+		buf.Reset()
+		if eventStream {
+			buf.WriteString("event: message\ndata: ")
+		} else {
+			buf.WriteString(`{"result":`)
+		}
+		if err := g.marshaler.Marshal(&buf, response); err != nil {
+			buf.Reset()
+			writeStreamError(&buf, status.Errorf(codes.Internal, "marshal response failed: %v", err), eventStream)
+			if !headerSent {
+				w.WriteHeader(http.StatusOK)
+			}
+			w.Write(buf.Bytes())
+			return
+		}
+		if eventStream {
+			buf.WriteString("\n\n")
+		} else {
+			buf.WriteString("}\n")
+		}
+
+		if !headerSent {
+			w.WriteHeader(http.StatusOK)
+			headerSent = true
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (g *gateway) buildRequest(rule *rule, r *http.Request, vars map[string]string) (proto.Message, error) {
+	fields := make(map[string]interface{})
+
+	if rule.body != "" {
+		var body interface{}
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err == io.EOF {
+			body = nil
+		} else if err != nil {
+			return nil, errors.Wrap(err, "decode body failed")
+		}
+
+		if rule.body == "*" {
+			if body != nil {
+				object, ok := body.(map[string]interface{})
+				if !ok {
+					return nil, errors.New("body must be an object")
+				}
+				fields = object
+			}
+		} else if body != nil {
+			fields[rule.body] = body
+		}
+	}
+
+	if rule.body != "*" {
+		for key, values := range r.URL.Query() {
+			if err := g.setField(rule, fields, strings.Split(key, "."), values); err != nil {
+				return nil, errors.Wrapf(err, "query parameter %s", key)
+			}
+		}
+	}
+
+	for key, value := range vars {
+		if err := g.setField(rule, fields, strings.Split(key, "."), []string{value}); err != nil {
+			return nil, errors.Wrapf(err, "path parameter %s", key)
+		}
+	}
+
+	buf, err := json.Marshal(fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request failed")
+	}
+	request := rule.newInput()
+	if err := jsonpb.Unmarshal(bytes.NewReader(buf), request); err != nil {
+		return nil, errors.Wrap(err, "unmarshal request failed")
+	}
+
+	return request, nil
+}
+
+// Sets field of JSON object according to its descriptor. Intermediate objects are created as needed.
+func (g *gateway) setField(rule *rule, fields map[string]interface{}, path []string, values []string) error {
+	field, err := g.types.field(rule.input, path)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range path[:len(path)-1] {
+		next, ok := fields[name].(map[string]interface{})
+		if !ok {
+			if fields[name] != nil {
+				return errors.Errorf("field %s is not an object", name)
+			}
+			next = make(map[string]interface{})
+			fields[name] = next
+		}
+		fields = next
+	}
+
+	var converted []interface{}
+	for _, value := range values {
+		v, err := convertValue(field, value)
+		if err != nil {
+			return err
+		}
+		converted = append(converted, v)
+	}
+
+	if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		fields[path[len(path)-1]] = converted
+	} else if len(converted) > 0 {
+		fields[path[len(path)-1]] = converted[len(converted)-1]
+	}
+
+	return nil
+}
+
+func convertValue(field *descriptor.FieldDescriptorProto, value string) (interface{}, error) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if value == "" {
+			return true, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Errorf("%q is not a bool", value)
+		}
+		return b, nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT,
+		descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, errors.Errorf("%q is not a number", value)
+		}
+		return json.Number(value), nil
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return nil, errors.New("message fields cannot be set from a string")
+	default:
+		return value, nil
+	}
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func (g *gateway) writeError(w http.ResponseWriter, err error) {
+	s, _ := status.FromError(err)
+	buf, _ := json.Marshal(errorResponse{Error: s.Message(), Code: s.Code().String()})
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(httpStatus(s.Code()))
+	w.Write(buf)
+	w.Write([]byte{'\n'})
+}
+
+func writeStreamError(buf *bytes.Buffer, err error, eventStream bool) {
+	s, _ := status.FromError(err)
+	e, _ := json.Marshal(errorResponse{Error: s.Message(), Code: s.Code().String()})
+	if eventStream {
+		fmt.Fprintf(buf, "event: error\ndata: %s\n\n", e)
+	} else {
+		fmt.Fprintf(buf, "{\"error\":%s}\n", e)
+	}
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,201 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServer struct {
+	emq.EventterMQServer
+	createTopic     *emq.TopicCreateRequest
+	deleteNamespace *emq.NamespaceDeleteRequest
+	listTopics      *emq.TopicListRequest
+	ack             *emq.MessageAckRequest
+}
+
+func (s *testServer) CreateTopic(ctx context.Context, request *emq.TopicCreateRequest) (*emq.TopicCreateResponse, error) {
+	s.createTopic = request
+	return &emq.TopicCreateResponse{OK: true, Index: 7}, nil
+}
+
+func (s *testServer) DeleteNamespace(ctx context.Context, request *emq.NamespaceDeleteRequest) (*emq.NamespaceDeleteResponse, error) {
+	s.deleteNamespace = request
+	return &emq.NamespaceDeleteResponse{OK: true}, nil
+}
+
+func (s *testServer) ListTopics(ctx context.Context, request *emq.TopicListRequest) (*emq.TopicListResponse, error) {
+	s.listTopics = request
+	if request.Namespace == "missing" {
+		return nil, status.Error(codes.NotFound, "namespace not found")
+	}
+	return &emq.TopicListResponse{}, nil
+}
+
+func (s *testServer) Ack(ctx context.Context, request *emq.MessageAckRequest) (*emq.MessageAckResponse, error) {
+	s.ack = request
+	return &emq.MessageAckResponse{OK: true}, nil
+}
+
+func (s *testServer) Subscribe(request *emq.ConsumerGroupSubscribeRequest, stream emq.EventterMQ_SubscribeServer) error {
+	for i := uint64(1); i <= request.MaxMessages; i++ {
+		err := stream.Send(&emq.ConsumerGroupSubscribeResponse{
+			SubscriptionID: 1,
+			SeqNo:          i,
+			TopicNamespace: request.Namespace,
+			TopicName:      "topic",
+			Message:        &emq.Message{Data: []byte("hello")},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestGateway(t *testing.T) (*testServer, *httptest.Server, func()) {
+	assert := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+
+	server := &testServer{}
+	grpcServer := grpc.NewServer()
+	emq.RegisterEventterMQServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, listener.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	assert.NoError(err)
+
+	handler, err := New(conn)
+	assert.NoError(err)
+
+	httpServer := httptest.NewServer(handler)
+
+	return server, httpServer, func() {
+		httpServer.Close()
+		conn.Close()
+		grpcServer.Stop()
+	}
+}
+
+func do(t *testing.T, method string, url string, body string, accept string) (*http.Response, string) {
+	assert := require.New(t)
+
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	assert.NoError(err)
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(err)
+	defer response.Body.Close()
+
+	buf := strings.Builder{}
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		buf.WriteString(scanner.Text())
+		buf.WriteByte('\n')
+	}
+	assert.NoError(scanner.Err())
+
+	return response, buf.String()
+}
+
+func TestGateway_Unary(t *testing.T) {
+	assert := require.New(t)
+
+	server, httpServer, cleanup := newTestGateway(t)
+	defer cleanup()
+
+	response, body := do(t, http.MethodPut, httpServer.URL+"/default/topics/orders", `{"default_exchange_type":"fanout","shards":1,"retention":"3600s"}`, "")
+	assert.Equal(http.StatusOK, response.StatusCode)
+	assert.Equal("application/json", response.Header.Get("Content-Type"))
+	assert.Equal("{\"ok\":true,\"index\":\"7\"}\n", body)
+	assert.NotNil(server.createTopic)
+	assert.Equal("default", server.createTopic.Topic.Namespace)
+	assert.Equal("orders", server.createTopic.Topic.Name)
+	assert.Equal("fanout", server.createTopic.Topic.DefaultExchangeType)
+	assert.Equal(uint32(1), server.createTopic.Topic.Shards)
+	assert.Equal(time.Hour, server.createTopic.Topic.Retention)
+
+	response, _ = do(t, http.MethodDelete, httpServer.URL+"/default", "", "")
+	assert.Equal(http.StatusOK, response.StatusCode)
+	assert.NotNil(server.deleteNamespace)
+	assert.Equal("default", server.deleteNamespace.Namespace)
+
+	response, _ = do(t, http.MethodGet, httpServer.URL+"/default/topics?name=orders&leader_only=true", "", "")
+	assert.Equal(http.StatusOK, response.StatusCode)
+	assert.NotNil(server.listTopics)
+	assert.Equal("default", server.listTopics.Namespace)
+	assert.Equal("orders", server.listTopics.Name)
+	assert.True(server.listTopics.LeaderOnly)
+
+	response, _ = do(t, http.MethodPost, httpServer.URL+"/_ack", `{"node_id":"18446744073709551615","subscription_id":2,"seq_no":3}`, "")
+	assert.Equal(http.StatusOK, response.StatusCode)
+	assert.NotNil(server.ack)
+	assert.Equal(uint64(18446744073709551615), server.ack.NodeID)
+	assert.Equal(uint64(2), server.ack.SubscriptionID)
+	assert.Equal(uint64(3), server.ack.SeqNo)
+}
+
+func TestGateway_Errors(t *testing.T) {
+	assert := require.New(t)
+
+	_, httpServer, cleanup := newTestGateway(t)
+	defer cleanup()
+
+	response, body := do(t, http.MethodGet, httpServer.URL+"/missing/topics", "", "")
+	assert.Equal(http.StatusNotFound, response.StatusCode)
+	assert.Equal("{\"error\":\"namespace not found\",\"code\":\"NotFound\"}\n", body)
+
+	response, _ = do(t, http.MethodPatch, httpServer.URL+"/default/topics", "", "")
+	assert.Equal(http.StatusNotFound, response.StatusCode)
+
+	response, _ = do(t, http.MethodGet, httpServer.URL+"/default/topics?unknown=1", "", "")
+	assert.Equal(http.StatusBadRequest, response.StatusCode)
+
+	response, _ = do(t, http.MethodGet, httpServer.URL+"/default/topics?leader_only=maybe", "", "")
+	assert.Equal(http.StatusBadRequest, response.StatusCode)
+
+	response, _ = do(t, http.MethodPut, httpServer.URL+"/default/topics/orders", `{"type":`, "")
+	assert.Equal(http.StatusBadRequest, response.StatusCode)
+}
+
+func TestGateway_Subscribe(t *testing.T) {
+	assert := require.New(t)
+
+	_, httpServer, cleanup := newTestGateway(t)
+	defer cleanup()
+
+	response, body := do(t, http.MethodGet, httpServer.URL+"/default/cgs/cg/subscribe?max_messages=2", "", "")
+	assert.Equal(http.StatusOK, response.StatusCode)
+	assert.Equal("application/x-ndjson", response.Header.Get("Content-Type"))
+	assert.Equal(
+		`{"result":{"subscription_id":"1","seq_no":"1","topic_namespace":"default","topic_name":"topic","message":{"data":"aGVsbG8="}}}`+"\n"+
+			`{"result":{"subscription_id":"1","seq_no":"2","topic_namespace":"default","topic_name":"topic","message":{"data":"aGVsbG8="}}}`+"\n",
+		body,
+	)
+
+	response, body = do(t, http.MethodGet, httpServer.URL+"/default/cgs/cg/subscribe?max_messages=1", "", "text/event-stream")
+	assert.Equal(http.StatusOK, response.StatusCode)
+	assert.Equal("text/event-stream", response.Header.Get("Content-Type"))
+	assert.Equal(
+		"event: message\n"+
+			`data: {"subscription_id":"1","seq_no":"1","topic_namespace":"default","topic_name":"topic","message":{"data":"aGVsbG8="}}`+"\n\n",
+		body,
+	)
+}
@@ -0,0 +1,229 @@
+package gateway
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	_ "eventter.io/mq/emq" // registers emq.proto descriptor & message types
+	"github.com/gogo/protobuf/proto"
+	golangproto "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+const (
+	protoFileName = "emq.proto"
+	serviceName   = "EventterMQ"
+)
+
+// HTTP rule of single RPC parsed from `google.api.http` method option.
+type rule struct {
+	httpMethod    string
+	path          []pathSegment
+	body          string
+	fullMethod    string
+	streamName    string
+	serverStreams bool
+	inputType     reflect.Type
+	outputType    reflect.Type
+	input         *descriptor.DescriptorProto
+}
+
+type pathSegment struct {
+	literal  string
+	variable []string
+}
+
+func (r *rule) match(httpMethod string, path []string) (vars map[string]string, ok bool) {
+	if r.httpMethod != httpMethod || len(r.path) != len(path) {
+		return nil, false
+	}
+	for i, segment := range r.path {
+		if segment.variable == nil {
+			if segment.literal != path[i] {
+				return nil, false
+			}
+			continue
+		}
+		value, err := url.PathUnescape(path[i])
+		if err != nil || value == "" {
+			return nil, false
+		}
+		if vars == nil {
+			vars = make(map[string]string)
+		}
+		vars[strings.Join(segment.variable, ".")] = value
+	}
+	return vars, true
+}
+
+func (r *rule) newInput() proto.Message {
+	return reflect.New(r.inputType.Elem()).Interface().(proto.Message)
+}
+
+func (r *rule) newOutput() proto.Message {
+	return reflect.New(r.outputType.Elem()).Interface().(proto.Message)
+}
+
+type messageTypes map[string]*descriptor.DescriptorProto
+
+// Loads HTTP rules of all service methods from registered file descriptor.
+func loadRules() ([]*rule, messageTypes, error) {
+	gz := proto.FileDescriptor(protoFileName)
+	if gz == nil {
+		return nil, nil, errors.Errorf("file descriptor %s not registered", protoFileName)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "gunzip failed")
+	}
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "gunzip failed")
+	}
+	file := &descriptor.FileDescriptorProto{}
+	if err := golangproto.Unmarshal(buf, file); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal file descriptor failed")
+	}
+
+	types := make(messageTypes)
+	var addTypes func(prefix string, messages []*descriptor.DescriptorProto)
+	addTypes = func(prefix string, messages []*descriptor.DescriptorProto) {
+		for _, message := range messages {
+			name := prefix + "." + message.GetName()
+			types[name] = message
+			addTypes(name, message.NestedType)
+		}
+	}
+	addTypes("."+file.GetPackage(), file.MessageType)
+
+	var service *descriptor.ServiceDescriptorProto
+	for _, s := range file.Service {
+		if s.GetName() == serviceName {
+			service = s
+			break
+		}
+	}
+	if service == nil {
+		return nil, nil, errors.Errorf("service %s not found in %s", serviceName, protoFileName)
+	}
+
+	var rules []*rule
+	for _, method := range service.Method {
+		if method.Options == nil || !golangproto.HasExtension(method.Options, annotations.E_Http) {
+			continue
+		}
+		ext, err := golangproto.GetExtension(method.Options, annotations.E_Http)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "method %s", method.GetName())
+		}
+		httpRule := ext.(*annotations.HttpRule)
+
+		rule := &rule{
+			body:          httpRule.Body,
+			fullMethod:    "/" + file.GetPackage() + "." + service.GetName() + "/" + method.GetName(),
+			streamName:    method.GetName(),
+			serverStreams: method.GetServerStreaming(),
+			inputType:     proto.MessageType(strings.TrimPrefix(method.GetInputType(), ".")),
+			outputType:    proto.MessageType(strings.TrimPrefix(method.GetOutputType(), ".")),
+			input:         types[method.GetInputType()],
+		}
+		if rule.inputType == nil || rule.outputType == nil || rule.input == nil {
+			return nil, nil, errors.Errorf("method %s: message type not registered", method.GetName())
+		}
+		if method.GetClientStreaming() {
+			return nil, nil, errors.Errorf("method %s: client streaming not supported", method.GetName())
+		}
+
+		var pattern string
+		switch p := httpRule.Pattern.(type) {
+		case *annotations.HttpRule_Get:
+			rule.httpMethod, pattern = http.MethodGet, p.Get
+		case *annotations.HttpRule_Put:
+			rule.httpMethod, pattern = http.MethodPut, p.Put
+		case *annotations.HttpRule_Post:
+			rule.httpMethod, pattern = http.MethodPost, p.Post
+		case *annotations.HttpRule_Delete:
+			rule.httpMethod, pattern = http.MethodDelete, p.Delete
+		case *annotations.HttpRule_Patch:
+			rule.httpMethod, pattern = http.MethodPatch, p.Patch
+		default:
+			return nil, nil, errors.Errorf("method %s: unsupported HTTP rule pattern", method.GetName())
+		}
+
+		rule.path, err = parsePattern(pattern)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "method %s", method.GetName())
+		}
+		for _, segment := range rule.path {
+			if segment.variable != nil {
+				if _, err := types.field(rule.input, segment.variable); err != nil {
+					return nil, nil, errors.Wrapf(err, "method %s: path variable", method.GetName())
+				}
+			}
+		}
+		if rule.body != "" && rule.body != "*" {
+			if _, err := types.field(rule.input, []string{rule.body}); err != nil {
+				return nil, nil, errors.Wrapf(err, "method %s: body", method.GetName())
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, types, nil
+}
+
+func parsePattern(pattern string) ([]pathSegment, error) {
+	if !strings.HasPrefix(pattern, "/") {
+		return nil, errors.Errorf("pattern %q must start with /", pattern)
+	}
+	var segments []pathSegment
+	for _, s := range strings.Split(strings.TrimPrefix(pattern, "/"), "/") {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			name := s[1 : len(s)-1]
+			if name == "" || strings.ContainsAny(name, "=*{}") {
+				return nil, errors.Errorf("pattern %q: unsupported variable %q", pattern, s)
+			}
+			segments = append(segments, pathSegment{variable: strings.Split(name, ".")})
+		} else if s == "" || strings.ContainsAny(s, "{}*") {
+			return nil, errors.Errorf("pattern %q: unsupported segment %q", pattern, s)
+		} else {
+			segments = append(segments, pathSegment{literal: s})
+		}
+	}
+	return segments, nil
+}
+
+// Resolves (possibly nested) field of message.
+func (t messageTypes) field(message *descriptor.DescriptorProto, path []string) (*descriptor.FieldDescriptorProto, error) {
+	for i, name := range path {
+		var field *descriptor.FieldDescriptorProto
+		for _, f := range message.Field {
+			if f.GetName() == name || f.GetJsonName() == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, errors.Errorf("field %s not found", strings.Join(path[:i+1], "."))
+		}
+		if i == len(path)-1 {
+			return field, nil
+		}
+		if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE || field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			return nil, errors.Errorf("field %s is not a message", strings.Join(path[:i+1], "."))
+		}
+		message = t[field.GetTypeName()]
+		if message == nil {
+			return nil, errors.Errorf("field %s has unknown type %s", strings.Join(path[:i+1], "."), field.GetTypeName())
+		}
+	}
+	return nil, errors.New("empty field path")
+}
@@ -0,0 +1,19 @@
+package logging
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns logger stored in the context. If there is none, nop logger is returned.
+func FromContext(ctx context.Context) Logger {
+	if logger, ok := ctx.Value(contextKey{}).(Logger); ok {
+		return logger
+	}
+	return Nop()
+}
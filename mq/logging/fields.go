@@ -0,0 +1,42 @@
+package logging
+
+import (
+	"fmt"
+)
+
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Error(err error) Field {
+	return Field{Key: "error", Value: err}
+}
+
+func NodeID(id uint64) Field {
+	return Field{Key: "node_id", Value: fmt.Sprintf("%016x", id)}
+}
+
+func Namespace(name string) Field {
+	return Field{Key: "namespace", Value: name}
+}
+
+func Topic(name string) Field {
+	return Field{Key: "topic", Value: name}
+}
+
+func ConsumerGroup(name string) Field {
+	return Field{Key: "consumer_group", Value: name}
+}
+
+func SegmentID(id uint64) Field {
+	return Field{Key: "segment_id", Value: id}
+}
+
+func ConnectionID(id uint64) Field {
+	return Field{Key: "connection_id", Value: id}
+}
@@ -0,0 +1,230 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Level int
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+)
+
+func (l Level) String() string {
+	switch l {
+	case DebugLevel:
+		return "debug"
+	case InfoLevel:
+		return "info"
+	case WarnLevel:
+		return "warn"
+	case ErrorLevel:
+		return "error"
+	default:
+		return "level(" + strconv.Itoa(int(l)) + ")"
+	}
+}
+
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return DebugLevel, nil
+	case "info":
+		return InfoLevel, nil
+	case "warn", "warning":
+		return WarnLevel, nil
+	case "error":
+		return ErrorLevel, nil
+	default:
+		return 0, errors.Errorf("unknown log level %q", s)
+	}
+}
+
+type Format int
+
+const (
+	TextFormat Format = iota
+	JSONFormat
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "text":
+		return TextFormat, nil
+	case "json":
+		return JSONFormat, nil
+	default:
+		return 0, errors.Errorf("unknown log format %q", s)
+	}
+}
+
+// Logger writes structured, levelled log entries. Fields added by With are written with every entry.
+type Logger interface {
+	With(fields ...Field) Logger
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
+
+type output struct {
+	mutex  sync.Mutex
+	w      io.Writer
+	level  Level
+	format Format
+	buf    bytes.Buffer
+}
+
+type logger struct {
+	out    *output
+	fields []Field
+}
+
+// New creates logger that writes entries of given level & above to w.
+func New(w io.Writer, level Level, format Format) Logger {
+	return &logger{
+		out: &output{
+			w:      w,
+			level:  level,
+			format: format,
+		},
+	}
+}
+
+func (l *logger) With(fields ...Field) Logger {
+	if len(fields) == 0 {
+		return l
+	}
+	next := &logger{
+		out:    l.out,
+		fields: make([]Field, 0, len(l.fields)+len(fields)),
+	}
+	next.fields = append(next.fields, l.fields...)
+	next.fields = append(next.fields, fields...)
+	return next
+}
+
+func (l *logger) Debug(msg string, fields ...Field) {
+	l.log(DebugLevel, msg, fields)
+}
+
+func (l *logger) Info(msg string, fields ...Field) {
+	l.log(InfoLevel, msg, fields)
+}
+
+func (l *logger) Warn(msg string, fields ...Field) {
+	l.log(WarnLevel, msg, fields)
+}
+
+func (l *logger) Error(msg string, fields ...Field) {
+	l.log(ErrorLevel, msg, fields)
+}
+
+func (l *logger) log(level Level, msg string, fields []Field) {
+	if level < l.out.level {
+		return
+	}
+
+	now := time.Now()
+
+	l.out.mutex.Lock()
+	defer l.out.mutex.Unlock()
+
+	buf := &l.out.buf
+	buf.Reset()
+
+	switch l.out.format {
+	case JSONFormat:
+		buf.WriteString(`{"time":`)
+		writeJSON(buf, now.Format(time.RFC3339Nano))
+		buf.WriteString(`,"level":`)
+		writeJSON(buf, level.String())
+		buf.WriteString(`,"msg":`)
+		writeJSON(buf, msg)
+		for _, field := range l.fields {
+			writeJSONField(buf, field)
+		}
+		for _, field := range fields {
+			writeJSONField(buf, field)
+		}
+		buf.WriteString("}\n")
+
+	default:
+		buf.WriteString(now.Format("2006-01-02T15:04:05.000Z07:00"))
+		buf.WriteByte(' ')
+		buf.WriteString(strings.ToUpper(level.String()))
+		buf.WriteByte(' ')
+		buf.WriteString(msg)
+		for _, field := range l.fields {
+			writeTextField(buf, field)
+		}
+		for _, field := range fields {
+			writeTextField(buf, field)
+		}
+		buf.WriteByte('\n')
+	}
+
+	l.out.w.Write(buf.Bytes())
+}
+
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprint(v))
+	}
+	buf.Write(b)
+}
+
+func writeJSONField(buf *bytes.Buffer, field Field) {
+	buf.WriteByte(',')
+	writeJSON(buf, field.Key)
+	buf.WriteByte(':')
+	writeJSON(buf, fieldValue(field))
+}
+
+func writeTextField(buf *bytes.Buffer, field Field) {
+	buf.WriteByte(' ')
+	buf.WriteString(field.Key)
+	buf.WriteByte('=')
+	s := fmt.Sprint(fieldValue(field))
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"=") {
+		s = strconv.Quote(s)
+	}
+	buf.WriteString(s)
+}
+
+func fieldValue(field Field) interface{} {
+	switch v := field.Value.(type) {
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+type nop struct{}
+
+// Nop returns logger that discards all entries.
+func Nop() Logger {
+	return nop{}
+}
+
+func (l nop) With(fields ...Field) Logger     { return l }
+func (nop) Debug(msg string, fields ...Field) {}
+func (nop) Info(msg string, fields ...Field)  {}
+func (nop) Warn(msg string, fields ...Field)  {}
+func (nop) Error(msg string, fields ...Field) {}
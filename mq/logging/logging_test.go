@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_Text(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	logger := New(buf, InfoLevel, TextFormat).With(NodeID(1))
+
+	logger.Debug("not logged")
+	assert.Empty(buf.String())
+
+	logger.Info("segment rotated", SegmentID(2), Topic("my topic"))
+	line := buf.String()
+	assert.Contains(line, " INFO segment rotated node_id=0000000000000001 segment_id=2 topic=\"my topic\"\n")
+
+	buf.Reset()
+	logger.Error("failed", Error(errors.New("boom")))
+	assert.Contains(buf.String(), " ERROR failed node_id=0000000000000001 error=boom\n")
+}
+
+func TestLogger_JSON(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	logger := New(buf, DebugLevel, JSONFormat).With(Namespace("default"))
+
+	logger.Debug("consumer group started", ConsumerGroup("cg"), ConnectionID(3))
+
+	var entry map[string]interface{}
+	assert.NoError(json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal("debug", entry["level"])
+	assert.Equal("consumer group started", entry["msg"])
+	assert.Equal("default", entry["namespace"])
+	assert.Equal("cg", entry["consumer_group"])
+	assert.Equal(float64(3), entry["connection_id"])
+	assert.NotEmpty(entry["time"])
+}
+
+func TestParseLevel(t *testing.T) {
+	assert := require.New(t)
+
+	level, err := ParseLevel("WARN")
+	assert.NoError(err)
+	assert.Equal(WarnLevel, level)
+
+	_, err = ParseLevel("verbose")
+	assert.Error(err)
+}
+
+func TestNewWriter(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	logger := New(buf, InfoLevel, TextFormat)
+	stdLogger := NewStdLogger(logger)
+
+	stdLogger.Printf("[DEBUG] raft: not logged")
+	assert.Empty(buf.String())
+
+	stdLogger.Printf("[WARN] raft: heartbeat timeout reached")
+	assert.Contains(buf.String(), " WARN raft: heartbeat timeout reached\n")
+
+	buf.Reset()
+	w := NewWriter(logger, ErrorLevel)
+	w.Write([]byte("partial "))
+	assert.Empty(buf.String())
+	w.Write([]byte("line\n"))
+	assert.Contains(buf.String(), " ERROR partial line\n")
+}
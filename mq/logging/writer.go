@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"strings"
+	"sync"
+)
+
+// Prefixes used by hashicorp libraries (raft, memberlist) to mark level of log line.
+var levelPrefixes = []struct {
+	prefix string
+	level  Level
+}{
+	{"[TRACE]", DebugLevel},
+	{"[DEBUG]", DebugLevel},
+	{"[INFO]", InfoLevel},
+	{"[WARN]", WarnLevel},
+	{"[ERR]", ErrorLevel},
+	{"[ERROR]", ErrorLevel},
+}
+
+type writer struct {
+	level  Level
+	mutex  sync.Mutex
+	buf    bytes.Buffer
+	target Logger
+}
+
+// NewWriter returns writer that logs every written line to the logger. Lines with level prefix (e.g. `[WARN]`) are
+// logged with corresponding level, the rest with default level.
+func NewWriter(logger Logger, defaultLevel Level) io.Writer {
+	return &writer{level: defaultLevel, target: logger}
+}
+
+// NewStdLogger returns stdlib logger writing to the logger, to be passed to libraries that expect `*log.Logger`.
+func NewStdLogger(logger Logger) *log.Logger {
+	return log.New(NewWriter(logger, InfoLevel), "", 0)
+}
+
+func (w *writer) Write(p []byte) (n int, err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i == -1 {
+			break
+		}
+		line := string(w.buf.Next(i + 1))
+		w.writeLine(strings.TrimRight(line, "\r\n"))
+	}
+
+	return len(p), nil
+}
+
+func (w *writer) writeLine(line string) {
+	level := w.level
+	for _, p := range levelPrefixes {
+		if i := strings.Index(line, p.prefix); i != -1 {
+			level = p.level
+			line = line[i+len(p.prefix):]
+			break
+		}
+	}
+
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+
+	switch level {
+	case DebugLevel:
+		w.target.Debug(line)
+	case InfoLevel:
+		w.target.Info(line)
+	case WarnLevel:
+		w.target.Warn(line)
+	default:
+		w.target.Error(line)
+	}
+}
@@ -1,11 +1,18 @@
 package mq
 
 import (
+	"bytes"
+	"container/list"
+	"encoding/json"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"eventter.io/mq/emq"
+	"eventter.io/mq/filter"
+	"github.com/gogo/protobuf/types"
 )
 
 func messageMatches(message *emq.Message, messageTime time.Time, topicName string, consumerGroup *ClusterConsumerGroup) bool {
@@ -13,56 +20,325 @@ func messageMatches(message *emq.Message, messageTime time.Time, topicName strin
 		return false
 	}
 
-BINDING:
 	for _, binding := range consumerGroup.Bindings {
 		if binding.TopicName != topicName {
 			continue
 		}
 
-		switch binding.ExchangeType {
-		case emq.ExchangeTypeDirect:
-			if by, ok := binding.By.(*ClusterConsumerGroup_Binding_RoutingKey); ok && by.RoutingKey == message.RoutingKey {
-				return true
-			}
-		case emq.ExchangeTypeFanout:
+		if bindingMatches(message, binding) && filterMatches(message, binding.Filter) {
 			return true
-		case emq.ExchangeTypeTopic:
-			if by, ok := binding.By.(*ClusterConsumerGroup_Binding_RoutingKey); ok && routingKeyMatches(by.RoutingKey, message.RoutingKey) {
-				return true
+		}
+	}
+
+	return false
+}
+
+func bindingMatches(message *emq.Message, binding *ClusterConsumerGroup_Binding) bool {
+	switch binding.ExchangeType {
+	case emq.ExchangeTypeDirect:
+		by, ok := binding.By.(*ClusterConsumerGroup_Binding_RoutingKey)
+		return ok && by.RoutingKey == message.RoutingKey
+	case emq.ExchangeTypeFanout:
+		return true
+	case emq.ExchangeTypeTopic:
+		by, ok := binding.By.(*ClusterConsumerGroup_Binding_RoutingKey)
+		return ok && routingKeyMatches(by.RoutingKey, message.RoutingKey)
+	case emq.ExchangeTypeHeaders:
+		switch by := binding.By.(type) {
+		case *ClusterConsumerGroup_Binding_HeadersAll:
+			return headersAllMatch(message.Headers, by.HeadersAll)
+		case *ClusterConsumerGroup_Binding_HeadersAny:
+			return headersAnyMatch(message.Headers, by.HeadersAny)
+		case nil:
+			// headers binding without headers is allowed only together with filter
+			return binding.Filter != ""
+		default:
+			return false
+		}
+	default:
+		panic("unhandled exchange type " + binding.ExchangeType)
+	}
+}
+
+// Maximum number of compiled filters kept in memory.
+const compiledFiltersCacheSize = 1024
+
+var compiledFilters = newFilterCache(compiledFiltersCacheSize)
+
+func filterMatches(message *emq.Message, source string) bool {
+	if source == "" {
+		return true
+	}
+
+	expr, ok := compiledFilters.Get(source)
+	if !ok {
+		var err error
+		expr, err = filter.Parse(source)
+		if err != nil {
+			// filters are validated when consumer group is created, this should not happen
+			return false
+		}
+		compiledFilters.Add(source, expr)
+	}
+
+	return expr.Matches(messageFilterEnv{message})
+}
+
+// Least recently used compiled filters keyed by their source.
+type filterCache struct {
+	mutex   sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List // most recently used at front
+}
+
+type filterCacheEntry struct {
+	source string
+	expr   *filter.Expr
+}
+
+func newFilterCache(size int) *filterCache {
+	return &filterCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *filterCache) Get(source string) (*filter.Expr, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[source]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*filterCacheEntry).expr, true
+}
+
+func (c *filterCache) Add(source string, expr *filter.Expr) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[source]; ok {
+		element.Value.(*filterCacheEntry).expr = expr
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[source] = c.order.PushFront(&filterCacheEntry{source, expr})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*filterCacheEntry).source)
+	}
+}
+
+func (c *filterCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
+
+// Resolves message properties by their names (e.g. `content_type`), any other name (or name prefixed by `headers.`)
+// resolves to header value.
+type messageFilterEnv struct {
+	message *emq.Message
+}
+
+func (e messageFilterEnv) Lookup(name string) (interface{}, bool) {
+	if strings.HasPrefix(name, "headers.") {
+		return e.header(strings.TrimPrefix(name, "headers."))
+	}
+
+	if name == "routing_key" {
+		return e.message.RoutingKey, true
+	}
+
+	if properties := e.message.Properties; properties != nil {
+		switch name {
+		case "content_type":
+			return nonEmpty(properties.ContentType)
+		case "content_encoding":
+			return nonEmpty(properties.ContentEncoding)
+		case "delivery_mode":
+			return properties.DeliveryMode, true
+		case "priority":
+			return properties.Priority, true
+		case "correlation_id":
+			return nonEmpty(properties.CorrelationID)
+		case "reply_to":
+			return nonEmpty(properties.ReplyTo)
+		case "expiration":
+			return nonEmpty(properties.Expiration)
+		case "message_id":
+			return nonEmpty(properties.MessageID)
+		case "timestamp":
+			if properties.Timestamp.IsZero() {
+				return nil, false
 			}
-		case emq.ExchangeTypeHeaders:
-			if message.Headers != nil && message.Headers.Fields != nil {
-				switch by := binding.By.(type) {
-				case *ClusterConsumerGroup_Binding_HeadersAll:
-					for headerName, expectedHeaderValue := range by.HeadersAll.Fields {
-						gotHeaderValue, ok := message.Headers.Fields[headerName]
-						if !ok {
-							continue BINDING
-						}
-						if !reflect.DeepEqual(expectedHeaderValue, gotHeaderValue) {
-							continue BINDING
-						}
-					}
-					return true
+			return properties.Timestamp.Unix(), true
+		case "type":
+			return nonEmpty(properties.Type)
+		case "user_id":
+			return nonEmpty(properties.UserID)
+		case "app_id":
+			return nonEmpty(properties.AppID)
+		case "to":
+			return nonEmpty(properties.To)
+		case "group_id":
+			return nonEmpty(properties.GroupID)
+		case "group_sequence":
+			return properties.GroupSequence, true
+		case "reply_to_group_id":
+			return nonEmpty(properties.ReplyToGroupID)
+		}
+	}
 
-				case *ClusterConsumerGroup_Binding_HeadersAny:
-					for headerName, expectedHeaderValue := range by.HeadersAny.Fields {
-						gotHeaderValue, ok := message.Headers.Fields[headerName]
-						if !ok {
-							continue
-						}
-						if reflect.DeepEqual(expectedHeaderValue, gotHeaderValue) {
-							return true
-						}
-					}
-				}
+	return e.header(name)
+}
+
+func (e messageFilterEnv) header(name string) (interface{}, bool) {
+	if e.message.Headers == nil {
+		return nil, false
+	}
+	value, ok := e.message.Headers.Fields[name]
+	if !ok {
+		return nil, false
+	}
+	switch kind := value.Kind.(type) {
+	case *types.Value_BoolValue:
+		return kind.BoolValue, true
+	case *types.Value_NumberValue:
+		return kind.NumberValue, true
+	case *types.Value_StringValue:
+		return kind.StringValue, true
+	default:
+		return nil, false
+	}
+}
+
+func nonEmpty(s string) (interface{}, bool) {
+	if s == "" {
+		return nil, false
+	}
+	return s, true
+}
+
+func headersAllMatch(headers *types.Struct, expected *types.Struct) bool {
+	if headers == nil || headers.Fields == nil {
+		return false
+	}
+	for headerName, expectedHeaderValue := range expected.Fields {
+		gotHeaderValue, ok := headers.Fields[headerName]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(expectedHeaderValue, gotHeaderValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func headersAnyMatch(headers *types.Struct, expected *types.Struct) bool {
+	if headers == nil || headers.Fields == nil {
+		return false
+	}
+	for headerName, expectedHeaderValue := range expected.Fields {
+		gotHeaderValue, ok := headers.Fields[headerName]
+		if !ok {
+			continue
+		}
+		if reflect.DeepEqual(expectedHeaderValue, gotHeaderValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func searchMatches(message *emq.Message, messageTime time.Time, request *emq.TopicSearchRequest) bool {
+	if request.Since != nil && messageTime.Before(*request.Since) {
+		return false
+	}
+	if request.Until != nil && !messageTime.Before(*request.Until) {
+		return false
+	}
+	if request.RoutingKey != "" && !routingKeyMatches(request.RoutingKey, message.RoutingKey) {
+		return false
+	}
+	if request.HeadersAll != nil && !headersAllMatch(message.Headers, request.HeadersAll) {
+		return false
+	}
+	if request.HeadersAny != nil && !headersAnyMatch(message.Headers, request.HeadersAny) {
+		return false
+	}
+	if request.DataContains != "" && !bytes.Contains(message.Data, []byte(request.DataContains)) {
+		return false
+	}
+	if request.DataJSONPath != "" {
+		var data interface{}
+		if err := json.Unmarshal(message.Data, &data); err != nil {
+			return false
+		}
+		value, ok := jsonPathLookup(data, request.DataJSONPath)
+		if !ok {
+			return false
+		}
+		if request.DataJSONValue != nil && !reflect.DeepEqual(value, valueToInterface(request.DataJSONValue)) {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonPathLookup(data interface{}, path string) (interface{}, bool) {
+	for _, key := range strings.Split(strings.TrimPrefix(path, "$."), ".") {
+		switch v := data.(type) {
+		case map[string]interface{}:
+			value, ok := v[key]
+			if !ok {
+				return nil, false
 			}
+			data = value
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			data = v[i]
 		default:
-			panic("unhandled exchange type " + binding.ExchangeType)
+			return nil, false
 		}
 	}
+	return data, true
+}
 
-	return false
+// Converts value to the same representation as `encoding/json` unmarshals JSON into.
+func valueToInterface(value *types.Value) interface{} {
+	switch kind := value.Kind.(type) {
+	case *types.Value_BoolValue:
+		return kind.BoolValue
+	case *types.Value_NumberValue:
+		return kind.NumberValue
+	case *types.Value_StringValue:
+		return kind.StringValue
+	case *types.Value_ListValue:
+		list := make([]interface{}, 0, len(kind.ListValue.Values))
+		for _, item := range kind.ListValue.Values {
+			list = append(list, valueToInterface(item))
+		}
+		return list
+	case *types.Value_StructValue:
+		m := make(map[string]interface{}, len(kind.StructValue.Fields))
+		for key, item := range kind.StructValue.Fields {
+			m[key] = valueToInterface(item)
+		}
+		return m
+	default:
+		return nil
+	}
 }
 
 const (
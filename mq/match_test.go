@@ -3,6 +3,11 @@ package mq
 import (
 	"fmt"
 	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/filter"
+	"github.com/gogo/protobuf/types"
 )
 
 func TestRoutingKeyMatches(t *testing.T) {
@@ -100,3 +105,147 @@ func TestRoutingKeyMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageMatches_Filter(t *testing.T) {
+	message := &emq.Message{
+		RoutingKey: "orders.created",
+		Properties: &emq.Message_Properties{
+			ContentType: "application/json",
+			Priority:    7,
+		},
+		Headers: &types.Struct{Fields: map[string]*types.Value{
+			"region":   {Kind: &types.Value_StringValue{StringValue: "eu"}},
+			"priority": {Kind: &types.Value_NumberValue{NumberValue: 1}},
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		binding  *ClusterConsumerGroup_Binding
+		expected bool
+	}{
+		{"fanout", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeFanout,
+			Filter:       "priority > 5 AND content_type = 'application/json' AND region IN ('eu','us')",
+		}, true},
+		{"fanout mismatch", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeFanout,
+			Filter:       "region = 'us'",
+		}, false},
+		{"header shadowed by property", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeFanout,
+			Filter:       "priority = 7 AND headers.priority = 1",
+		}, true},
+		{"topic", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeTopic,
+			By:           &ClusterConsumerGroup_Binding_RoutingKey{RoutingKey: "orders.*"},
+			Filter:       "routing_key LIKE '%.created'",
+		}, true},
+		{"topic mismatch", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeTopic,
+			By:           &ClusterConsumerGroup_Binding_RoutingKey{RoutingKey: "payments.*"},
+			Filter:       "region = 'eu'",
+		}, false},
+		{"headers without headers", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeHeaders,
+			Filter:       "region = 'eu'",
+		}, true},
+		{"unknown", &ClusterConsumerGroup_Binding{
+			ExchangeType: emq.ExchangeTypeFanout,
+			Filter:       "missing <> 'eu'",
+		}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.binding.TopicName = "orders"
+			consumerGroup := &ClusterConsumerGroup{Bindings: []*ClusterConsumerGroup_Binding{test.binding}}
+			if got := messageMatches(message, time.Now(), "orders", consumerGroup); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestFilterCache(t *testing.T) {
+	cache := newFilterCache(2)
+
+	for _, source := range []string{"a = 1", "b = 1", "a = 1", "c = 1"} {
+		if _, ok := cache.Get(source); ok {
+			continue
+		}
+		expr, err := filter.Parse(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cache.Add(source, expr)
+	}
+
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 cached filters, got %d", cache.Len())
+	}
+	// "b = 1" is the least recently used one
+	for source, expected := range map[string]bool{"a = 1": true, "b = 1": false, "c = 1": true} {
+		if _, ok := cache.Get(source); ok != expected {
+			t.Errorf("expected %q cached %v, got %v", source, expected, ok)
+		}
+	}
+}
+
+func TestSearchMatches(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Minute)
+	after := now.Add(time.Minute)
+
+	message := &emq.Message{
+		RoutingKey: "orders.created",
+		Headers: &types.Struct{Fields: map[string]*types.Value{
+			"region": {Kind: &types.Value_StringValue{StringValue: "eu"}},
+		}},
+		Data: []byte(`{"order":{"id":42,"items":[{"sku":"abc"}]}}`),
+	}
+
+	tests := []struct {
+		name     string
+		request  *emq.TopicSearchRequest
+		expected bool
+	}{
+		{"empty", &emq.TopicSearchRequest{}, true},
+		{"since", &emq.TopicSearchRequest{Since: &before}, true},
+		{"since after", &emq.TopicSearchRequest{Since: &after}, false},
+		{"until", &emq.TopicSearchRequest{Until: &after}, true},
+		{"until before", &emq.TopicSearchRequest{Until: &before}, false},
+		{"until exclusive", &emq.TopicSearchRequest{Until: &now}, false},
+		{"routing key", &emq.TopicSearchRequest{RoutingKey: "orders.*"}, true},
+		{"routing key mismatch", &emq.TopicSearchRequest{RoutingKey: "payments.#"}, false},
+		{"headers all", &emq.TopicSearchRequest{HeadersAll: &types.Struct{Fields: map[string]*types.Value{
+			"region": {Kind: &types.Value_StringValue{StringValue: "eu"}},
+		}}}, true},
+		{"headers all mismatch", &emq.TopicSearchRequest{HeadersAll: &types.Struct{Fields: map[string]*types.Value{
+			"region": {Kind: &types.Value_StringValue{StringValue: "eu"}},
+			"tier":   {Kind: &types.Value_StringValue{StringValue: "gold"}},
+		}}}, false},
+		{"headers any", &emq.TopicSearchRequest{HeadersAny: &types.Struct{Fields: map[string]*types.Value{
+			"region": {Kind: &types.Value_StringValue{StringValue: "us"}},
+			"tier":   {Kind: &types.Value_StringValue{StringValue: "gold"}},
+		}}}, false},
+		{"data contains", &emq.TopicSearchRequest{DataContains: `"sku":"abc"`}, true},
+		{"data contains mismatch", &emq.TopicSearchRequest{DataContains: "xyz"}, false},
+		{"json path", &emq.TopicSearchRequest{DataJSONPath: "order.items.0.sku"}, true},
+		{"json path missing", &emq.TopicSearchRequest{DataJSONPath: "order.items.1.sku"}, false},
+		{"json path value", &emq.TopicSearchRequest{
+			DataJSONPath:  "$.order.id",
+			DataJSONValue: &types.Value{Kind: &types.Value_NumberValue{NumberValue: 42}},
+		}, true},
+		{"json path value mismatch", &emq.TopicSearchRequest{
+			DataJSONPath:  "order.id",
+			DataJSONValue: &types.Value{Kind: &types.Value_StringValue{StringValue: "42"}},
+		}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := searchMatches(message, now, test.request); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
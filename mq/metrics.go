@@ -0,0 +1,196 @@
+package mq
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace      = "eventtermq"
+	publishRouteLocal     = "local"
+	publishRouteForwarded = "forwarded"
+	publishRouteDuplicate = "duplicate"
+	amqpProtocolV0        = "0-9-1"
+	amqpProtocolV1        = "1.0"
+)
+
+var (
+	publishesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "publishes_total",
+		Help:      "Messages published to topic. Route is either local (written to segment on this node), forwarded (to another node), or duplicate (acknowledged, but not written again).",
+	}, []string{"namespace", "topic", "route"})
+	publishDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "publish_duration_seconds",
+		Help:      "Latency of successful publishes to topic.",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"namespace", "topic"})
+	raftApplyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "raft_apply_duration_seconds",
+		Help:      "Latency of cluster state commands applied through Raft.",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+	})
+	reconcilerActionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconciler_actions_total",
+		Help:      "Cluster state commands issued by reconciler.",
+	}, []string{"action"})
+	amqpConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "amqp_connections",
+		Help:      "Open AMQP connections.",
+	}, []string{"protocol"})
+	amqpChannels = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "amqp_channels",
+		Help:      "Open AMQP 0.9.1 channels, or AMQP 1.0 sessions.",
+	}, []string{"protocol"})
+	amqpLinks = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "amqp_links",
+		Help:      "Attached AMQP 1.0 links.",
+	})
+
+	segmentBytesDesc = prometheus.NewDesc(
+		metricsNamespace+"_segment_bytes",
+		"Size of segment files stored on this node.",
+		nil, nil,
+	)
+	segmentFilesDesc = prometheus.NewDesc(
+		metricsNamespace+"_segment_files",
+		"Number of segment files stored on this node.",
+		nil, nil,
+	)
+	openSegmentsDesc = prometheus.NewDesc(
+		metricsNamespace+"_open_segments",
+		"Number of open segments this node is primary for.",
+		[]string{"type"}, nil,
+	)
+	consumerGroupLagDesc = prometheus.NewDesc(
+		metricsNamespace+"_consumer_group_lag_bytes",
+		"Bytes of topic segments not yet consumed by consumer group running on this node (computed from offsets committed to cluster state).",
+		[]string{"namespace", "consumer_group"}, nil,
+	)
+	consumerGroupWaitingDesc = prometheus.NewDesc(
+		metricsNamespace+"_consumer_group_waiting_messages",
+		"Messages buffered in consumer group running on this node waiting to be delivered to subscriber.",
+		[]string{"namespace", "consumer_group"}, nil,
+	)
+	consumerGroupInflightDesc = prometheus.NewDesc(
+		metricsNamespace+"_consumer_group_inflight_messages",
+		"Messages delivered to subscribers of consumer group running on this node, not (n)acked yet.",
+		[]string{"namespace", "consumer_group"}, nil,
+	)
+	raftStateDesc = prometheus.NewDesc(
+		metricsNamespace+"_raft_state",
+		"Raft state of this node (1 for current state, 0 otherwise).",
+		[]string{"state"}, nil,
+	)
+	clusterStateIndexDesc = prometheus.NewDesc(
+		metricsNamespace+"_cluster_state_index",
+		"Raft index of cluster state applied on this node.",
+		nil, nil,
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		publishesTotal,
+		publishDuration,
+		raftApplyDuration,
+		reconcilerActionsTotal,
+		amqpConnections,
+		amqpChannels,
+		amqpLinks,
+	)
+}
+
+func reconcilerActionName(cmd interface{}) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", cmd), "*mq.ClusterCommand")
+}
+
+var _ prometheus.Collector = (*Server)(nil)
+
+func (s *Server) Describe(ch chan<- *prometheus.Desc) {
+	ch <- segmentBytesDesc
+	ch <- segmentFilesDesc
+	ch <- openSegmentsDesc
+	ch <- consumerGroupLagDesc
+	ch <- consumerGroupWaitingDesc
+	ch <- consumerGroupInflightDesc
+	ch <- raftStateDesc
+	ch <- clusterStateIndexDesc
+}
+
+func (s *Server) Collect(ch chan<- prometheus.Metric) {
+	state := s.clusterState.Current()
+
+	localSizes := make(map[uint64]int64)
+	if infos, err := s.segmentDir.List(); err == nil {
+		var bytes int64
+		for _, info := range infos {
+			localSizes[info.ID] = info.Size
+			bytes += info.Size
+		}
+		ch <- prometheus.MustNewConstMetric(segmentBytesDesc, prometheus.GaugeValue, float64(bytes))
+		ch <- prometheus.MustNewConstMetric(segmentFilesDesc, prometheus.GaugeValue, float64(len(infos)))
+	}
+
+	openSegments := make(map[ClusterSegment_Type]int)
+	for _, segment := range state.OpenSegments {
+		if segment.Nodes.PrimaryNodeID == s.nodeID {
+			openSegments[segment.Type]++
+		}
+	}
+	for _, segmentType := range []ClusterSegment_Type{ClusterSegment_TOPIC, ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS} {
+		ch <- prometheus.MustNewConstMetric(openSegmentsDesc, prometheus.GaugeValue, float64(openSegments[segmentType]), strings.ToLower(segmentType.String()))
+	}
+
+	for _, namespace := range state.Namespaces {
+		for _, consumerGroup := range namespace.ConsumerGroups {
+			s.groupMutex.RLock()
+			group, ok := s.groups[s.makeConsumerGroupMapKey(namespace.Name, consumerGroup.Name)]
+			s.groupMutex.RUnlock()
+			if !ok {
+				continue
+			}
+
+			var lag int64
+			for _, commit := range consumerGroup.OffsetCommits {
+				segment := state.GetSegment(commit.SegmentID)
+				if segment == nil {
+					continue
+				}
+				size := segment.Size_
+				if segment.ClosedAt.IsZero() {
+					size = localSizes[segment.ID]
+				}
+				if size > commit.Offset {
+					lag += size - commit.Offset
+				}
+			}
+
+			stats := group.Stats()
+
+			ch <- prometheus.MustNewConstMetric(consumerGroupLagDesc, prometheus.GaugeValue, float64(lag), namespace.Name, consumerGroup.Name)
+			ch <- prometheus.MustNewConstMetric(consumerGroupWaitingDesc, prometheus.GaugeValue, float64(stats.Waiting), namespace.Name, consumerGroup.Name)
+			ch <- prometheus.MustNewConstMetric(consumerGroupInflightDesc, prometheus.GaugeValue, float64(stats.InFlight), namespace.Name, consumerGroup.Name)
+		}
+	}
+
+	raftState := s.raftNode.State()
+	for _, state := range []raft.RaftState{raft.Follower, raft.Candidate, raft.Leader, raft.Shutdown} {
+		value := 0.0
+		if state == raftState {
+			value = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(raftStateDesc, prometheus.GaugeValue, value, strings.ToLower(state.String()))
+	}
+
+	ch <- prometheus.MustNewConstMetric(clusterStateIndexDesc, prometheus.GaugeValue, float64(state.Index))
+}
@@ -0,0 +1,39 @@
+package mq
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Collect(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	registry := prometheus.NewRegistry()
+	assert.NoError(registry.Register(ts.Server))
+
+	families, err := registry.Gather()
+	assert.NoError(err)
+
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			name := family.GetName()
+			for _, label := range metric.Label {
+				name += "/" + label.GetValue()
+			}
+			values[name] = metric.GetGauge().GetValue()
+		}
+	}
+
+	assert.Equal(1.0, values["eventtermq_raft_state/leader"])
+	assert.Equal(0.0, values["eventtermq_raft_state/follower"])
+	assert.Equal(0.0, values["eventtermq_open_segments/topic"])
+	assert.Contains(values, "eventtermq_cluster_state_index")
+	assert.Contains(values, "eventtermq_segment_bytes")
+}
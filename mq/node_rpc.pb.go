@@ -7,16 +7,22 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,7 +39,7 @@ func (m *DebugRequest) Reset()         { *m = DebugRequest{} }
 func (m *DebugRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugRequest) ProtoMessage()    {}
 func (*DebugRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{0}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{0}
 }
 func (m *DebugRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -66,16 +72,18 @@ type DebugResponse struct {
 	// Cluster state dumped to string.
 	ClusterState string `protobuf:"bytes,1,opt,name=cluster_state,json=clusterState,proto3" json:"cluster_state,omitempty"`
 	// Open segments info dumped to string.
-	Segments             []string `protobuf:"bytes,2,rep,name=segments" json:"segments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Segments []string `protobuf:"bytes,2,rep,name=segments" json:"segments,omitempty"`
+	// Segments whose replicas are not spread across as many zones as they could be.
+	UnderDiversifiedSegments []string `protobuf:"bytes,3,rep,name=under_diversified_segments,json=underDiversifiedSegments" json:"under_diversified_segments,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *DebugResponse) Reset()         { *m = DebugResponse{} }
 func (m *DebugResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugResponse) ProtoMessage()    {}
 func (*DebugResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{1}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{1}
 }
 func (m *DebugResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,6 +126,13 @@ func (m *DebugResponse) GetSegments() []string {
 	return nil
 }
 
+func (m *DebugResponse) GetUnderDiversifiedSegments() []string {
+	if m != nil {
+		return m.UnderDiversifiedSegments
+	}
+	return nil
+}
+
 type ConsumerGroupWaitRequest struct {
 	DoNotForward         bool     `protobuf:"varint,99,opt,name=do_not_forward,json=doNotForward,proto3" json:"do_not_forward,omitempty"`
 	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -130,7 +145,7 @@ func (m *ConsumerGroupWaitRequest) Reset()         { *m = ConsumerGroupWaitReque
 func (m *ConsumerGroupWaitRequest) String() string { return proto.CompactTextString(m) }
 func (*ConsumerGroupWaitRequest) ProtoMessage()    {}
 func (*ConsumerGroupWaitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{2}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{2}
 }
 func (m *ConsumerGroupWaitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +204,7 @@ func (m *ConsumerGroupWaitResponse) Reset()         { *m = ConsumerGroupWaitResp
 func (m *ConsumerGroupWaitResponse) String() string { return proto.CompactTextString(m) }
 func (*ConsumerGroupWaitResponse) ProtoMessage()    {}
 func (*ConsumerGroupWaitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{3}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{3}
 }
 func (m *ConsumerGroupWaitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +247,7 @@ func (m *SubscriptionResizeRequest) Reset()         { *m = SubscriptionResizeReq
 func (m *SubscriptionResizeRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionResizeRequest) ProtoMessage()    {}
 func (*SubscriptionResizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{4}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{4}
 }
 func (m *SubscriptionResizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +313,7 @@ func (m *SubscriptionResizeResponse) Reset()         { *m = SubscriptionResizeRe
 func (m *SubscriptionResizeResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionResizeResponse) ProtoMessage()    {}
 func (*SubscriptionResizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{5}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{5}
 }
 func (m *SubscriptionResizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,20 +344,22 @@ var xxx_messageInfo_SubscriptionResizeResponse proto.InternalMessageInfo
 
 type SegmentOpenRequest struct {
 	// If true and node is not a leader, request will fail.
-	LeaderOnly           bool                `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
-	NodeID               uint64              `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Type                 ClusterSegment_Type `protobuf:"varint,2,opt,name=type,proto3,enum=io.eventter.mq.ClusterSegment_Type" json:"type,omitempty"`
-	OwnerNamespace       string              `protobuf:"bytes,3,opt,name=owner_namespace,json=ownerNamespace,proto3" json:"owner_namespace,omitempty"`
-	OwnerName            string              `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	LeaderOnly     bool                `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	NodeID         uint64              `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Type           ClusterSegment_Type `protobuf:"varint,2,opt,name=type,proto3,enum=io.eventter.mq.ClusterSegment_Type" json:"type,omitempty"`
+	OwnerNamespace string              `protobuf:"bytes,3,opt,name=owner_namespace,json=ownerNamespace,proto3" json:"owner_namespace,omitempty"`
+	OwnerName      string              `protobuf:"bytes,4,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	// Shard of partitioned topic the segment is opened for. Zero means any shard.
+	Shard                uint32   `protobuf:"varint,5,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentOpenRequest) Reset()         { *m = SegmentOpenRequest{} }
 func (m *SegmentOpenRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentOpenRequest) ProtoMessage()    {}
 func (*SegmentOpenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{6}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{6}
 }
 func (m *SegmentOpenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,6 +423,13 @@ func (m *SegmentOpenRequest) GetOwnerName() string {
 	return ""
 }
 
+func (m *SegmentOpenRequest) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
 type SegmentOpenResponse struct {
 	SegmentID            uint64   `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
 	PrimaryNodeID        uint64   `protobuf:"varint,2,opt,name=primary_node_id,json=primaryNodeId,proto3" json:"primary_node_id,omitempty"`
@@ -417,7 +441,7 @@ func (m *SegmentOpenResponse) Reset()         { *m = SegmentOpenResponse{} }
 func (m *SegmentOpenResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentOpenResponse) ProtoMessage()    {}
 func (*SegmentOpenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{7}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{7}
 }
 func (m *SegmentOpenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +500,7 @@ func (m *SegmentCloseRequest) Reset()         { *m = SegmentCloseRequest{} }
 func (m *SegmentCloseRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentCloseRequest) ProtoMessage()    {}
 func (*SegmentCloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{8}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{8}
 }
 func (m *SegmentCloseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +580,7 @@ func (m *SegmentCloseResponse) Reset()         { *m = SegmentCloseResponse{} }
 func (m *SegmentCloseResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentCloseResponse) ProtoMessage()    {}
 func (*SegmentCloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{9}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{9}
 }
 func (m *SegmentCloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +620,7 @@ func (m *SegmentSumRequest) Reset()         { *m = SegmentSumRequest{} }
 func (m *SegmentSumRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentSumRequest) ProtoMessage()    {}
 func (*SegmentSumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{10}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{10}
 }
 func (m *SegmentSumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +675,7 @@ func (m *SegmentSumResponse) Reset()         { *m = SegmentSumResponse{} }
 func (m *SegmentSumResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentSumResponse) ProtoMessage()    {}
 func (*SegmentSumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{11}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{11}
 }
 func (m *SegmentSumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +739,7 @@ func (m *SegmentReadRequest) Reset()         { *m = SegmentReadRequest{} }
 func (m *SegmentReadRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentReadRequest) ProtoMessage()    {}
 func (*SegmentReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{12}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{12}
 }
 func (m *SegmentReadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +802,7 @@ func (m *SegmentReadResponse) Reset()         { *m = SegmentReadResponse{} }
 func (m *SegmentReadResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentReadResponse) ProtoMessage()    {}
 func (*SegmentReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_rpc_91fe4660183419af, []int{13}
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{13}
 }
 func (m *SegmentReadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,1086 +859,3520 @@ func (m *SegmentReadResponse) GetCommitOffset() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*DebugRequest)(nil), "io.eventter.mq.DebugRequest")
-	proto.RegisterType((*DebugResponse)(nil), "io.eventter.mq.DebugResponse")
-	proto.RegisterType((*ConsumerGroupWaitRequest)(nil), "io.eventter.mq.ConsumerGroupWaitRequest")
-	proto.RegisterType((*ConsumerGroupWaitResponse)(nil), "io.eventter.mq.ConsumerGroupWaitResponse")
-	proto.RegisterType((*SubscriptionResizeRequest)(nil), "io.eventter.mq.SubscriptionResizeRequest")
-	proto.RegisterType((*SubscriptionResizeResponse)(nil), "io.eventter.mq.SubscriptionResizeResponse")
-	proto.RegisterType((*SegmentOpenRequest)(nil), "io.eventter.mq.SegmentOpenRequest")
-	proto.RegisterType((*SegmentOpenResponse)(nil), "io.eventter.mq.SegmentOpenResponse")
-	proto.RegisterType((*SegmentCloseRequest)(nil), "io.eventter.mq.SegmentCloseRequest")
-	proto.RegisterType((*SegmentCloseResponse)(nil), "io.eventter.mq.SegmentCloseResponse")
-	proto.RegisterType((*SegmentSumRequest)(nil), "io.eventter.mq.SegmentSumRequest")
-	proto.RegisterType((*SegmentSumResponse)(nil), "io.eventter.mq.SegmentSumResponse")
-	proto.RegisterType((*SegmentReadRequest)(nil), "io.eventter.mq.SegmentReadRequest")
-	proto.RegisterType((*SegmentReadResponse)(nil), "io.eventter.mq.SegmentReadResponse")
+type SegmentIndexReadRequest struct {
+	SegmentID            uint64   `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *SegmentIndexReadRequest) Reset()         { *m = SegmentIndexReadRequest{} }
+func (m *SegmentIndexReadRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndexReadRequest) ProtoMessage()    {}
+func (*SegmentIndexReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{14}
+}
+func (m *SegmentIndexReadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentIndexReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentIndexReadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SegmentIndexReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndexReadRequest.Merge(dst, src)
+}
+func (m *SegmentIndexReadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentIndexReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndexReadRequest.DiscardUnknown(m)
+}
 
-// Client API for NodeRPC service
+var xxx_messageInfo_SegmentIndexReadRequest proto.InternalMessageInfo
 
-type NodeRPCClient interface {
-	Debug(ctx context.Context, in *DebugRequest, opts ...grpc.CallOption) (*DebugResponse, error)
-	ConsumerGroupWait(ctx context.Context, in *ConsumerGroupWaitRequest, opts ...grpc.CallOption) (*ConsumerGroupWaitResponse, error)
-	SubscriptionResize(ctx context.Context, in *SubscriptionResizeRequest, opts ...grpc.CallOption) (*SubscriptionResizeResponse, error)
-	SegmentOpen(ctx context.Context, in *SegmentOpenRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error)
-	SegmentRotate(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error)
-	SegmentClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error)
-	SegmentReplicaClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error)
-	SegmentSum(ctx context.Context, in *SegmentSumRequest, opts ...grpc.CallOption) (*SegmentSumResponse, error)
-	SegmentRead(ctx context.Context, in *SegmentReadRequest, opts ...grpc.CallOption) (NodeRPC_SegmentReadClient, error)
+func (m *SegmentIndexReadRequest) GetSegmentID() uint64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
 }
 
-type nodeRPCClient struct {
-	cc *grpc.ClientConn
+type SegmentIndexReadResponse struct {
+	// False if node has not built index of the segment (yet).
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// Serialized SegmentIndex.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewNodeRPCClient(cc *grpc.ClientConn) NodeRPCClient {
-	return &nodeRPCClient{cc}
+func (m *SegmentIndexReadResponse) Reset()         { *m = SegmentIndexReadResponse{} }
+func (m *SegmentIndexReadResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndexReadResponse) ProtoMessage()    {}
+func (*SegmentIndexReadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{15}
 }
-
-func (c *nodeRPCClient) Debug(ctx context.Context, in *DebugRequest, opts ...grpc.CallOption) (*DebugResponse, error) {
-	out := new(DebugResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/Debug", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SegmentIndexReadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *nodeRPCClient) ConsumerGroupWait(ctx context.Context, in *ConsumerGroupWaitRequest, opts ...grpc.CallOption) (*ConsumerGroupWaitResponse, error) {
-	out := new(ConsumerGroupWaitResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/ConsumerGroupWait", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SegmentIndexReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentIndexReadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *SegmentIndexReadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndexReadResponse.Merge(dst, src)
+}
+func (m *SegmentIndexReadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentIndexReadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndexReadResponse.DiscardUnknown(m)
 }
 
-func (c *nodeRPCClient) SubscriptionResize(ctx context.Context, in *SubscriptionResizeRequest, opts ...grpc.CallOption) (*SubscriptionResizeResponse, error) {
-	out := new(SubscriptionResizeResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SubscriptionResize", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SegmentIndexReadResponse proto.InternalMessageInfo
+
+func (m *SegmentIndexReadResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return out, nil
+	return false
 }
 
-func (c *nodeRPCClient) SegmentOpen(ctx context.Context, in *SegmentOpenRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error) {
-	out := new(SegmentOpenResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentOpen", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SegmentIndexReadResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *nodeRPCClient) SegmentRotate(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error) {
-	out := new(SegmentOpenResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentRotate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type NodeDrainRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly bool   `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	NodeID     uint64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// If true, draining will be cancelled and node will be eligible for new segments & consumer groups again.
+	Cancel               bool     `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *nodeRPCClient) SegmentClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error) {
-	out := new(SegmentCloseResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentClose", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *NodeDrainRequest) Reset()         { *m = NodeDrainRequest{} }
+func (m *NodeDrainRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeDrainRequest) ProtoMessage()    {}
+func (*NodeDrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{16}
+}
+func (m *NodeDrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeDrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *NodeDrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDrainRequest.Merge(dst, src)
+}
+func (m *NodeDrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDrainRequest.DiscardUnknown(m)
 }
 
-func (c *nodeRPCClient) SegmentReplicaClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error) {
-	out := new(SegmentCloseResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentReplicaClose", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NodeDrainRequest proto.InternalMessageInfo
+
+func (m *NodeDrainRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *nodeRPCClient) SegmentSum(ctx context.Context, in *SegmentSumRequest, opts ...grpc.CallOption) (*SegmentSumResponse, error) {
-	out := new(SegmentSumResponse)
-	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentSum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *NodeDrainRequest) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *nodeRPCClient) SegmentRead(ctx context.Context, in *SegmentReadRequest, opts ...grpc.CallOption) (NodeRPC_SegmentReadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NodeRPC_serviceDesc.Streams[0], "/io.eventter.mq.NodeRPC/SegmentRead", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &nodeRPCSegmentReadClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *NodeDrainRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
 	}
-	return x, nil
+	return false
 }
 
-type NodeRPC_SegmentReadClient interface {
-	Recv() (*SegmentReadResponse, error)
-	grpc.ClientStream
+type NodeDrainResponse struct {
+	NodeID     uint64                 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	AdminState ClusterNode_AdminState `protobuf:"varint,2,opt,name=admin_state,json=adminState,proto3,enum=io.eventter.mq.ClusterNode_AdminState" json:"admin_state,omitempty"`
+	// Number of open segments (including consumer group offset commits segments) still placed on the node.
+	OpenSegments uint32 `protobuf:"varint,3,opt,name=open_segments,json=openSegments,proto3" json:"open_segments,omitempty"`
+	// Number of closed segments still placed on the node.
+	ClosedSegments uint32 `protobuf:"varint,4,opt,name=closed_segments,json=closedSegments,proto3" json:"closed_segments,omitempty"`
+	// True if node is drained and does not hold any segments, therefore it can be safely removed from the cluster.
+	SafeToRemove         bool     `protobuf:"varint,5,opt,name=safe_to_remove,json=safeToRemove,proto3" json:"safe_to_remove,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type nodeRPCSegmentReadClient struct {
-	grpc.ClientStream
+func (m *NodeDrainResponse) Reset()         { *m = NodeDrainResponse{} }
+func (m *NodeDrainResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeDrainResponse) ProtoMessage()    {}
+func (*NodeDrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{17}
 }
-
-func (x *nodeRPCSegmentReadClient) Recv() (*SegmentReadResponse, error) {
-	m := new(SegmentReadResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *NodeDrainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeDrainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (dst *NodeDrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDrainResponse.Merge(dst, src)
+}
+func (m *NodeDrainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDrainResponse.DiscardUnknown(m)
 }
 
-// Server API for NodeRPC service
+var xxx_messageInfo_NodeDrainResponse proto.InternalMessageInfo
 
-type NodeRPCServer interface {
-	Debug(context.Context, *DebugRequest) (*DebugResponse, error)
-	ConsumerGroupWait(context.Context, *ConsumerGroupWaitRequest) (*ConsumerGroupWaitResponse, error)
-	SubscriptionResize(context.Context, *SubscriptionResizeRequest) (*SubscriptionResizeResponse, error)
-	SegmentOpen(context.Context, *SegmentOpenRequest) (*SegmentOpenResponse, error)
-	SegmentRotate(context.Context, *SegmentCloseRequest) (*SegmentOpenResponse, error)
-	SegmentClose(context.Context, *SegmentCloseRequest) (*SegmentCloseResponse, error)
-	SegmentReplicaClose(context.Context, *SegmentCloseRequest) (*SegmentCloseResponse, error)
-	SegmentSum(context.Context, *SegmentSumRequest) (*SegmentSumResponse, error)
-	SegmentRead(*SegmentReadRequest, NodeRPC_SegmentReadServer) error
+func (m *NodeDrainResponse) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
 }
 
-func RegisterNodeRPCServer(s *grpc.Server, srv NodeRPCServer) {
-	s.RegisterService(&_NodeRPC_serviceDesc, srv)
+func (m *NodeDrainResponse) GetAdminState() ClusterNode_AdminState {
+	if m != nil {
+		return m.AdminState
+	}
+	return ClusterNode_ACTIVE
 }
 
-func _NodeRPC_Debug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).Debug(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/Debug",
+func (m *NodeDrainResponse) GetOpenSegments() uint32 {
+	if m != nil {
+		return m.OpenSegments
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).Debug(ctx, req.(*DebugRequest))
+	return 0
+}
+
+func (m *NodeDrainResponse) GetClosedSegments() uint32 {
+	if m != nil {
+		return m.ClosedSegments
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _NodeRPC_ConsumerGroupWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumerGroupWaitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).ConsumerGroupWait(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/ConsumerGroupWait",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).ConsumerGroupWait(ctx, req.(*ConsumerGroupWaitRequest))
+func (m *NodeDrainResponse) GetSafeToRemove() bool {
+	if m != nil {
+		return m.SafeToRemove
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _NodeRPC_SubscriptionResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubscriptionResizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).SubscriptionResize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/SubscriptionResize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).SubscriptionResize(ctx, req.(*SubscriptionResizeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SegmentRebalanceStatusRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _NodeRPC_SegmentOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SegmentOpenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).SegmentOpen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/SegmentOpen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).SegmentOpen(ctx, req.(*SegmentOpenRequest))
+func (m *SegmentRebalanceStatusRequest) Reset()         { *m = SegmentRebalanceStatusRequest{} }
+func (m *SegmentRebalanceStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentRebalanceStatusRequest) ProtoMessage()    {}
+func (*SegmentRebalanceStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{18}
+}
+func (m *SegmentRebalanceStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentRebalanceStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentRebalanceStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *SegmentRebalanceStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRebalanceStatusRequest.Merge(dst, src)
+}
+func (m *SegmentRebalanceStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentRebalanceStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRebalanceStatusRequest.DiscardUnknown(m)
 }
 
-func _NodeRPC_SegmentRotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SegmentCloseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).SegmentRotate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/SegmentRotate",
+var xxx_messageInfo_SegmentRebalanceStatusRequest proto.InternalMessageInfo
+
+func (m *SegmentRebalanceStatusRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).SegmentRotate(ctx, req.(*SegmentCloseRequest))
+	return false
+}
+
+type SegmentRebalanceStatusResponse struct {
+	// Moves of closed segment replicas currently in progress.
+	Moves []*SegmentMove `protobuf:"bytes,1,rep,name=moves" json:"moves,omitempty"`
+	// Number of moves completed by the leader.
+	CompletedMoves uint64 `protobuf:"varint,2,opt,name=completed_moves,json=completedMoves,proto3" json:"completed_moves,omitempty"`
+	// Number of moves cancelled by the leader.
+	CancelledMoves       uint64                                      `protobuf:"varint,3,opt,name=cancelled_moves,json=cancelledMoves,proto3" json:"cancelled_moves,omitempty"`
+	Nodes                []*SegmentRebalanceStatusResponse_NodeUsage `protobuf:"bytes,4,rep,name=nodes" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *SegmentRebalanceStatusResponse) Reset()         { *m = SegmentRebalanceStatusResponse{} }
+func (m *SegmentRebalanceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentRebalanceStatusResponse) ProtoMessage()    {}
+func (*SegmentRebalanceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{19}
+}
+func (m *SegmentRebalanceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentRebalanceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentRebalanceStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *SegmentRebalanceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRebalanceStatusResponse.Merge(dst, src)
+}
+func (m *SegmentRebalanceStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentRebalanceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRebalanceStatusResponse.DiscardUnknown(m)
 }
 
-func _NodeRPC_SegmentClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SegmentCloseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_SegmentRebalanceStatusResponse proto.InternalMessageInfo
+
+func (m *SegmentRebalanceStatusResponse) GetMoves() []*SegmentMove {
+	if m != nil {
+		return m.Moves
 	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).SegmentClose(ctx, in)
+	return nil
+}
+
+func (m *SegmentRebalanceStatusResponse) GetCompletedMoves() uint64 {
+	if m != nil {
+		return m.CompletedMoves
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/SegmentClose",
+	return 0
+}
+
+func (m *SegmentRebalanceStatusResponse) GetCancelledMoves() uint64 {
+	if m != nil {
+		return m.CancelledMoves
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).SegmentClose(ctx, req.(*SegmentCloseRequest))
+	return 0
+}
+
+func (m *SegmentRebalanceStatusResponse) GetNodes() []*SegmentRebalanceStatusResponse_NodeUsage {
+	if m != nil {
+		return m.Nodes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NodeRPC_SegmentReplicaClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SegmentCloseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type SegmentRebalanceStatusResponse_NodeUsage struct {
+	NodeID uint64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Number of closed segments stored on the node.
+	Segments uint32 `protobuf:"varint,2,opt,name=segments,proto3" json:"segments,omitempty"`
+	// Total size of closed segments stored on the node.
+	Bytes                int64    `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) Reset() {
+	*m = SegmentRebalanceStatusResponse_NodeUsage{}
+}
+func (m *SegmentRebalanceStatusResponse_NodeUsage) String() string { return proto.CompactTextString(m) }
+func (*SegmentRebalanceStatusResponse_NodeUsage) ProtoMessage()    {}
+func (*SegmentRebalanceStatusResponse_NodeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{19, 0}
+}
+func (m *SegmentRebalanceStatusResponse_NodeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentRebalanceStatusResponse_NodeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentRebalanceStatusResponse_NodeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).SegmentReplicaClose(ctx, in)
+}
+func (dst *SegmentRebalanceStatusResponse_NodeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRebalanceStatusResponse_NodeUsage.Merge(dst, src)
+}
+func (m *SegmentRebalanceStatusResponse_NodeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentRebalanceStatusResponse_NodeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRebalanceStatusResponse_NodeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRebalanceStatusResponse_NodeUsage proto.InternalMessageInfo
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/SegmentReplicaClose",
+	return 0
+}
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) GetSegments() uint32 {
+	if m != nil {
+		return m.Segments
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).SegmentReplicaClose(ctx, req.(*SegmentCloseRequest))
+	return 0
+}
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _NodeRPC_SegmentSum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SegmentSumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type SegmentMove struct {
+	SegmentID            uint64    `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	SourceNodeID         uint64    `protobuf:"varint,2,opt,name=source_node_id,json=sourceNodeId,proto3" json:"source_node_id,omitempty"`
+	TargetNodeID         uint64    `protobuf:"varint,3,opt,name=target_node_id,json=targetNodeId,proto3" json:"target_node_id,omitempty"`
+	Size_                int64     `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	StartedAt            time.Time `protobuf:"bytes,5,opt,name=started_at,json=startedAt,stdtime" json:"started_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SegmentMove) Reset()         { *m = SegmentMove{} }
+func (m *SegmentMove) String() string { return proto.CompactTextString(m) }
+func (*SegmentMove) ProtoMessage()    {}
+func (*SegmentMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{20}
+}
+func (m *SegmentMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(NodeRPCServer).SegmentSum(ctx, in)
+}
+func (dst *SegmentMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMove.Merge(dst, src)
+}
+func (m *SegmentMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMove proto.InternalMessageInfo
+
+func (m *SegmentMove) GetSegmentID() uint64 {
+	if m != nil {
+		return m.SegmentID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/io.eventter.mq.NodeRPC/SegmentSum",
+	return 0
+}
+
+func (m *SegmentMove) GetSourceNodeID() uint64 {
+	if m != nil {
+		return m.SourceNodeID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeRPCServer).SegmentSum(ctx, req.(*SegmentSumRequest))
+	return 0
+}
+
+func (m *SegmentMove) GetTargetNodeID() uint64 {
+	if m != nil {
+		return m.TargetNodeID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _NodeRPC_SegmentRead_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SegmentReadRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *SegmentMove) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
 	}
-	return srv.(NodeRPCServer).SegmentRead(m, &nodeRPCSegmentReadServer{stream})
+	return 0
 }
 
-type NodeRPC_SegmentReadServer interface {
-	Send(*SegmentReadResponse) error
-	grpc.ServerStream
+func (m *SegmentMove) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
 }
 
-type nodeRPCSegmentReadServer struct {
-	grpc.ServerStream
+type ClusterStateSnapshotRequest struct {
+	// If true and node is not a leader, request will fail.
+	LeaderOnly           bool     `protobuf:"varint,99,opt,name=leader_only,json=leaderOnly,proto3" json:"leader_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *nodeRPCSegmentReadServer) Send(m *SegmentReadResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *ClusterStateSnapshotRequest) Reset()         { *m = ClusterStateSnapshotRequest{} }
+func (m *ClusterStateSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterStateSnapshotRequest) ProtoMessage()    {}
+func (*ClusterStateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{21}
+}
+func (m *ClusterStateSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterStateSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterStateSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ClusterStateSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterStateSnapshotRequest.Merge(dst, src)
+}
+func (m *ClusterStateSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterStateSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterStateSnapshotRequest.DiscardUnknown(m)
 }
 
-var _NodeRPC_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "io.eventter.mq.NodeRPC",
-	HandlerType: (*NodeRPCServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Debug",
-			Handler:    _NodeRPC_Debug_Handler,
-		},
-		{
-			MethodName: "ConsumerGroupWait",
-			Handler:    _NodeRPC_ConsumerGroupWait_Handler,
-		},
-		{
-			MethodName: "SubscriptionResize",
-			Handler:    _NodeRPC_SubscriptionResize_Handler,
-		},
-		{
-			MethodName: "SegmentOpen",
-			Handler:    _NodeRPC_SegmentOpen_Handler,
-		},
-		{
-			MethodName: "SegmentRotate",
-			Handler:    _NodeRPC_SegmentRotate_Handler,
-		},
-		{
-			MethodName: "SegmentClose",
-			Handler:    _NodeRPC_SegmentClose_Handler,
-		},
-		{
-			MethodName: "SegmentReplicaClose",
-			Handler:    _NodeRPC_SegmentReplicaClose_Handler,
-		},
-		{
-			MethodName: "SegmentSum",
-			Handler:    _NodeRPC_SegmentSum_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "SegmentRead",
-			Handler:       _NodeRPC_SegmentRead_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "node_rpc.proto",
+var xxx_messageInfo_ClusterStateSnapshotRequest proto.InternalMessageInfo
+
+func (m *ClusterStateSnapshotRequest) GetLeaderOnly() bool {
+	if m != nil {
+		return m.LeaderOnly
+	}
+	return false
 }
 
-func (m *DebugRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+type ClusterStateSnapshotResponse struct {
+	// Cluster state as committed by the leader at the time of the request.
+	ClusterState         *ClusterState `protobuf:"bytes,1,opt,name=cluster_state,json=clusterState" json:"cluster_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClusterStateSnapshotResponse) Reset()         { *m = ClusterStateSnapshotResponse{} }
+func (m *ClusterStateSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*ClusterStateSnapshotResponse) ProtoMessage()    {}
+func (*ClusterStateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_rpc_d3c3101a9d592348, []int{22}
+}
+func (m *ClusterStateSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterStateSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterStateSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *ClusterStateSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterStateSnapshotResponse.Merge(dst, src)
+}
+func (m *ClusterStateSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterStateSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterStateSnapshotResponse.DiscardUnknown(m)
 }
 
-func (m *DebugRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+var xxx_messageInfo_ClusterStateSnapshotResponse proto.InternalMessageInfo
+
+func (m *ClusterStateSnapshotResponse) GetClusterState() *ClusterState {
+	if m != nil {
+		return m.ClusterState
+	}
+	return nil
 }
 
-func (m *DebugResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func init() {
+	proto.RegisterType((*DebugRequest)(nil), "io.eventter.mq.DebugRequest")
+	proto.RegisterType((*DebugResponse)(nil), "io.eventter.mq.DebugResponse")
+	proto.RegisterType((*ConsumerGroupWaitRequest)(nil), "io.eventter.mq.ConsumerGroupWaitRequest")
+	proto.RegisterType((*ConsumerGroupWaitResponse)(nil), "io.eventter.mq.ConsumerGroupWaitResponse")
+	proto.RegisterType((*SubscriptionResizeRequest)(nil), "io.eventter.mq.SubscriptionResizeRequest")
+	proto.RegisterType((*SubscriptionResizeResponse)(nil), "io.eventter.mq.SubscriptionResizeResponse")
+	proto.RegisterType((*SegmentOpenRequest)(nil), "io.eventter.mq.SegmentOpenRequest")
+	proto.RegisterType((*SegmentOpenResponse)(nil), "io.eventter.mq.SegmentOpenResponse")
+	proto.RegisterType((*SegmentCloseRequest)(nil), "io.eventter.mq.SegmentCloseRequest")
+	proto.RegisterType((*SegmentCloseResponse)(nil), "io.eventter.mq.SegmentCloseResponse")
+	proto.RegisterType((*SegmentSumRequest)(nil), "io.eventter.mq.SegmentSumRequest")
+	proto.RegisterType((*SegmentSumResponse)(nil), "io.eventter.mq.SegmentSumResponse")
+	proto.RegisterType((*SegmentReadRequest)(nil), "io.eventter.mq.SegmentReadRequest")
+	proto.RegisterType((*SegmentReadResponse)(nil), "io.eventter.mq.SegmentReadResponse")
+	proto.RegisterType((*SegmentIndexReadRequest)(nil), "io.eventter.mq.SegmentIndexReadRequest")
+	proto.RegisterType((*SegmentIndexReadResponse)(nil), "io.eventter.mq.SegmentIndexReadResponse")
+	proto.RegisterType((*NodeDrainRequest)(nil), "io.eventter.mq.NodeDrainRequest")
+	proto.RegisterType((*NodeDrainResponse)(nil), "io.eventter.mq.NodeDrainResponse")
+	proto.RegisterType((*SegmentRebalanceStatusRequest)(nil), "io.eventter.mq.SegmentRebalanceStatusRequest")
+	proto.RegisterType((*SegmentRebalanceStatusResponse)(nil), "io.eventter.mq.SegmentRebalanceStatusResponse")
+	proto.RegisterType((*SegmentRebalanceStatusResponse_NodeUsage)(nil), "io.eventter.mq.SegmentRebalanceStatusResponse.NodeUsage")
+	proto.RegisterType((*SegmentMove)(nil), "io.eventter.mq.SegmentMove")
+	proto.RegisterType((*ClusterStateSnapshotRequest)(nil), "io.eventter.mq.ClusterStateSnapshotRequest")
+	proto.RegisterType((*ClusterStateSnapshotResponse)(nil), "io.eventter.mq.ClusterStateSnapshotResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for NodeRPC service
+
+type NodeRPCClient interface {
+	Debug(ctx context.Context, in *DebugRequest, opts ...grpc.CallOption) (*DebugResponse, error)
+	ConsumerGroupWait(ctx context.Context, in *ConsumerGroupWaitRequest, opts ...grpc.CallOption) (*ConsumerGroupWaitResponse, error)
+	SubscriptionResize(ctx context.Context, in *SubscriptionResizeRequest, opts ...grpc.CallOption) (*SubscriptionResizeResponse, error)
+	SegmentOpen(ctx context.Context, in *SegmentOpenRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error)
+	SegmentRotate(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error)
+	SegmentClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error)
+	SegmentReplicaClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error)
+	SegmentSum(ctx context.Context, in *SegmentSumRequest, opts ...grpc.CallOption) (*SegmentSumResponse, error)
+	SegmentRead(ctx context.Context, in *SegmentReadRequest, opts ...grpc.CallOption) (NodeRPC_SegmentReadClient, error)
+	SegmentIndexRead(ctx context.Context, in *SegmentIndexReadRequest, opts ...grpc.CallOption) (*SegmentIndexReadResponse, error)
+	NodeDrain(ctx context.Context, in *NodeDrainRequest, opts ...grpc.CallOption) (*NodeDrainResponse, error)
+	SegmentRebalanceStatus(ctx context.Context, in *SegmentRebalanceStatusRequest, opts ...grpc.CallOption) (*SegmentRebalanceStatusResponse, error)
+	ClusterStateSnapshot(ctx context.Context, in *ClusterStateSnapshotRequest, opts ...grpc.CallOption) (*ClusterStateSnapshotResponse, error)
+}
+
+type nodeRPCClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeRPCClient(cc *grpc.ClientConn) NodeRPCClient {
+	return &nodeRPCClient{cc}
+}
+
+func (c *nodeRPCClient) Debug(ctx context.Context, in *DebugRequest, opts ...grpc.CallOption) (*DebugResponse, error) {
+	out := new(DebugResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/Debug", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DebugResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterState) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.ClusterState)))
-		i += copy(dAtA[i:], m.ClusterState)
-	}
-	if len(m.Segments) > 0 {
-		for _, s := range m.Segments {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+func (c *nodeRPCClient) ConsumerGroupWait(ctx context.Context, in *ConsumerGroupWaitRequest, opts ...grpc.CallOption) (*ConsumerGroupWaitResponse, error) {
+	out := new(ConsumerGroupWaitResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/ConsumerGroupWait", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ConsumerGroupWaitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *nodeRPCClient) SubscriptionResize(ctx context.Context, in *SubscriptionResizeRequest, opts ...grpc.CallOption) (*SubscriptionResizeResponse, error) {
+	out := new(SubscriptionResizeResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SubscriptionResize", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ConsumerGroupWaitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func (c *nodeRPCClient) SegmentOpen(ctx context.Context, in *SegmentOpenRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error) {
+	out := new(SegmentOpenResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentOpen", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DoNotForward {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.DoNotForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return out, nil
+}
+
+func (c *nodeRPCClient) SegmentRotate(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentOpenResponse, error) {
+	out := new(SegmentOpenResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentRotate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ConsumerGroupWaitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *nodeRPCClient) SegmentClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error) {
+	out := new(SegmentCloseResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentClose", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ConsumerGroupWaitResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (c *nodeRPCClient) SegmentReplicaClose(ctx context.Context, in *SegmentCloseRequest, opts ...grpc.CallOption) (*SegmentCloseResponse, error) {
+	out := new(SegmentCloseResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentReplicaClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubscriptionResizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *nodeRPCClient) SegmentSum(ctx context.Context, in *SegmentSumRequest, opts ...grpc.CallOption) (*SegmentSumResponse, error) {
+	out := new(SegmentSumResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentSum", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SubscriptionResizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
-	}
-	if m.SubscriptionID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SubscriptionID))
+func (c *nodeRPCClient) SegmentRead(ctx context.Context, in *SegmentReadRequest, opts ...grpc.CallOption) (NodeRPC_SegmentReadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NodeRPC_serviceDesc.Streams[0], "/io.eventter.mq.NodeRPC/SegmentRead", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	x := &nodeRPCSegmentReadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.DoNotForward {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.DoNotForward {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *SubscriptionResizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type NodeRPC_SegmentReadClient interface {
+	Recv() (*SegmentReadResponse, error)
+	grpc.ClientStream
 }
 
-func (m *SubscriptionResizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type nodeRPCSegmentReadClient struct {
+	grpc.ClientStream
 }
 
-func (m *SegmentOpenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *nodeRPCSegmentReadClient) Recv() (*SegmentReadResponse, error) {
+	m := new(SegmentReadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *SegmentOpenRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
-	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Type))
-	}
-	if len(m.OwnerNamespace) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.OwnerNamespace)))
-		i += copy(dAtA[i:], m.OwnerNamespace)
-	}
-	if len(m.OwnerName) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.OwnerName)))
-		i += copy(dAtA[i:], m.OwnerName)
-	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *nodeRPCClient) SegmentIndexRead(ctx context.Context, in *SegmentIndexReadRequest, opts ...grpc.CallOption) (*SegmentIndexReadResponse, error) {
+	out := new(SegmentIndexReadResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentIndexRead", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *SegmentOpenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *nodeRPCClient) NodeDrain(ctx context.Context, in *NodeDrainRequest, opts ...grpc.CallOption) (*NodeDrainResponse, error) {
+	out := new(NodeDrainResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/NodeDrain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SegmentOpenResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
-	}
-	if m.PrimaryNodeID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.PrimaryNodeID))
+func (c *nodeRPCClient) SegmentRebalanceStatus(ctx context.Context, in *SegmentRebalanceStatusRequest, opts ...grpc.CallOption) (*SegmentRebalanceStatusResponse, error) {
+	out := new(SegmentRebalanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/SegmentRebalanceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *SegmentCloseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *nodeRPCClient) ClusterStateSnapshot(ctx context.Context, in *ClusterStateSnapshotRequest, opts ...grpc.CallOption) (*ClusterStateSnapshotResponse, error) {
+	out := new(ClusterStateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/io.eventter.mq.NodeRPC/ClusterStateSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SegmentCloseRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
-	}
-	if m.NodeID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
-	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+// Server API for NodeRPC service
+
+type NodeRPCServer interface {
+	Debug(context.Context, *DebugRequest) (*DebugResponse, error)
+	ConsumerGroupWait(context.Context, *ConsumerGroupWaitRequest) (*ConsumerGroupWaitResponse, error)
+	SubscriptionResize(context.Context, *SubscriptionResizeRequest) (*SubscriptionResizeResponse, error)
+	SegmentOpen(context.Context, *SegmentOpenRequest) (*SegmentOpenResponse, error)
+	SegmentRotate(context.Context, *SegmentCloseRequest) (*SegmentOpenResponse, error)
+	SegmentClose(context.Context, *SegmentCloseRequest) (*SegmentCloseResponse, error)
+	SegmentReplicaClose(context.Context, *SegmentCloseRequest) (*SegmentCloseResponse, error)
+	SegmentSum(context.Context, *SegmentSumRequest) (*SegmentSumResponse, error)
+	SegmentRead(*SegmentReadRequest, NodeRPC_SegmentReadServer) error
+	SegmentIndexRead(context.Context, *SegmentIndexReadRequest) (*SegmentIndexReadResponse, error)
+	NodeDrain(context.Context, *NodeDrainRequest) (*NodeDrainResponse, error)
+	SegmentRebalanceStatus(context.Context, *SegmentRebalanceStatusRequest) (*SegmentRebalanceStatusResponse, error)
+	ClusterStateSnapshot(context.Context, *ClusterStateSnapshotRequest) (*ClusterStateSnapshotResponse, error)
+}
+
+func RegisterNodeRPCServer(s *grpc.Server, srv NodeRPCServer) {
+	s.RegisterService(&_NodeRPC_serviceDesc, srv)
+}
+
+func _NodeRPC_Debug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Sha1) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Sha1)))
-		i += copy(dAtA[i:], m.Sha1)
+	if interceptor == nil {
+		return srv.(NodeRPCServer).Debug(ctx, in)
 	}
-	if m.OffsetCommitsUpdate != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.OffsetCommitsUpdate.Size()))
-		n1, err := m.OffsetCommitsUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/Debug",
 	}
-	if m.LeaderOnly {
-		dAtA[i] = 0x98
-		i++
-		dAtA[i] = 0x6
-		i++
-		if m.LeaderOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).Debug(ctx, req.(*DebugRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentCloseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _NodeRPC_ConsumerGroupWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupWaitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SegmentCloseResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+	if interceptor == nil {
+		return srv.(NodeRPCServer).ConsumerGroupWait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/ConsumerGroupWait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).ConsumerGroupWait(ctx, req.(*ConsumerGroupWaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentSumRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _NodeRPC_SubscriptionResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionResizeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SegmentSumRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SubscriptionResize(ctx, in)
 	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SubscriptionResize",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SubscriptionResize(ctx, req.(*SubscriptionResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentSumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _NodeRPC_SegmentOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentOpenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SegmentSumResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentOpen(ctx, in)
 	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentOpen",
 	}
-	if len(m.Sha1) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Sha1)))
-		i += copy(dAtA[i:], m.Sha1)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentOpen(ctx, req.(*SegmentOpenRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentReadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _NodeRPC_SegmentRotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentCloseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentRotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentRotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentRotate(ctx, req.(*SegmentCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentReadRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+func _NodeRPC_SegmentClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Offset))
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentClose(ctx, in)
 	}
-	if m.Wait {
-		dAtA[i] = 0x18
-		i++
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentClose",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentClose(ctx, req.(*SegmentCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentReadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _NodeRPC_SegmentReplicaClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentCloseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentReplicaClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentReplicaClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentReplicaClose(ctx, req.(*SegmentCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentReadResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+func _NodeRPC_SegmentSum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentSumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentSum(ctx, in)
 	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Offset))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentSum",
 	}
-	if m.CommitOffset != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintNodeRpc(dAtA, i, uint64(m.CommitOffset))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentSum(ctx, req.(*SegmentSumRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintNodeRpc(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _NodeRPC_SegmentRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SegmentReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return srv.(NodeRPCServer).SegmentRead(m, &nodeRPCSegmentReadServer{stream})
 }
-func (m *DebugRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+
+type NodeRPC_SegmentReadServer interface {
+	Send(*SegmentReadResponse) error
+	grpc.ServerStream
 }
 
-func (m *DebugResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ClusterState)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
-	}
-	if len(m.Segments) > 0 {
-		for _, s := range m.Segments {
-			l = len(s)
-			n += 1 + l + sovNodeRpc(uint64(l))
-		}
-	}
-	return n
+type nodeRPCSegmentReadServer struct {
+	grpc.ServerStream
 }
 
-func (m *ConsumerGroupWaitRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
+func (x *nodeRPCSegmentReadServer) Send(m *SegmentReadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeRPC_SegmentIndexRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentIndexReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentIndexRead(ctx, in)
 	}
-	if m.DoNotForward {
-		n += 3
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentIndexRead",
 	}
-	return n
-}
-
-func (m *ConsumerGroupWaitResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentIndexRead(ctx, req.(*SegmentIndexReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubscriptionResizeRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.NodeID))
+func _NodeRPC_NodeDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SubscriptionID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SubscriptionID))
+	if interceptor == nil {
+		return srv.(NodeRPCServer).NodeDrain(ctx, in)
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Size_))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/NodeDrain",
 	}
-	if m.DoNotForward {
-		n += 3
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).NodeDrain(ctx, req.(*NodeDrainRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubscriptionResizeResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *SegmentOpenRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.NodeID))
-	}
-	if m.Type != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Type))
-	}
-	l = len(m.OwnerNamespace)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
-	}
-	l = len(m.OwnerName)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
+func _NodeRPC_SegmentRebalanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentRebalanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LeaderOnly {
-		n += 3
+	if interceptor == nil {
+		return srv.(NodeRPCServer).SegmentRebalanceStatus(ctx, in)
 	}
-	return n
-}
-
-func (m *SegmentOpenResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/SegmentRebalanceStatus",
 	}
-	if m.PrimaryNodeID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.PrimaryNodeID))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).SegmentRebalanceStatus(ctx, req.(*SegmentRebalanceStatusRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SegmentCloseRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SegmentID))
-	}
-	if m.NodeID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.NodeID))
+func _NodeRPC_ClusterStateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterStateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Size_))
+	if interceptor == nil {
+		return srv.(NodeRPCServer).ClusterStateSnapshot(ctx, in)
 	}
-	l = len(m.Sha1)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.eventter.mq.NodeRPC/ClusterStateSnapshot",
 	}
-	if m.OffsetCommitsUpdate != nil {
-		l = m.OffsetCommitsUpdate.Size()
-		n += 1 + l + sovNodeRpc(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeRPCServer).ClusterStateSnapshot(ctx, req.(*ClusterStateSnapshotRequest))
 	}
-	if m.LeaderOnly {
-		n += 3
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeRPC_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "io.eventter.mq.NodeRPC",
+	HandlerType: (*NodeRPCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Debug",
+			Handler:    _NodeRPC_Debug_Handler,
+		},
+		{
+			MethodName: "ConsumerGroupWait",
+			Handler:    _NodeRPC_ConsumerGroupWait_Handler,
+		},
+		{
+			MethodName: "SubscriptionResize",
+			Handler:    _NodeRPC_SubscriptionResize_Handler,
+		},
+		{
+			MethodName: "SegmentOpen",
+			Handler:    _NodeRPC_SegmentOpen_Handler,
+		},
+		{
+			MethodName: "SegmentRotate",
+			Handler:    _NodeRPC_SegmentRotate_Handler,
+		},
+		{
+			MethodName: "SegmentClose",
+			Handler:    _NodeRPC_SegmentClose_Handler,
+		},
+		{
+			MethodName: "SegmentReplicaClose",
+			Handler:    _NodeRPC_SegmentReplicaClose_Handler,
+		},
+		{
+			MethodName: "SegmentSum",
+			Handler:    _NodeRPC_SegmentSum_Handler,
+		},
+		{
+			MethodName: "SegmentIndexRead",
+			Handler:    _NodeRPC_SegmentIndexRead_Handler,
+		},
+		{
+			MethodName: "NodeDrain",
+			Handler:    _NodeRPC_NodeDrain_Handler,
+		},
+		{
+			MethodName: "SegmentRebalanceStatus",
+			Handler:    _NodeRPC_SegmentRebalanceStatus_Handler,
+		},
+		{
+			MethodName: "ClusterStateSnapshot",
+			Handler:    _NodeRPC_ClusterStateSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SegmentRead",
+			Handler:       _NodeRPC_SegmentRead_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "node_rpc.proto",
+}
+
+func (m *DebugRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SegmentCloseResponse) Size() (n int) {
+func (m *DebugRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	return i, nil
 }
 
-func (m *SegmentSumRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SegmentID))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Size_))
+func (m *DebugResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SegmentSumResponse) Size() (n int) {
+func (m *DebugResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.SegmentID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	if len(m.ClusterState) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.ClusterState)))
+		i += copy(dAtA[i:], m.ClusterState)
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Size_))
+	if len(m.Segments) > 0 {
+		for _, s := range m.Segments {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	l = len(m.Sha1)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
+	if len(m.UnderDiversifiedSegments) > 0 {
+		for _, s := range m.UnderDiversifiedSegments {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *SegmentReadRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.SegmentID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SegmentID))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Offset))
-	}
-	if m.Wait {
-		n += 2
+func (m *ConsumerGroupWaitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SegmentReadResponse) Size() (n int) {
+func (m *ConsumerGroupWaitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.SegmentID != 0 {
-		n += 1 + sovNodeRpc(uint64(m.SegmentID))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovNodeRpc(uint64(l))
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
 	}
-	if m.Offset != 0 {
-		n += 1 + sovNodeRpc(uint64(m.Offset))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.CommitOffset != 0 {
-		n += 1 + sovNodeRpc(uint64(m.CommitOffset))
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func sovNodeRpc(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
+func (m *ConsumerGroupWaitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupWaitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SubscriptionResizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionResizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SubscriptionID))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	}
+	if m.DoNotForward {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.DoNotForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SubscriptionResizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionResizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SegmentOpenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentOpenRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Type))
+	}
+	if len(m.OwnerNamespace) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.OwnerNamespace)))
+		i += copy(dAtA[i:], m.OwnerNamespace)
+	}
+	if len(m.OwnerName) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.OwnerName)))
+		i += copy(dAtA[i:], m.OwnerName)
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Shard))
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SegmentOpenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentOpenResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.PrimaryNodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.PrimaryNodeID))
+	}
+	return i, nil
+}
+
+func (m *SegmentCloseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentCloseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	}
+	if len(m.Sha1) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Sha1)))
+		i += copy(dAtA[i:], m.Sha1)
+	}
+	if m.OffsetCommitsUpdate != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.OffsetCommitsUpdate.Size()))
+		n1, err := m.OffsetCommitsUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SegmentCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SegmentSumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentSumRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	}
+	return i, nil
+}
+
+func (m *SegmentSumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentSumResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	}
+	if len(m.Sha1) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Sha1)))
+		i += copy(dAtA[i:], m.Sha1)
+	}
+	return i, nil
+}
+
+func (m *SegmentReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.Wait {
+		dAtA[i] = 0x18
+		i++
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SegmentReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Offset))
+	}
+	if m.CommitOffset != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.CommitOffset))
+	}
+	return i, nil
+}
+
+func (m *SegmentIndexReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentIndexReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	return i, nil
+}
+
+func (m *SegmentIndexReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentIndexReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Found {
+		dAtA[i] = 0x8
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *NodeDrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
+	}
+	if m.Cancel {
+		dAtA[i] = 0x10
+		i++
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NodeDrainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeDrainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
+	}
+	if m.AdminState != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.AdminState))
+	}
+	if m.OpenSegments != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.OpenSegments))
+	}
+	if m.ClosedSegments != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.ClosedSegments))
+	}
+	if m.SafeToRemove {
+		dAtA[i] = 0x28
+		i++
+		if m.SafeToRemove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SegmentRebalanceStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentRebalanceStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SegmentRebalanceStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentRebalanceStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for _, msg := range m.Moves {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNodeRpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CompletedMoves != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.CompletedMoves))
+	}
+	if m.CancelledMoves != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.CancelledMoves))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintNodeRpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.NodeID))
+	}
+	if m.Segments != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Segments))
+	}
+	if m.Bytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Bytes))
+	}
+	return i, nil
+}
+
+func (m *SegmentMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentMove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SegmentID))
+	}
+	if m.SourceNodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.SourceNodeID))
+	}
+	if m.TargetNodeID != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.TargetNodeID))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.Size_))
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintNodeRpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)))
+	n2, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	return i, nil
+}
+
+func (m *ClusterStateSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterStateSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.LeaderOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ClusterStateSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterStateSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ClusterState != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNodeRpc(dAtA, i, uint64(m.ClusterState.Size()))
+		n3, err := m.ClusterState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func encodeVarintNodeRpc(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DebugRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DebugResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ClusterState)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	if len(m.Segments) > 0 {
+		for _, s := range m.Segments {
+			l = len(s)
+			n += 1 + l + sovNodeRpc(uint64(l))
+		}
+	}
+	if len(m.UnderDiversifiedSegments) > 0 {
+		for _, s := range m.UnderDiversifiedSegments {
+			l = len(s)
+			n += 1 + l + sovNodeRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerGroupWaitRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerGroupWaitResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SubscriptionResizeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.NodeID))
+	}
+	if m.SubscriptionID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SubscriptionID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Size_))
+	}
+	if m.DoNotForward {
+		n += 3
+	}
+	return n
+}
+
+func (m *SubscriptionResizeResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SegmentOpenRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.NodeID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Type))
+	}
+	l = len(m.OwnerNamespace)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	l = len(m.OwnerName)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Shard))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *SegmentOpenResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	if m.PrimaryNodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.PrimaryNodeID))
+	}
+	return n
+}
+
+func (m *SegmentCloseRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	if m.NodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.NodeID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Size_))
+	}
+	l = len(m.Sha1)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	if m.OffsetCommitsUpdate != nil {
+		l = m.OffsetCommitsUpdate.Size()
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *SegmentCloseResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SegmentSumRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *SegmentSumResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Size_))
+	}
+	l = len(m.Sha1)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	return n
+}
+
+func (m *SegmentReadRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Offset))
+	}
+	if m.Wait {
+		n += 2
+	}
+	return n
+}
+
+func (m *SegmentReadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Offset))
+	}
+	if m.CommitOffset != 0 {
+		n += 1 + sovNodeRpc(uint64(m.CommitOffset))
+	}
+	return n
+}
+
+func (m *SegmentIndexReadRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	return n
+}
+
+func (m *SegmentIndexReadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeDrainRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.NodeID))
+	}
+	if m.Cancel {
+		n += 2
+	}
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *NodeDrainResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.NodeID))
+	}
+	if m.AdminState != 0 {
+		n += 1 + sovNodeRpc(uint64(m.AdminState))
+	}
+	if m.OpenSegments != 0 {
+		n += 1 + sovNodeRpc(uint64(m.OpenSegments))
+	}
+	if m.ClosedSegments != 0 {
+		n += 1 + sovNodeRpc(uint64(m.ClosedSegments))
+	}
+	if m.SafeToRemove {
+		n += 2
+	}
+	return n
+}
+
+func (m *SegmentRebalanceStatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *SegmentRebalanceStatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovNodeRpc(uint64(l))
+		}
+	}
+	if m.CompletedMoves != 0 {
+		n += 1 + sovNodeRpc(uint64(m.CompletedMoves))
+	}
+	if m.CancelledMoves != 0 {
+		n += 1 + sovNodeRpc(uint64(m.CancelledMoves))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovNodeRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SegmentRebalanceStatusResponse_NodeUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.NodeID))
+	}
+	if m.Segments != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Segments))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Bytes))
+	}
+	return n
+}
+
+func (m *SegmentMove) Size() (n int) {
+	var l int
+	_ = l
+	if m.SegmentID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SegmentID))
+	}
+	if m.SourceNodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.SourceNodeID))
+	}
+	if m.TargetNodeID != 0 {
+		n += 1 + sovNodeRpc(uint64(m.TargetNodeID))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovNodeRpc(uint64(m.Size_))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovNodeRpc(uint64(l))
+	return n
+}
+
+func (m *ClusterStateSnapshotRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.LeaderOnly {
+		n += 3
+	}
+	return n
+}
+
+func (m *ClusterStateSnapshotResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterState != nil {
+		l = m.ClusterState.Size()
+		n += 1 + l + sovNodeRpc(uint64(l))
+	}
+	return n
+}
+
+func sovNodeRpc(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
 		if x == 0 {
 			break
 		}
 	}
-	return n
+	return n
+}
+func sozNodeRpc(x uint64) (n int) {
+	return sovNodeRpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DebugRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderDiversifiedSegments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnderDiversifiedSegments = append(m.UnderDiversifiedSegments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupWaitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupWaitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoNotForward = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupWaitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupWaitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupWaitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionResizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionResizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionResizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			m.SubscriptionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoNotForward = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionResizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionResizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionResizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentOpenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentOpenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ClusterSegment_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentOpenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentOpenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+			}
+			m.PrimaryNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentCloseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha1 == nil {
+				m.Sha1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommitsUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OffsetCommitsUpdate == nil {
+				m.OffsetCommitsUpdate = &ClusterCommandConsumerGroupOffsetCommitsUpdate{}
+			}
+			if err := m.OffsetCommitsUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaderOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozNodeRpc(x uint64) (n int) {
-	return sovNodeRpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SegmentCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DebugRequest) Unmarshal(dAtA []byte) error {
+func (m *SegmentSumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentSumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentSumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1933,16 +4391,85 @@ func (m *DebugRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DebugRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentSumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentSumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sha1 == nil {
+				m.Sha1 = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -1964,7 +4491,7 @@ func (m *DebugRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebugResponse) Unmarshal(dAtA []byte) error {
+func (m *SegmentReadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1987,17 +4514,17 @@ func (m *DebugResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebugResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SegmentReadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SegmentReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
 			}
-			var stringLen uint64
+			m.SegmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2007,26 +4534,16 @@ func (m *DebugResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNodeRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2036,21 +4553,31 @@ func (m *DebugResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNodeRpc
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Segments = append(m.Segments, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Wait = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2072,7 +4599,7 @@ func (m *DebugResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
+func (m *SegmentReadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2095,17 +4622,36 @@ func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupWaitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SegmentReadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupWaitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SegmentReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			}
+			m.SegmentID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2115,26 +4661,28 @@ func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNodeRpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2144,26 +4692,85 @@ func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitOffset", wireType)
+			}
+			m.CommitOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodeRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthNodeRpc
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 99:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentIndexReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodeRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentIndexReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentIndexReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
 			}
-			var v int
+			m.SegmentID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2173,12 +4780,11 @@ func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.SegmentID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoNotForward = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2200,7 +4806,7 @@ func (m *ConsumerGroupWaitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroupWaitResponse) Unmarshal(dAtA []byte) error {
+func (m *SegmentIndexReadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2219,16 +4825,67 @@ func (m *ConsumerGroupWaitResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroupWaitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroupWaitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentIndexReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentIndexReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2250,7 +4907,7 @@ func (m *ConsumerGroupWaitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionResizeRequest) Unmarshal(dAtA []byte) error {
+func (m *NodeDrainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2273,10 +4930,10 @@ func (m *SubscriptionResizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionResizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDrainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionResizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2300,28 +4957,9 @@ func (m *SubscriptionResizeRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
-			}
-			m.SubscriptionID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubscriptionID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
 			}
-			m.Size_ = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2331,14 +4969,15 @@ func (m *SubscriptionResizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Cancel = bool(v != 0)
 		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoNotForward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2355,57 +4994,7 @@ func (m *SubscriptionResizeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DoNotForward = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNodeRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNodeRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubscriptionResizeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNodeRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionResizeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionResizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2427,7 +5016,7 @@ func (m *SubscriptionResizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
+func (m *NodeDrainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2450,10 +5039,10 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentOpenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDrainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentOpenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2477,9 +5066,9 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminState", wireType)
 			}
-			m.Type = 0
+			m.AdminState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2489,16 +5078,16 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (ClusterSegment_Type(b) & 0x7F) << shift
+				m.AdminState |= (ClusterNode_AdminState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerNamespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenSegments", wireType)
 			}
-			var stringLen uint64
+			m.OpenSegments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2508,26 +5097,16 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.OpenSegments |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNodeRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedSegments", wireType)
 			}
-			var stringLen uint64
+			m.ClosedSegments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2537,24 +5116,14 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ClosedSegments |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNodeRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 99:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SafeToRemove", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2571,7 +5140,7 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
+			m.SafeToRemove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2593,7 +5162,7 @@ func (m *SegmentOpenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentOpenResponse) Unmarshal(dAtA []byte) error {
+func (m *SegmentRebalanceStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2616,36 +5185,17 @@ func (m *SegmentOpenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentOpenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SegmentRebalanceStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SegmentRebalanceStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
-			}
-			m.SegmentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SegmentID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
 			}
-			m.PrimaryNodeID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2655,11 +5205,12 @@ func (m *SegmentOpenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimaryNodeID |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2681,7 +5232,7 @@ func (m *SegmentOpenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
+func (m *SegmentRebalanceStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2704,17 +5255,17 @@ func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentCloseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SegmentRebalanceStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentCloseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SegmentRebalanceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
 			}
-			m.SegmentID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2724,16 +5275,28 @@ func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodeRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, &SegmentMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedMoves", wireType)
 			}
-			m.NodeID = 0
+			m.CompletedMoves = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2743,16 +5306,16 @@ func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= (uint64(b) & 0x7F) << shift
+				m.CompletedMoves |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledMoves", wireType)
 			}
-			m.Size_ = 0
+			m.CancelledMoves = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2762,80 +5325,16 @@ func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (int64(b) & 0x7F) << shift
+				m.CancelledMoves |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNodeRpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sha1 == nil {
-				m.Sha1 = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetCommitsUpdate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthNodeRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OffsetCommitsUpdate == nil {
-				m.OffsetCommitsUpdate = &ClusterCommandConsumerGroupOffsetCommitsUpdate{}
-			}
-			if err := m.OffsetCommitsUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2845,62 +5344,23 @@ func (m *SegmentCloseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LeaderOnly = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNodeRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNodeRpc
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SegmentCloseResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNodeRpc
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Nodes = append(m.Nodes, &SegmentRebalanceStatusResponse_NodeUsage{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentCloseResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -2922,7 +5382,7 @@ func (m *SegmentCloseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentSumRequest) Unmarshal(dAtA []byte) error {
+func (m *SegmentRebalanceStatusResponse_NodeUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2945,17 +5405,17 @@ func (m *SegmentSumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentSumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentSumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.SegmentID = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2965,16 +5425,16 @@ func (m *SegmentSumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SegmentID |= (uint64(b) & 0x7F) << shift
+				m.NodeID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
-			m.Size_ = 0
+			m.Segments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -2984,7 +5444,26 @@ func (m *SegmentSumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (int64(b) & 0x7F) << shift
+				m.Segments |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3010,7 +5489,7 @@ func (m *SegmentSumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
+func (m *SegmentMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3033,10 +5512,10 @@ func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentSumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SegmentMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentSumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SegmentMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3059,6 +5538,44 @@ func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNodeID", wireType)
+			}
+			m.SourceNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNodeID", wireType)
+			}
+			m.TargetNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodeRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
@@ -3077,11 +5594,11 @@ func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -3091,21 +5608,20 @@ func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNodeRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sha1 = append(m.Sha1[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sha1 == nil {
-				m.Sha1 = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3129,7 +5645,7 @@ func (m *SegmentSumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentReadRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterStateSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3152,53 +5668,15 @@ func (m *SegmentReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterStateSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterStateSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
-			}
-			m.SegmentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SegmentID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 99:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3215,7 +5693,7 @@ func (m *SegmentReadRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
+			m.LeaderOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -3237,7 +5715,7 @@ func (m *SegmentReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SegmentReadResponse) Unmarshal(dAtA []byte) error {
+func (m *ClusterStateSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3260,36 +5738,17 @@ func (m *SegmentReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SegmentReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterStateSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SegmentReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterStateSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentID", wireType)
-			}
-			m.SegmentID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SegmentID |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterState", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodeRpc
@@ -3299,61 +5758,25 @@ func (m *SegmentReadResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNodeRpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitOffset", wireType)
+			if m.ClusterState == nil {
+				m.ClusterState = &ClusterState{}
 			}
-			m.CommitOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNodeRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitOffset |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ClusterState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodeRpc(dAtA[iNdEx:])
@@ -3480,62 +5903,95 @@ var (
 	ErrIntOverflowNodeRpc   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("node_rpc.proto", fileDescriptor_node_rpc_91fe4660183419af) }
-
-var fileDescriptor_node_rpc_91fe4660183419af = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x2e, 0x25, 0x59, 0xb6, 0xc6, 0x12, 0x0d, 0xaf, 0x5d, 0x43, 0x66, 0x6d, 0x49, 0xa5, 0x0c,
-	0x54, 0x2d, 0x0a, 0xb5, 0x75, 0x0f, 0x45, 0x51, 0xa0, 0x07, 0x4b, 0x68, 0xa1, 0x8b, 0x6d, 0xac,
-	0xea, 0xfe, 0x5d, 0x58, 0x5a, 0x5c, 0xcb, 0x6c, 0x45, 0x2e, 0x4d, 0x2e, 0x6b, 0x28, 0x87, 0x3c,
-	0x43, 0x0e, 0x79, 0x85, 0x3c, 0x43, 0x9e, 0x20, 0x40, 0x8e, 0xb9, 0x07, 0x10, 0x02, 0xe5, 0x45,
-	0x02, 0xee, 0xae, 0x48, 0x2a, 0x32, 0x1d, 0x59, 0xc8, 0x6d, 0x77, 0x76, 0xf6, 0x9b, 0x99, 0x6f,
-	0x3e, 0xce, 0x12, 0x54, 0x97, 0x5a, 0xc4, 0xf0, 0xbd, 0x41, 0xdb, 0xf3, 0x29, 0xa3, 0x48, 0xb5,
-	0x69, 0x9b, 0xfc, 0x4f, 0x5c, 0xc6, 0x88, 0xdf, 0x76, 0x6e, 0xb4, 0x9d, 0xc1, 0x28, 0x0c, 0x18,
-	0xf1, 0x8d, 0x80, 0x99, 0x8c, 0x08, 0x27, 0x6d, 0x77, 0x48, 0x87, 0x94, 0x2f, 0xbf, 0x89, 0x56,
-	0xc2, 0xaa, 0xab, 0x50, 0xee, 0x92, 0xcb, 0x70, 0x88, 0xc9, 0x4d, 0x48, 0x02, 0xa6, 0x9f, 0x43,
-	0x45, 0xee, 0x03, 0x8f, 0xba, 0x01, 0x41, 0x4d, 0xa8, 0xcc, 0xa1, 0x55, 0x95, 0x86, 0xd2, 0x2a,
-	0xe1, 0xb2, 0x34, 0xf6, 0x23, 0x1b, 0xd2, 0x60, 0x23, 0x20, 0x43, 0x87, 0xb8, 0x2c, 0xa8, 0xe6,
-	0x1a, 0xf9, 0x56, 0x09, 0xc7, 0x7b, 0xdd, 0x87, 0x6a, 0x87, 0xba, 0x41, 0xe8, 0x10, 0xff, 0x57,
-	0x9f, 0x86, 0xde, 0x1f, 0xa6, 0xcd, 0x64, 0x34, 0x74, 0x00, 0x25, 0xd7, 0x74, 0x48, 0xe0, 0x99,
-	0x83, 0x19, 0x70, 0x62, 0x40, 0x08, 0x0a, 0xd1, 0xa6, 0x9a, 0xe3, 0x07, 0x7c, 0x8d, 0x8e, 0x40,
-	0xb5, 0xa8, 0xe1, 0x52, 0x66, 0x5c, 0x51, 0xff, 0xd6, 0xf4, 0xad, 0xea, 0xa0, 0xa1, 0xb4, 0x36,
-	0x70, 0xd9, 0xa2, 0xa7, 0x94, 0xfd, 0x22, 0x6c, 0xfa, 0x67, 0xb0, 0x7f, 0x47, 0x4c, 0x51, 0x91,
-	0xfe, 0x5c, 0x81, 0xfd, 0x7e, 0x78, 0x19, 0x0c, 0x7c, 0xdb, 0x63, 0x36, 0x75, 0x31, 0x09, 0xec,
-	0x47, 0x64, 0x96, 0x52, 0x13, 0xd6, 0x39, 0xbb, 0xb6, 0xc5, 0x13, 0x2a, 0x9c, 0xc0, 0x74, 0x52,
-	0x2f, 0x9e, 0x52, 0x8b, 0xf4, 0xba, 0xb8, 0x18, 0x1d, 0xf5, 0x2c, 0xf4, 0x13, 0x6c, 0x05, 0x29,
-	0x84, 0xc8, 0x39, 0xc7, 0x9d, 0xd1, 0x74, 0x52, 0x57, 0xd3, 0xe0, 0xbd, 0x2e, 0x56, 0xd3, 0xae,
-	0x3d, 0x2b, 0x2a, 0x2b, 0x0a, 0x58, 0xcd, 0x37, 0x94, 0x56, 0x05, 0xf3, 0xf5, 0x92, 0x65, 0x1d,
-	0x80, 0x76, 0x57, 0xe2, 0xb2, 0xae, 0xd7, 0x0a, 0xa0, 0xbe, 0x60, 0xfd, 0xcc, 0x23, 0xee, 0x83,
-	0x0a, 0xfa, 0x01, 0x0a, 0x6c, 0xec, 0x09, 0xaa, 0xd5, 0xe3, 0x66, 0x7b, 0x5e, 0x50, 0xed, 0x8e,
-	0x6c, 0xb6, 0x40, 0x6f, 0xff, 0x36, 0xf6, 0x08, 0xe6, 0x17, 0xd0, 0x17, 0xb0, 0x45, 0x6f, 0x5d,
-	0xe2, 0x1b, 0x49, 0x1f, 0xf3, 0xbc, 0x5d, 0x2a, 0x37, 0x9f, 0xc6, 0xcd, 0x3c, 0x04, 0x48, 0x1c,
-	0xab, 0x05, 0xd1, 0xeb, 0xd8, 0x07, 0xd5, 0x61, 0x73, 0x44, 0x4c, 0x8b, 0xf8, 0x06, 0x75, 0x47,
-	0x63, 0x59, 0x3d, 0x08, 0xd3, 0x99, 0x3b, 0x1a, 0xeb, 0x8f, 0x61, 0x67, 0xae, 0x38, 0x29, 0xcf,
-	0xaf, 0x01, 0xa4, 0xd2, 0x92, 0x02, 0x2b, 0xd3, 0x49, 0xbd, 0x24, 0x9d, 0x7b, 0x5d, 0x5c, 0x92,
-	0x0e, 0x3d, 0x0b, 0xfd, 0x08, 0x5b, 0x9e, 0x6f, 0x3b, 0xa6, 0x3f, 0x36, 0x66, 0x9c, 0x88, 0xbe,
-	0x6d, 0x4f, 0x27, 0xf5, 0xca, 0xb9, 0x38, 0x92, 0xd4, 0x54, 0xbc, 0xd4, 0xd6, 0xd2, 0x9f, 0xe5,
-	0xe2, 0x04, 0x3a, 0x23, 0x1a, 0xc4, 0x7a, 0x79, 0x58, 0x02, 0xa9, 0x66, 0xe4, 0x32, 0x9b, 0x91,
-	0x16, 0x48, 0x5e, 0x0a, 0x24, 0xb2, 0x5d, 0x9b, 0xdf, 0x71, 0xe2, 0xca, 0x98, 0xaf, 0x91, 0x0f,
-	0x9f, 0xd2, 0xab, 0xab, 0x80, 0x30, 0x63, 0x40, 0x1d, 0xc7, 0x66, 0x81, 0x11, 0x7a, 0x56, 0xf4,
-	0x89, 0xae, 0x35, 0x94, 0xd6, 0xe6, 0xf1, 0xcf, 0x19, 0x5d, 0xec, 0x50, 0xc7, 0x31, 0x5d, 0x6b,
-	0xee, 0x03, 0x39, 0xe3, 0x38, 0x1d, 0x01, 0x73, 0xc1, 0x51, 0xf0, 0x0e, 0x5d, 0x34, 0x7e, 0xb8,
-	0x4f, 0x7b, 0xb0, 0x3b, 0x4f, 0x93, 0x54, 0xe7, 0x05, 0x6c, 0x4b, 0x7b, 0x3f, 0x74, 0x56, 0x23,
-	0x6f, 0xc6, 0x4b, 0x2e, 0xe1, 0x45, 0xff, 0x37, 0xd6, 0x3c, 0x87, 0x5d, 0x49, 0x15, 0x77, 0xe0,
-	0xc6, 0x7c, 0xe7, 0x13, 0xbe, 0x75, 0x37, 0x8e, 0x85, 0x89, 0x69, 0xad, 0x56, 0xc3, 0x1e, 0x14,
-	0x05, 0xad, 0x32, 0x9a, 0xdc, 0x45, 0xf1, 0x6e, 0x4d, 0x9b, 0xf1, 0x78, 0x1b, 0x98, 0xaf, 0xf5,
-	0xa7, 0x4a, 0x2c, 0x39, 0x11, 0x70, 0xd5, 0xea, 0x2c, 0x93, 0x99, 0x3c, 0x5e, 0x19, 0xf3, 0x75,
-	0x2a, 0x8b, 0xfc, 0x5c, 0x16, 0xd1, 0xb0, 0xe7, 0xed, 0x36, 0xe4, 0x71, 0x81, 0x1f, 0x97, 0x85,
-	0x51, 0x88, 0xe4, 0xf8, 0x45, 0x11, 0xd6, 0x23, 0xc5, 0xe2, 0xf3, 0x0e, 0xea, 0xc2, 0x1a, 0x7f,
-	0x2e, 0xd0, 0xc1, 0xfb, 0x62, 0x4b, 0xbf, 0x2a, 0xda, 0x61, 0xc6, 0xa9, 0x2c, 0xe8, 0x1a, 0xb6,
-	0x17, 0xc6, 0x35, 0x6a, 0x2d, 0xc8, 0x37, 0xe3, 0x15, 0xd1, 0xbe, 0x5c, 0xc2, 0x53, 0x46, 0xfa,
-	0x0f, 0xd0, 0xe2, 0x04, 0x45, 0x0b, 0x00, 0x99, 0xcf, 0x83, 0xf6, 0xd5, 0x32, 0xae, 0x32, 0xd8,
-	0xef, 0xb0, 0x99, 0x1a, 0x59, 0x48, 0x5f, 0xb8, 0xba, 0x30, 0xac, 0xb5, 0xe6, 0xbd, 0x3e, 0x12,
-	0xf7, 0x2f, 0xa8, 0xcc, 0x64, 0x41, 0xf9, 0xf3, 0x9b, 0x75, 0x2b, 0x3d, 0xa8, 0x96, 0x85, 0x2e,
-	0xa7, 0xef, 0x2e, 0x87, 0x7c, 0x74, 0xbf, 0x93, 0x84, 0xfe, 0x27, 0x25, 0x66, 0x6f, 0x64, 0x0f,
-	0xcc, 0x8f, 0x1e, 0xa1, 0x0f, 0x90, 0xcc, 0x02, 0xf4, 0x79, 0xc6, 0x9d, 0x64, 0xfc, 0x68, 0xfa,
-	0x7d, 0x2e, 0x12, 0xf4, 0xcf, 0xb8, 0x89, 0xd1, 0x37, 0x98, 0xd9, 0xc4, 0xd4, 0x44, 0xc8, 0x64,
-	0x3a, 0xfd, 0x11, 0x7f, 0xab, 0x9c, 0xec, 0xbe, 0x9c, 0xd6, 0x94, 0x57, 0xd3, 0x9a, 0xf2, 0x66,
-	0x5a, 0x53, 0x9e, 0xbc, 0xad, 0x7d, 0xf2, 0x77, 0xce, 0xb9, 0xb9, 0x2c, 0xf2, 0xff, 0xb2, 0xef,
-	0xdf, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xef, 0x45, 0x12, 0xe4, 0x09, 0x00, 0x00,
+func init() { proto.RegisterFile("node_rpc.proto", fileDescriptor_node_rpc_d3c3101a9d592348) }
+
+var fileDescriptor_node_rpc_d3c3101a9d592348 = []byte{
+	// 1390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x73, 0x1b, 0xc5,
+	0x13, 0xcf, 0x4a, 0xb6, 0x63, 0xb5, 0x1e, 0x89, 0xc7, 0xfe, 0xfb, 0xaf, 0x6c, 0x1c, 0xcb, 0x59,
+	0xa7, 0x88, 0x80, 0xa0, 0x10, 0x53, 0xc5, 0xa3, 0xa0, 0x52, 0x24, 0x52, 0x91, 0xf2, 0x21, 0x4e,
+	0x6a, 0xe4, 0xf0, 0xba, 0x2c, 0x23, 0xed, 0x48, 0x5e, 0xd0, 0xee, 0xac, 0x77, 0x47, 0x36, 0xe6,
+	0xc0, 0x89, 0x33, 0x95, 0x03, 0x5f, 0x81, 0xcf, 0xc0, 0x57, 0xc8, 0x91, 0x2b, 0x17, 0x43, 0x89,
+	0x1b, 0x37, 0x0e, 0xdc, 0xa9, 0x79, 0x68, 0xb5, 0x7a, 0xac, 0x2d, 0xbb, 0xb8, 0xcd, 0xf4, 0xfc,
+	0xfa, 0xdd, 0xd3, 0xdd, 0x50, 0xf2, 0x99, 0x43, 0xed, 0x30, 0x68, 0xd7, 0x82, 0x90, 0x71, 0x86,
+	0x4a, 0x2e, 0xab, 0xd1, 0x23, 0xea, 0x73, 0x4e, 0xc3, 0x9a, 0x77, 0x68, 0xae, 0xb6, 0x7b, 0xfd,
+	0x88, 0xd3, 0xd0, 0x8e, 0x38, 0xe1, 0x54, 0x81, 0xcc, 0xb5, 0x2e, 0xeb, 0x32, 0x79, 0xbc, 0x2f,
+	0x4e, 0x9a, 0x5a, 0xe9, 0x32, 0xd6, 0xed, 0xd1, 0xfb, 0xf2, 0xd6, 0xea, 0x77, 0xee, 0x73, 0xd7,
+	0xa3, 0x11, 0x27, 0x5e, 0xa0, 0x00, 0x56, 0x09, 0x0a, 0x0d, 0xda, 0xea, 0x77, 0x31, 0x3d, 0xec,
+	0xd3, 0x88, 0x5b, 0x3f, 0x1a, 0x50, 0xd4, 0x84, 0x28, 0x60, 0x7e, 0x44, 0xd1, 0x36, 0x14, 0xc7,
+	0xf4, 0x95, 0x8d, 0x2d, 0xa3, 0x9a, 0xc3, 0x05, 0x4d, 0x6c, 0x0a, 0x1a, 0x32, 0x61, 0x39, 0xa2,
+	0x5d, 0x8f, 0xfa, 0x3c, 0x2a, 0x67, 0xb6, 0xb2, 0xd5, 0x1c, 0x8e, 0xef, 0xe8, 0x23, 0x30, 0xfb,
+	0xbe, 0x43, 0x43, 0xdb, 0x71, 0x8f, 0x68, 0x18, 0xb9, 0x1d, 0x97, 0x3a, 0x76, 0x8c, 0xce, 0x4a,
+	0x74, 0x59, 0x22, 0x1a, 0x23, 0x40, 0x53, 0xbf, 0x5b, 0x21, 0x94, 0xeb, 0xcc, 0x8f, 0xfa, 0x1e,
+	0x0d, 0x9f, 0x84, 0xac, 0x1f, 0x7c, 0x46, 0x5c, 0xae, 0x8d, 0x45, 0x1b, 0x90, 0xf3, 0x89, 0x47,
+	0xa3, 0x80, 0xb4, 0x87, 0x66, 0x8d, 0x08, 0x08, 0xc1, 0x82, 0xb8, 0x94, 0x33, 0xf2, 0x41, 0x9e,
+	0xd1, 0x1d, 0x28, 0x39, 0xcc, 0xf6, 0x19, 0xb7, 0x3b, 0x2c, 0x3c, 0x26, 0xa1, 0x53, 0x6e, 0x6f,
+	0x19, 0xd5, 0x65, 0x5c, 0x70, 0xd8, 0x1e, 0xe3, 0x9f, 0x28, 0x9a, 0x75, 0x13, 0x6e, 0xcc, 0xd0,
+	0xa9, 0xe2, 0x61, 0xfd, 0x62, 0xc0, 0x8d, 0x66, 0xbf, 0x15, 0xb5, 0x43, 0x37, 0xe0, 0x2e, 0xf3,
+	0x31, 0x8d, 0xdc, 0xef, 0xe8, 0xd0, 0xa4, 0x6d, 0xb8, 0x2a, 0xb3, 0xe7, 0x3a, 0xd2, 0xa0, 0x85,
+	0xc7, 0x30, 0x38, 0xad, 0x2c, 0xed, 0x31, 0x87, 0xee, 0x36, 0xf0, 0x92, 0x78, 0xda, 0x75, 0xd0,
+	0x87, 0x70, 0x2d, 0x4a, 0x48, 0x10, 0xe0, 0x8c, 0x04, 0xa3, 0xc1, 0x69, 0xa5, 0x94, 0x14, 0xbe,
+	0xdb, 0xc0, 0xa5, 0x24, 0x74, 0xd7, 0x11, 0x6e, 0x09, 0x85, 0xe5, 0xec, 0x96, 0x51, 0x2d, 0x62,
+	0x79, 0x9e, 0xd3, 0xad, 0x0d, 0x30, 0x67, 0x19, 0xae, 0xfd, 0xfa, 0xdb, 0x00, 0xa4, 0xa3, 0xfe,
+	0x2c, 0xa0, 0xfe, 0x85, 0x1c, 0x7a, 0x0f, 0x16, 0xf8, 0x49, 0xa0, 0x42, 0x5d, 0xda, 0xd9, 0xae,
+	0x8d, 0x17, 0x6c, 0xad, 0xae, 0x4b, 0x45, 0x49, 0xaf, 0xed, 0x9f, 0x04, 0x14, 0x4b, 0x06, 0x74,
+	0x17, 0xae, 0xb1, 0x63, 0x9f, 0x86, 0xf6, 0x28, 0x8f, 0x59, 0x99, 0xae, 0x92, 0x24, 0xef, 0xc5,
+	0xc9, 0xbc, 0x05, 0x30, 0x02, 0x96, 0x17, 0x54, 0xae, 0x63, 0x0c, 0x5a, 0x83, 0xc5, 0xe8, 0x40,
+	0xf8, 0xbd, 0x28, 0xa3, 0xa2, 0x2e, 0xa8, 0x02, 0xf9, 0x1e, 0x25, 0xa2, 0xf4, 0x98, 0xdf, 0x3b,
+	0xd1, 0x31, 0x01, 0x45, 0x7a, 0xe6, 0xf7, 0x4e, 0xac, 0xef, 0x61, 0x75, 0xcc, 0x65, 0x5d, 0xf2,
+	0xf7, 0x00, 0x74, 0x7d, 0x8e, 0xdc, 0x2e, 0x0e, 0x4e, 0x2b, 0x39, 0x0d, 0xde, 0x6d, 0xe0, 0x9c,
+	0x06, 0xec, 0x3a, 0xe8, 0x03, 0xb8, 0x16, 0x84, 0xae, 0x47, 0xc2, 0x13, 0x7b, 0x18, 0x29, 0x95,
+	0xcd, 0x95, 0xc1, 0x69, 0xa5, 0xf8, 0x5c, 0x3d, 0xe9, 0x80, 0x15, 0x83, 0xc4, 0xd5, 0xb1, 0x7e,
+	0xce, 0xc4, 0x06, 0xd4, 0x7b, 0x2c, 0x8a, 0xab, 0xe8, 0x62, 0x06, 0x24, 0x52, 0x94, 0x49, 0x4d,
+	0x51, 0xb2, 0x6c, 0xb2, 0xba, 0x6c, 0x04, 0xed, 0x80, 0x3c, 0x90, 0xe1, 0x2c, 0x60, 0x79, 0x46,
+	0x21, 0xfc, 0x8f, 0x75, 0x3a, 0x11, 0xe5, 0x76, 0x9b, 0x79, 0x9e, 0xcb, 0x23, 0xbb, 0x1f, 0x38,
+	0xe2, 0xdb, 0x8b, 0xc8, 0xe6, 0x77, 0x1e, 0xa6, 0xe4, 0xb6, 0xce, 0x3c, 0x8f, 0xf8, 0xce, 0xd8,
+	0xb7, 0x79, 0x26, 0xe5, 0xd4, 0x95, 0x98, 0x17, 0x52, 0x0a, 0x5e, 0x65, 0xd3, 0xc4, 0xf3, 0xf3,
+	0xb4, 0x0e, 0x6b, 0xe3, 0x61, 0xd2, 0x35, 0xfb, 0x02, 0x56, 0x34, 0xbd, 0xd9, 0xf7, 0x2e, 0x17,
+	0xbc, 0x61, 0x5c, 0x32, 0xa3, 0xb8, 0x58, 0x5f, 0x03, 0x4a, 0x8a, 0xbd, 0x54, 0x55, 0xcc, 0x90,
+	0x1b, 0xc7, 0x3b, 0x3b, 0x8a, 0xb7, 0xe5, 0xc7, 0xba, 0x30, 0x25, 0xce, 0xe5, 0x7c, 0x58, 0x87,
+	0x25, 0x15, 0x56, 0xad, 0x4d, 0xdf, 0x84, 0xbe, 0x63, 0xe2, 0x72, 0xa9, 0x6f, 0x19, 0xcb, 0xb3,
+	0xf5, 0x93, 0x01, 0xab, 0x63, 0x0a, 0x2f, 0xeb, 0x9d, 0x43, 0x38, 0x91, 0xfa, 0x0a, 0x58, 0x9e,
+	0x13, 0x56, 0x64, 0xc7, 0xac, 0x10, 0x03, 0x44, 0xa6, 0xdb, 0xd6, 0xcf, 0x0b, 0xf2, 0xb9, 0xa0,
+	0x88, 0xaa, 0x48, 0xac, 0x27, 0xf0, 0xff, 0xa1, 0x22, 0xdf, 0xa1, 0xdf, 0x5e, 0x3a, 0x16, 0x56,
+	0x03, 0xca, 0xd3, 0x82, 0xb4, 0x8f, 0x6b, 0xb0, 0xd8, 0x61, 0x7d, 0x5f, 0x09, 0x59, 0xc6, 0xea,
+	0x32, 0xcb, 0x17, 0x2b, 0x80, 0xeb, 0xe2, 0xff, 0x34, 0x42, 0xe2, 0x5e, 0xac, 0x13, 0xae, 0xc3,
+	0x52, 0x9b, 0xf8, 0x6d, 0xda, 0x93, 0xe2, 0x96, 0xb1, 0xbe, 0x9d, 0x5f, 0xe2, 0xff, 0x18, 0xb0,
+	0x92, 0x50, 0x19, 0x0f, 0xdf, 0x39, 0x74, 0x3e, 0x81, 0x3c, 0x71, 0x3c, 0xd7, 0xd7, 0xf3, 0x59,
+	0x35, 0xe1, 0xd7, 0x52, 0x3e, 0xaa, 0xe0, 0xad, 0x3d, 0x12, 0x70, 0x39, 0xb9, 0x31, 0x90, 0xf8,
+	0x2c, 0x32, 0xc5, 0x02, 0xea, 0x27, 0x87, 0xb3, 0xe8, 0xa6, 0x05, 0x41, 0x1c, 0x0e, 0x64, 0xd1,
+	0xb2, 0xdb, 0xe2, 0x13, 0x26, 0x66, 0xf8, 0x82, 0x84, 0x95, 0x14, 0x39, 0x06, 0xde, 0x81, 0x52,
+	0x44, 0x3a, 0xd4, 0xe6, 0xcc, 0x0e, 0xa9, 0xc7, 0x8e, 0x54, 0x0b, 0x59, 0xc6, 0x05, 0x41, 0xdd,
+	0x67, 0x58, 0xd2, 0xac, 0x8f, 0xe1, 0x56, 0x5c, 0x8e, 0x2d, 0xd2, 0x13, 0xd1, 0x12, 0xc6, 0xf4,
+	0xa3, 0x61, 0xd8, 0xcf, 0x8d, 0xdc, 0x6f, 0x19, 0xd8, 0x4c, 0x13, 0xa1, 0xc3, 0xf8, 0x00, 0x16,
+	0x85, 0xb2, 0xa8, 0x6c, 0x6c, 0x65, 0xab, 0xf9, 0x9d, 0x9b, 0x93, 0xb1, 0xd1, 0xec, 0x4f, 0xd9,
+	0x11, 0xc5, 0x0a, 0x29, 0xdd, 0x64, 0x5e, 0xd0, 0xa3, 0x9c, 0x3a, 0xb6, 0x62, 0x96, 0xcd, 0x15,
+	0x97, 0x62, 0xf2, 0xd3, 0x18, 0x28, 0x73, 0xdc, 0x8b, 0x81, 0x59, 0x0d, 0x1c, 0x92, 0x15, 0x70,
+	0x0f, 0x16, 0x45, 0xc2, 0x44, 0xb8, 0x84, 0x11, 0xef, 0xa7, 0x18, 0x91, 0xe2, 0x43, 0x4d, 0x24,
+	0xee, 0x45, 0x44, 0xba, 0x14, 0x2b, 0x31, 0x66, 0x0b, 0x72, 0x31, 0x6d, 0xbe, 0x42, 0x19, 0xdf,
+	0xd2, 0x44, 0xce, 0xe2, 0xbb, 0xf8, 0x1b, 0xad, 0x13, 0xae, 0x8d, 0xcf, 0x62, 0x75, 0xb1, 0x7e,
+	0xc8, 0x40, 0x3e, 0x11, 0x9c, 0x0b, 0x76, 0x89, 0x77, 0xa1, 0x14, 0xb1, 0x7e, 0xd8, 0xa6, 0x13,
+	0x83, 0xf1, 0xfa, 0xe0, 0xb4, 0x52, 0x68, 0xca, 0x17, 0x6d, 0x61, 0x21, 0x1a, 0xdd, 0x24, 0x1f,
+	0x27, 0x61, 0x97, 0xf2, 0x98, 0x2f, 0x3b, 0xe2, 0xdb, 0x97, 0x2f, 0x43, 0x3e, 0x3e, 0xba, 0x8d,
+	0x7a, 0xee, 0x42, 0xa2, 0xe7, 0xd6, 0x01, 0x22, 0x4e, 0x42, 0x91, 0x45, 0xc2, 0xf5, 0x10, 0x33,
+	0x6b, 0x6a, 0x2d, 0xae, 0x0d, 0xd7, 0xe2, 0xda, 0xfe, 0x70, 0x2d, 0x7e, 0xbc, 0xfc, 0xea, 0xb4,
+	0x72, 0xe5, 0xe5, 0xef, 0x15, 0x03, 0xe7, 0x34, 0xdf, 0x23, 0x6e, 0x3d, 0x84, 0x9b, 0xf5, 0xc4,
+	0xba, 0xdb, 0xf4, 0x49, 0x10, 0x1d, 0x30, 0x3e, 0x77, 0x89, 0x12, 0xd8, 0x98, 0xcd, 0xaf, 0xeb,
+	0xf3, 0xd1, 0xac, 0x1d, 0x3b, 0xbf, 0xb3, 0x91, 0xb6, 0x48, 0xc9, 0x9f, 0x3b, 0xb6, 0x81, 0xef,
+	0xfc, 0x95, 0x83, 0xab, 0x22, 0x0c, 0xf8, 0x79, 0x1d, 0x35, 0x60, 0x51, 0xee, 0xf0, 0x68, 0x4a,
+	0x40, 0x72, 0xd7, 0x37, 0x6f, 0xa5, 0xbc, 0x6a, 0xa3, 0x0e, 0x60, 0x65, 0x6a, 0x0b, 0x46, 0xd5,
+	0x29, 0x93, 0x52, 0x96, 0x73, 0xf3, 0xf5, 0x39, 0x90, 0x5a, 0xd3, 0x37, 0x80, 0xa6, 0x17, 0x53,
+	0x34, 0x25, 0x20, 0x75, 0xeb, 0x36, 0xdf, 0x98, 0x07, 0xaa, 0x95, 0x7d, 0x0a, 0xf9, 0xc4, 0xce,
+	0x87, 0xac, 0x94, 0x6f, 0x98, 0xd8, 0x81, 0xcd, 0xed, 0x33, 0x31, 0x5a, 0xee, 0x17, 0x50, 0xd4,
+	0x64, 0xcc, 0x54, 0x37, 0x4d, 0xe1, 0x4a, 0x6e, 0x7a, 0xf3, 0x8a, 0x2e, 0x24, 0x79, 0xe7, 0x93,
+	0x7c, 0xe7, 0x6c, 0x90, 0x16, 0xfd, 0x55, 0x62, 0x1b, 0x08, 0x7a, 0x6e, 0x9b, 0xfc, 0xe7, 0x1a,
+	0x9a, 0x00, 0xa3, 0x65, 0x0a, 0xdd, 0x4e, 0xe1, 0x19, 0xed, 0x6f, 0xa6, 0x75, 0x16, 0x44, 0x0b,
+	0xfd, 0x3c, 0x4e, 0xa2, 0x18, 0xf0, 0xa9, 0x49, 0x4c, 0xac, 0x11, 0xe6, 0xf6, 0x99, 0x18, 0x25,
+	0xf7, 0x6d, 0x03, 0x51, 0xb8, 0x3e, 0xb9, 0x3f, 0xa0, 0xbb, 0x29, 0xac, 0x93, 0xab, 0x8a, 0x59,
+	0x3d, 0x1f, 0xa8, 0x1d, 0x78, 0x0e, 0xb9, 0x78, 0xda, 0xa3, 0xad, 0x49, 0xb6, 0xc9, 0xdd, 0xc3,
+	0xbc, 0x7d, 0x06, 0x42, 0x4b, 0x3c, 0x86, 0xf5, 0xd9, 0x13, 0x04, 0xbd, 0x35, 0xef, 0xa4, 0x51,
+	0xba, 0x6a, 0x17, 0x1b, 0x4c, 0xe8, 0x10, 0xd6, 0x66, 0x35, 0x37, 0xf4, 0xe6, 0x59, 0xdd, 0x6b,
+	0xa2, 0x85, 0x9a, 0xf7, 0xe6, 0x03, 0x2b, 0x95, 0x8f, 0xd7, 0x5e, 0x0d, 0x36, 0x8d, 0x5f, 0x07,
+	0x9b, 0xc6, 0x1f, 0x83, 0x4d, 0xe3, 0xe5, 0x9f, 0x9b, 0x57, 0xbe, 0xcc, 0x78, 0x87, 0xad, 0x25,
+	0xd9, 0xce, 0xdf, 0xf9, 0x77, 0x00, 0xa4, 0x0d, 0xfe, 0xf1, 0x40, 0x11, 0x00, 0x00,
 }
@@ -0,0 +1,90 @@
+package mq
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/gogo/protobuf/types"
+)
+
+// Returns partition key of the message, or empty string if topic isn't partitioned or message doesn't contain the key.
+func messagePartitionKey(topic *ClusterTopic, message *emq.Message) string {
+	if topic == nil {
+		return ""
+	}
+	return messageKey(topic.PartitionKey, message)
+}
+
+// Returns value of the key (either `routing_key`, or header name prefixed by `headers.`) in the message, or empty
+// string if message doesn't contain the key.
+func messageKey(key string, message *emq.Message) string {
+	if key == "" || message == nil {
+		return ""
+	}
+
+	if key == emq.PartitionKeyRoutingKey {
+		return message.RoutingKey
+	}
+
+	if message.Headers == nil {
+		return ""
+	}
+	value, ok := message.Headers.Fields[strings.TrimPrefix(key, emq.PartitionKeyHeaderPrefix)]
+	if !ok {
+		return ""
+	}
+	switch kind := value.Kind.(type) {
+	case *types.Value_StringValue:
+		return kind.StringValue
+	case *types.Value_NumberValue:
+		return strconv.FormatFloat(kind.NumberValue, 'g', -1, 64)
+	case *types.Value_BoolValue:
+		return strconv.FormatBool(kind.BoolValue)
+	default:
+		return ""
+	}
+}
+
+// Returns true if topic's messages are routed to shards by key (partition key, or deduplication key) instead of
+// being written to segment on publishing node.
+func shardRouted(topic *ClusterTopic) bool {
+	return topic.Shards > 0 && (topic.PartitionKey != "" || topic.DeduplicationWindow > 0)
+}
+
+// Maps partition key to shard. Shards are numbered from 1, same as segment shards.
+func partitionShard(key string, shards uint32) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()%shards + 1
+}
+
+// Returns true if consumer group hasn't read whole segment preceding given segment in the same shard of partitioned
+// topic yet.
+func partitionPredecessorPending(state *ClusterState, segment *ClusterSegment, committedOffsets map[uint64]int64, since time.Time) bool {
+	for segmentID, offset := range committedOffsets {
+		if segmentID == segment.ID {
+			continue
+		}
+		predecessor := state.GetSegment(segmentID)
+		if predecessor == nil || predecessor.Type != ClusterSegment_TOPIC || predecessor.Shard != segment.Shard ||
+			predecessor.OwnerNamespace != segment.OwnerNamespace || predecessor.OwnerName != segment.OwnerName {
+			continue
+		}
+		// compacted segment gets new ID, however, keeps open time of the oldest segment it replaced => order by open
+		// time, then by ID (allocated sequentially)
+		if predecessor.CreatedAt.After(segment.CreatedAt) ||
+			(predecessor.CreatedAt.Equal(segment.CreatedAt) && predecessor.ID > segment.ID) {
+			continue
+		}
+		if predecessor.ClosedAt.IsZero() {
+			return true
+		}
+		if offset < predecessor.Size_ && !predecessor.ClosedAt.Before(since) {
+			return true
+		}
+	}
+	return false
+}
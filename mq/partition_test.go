@@ -0,0 +1,48 @@
+package mq
+
+import (
+	"testing"
+
+	"eventter.io/mq/emq"
+	"github.com/gogo/protobuf/types"
+)
+
+func TestMessagePartitionKey(t *testing.T) {
+	message := &emq.Message{
+		RoutingKey: "orders.created",
+		Headers: &types.Struct{Fields: map[string]*types.Value{
+			"customer": {Kind: &types.Value_StringValue{StringValue: "c-42"}},
+			"tenant":   {Kind: &types.Value_NumberValue{NumberValue: 7}},
+		}},
+	}
+
+	tests := []struct {
+		partitionKey string
+		expected     string
+	}{
+		{"", ""},
+		{emq.PartitionKeyRoutingKey, "orders.created"},
+		{"headers.customer", "c-42"},
+		{"headers.tenant", "7"},
+		{"headers.missing", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.partitionKey, func(t *testing.T) {
+			if got := messagePartitionKey(&ClusterTopic{PartitionKey: test.partitionKey}, message); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestPartitionShard(t *testing.T) {
+	for _, key := range []string{"", "a", "orders.created", "c-42"} {
+		shard := partitionShard(key, 3)
+		if shard < 1 || shard > 3 {
+			t.Errorf("shard %d of key %q out of range", shard, key)
+		}
+		if again := partitionShard(key, 3); again != shard {
+			t.Errorf("key %q mapped to shard %d, then to %d", key, shard, again)
+		}
+	}
+}
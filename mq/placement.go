@@ -0,0 +1,61 @@
+package mq
+
+// Selects up to n nodes out of candidates to place segment replicas on. Nodes located in zones that are used the least
+// by already selected nodes (placedNodeIDs & nodes selected by this function) are preferred, ties are broken by
+// the number of segments on the node. Segment counts of selected nodes are incremented.
+func selectNodes(candidateNodeIDs []uint64, n int, placedNodeIDs []uint64, nodeMap map[uint64]*ClusterNode, nodeSegmentCounts map[uint64]int) []uint64 {
+	zoneCounts := make(map[string]int)
+	for _, nodeID := range placedNodeIDs {
+		if node := nodeMap[nodeID]; node != nil {
+			zoneCounts[node.Zone]++
+		}
+	}
+
+	candidates := make([]uint64, len(candidateNodeIDs))
+	copy(candidates, candidateNodeIDs)
+
+	var selectedNodeIDs []uint64
+
+	for len(candidates) > 0 && len(selectedNodeIDs) < n {
+		var candidateIndex = -1
+		for i, candidateNodeID := range candidates {
+			if candidateIndex == -1 {
+				candidateIndex = i
+				continue
+			}
+
+			zoneCount := zoneCounts[nodeMap[candidateNodeID].Zone]
+			bestZoneCount := zoneCounts[nodeMap[candidates[candidateIndex]].Zone]
+			if zoneCount < bestZoneCount ||
+				(zoneCount == bestZoneCount && nodeSegmentCounts[candidateNodeID] < nodeSegmentCounts[candidates[candidateIndex]]) {
+				candidateIndex = i
+			}
+		}
+
+		nodeID := candidates[candidateIndex]
+		nodeSegmentCounts[nodeID]++
+		zoneCounts[nodeMap[nodeID].Zone]++
+
+		selectedNodeIDs = append(selectedNodeIDs, nodeID)
+		copy(candidates[candidateIndex:], candidates[candidateIndex+1:])
+		candidates = candidates[:len(candidates)-1]
+	}
+
+	return selectedNodeIDs
+}
+
+// Returns number of distinct zones of alive nodes segment is placed on.
+func countSegmentZones(segment *ClusterSegment, nodeMap map[uint64]*ClusterNode) (zones int, nodes int) {
+	seen := make(map[string]bool)
+	for _, nodeIDs := range [][]uint64{{segment.Nodes.PrimaryNodeID}, segment.Nodes.ReplicatingNodeIDs, segment.Nodes.DoneNodeIDs} {
+		for _, nodeID := range nodeIDs {
+			node := nodeMap[nodeID]
+			if node == nil || node.State != ClusterNode_ALIVE {
+				continue
+			}
+			nodes++
+			seen[node.Zone] = true
+		}
+	}
+	return len(seen), nodes
+}
@@ -0,0 +1,50 @@
+package mq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectNodes(t *testing.T) {
+	assert := require.New(t)
+
+	nodeMap := map[uint64]*ClusterNode{
+		1: {ID: 1, State: ClusterNode_ALIVE, Zone: "a"},
+		2: {ID: 2, State: ClusterNode_ALIVE, Zone: "a"},
+		3: {ID: 3, State: ClusterNode_ALIVE, Zone: "a"},
+		4: {ID: 4, State: ClusterNode_ALIVE, Zone: "b"},
+		5: {ID: 5, State: ClusterNode_ALIVE, Zone: "c"},
+	}
+
+	{
+		// zones are preferred over segment counts
+		nodeSegmentCounts := map[uint64]int{1: 0, 2: 0, 3: 0, 4: 10, 5: 20}
+		selected := selectNodes([]uint64{2, 3, 4, 5}, 2, []uint64{1}, nodeMap, nodeSegmentCounts)
+		assert.ElementsMatch([]uint64{4, 5}, selected)
+		assert.Equal(11, nodeSegmentCounts[4])
+		assert.Equal(21, nodeSegmentCounts[5])
+	}
+
+	{
+		// all zones used => node with the least segments
+		nodeSegmentCounts := map[uint64]int{1: 0, 2: 5, 3: 1, 4: 0, 5: 0}
+		selected := selectNodes([]uint64{2, 3}, 1, []uint64{1, 4, 5}, nodeMap, nodeSegmentCounts)
+		assert.Equal([]uint64{3}, selected)
+	}
+
+	{
+		// not enough candidates
+		selected := selectNodes([]uint64{2}, 2, []uint64{1}, nodeMap, make(map[uint64]int))
+		assert.Equal([]uint64{2}, selected)
+	}
+
+	{
+		segment := &ClusterSegment{}
+		segment.Nodes.PrimaryNodeID = 1
+		segment.Nodes.ReplicatingNodeIDs = []uint64{2, 4}
+		zones, nodes := countSegmentZones(segment, nodeMap)
+		assert.Equal(2, zones)
+		assert.Equal(3, nodes)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"eventter.io/mq/logging"
 	"github.com/hashicorp/raft"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -19,6 +20,7 @@ const (
 )
 
 type RaftRPCTransport struct {
+	logger           logging.Logger
 	advertiseIP      net.IP
 	advertisePort    int
 	pool             *ClientConnPool
@@ -27,8 +29,9 @@ type RaftRPCTransport struct {
 	heartbeatHandler func(rpc raft.RPC)
 }
 
-func NewRaftRPCTransport(advertiseIP net.IP, advertisePort int, pool *ClientConnPool) *RaftRPCTransport {
+func NewRaftRPCTransport(advertiseIP net.IP, advertisePort int, pool *ClientConnPool, logger logging.Logger) *RaftRPCTransport {
 	return &RaftRPCTransport{
+		logger:        logger,
 		advertiseIP:   advertiseIP,
 		advertisePort: advertisePort,
 		pool:          pool,
@@ -505,10 +508,12 @@ func (t *RaftRPCTransport) DoInstallSnapshot(stream RaftRPC_DoInstallSnapshotSer
 
 	response := responseOrError.Response.(*raft.InstallSnapshotResponse)
 
-	stream.SendAndClose(&InstallSnapshotResponse{
+	if err := stream.SendAndClose(&InstallSnapshotResponse{
 		Term:    response.Term,
 		Success: response.Success,
-	})
+	}); err != nil {
+		t.logger.Warn("could not send install snapshot response", logging.Error(err))
+	}
 
 	return nil
 }
@@ -2,12 +2,20 @@ package mq
 
 import (
 	"context"
+	"sync"
 
+	"eventter.io/mq/logging"
 	"github.com/hashicorp/memberlist"
 )
 
 type Reconciler struct {
-	delegate ReconcilerDelegate
+	delegate          ReconcilerDelegate
+	logger            logging.Logger
+	diskHighWatermark float64
+	movesMutex        sync.Mutex
+	moves             map[uint64]*SegmentMove
+	completedMoves    uint64
+	cancelledMoves    uint64
 }
 
 type ReconcilerDelegate interface {
@@ -18,8 +26,40 @@ type ReconcilerDelegate interface {
 	NextSegmentID() uint64
 }
 
-func NewReconciler(delegate ReconcilerDelegate) *Reconciler {
+func NewReconciler(delegate ReconcilerDelegate, logger logging.Logger, diskHighWatermark float64) *Reconciler {
 	return &Reconciler{
-		delegate: delegate,
+		delegate:          delegate,
+		logger:            logger,
+		diskHighWatermark: diskHighWatermark,
+		moves:             make(map[uint64]*SegmentMove),
 	}
 }
+
+func (r *Reconciler) apply(cmd interface{}) (uint64, error) {
+	index, err := r.delegate.Apply(cmd)
+	if err == nil {
+		reconcilerActionsTotal.WithLabelValues(reconcilerActionName(cmd)).Inc()
+	}
+	return index, err
+}
+
+// Node accepts new segment replicas (or keeps copying data of replicas it hasn't completed yet) only if it's active and
+// its disk isn't above high watermark.
+func (r *Reconciler) acceptsReplicas(node *ClusterNode) bool {
+	return node != nil && node.IsActive() && !node.IsDiskFull(r.diskHighWatermark)
+}
+
+// segmentFields returns log fields identifying the segment & its owner.
+func segmentFields(segment *ClusterSegment) []logging.Field {
+	fields := []logging.Field{
+		logging.SegmentID(segment.ID),
+		logging.F("segment_type", segment.Type.String()),
+		logging.Namespace(segment.OwnerNamespace),
+	}
+	if segment.Type == ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS {
+		fields = append(fields, logging.ConsumerGroup(segment.OwnerName))
+	} else {
+		fields = append(fields, logging.Topic(segment.OwnerName))
+	}
+	return fields
+}
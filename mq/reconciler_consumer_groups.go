@@ -1,11 +1,10 @@
 package mq
 
 import (
-	"log"
 	"math"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
+	"eventter.io/mq/logging"
 )
 
 func (r *Reconciler) ReconcileConsumerGroups(state *ClusterState) {
@@ -45,22 +44,22 @@ func (r *Reconciler) reconcileConsumerGroupOffsetCommitsSegment(state *ClusterSt
 		primarySegmentCount = math.MaxInt32
 	)
 	for _, node := range state.Nodes {
-		if segmentCount := nodeSegmentCounts[node.ID]; node.State == ClusterNode_ALIVE && segmentCount < primarySegmentCount {
+		if segmentCount := nodeSegmentCounts[node.ID]; node.IsActive() && !node.IsDiskFull(r.diskHighWatermark) && segmentCount < primarySegmentCount {
 			primaryNodeID = node.ID
 			primarySegmentCount = segmentCount
 		}
 	}
 
 	if primaryNodeID == 0 {
-		log.Printf(
-			"could not select primary for consumer group %s/%s offsets segment",
-			namespace.Name,
-			consumerGroup.Name,
+		r.logger.Warn(
+			"could not select primary for consumer group offsets segment",
+			logging.Namespace(namespace.Name),
+			logging.ConsumerGroup(consumerGroup.Name),
 		)
 		return
 	}
 
-	_, err := r.delegate.Apply(&ClusterCommandSegmentCreate{
+	_, err := r.apply(&ClusterCommandSegmentCreate{
 		ID:                 r.delegate.NextSegmentID(),
 		OwnerNamespace:     namespace.Name,
 		OwnerName:          consumerGroup.Name,
@@ -70,10 +69,11 @@ func (r *Reconciler) reconcileConsumerGroupOffsetCommitsSegment(state *ClusterSt
 		ReplicatingNodeIDs: nil,
 	})
 	if err != nil {
-		log.Printf(
-			"could not open consumer group %s/%s offsets segment",
-			namespace.Name,
-			consumerGroup.Name,
+		r.logger.Error(
+			"could not open consumer group offsets segment",
+			logging.Namespace(namespace.Name),
+			logging.ConsumerGroup(consumerGroup.Name),
+			logging.Error(err),
 		)
 		return
 	}
@@ -138,20 +138,20 @@ func (r *Reconciler) reconcileConsumerGroupOffsetCommits(state *ClusterState, na
 		OffsetCommits: commits,
 	}
 
-	index, err := r.delegate.Apply(cmd)
+	index, err := r.apply(cmd)
 	if err != nil {
-		log.Printf(
-			"could not update consumer group %s/%s offset commits: %v",
-			namespace.Name,
-			consumerGroup.Name,
-			err,
+		r.logger.Error(
+			"could not update consumer group offset commits",
+			logging.Namespace(namespace.Name),
+			logging.ConsumerGroup(consumerGroup.Name),
+			logging.Error(err),
 		)
 	} else {
-		log.Printf(
-			"updated consumer group %s/%s offset commits: %s",
-			namespace.Name,
-			consumerGroup.Name,
-			proto.MarshalTextString(cmd),
+		r.logger.Info(
+			"updated consumer group offset commits",
+			logging.Namespace(namespace.Name),
+			logging.ConsumerGroup(consumerGroup.Name),
+			logging.F("offset_commits", len(commits)),
 		)
 	}
 
@@ -1,9 +1,8 @@
 package mq
 
 import (
-	"log"
-
 	"eventter.io/mq/emq"
+	"eventter.io/mq/logging"
 )
 
 func (r *Reconciler) ReconcileNamespaces(state *ClusterState) {
@@ -12,11 +11,11 @@ func (r *Reconciler) ReconcileNamespaces(state *ClusterState) {
 		return
 	}
 
-	_, err := r.delegate.Apply(&ClusterCommandNamespaceCreate{Namespace: emq.DefaultNamespace})
+	_, err := r.apply(&ClusterCommandNamespaceCreate{Namespace: emq.DefaultNamespace})
 	if err != nil {
-		log.Printf("could not create default namespace: %v", err)
+		r.logger.Error("could not create default namespace", logging.Namespace(emq.DefaultNamespace), logging.Error(err))
 		return
 	}
 
-	log.Printf("created default namespace (%s)", emq.DefaultNamespace)
+	r.logger.Info("created default namespace", logging.Namespace(emq.DefaultNamespace))
 }
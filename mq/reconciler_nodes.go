@@ -1,8 +1,9 @@
 package mq
 
 import (
-	"log"
 	"time"
+
+	"eventter.io/mq/logging"
 )
 
 func (r *Reconciler) ReconcileNodes(state *ClusterState) {
@@ -19,22 +20,29 @@ func (r *Reconciler) ReconcileNodes(state *ClusterState) {
 			}
 		}
 
-		if node == nil || node.Address != member.Address() || node.State != ClusterNode_ALIVE {
+		meta := decodeDiscoveryNodeMeta(member, r.logger)
+
+		if node == nil || node.Address != member.Address() || node.State != ClusterNode_ALIVE || node.Zone != meta.Zone ||
+			node.DiskTotal != meta.DiskTotal || node.DiskFree != meta.DiskFree {
+
 			cmd := &ClusterCommandNodeUpdate{
-				ID:      id,
-				Address: member.Address(),
-				State:   ClusterNode_ALIVE,
+				ID:        id,
+				Address:   member.Address(),
+				State:     ClusterNode_ALIVE,
+				Zone:      meta.Zone,
+				DiskTotal: meta.DiskTotal,
+				DiskFree:  meta.DiskFree,
 			}
-			_, err := r.delegate.Apply(cmd)
+			_, err := r.apply(cmd)
 			if err != nil {
-				log.Printf("could not Apply update node: %v", err)
+				r.logger.Error("could not apply update node", logging.NodeID(cmd.ID), logging.Error(err))
 				continue
 			} else {
-				log.Printf("updated node: %s", cmd.String())
+				r.logger.Info("updated node", logging.NodeID(cmd.ID), logging.F("state", cmd.State.String()), logging.F("address", cmd.Address))
 				if cmd.State == ClusterNode_ALIVE {
 					err := r.delegate.AddVoter(member.Name, cmd.Address)
 					if err != nil {
-						log.Printf("could not add peer: %v", err)
+						r.logger.Error("could not add peer", logging.NodeID(cmd.ID), logging.Error(err))
 						continue
 					}
 				}
@@ -54,14 +62,17 @@ func (r *Reconciler) ReconcileNodes(state *ClusterState) {
 			Address:       node.Address,
 			State:         ClusterNode_DEAD,
 			LastSeenAlive: &now,
+			Zone:          node.Zone,
+			DiskTotal:     node.DiskTotal,
+			DiskFree:      node.DiskFree,
 		}
-		_, err := r.delegate.Apply(cmd)
+		_, err := r.apply(cmd)
 		if err != nil {
-			log.Printf("could not Apply update node: %v", err)
+			r.logger.Error("could not apply update node", logging.NodeID(cmd.ID), logging.Error(err))
 			return
 		}
 
-		log.Printf("updated node: %s", cmd.String())
+		r.logger.Info("updated node", logging.NodeID(cmd.ID), logging.F("state", cmd.State.String()), logging.F("address", cmd.Address))
 	}
 
 	// TODO: remove nodes dead for more than X minutes from voters
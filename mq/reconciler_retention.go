@@ -0,0 +1,113 @@
+package mq
+
+import (
+	"sort"
+
+	"eventter.io/mq/logging"
+)
+
+// Deletes the oldest closed segments of topics & namespaces that exceed their retention bytes. Compacted topics are
+// exempt and don't count towards namespace's retention bytes.
+func (r *Reconciler) ReconcileRetention(state *ClusterState) {
+	topicSegments := make(map[string][]*ClusterSegment)
+	for _, segment := range state.ClosedSegments {
+		if segment.Type != ClusterSegment_TOPIC {
+			continue
+		}
+		key := segment.OwnerNamespace + "/" + segment.OwnerName
+		topicSegments[key] = append(topicSegments[key], segment)
+	}
+
+	for _, namespace := range state.Namespaces {
+		var namespaceSegments []*ClusterSegment
+
+		for _, topic := range namespace.Topics {
+			// compacted segments hold the only copy of the newest message for keys that haven't changed recently,
+			// they aren't deleted by retention bytes (as they aren't by retention period)
+			if topic.CompactionKey != "" {
+				continue
+			}
+
+			segments := topicSegments[namespace.Name+"/"+topic.Name]
+			sortSegmentsByClosedAt(segments)
+
+			if topic.RetentionBytes > 0 {
+				segments = r.reconcileRetentionBytes(state, segments, topic.RetentionBytes, func(*ClusterSegment) bool {
+					return topic.ForceRetentionBytes
+				})
+			}
+
+			namespaceSegments = append(namespaceSegments, segments...)
+		}
+
+		if namespace.RetentionBytes > 0 {
+			sortSegmentsByClosedAt(namespaceSegments)
+			r.reconcileRetentionBytes(state, namespaceSegments, namespace.RetentionBytes, func(segment *ClusterSegment) bool {
+				topic, _ := namespace.FindTopic(segment.OwnerName)
+				return topic != nil && topic.ForceRetentionBytes
+			})
+		}
+	}
+}
+
+// Deletes segments (ordered from the oldest) until their total size fits into the limit. Returns segments that were kept.
+func (r *Reconciler) reconcileRetentionBytes(state *ClusterState, segments []*ClusterSegment, retentionBytes uint64, force func(*ClusterSegment) bool) []*ClusterSegment {
+	var size uint64
+	for _, segment := range segments {
+		size += uint64(segment.Size_)
+	}
+
+	// topics whose oldest remaining segment is still needed by consumer group => no newer segment can be deleted
+	blocked := make(map[string]bool)
+	kept := make([]*ClusterSegment, 0, len(segments))
+	for _, segment := range segments {
+		if size <= retentionBytes || blocked[segment.OwnerName] {
+			kept = append(kept, segment)
+			continue
+		}
+		if segmentNeeded(state, segment) && !force(segment) {
+			blocked[segment.OwnerName] = true
+			kept = append(kept, segment)
+			continue
+		}
+
+		logger := r.logger.With(segmentFields(segment)...)
+		_, err := r.apply(&ClusterCommandSegmentDelete{
+			ID:    segment.ID,
+			Which: ClusterCommandSegmentDelete_CLOSED,
+		})
+		if err != nil {
+			logger.Error("could not delete closed segment exceeding retention bytes", logging.Error(err))
+			kept = append(kept, segment)
+			continue
+		}
+		logger.Info("closed segment exceeded retention bytes, deleted", logging.F("retention_bytes", retentionBytes))
+
+		size -= uint64(segment.Size_)
+	}
+
+	return kept
+}
+
+// Returns true if some consumer group hasn't consumed the whole segment yet.
+func segmentNeeded(state *ClusterState, segment *ClusterSegment) bool {
+	for _, namespace := range state.Namespaces {
+		for _, cg := range namespace.ConsumerGroups {
+			for _, commit := range cg.OffsetCommits {
+				if commit.SegmentID == segment.ID && commit.Offset < segment.Size_ {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func sortSegmentsByClosedAt(segments []*ClusterSegment) {
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].ClosedAt.Equal(segments[j].ClosedAt) {
+			return segments[i].ID < segments[j].ID
+		}
+		return segments[i].ClosedAt.Before(segments[j].ClosedAt)
+	})
+}
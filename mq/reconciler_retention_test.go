@@ -0,0 +1,92 @@
+package mq
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"eventter.io/mq/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciler_ReconcileRetention(t *testing.T) {
+	now := time.Now()
+	closedSegment := func(id uint64, topicName string, age time.Duration) *ClusterSegment {
+		return &ClusterSegment{
+			ID:             id,
+			Type:           ClusterSegment_TOPIC,
+			OwnerNamespace: "default",
+			OwnerName:      topicName,
+			Size_:          100,
+			ClosedAt:       now.Add(-age),
+		}
+	}
+	closedSegments := []*ClusterSegment{
+		closedSegment(1, "a", 6*time.Hour),
+		closedSegment(2, "b", 5*time.Hour),
+		closedSegment(3, "a", 4*time.Hour),
+		closedSegment(4, "b", 3*time.Hour),
+		closedSegment(5, "a", 2*time.Hour),
+		closedSegment(6, "b", 1*time.Hour),
+	}
+	needed := []*ClusterConsumerGroup{
+		{
+			Name: "cg",
+			OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+				{SegmentID: 3, Offset: 50},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                    string
+		namespaceRetentionBytes uint64
+		topicRetentionBytes     uint64
+		force                   bool
+		compacted               bool
+		consumerGroups          []*ClusterConsumerGroup
+		deleted                 []uint64
+	}{
+		{"unlimited", 0, 0, false, false, nil, nil},
+		{"topic", 0, 200, false, false, nil, []uint64{1, 2}},
+		{"topic needed", 0, 100, false, false, needed, []uint64{1, 2, 4}},
+		{"topic forced", 0, 100, true, false, needed, []uint64{1, 3, 2, 4}},
+		{"topic compacted", 0, 100, true, true, nil, []uint64{2, 4}},
+		{"namespace", 300, 0, false, false, nil, []uint64{1, 2, 3}},
+		{"namespace needed", 300, 0, false, false, needed, []uint64{1, 2, 4}},
+		{"namespace compacted", 200, 0, true, true, nil, []uint64{2}},
+		{"namespace & topic", 300, 200, false, false, nil, []uint64{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			// only topic "a" is compacted
+			var compactionKey string
+			if test.compacted {
+				compactionKey = "routing_key"
+			}
+			state := &ClusterState{
+				Namespaces: []*ClusterNamespace{
+					{
+						Name:           "default",
+						RetentionBytes: test.namespaceRetentionBytes,
+						Topics: []*ClusterTopic{
+							{Name: "a", RetentionBytes: test.topicRetentionBytes, ForceRetentionBytes: test.force, CompactionKey: compactionKey},
+							{Name: "b", RetentionBytes: test.topicRetentionBytes, ForceRetentionBytes: test.force},
+						},
+						ConsumerGroups: test.consumerGroups,
+					},
+				},
+				ClosedSegments: append([]*ClusterSegment(nil), closedSegments...),
+			}
+
+			delegate := &reconcilerTestDelegate{}
+			reconciler := NewReconciler(delegate, logging.New(ioutil.Discard, logging.InfoLevel, logging.TextFormat), 0)
+			reconciler.ReconcileRetention(state)
+
+			assert.Equal(test.deleted, delegate.deleted)
+		})
+	}
+}
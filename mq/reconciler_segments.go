@@ -2,23 +2,27 @@ package mq
 
 import (
 	"context"
-	"log"
 	"time"
+
+	"eventter.io/mq/logging"
 )
 
 func (r *Reconciler) ReconcileSegments(state *ClusterState) {
 	nodeSegmentCounts := state.CountSegmentsPerNode()
 	nodeMap := make(map[uint64]*ClusterNode)
-	aliveNodeIDs := make([]uint64, 0, len(state.Nodes))
+	activeNodeIDs := make([]uint64, 0, len(state.Nodes))
 	for _, node := range state.Nodes {
 		nodeMap[node.ID] = node
-		if node.State == ClusterNode_ALIVE {
-			aliveNodeIDs = append(aliveNodeIDs, node.ID)
+		if r.acceptsReplicas(node) {
+			activeNodeIDs = append(activeNodeIDs, node.ID)
 		}
 	}
 
-	r.reconcileOpenSegments(state, nodeSegmentCounts, nodeMap, aliveNodeIDs)
-	r.reconcileClosedSegments(state, nodeSegmentCounts, nodeMap, aliveNodeIDs)
+	r.restoreMoves(state, nodeMap)
+	r.reconcileOpenSegments(state, nodeSegmentCounts, nodeMap, activeNodeIDs)
+	r.reconcileClosedSegments(state, nodeSegmentCounts, nodeMap, activeNodeIDs)
+	r.reconcileReplacingSegments(state)
+	r.rebalanceClosedSegments(state, nodeMap)
 }
 
 func (r *Reconciler) reconcileOpenSegments(state *ClusterState, nodeSegmentCounts map[uint64]int, nodeMap map[uint64]*ClusterNode, allCandidateNodeIDs []uint64) {
@@ -28,26 +32,23 @@ func (r *Reconciler) reconcileOpenSegments(state *ClusterState, nodeSegmentCount
 }
 
 func (r *Reconciler) reconcileOpenSegment(segment *ClusterSegment, state *ClusterState, nodeSegmentCounts map[uint64]int, nodeMap map[uint64]*ClusterNode, allCandidateNodeIDs []uint64) {
+	logger := r.logger.With(segmentFields(segment)...)
+
 	var replicationFactor uint32 = defaultReplicationFactor
 
 	if segment.Type == ClusterSegment_TOPIC {
 		topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName)
 
 		if topic == nil {
-			_, err := r.delegate.Apply(&ClusterCommandSegmentDelete{
+			_, err := r.apply(&ClusterCommandSegmentDelete{
 				ID:    segment.ID,
 				Which: ClusterCommandSegmentDelete_OPEN,
 			})
 			if err != nil {
-				log.Printf("could not delete open segment with non-existent topic: %v", err)
+				logger.Error("could not delete open segment with non-existent topic", logging.Error(err))
 				return
 			}
-			log.Printf(
-				"topic %s/%s does not exist, open segment %d deleted",
-				segment.OwnerNamespace,
-				segment.OwnerName,
-				segment.ID,
-			)
+			logger.Info("topic does not exist, open segment deleted")
 			return
 		}
 
@@ -57,20 +58,15 @@ func (r *Reconciler) reconcileOpenSegment(segment *ClusterSegment, state *Cluste
 		consumerGroup := state.GetConsumerGroup(segment.OwnerNamespace, segment.OwnerName)
 
 		if consumerGroup == nil {
-			_, err := r.delegate.Apply(&ClusterCommandSegmentDelete{
+			_, err := r.apply(&ClusterCommandSegmentDelete{
 				ID:    segment.ID,
 				Which: ClusterCommandSegmentDelete_OPEN,
 			})
 			if err != nil {
-				log.Printf("could not delete open segment with non-existent consumer group: %v", err)
+				logger.Error("could not delete open segment with non-existent consumer group", logging.Error(err))
 				return
 			}
-			log.Printf(
-				"consumer group %s/%s does not exist, open segment %d deleted",
-				segment.OwnerNamespace,
-				segment.OwnerNamespace,
-				segment.ID,
-			)
+			logger.Info("consumer group does not exist, open segment deleted")
 			return
 		}
 	}
@@ -86,18 +82,24 @@ func (r *Reconciler) reconcileOpenSegment(segment *ClusterSegment, state *Cluste
 }
 
 func (r *Reconciler) reconcileOpenSegmentWithAlivePrimary(segment *ClusterSegment, replicationFactor uint32, nodeSegmentCounts map[uint64]int, nodeMap map[uint64]*ClusterNode, allCandidateNodeIDs []uint64) {
+	logger := r.logger.With(segmentFields(segment)...)
+
 	if replicationFactor < 1 {
 		panic("replication factor is zero")
 	}
 
 	aliveReplicas := uint32(1) // 1 for primary
+	drainingReplicas := uint32(0)
+	// replicas being copied to draining or full nodes are replaced by the ones on other nodes
 	for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-		if nodeMap[nodeID].State == ClusterNode_ALIVE {
+		if r.acceptsReplicas(nodeMap[nodeID]) {
 			aliveReplicas++
+		} else if nodeMap[nodeID].State == ClusterNode_ALIVE {
+			drainingReplicas++
 		}
 	}
 
-	if aliveReplicas > replicationFactor {
+	if aliveReplicas > replicationFactor || (drainingReplicas > 0 && aliveReplicas >= replicationFactor) {
 		cmd := &ClusterCommandSegmentNodesUpdate{
 			ID:    segment.ID,
 			Which: ClusterCommandSegmentNodesUpdate_OPEN,
@@ -106,7 +108,7 @@ func (r *Reconciler) reconcileOpenSegmentWithAlivePrimary(segment *ClusterSegmen
 		if replicationFactor-1 > 0 {
 			cmd.Nodes.ReplicatingNodeIDs = make([]uint64, 0, replicationFactor-1)
 			for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-				if nodeMap[nodeID].State == ClusterNode_ALIVE && uint32(1+len(cmd.Nodes.ReplicatingNodeIDs)) < replicationFactor {
+				if r.acceptsReplicas(nodeMap[nodeID]) && uint32(1+len(cmd.Nodes.ReplicatingNodeIDs)) < replicationFactor {
 					cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, nodeID)
 				} else {
 					nodeSegmentCounts[nodeID]--
@@ -114,18 +116,12 @@ func (r *Reconciler) reconcileOpenSegmentWithAlivePrimary(segment *ClusterSegmen
 			}
 		}
 
-		_, err := r.delegate.Apply(cmd)
+		_, err := r.apply(cmd)
 		if err != nil {
-			log.Printf("could not remove segment replica(s): %v", err)
+			logger.Error("could not remove segment replica(s)", logging.Error(err))
 			return
 		}
-		log.Printf(
-			"open segment %d (%s %s/%s) was over-replicated, removed replica(s)",
-			segment.ID,
-			segment.Type.String(),
-			segment.OwnerNamespace,
-			segment.OwnerName,
-		)
+		logger.Info("open segment was over-replicated, removed replica(s)")
 
 	} else if aliveReplicas < replicationFactor {
 		cmd := &ClusterCommandSegmentNodesUpdate{
@@ -135,7 +131,8 @@ func (r *Reconciler) reconcileOpenSegmentWithAlivePrimary(segment *ClusterSegmen
 		cmd.Nodes.PrimaryNodeID = segment.Nodes.PrimaryNodeID
 		cmd.Nodes.ReplicatingNodeIDs = make([]uint64, 0, replicationFactor-1)
 		for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-			if nodeMap[nodeID].State == ClusterNode_ALIVE {
+			// replicas on draining or full nodes are replaced by the ones on other nodes
+			if r.acceptsReplicas(nodeMap[nodeID]) {
 				cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, nodeID)
 			}
 		}
@@ -155,50 +152,31 @@ func (r *Reconciler) reconcileOpenSegmentWithAlivePrimary(segment *ClusterSegmen
 			candidateNodeIDs = append(candidateNodeIDs, candidateNodeID)
 		}
 
-		added := false
-
-		for len(candidateNodeIDs) > 0 && uint32(len(cmd.Nodes.ReplicatingNodeIDs)) < replicationFactor-1 {
-			var candidateIndex = -1
-			for i, candidateNodeID := range candidateNodeIDs {
-				if candidateIndex == -1 || nodeSegmentCounts[candidateNodeID] < nodeSegmentCounts[candidateNodeIDs[candidateIndex]] {
-					candidateIndex = i
-				}
-			}
-
-			nodeSegmentCounts[candidateNodeIDs[candidateIndex]]++
-
-			cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, candidateNodeIDs[candidateIndex])
-			copy(candidateNodeIDs[candidateIndex:], candidateNodeIDs[candidateIndex+1:])
-			candidateNodeIDs = candidateNodeIDs[:len(candidateNodeIDs)-1]
-			added = true
-		}
+		selectedNodeIDs := selectNodes(
+			candidateNodeIDs,
+			int(replicationFactor-1)-len(cmd.Nodes.ReplicatingNodeIDs),
+			append([]uint64{cmd.Nodes.PrimaryNodeID}, cmd.Nodes.ReplicatingNodeIDs...),
+			nodeMap,
+			nodeSegmentCounts,
+		)
+		cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, selectedNodeIDs...)
 
-		if added {
-			_, err := r.delegate.Apply(cmd)
+		if len(selectedNodeIDs) > 0 {
+			_, err := r.apply(cmd)
 			if err != nil {
-				log.Printf("could not add segment replica(s): %v", err)
+				logger.Error("could not add segment replica(s)", logging.Error(err))
 				return
 			}
-			log.Printf(
-				"open segment %d (%s %s/%s) was under-replicated, added replica(s)",
-				segment.ID,
-				segment.Type.String(),
-				segment.OwnerNamespace,
-				segment.OwnerName,
-			)
+			logger.Info("open segment was under-replicated, added replica(s)")
 		}
 	}
 }
 
 func (r *Reconciler) reconcileOpenSegmentWithDeadPrimary(segment *ClusterSegment, nodeMap map[uint64]*ClusterNode) {
+	logger := r.logger.With(segmentFields(segment)...)
+
 	if len(segment.Nodes.ReplicatingNodeIDs) == 0 {
-		log.Printf(
-			"open segment %d (%s %s/%s) has dead primary and no replica to promote to primary",
-			segment.ID,
-			segment.Type.String(),
-			segment.OwnerNamespace,
-			segment.OwnerName,
-		)
+		logger.Warn("open segment has dead primary and no replica to promote to primary")
 		return
 	}
 
@@ -215,11 +193,10 @@ func (r *Reconciler) reconcileOpenSegmentWithDeadPrimary(segment *ClusterSegment
 
 		replicaSegmentSize, err := r.delegate.GetSegmentSizeFromNode(ctx, segment.ID, replicaNodeID, replica.Address)
 		if err != nil {
-			log.Printf(
-				"could not get size of segment %d from node %d: %v",
-				segment.ID,
-				replicaNodeID,
-				err,
+			logger.Error(
+				"could not get size of segment from replica",
+				logging.F("replica_node_id", NodeIDToString(replicaNodeID)),
+				logging.Error(err),
 			)
 			return
 		}
@@ -231,13 +208,7 @@ func (r *Reconciler) reconcileOpenSegmentWithDeadPrimary(segment *ClusterSegment
 	}
 
 	if newPrimaryNodeID == 0 {
-		log.Printf(
-			"open segment %d (%s %s/%s) has dead primary and no suitable replica",
-			segment.ID,
-			segment.Type.String(),
-			segment.OwnerNamespace,
-			segment.OwnerName,
-		)
+		logger.Warn("open segment has dead primary and no suitable replica")
 		return
 	}
 
@@ -257,22 +228,39 @@ func (r *Reconciler) reconcileOpenSegmentWithDeadPrimary(segment *ClusterSegment
 		},
 	}
 
-	_, err := r.delegate.Apply(cmd)
+	_, err := r.apply(cmd)
 	if err != nil {
-		log.Printf("could not change primary of segment %d: %v", segment.ID, err)
+		logger.Error("could not change primary of segment", logging.Error(err))
 		return
 	}
-	log.Printf(
-		"open segment %d (%s %s/%s) changed primary from %d to %d",
-		segment.ID,
-		segment.Type.String(),
-		segment.OwnerNamespace,
-		segment.OwnerName,
-		segment.Nodes.PrimaryNodeID,
-		newPrimaryNodeID,
+	logger.Info(
+		"open segment changed primary",
+		logging.F("old_primary_node_id", NodeIDToString(segment.Nodes.PrimaryNodeID)),
+		logging.F("new_primary_node_id", NodeIDToString(newPrimaryNodeID)),
 	)
 }
 
+// Replacing segments are managed by compaction of their topic, only the ones left behind by compaction that won't run
+// anymore are deleted.
+func (r *Reconciler) reconcileReplacingSegments(state *ClusterState) {
+	for _, segment := range state.ReplacingSegments {
+		topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName)
+		if topic != nil && topic.CompactionKey != "" {
+			continue
+		}
+
+		_, err := r.apply(&ClusterCommandSegmentDelete{
+			ID:    segment.ID,
+			Which: ClusterCommandSegmentDelete_REPLACING,
+		})
+		if err != nil {
+			r.logger.Error("could not delete replacing segment", logging.SegmentID(segment.ID), logging.Error(err))
+			continue
+		}
+		r.logger.Info("topic isn't compacted anymore, replacing segment deleted", logging.SegmentID(segment.ID))
+	}
+}
+
 func (r *Reconciler) reconcileClosedSegments(state *ClusterState, nodeSegmentCounts map[uint64]int, nodeMap map[uint64]*ClusterNode, allCandidateNodeIDs []uint64) {
 	for _, segment := range state.ClosedSegments {
 		r.reconcileClosedSegment(segment, state, nodeSegmentCounts, nodeMap, allCandidateNodeIDs)
@@ -280,99 +268,98 @@ func (r *Reconciler) reconcileClosedSegments(state *ClusterState, nodeSegmentCou
 }
 
 func (r *Reconciler) reconcileClosedSegment(segment *ClusterSegment, state *ClusterState, nodeSegmentCounts map[uint64]int, nodeMap map[uint64]*ClusterNode, allCandidateNodeIDs []uint64) {
+	logger := r.logger.With(segmentFields(segment)...)
+
 	var replicationFactor uint32 = defaultReplicationFactor
 
 	if segment.Type == ClusterSegment_TOPIC {
 		topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName)
 
 		if topic == nil {
-			_, err := r.delegate.Apply(&ClusterCommandSegmentDelete{
+			_, err := r.apply(&ClusterCommandSegmentDelete{
 				ID:    segment.ID,
 				Which: ClusterCommandSegmentDelete_CLOSED,
 			})
 			if err != nil {
-				log.Printf("could not delete closed segment with non-existent topic: %v", err)
+				logger.Error("could not delete closed segment with non-existent topic", logging.Error(err))
 				return
 			}
 
-			log.Printf(
-				"topic %s/%s does not exist, closed segment %d deleted",
-				segment.OwnerNamespace,
-				segment.OwnerName,
-				segment.ID,
-			)
+			logger.Info("topic does not exist, closed segment deleted")
 			return
 
-		} else if topic.Retention > 0 {
+		} else if topic.Retention > 0 && topic.CompactionKey == "" {
 			retainTill := time.Now().Add(-topic.Retention)
 			if segment.ClosedAt.Before(retainTill) {
 
-				needed := false
-			NS:
-				for _, namespace := range state.Namespaces {
-					for _, cg := range namespace.ConsumerGroups {
-						for _, commit := range cg.OffsetCommits {
-							if commit.SegmentID == segment.ID && commit.Offset < segment.Size_ {
-								needed = true
-								break NS
-							}
-						}
-					}
-				}
-				if !needed {
-					_, err := r.delegate.Apply(&ClusterCommandSegmentDelete{
+				if !segmentNeeded(state, segment) {
+					_, err := r.apply(&ClusterCommandSegmentDelete{
 						ID:    segment.ID,
 						Which: ClusterCommandSegmentDelete_CLOSED,
 					})
 					if err != nil {
-						log.Printf("could not delete closed segment after retention period: %v", err)
+						logger.Error("could not delete closed segment after retention period", logging.Error(err))
 						return
 					}
-					log.Printf(
-						"closed segment %d (%s %s/%s) fell off retention period and wasn't needed by any consumer group, deleted",
-						segment.ID,
-						segment.Type.String(),
-						segment.OwnerNamespace,
-						segment.OwnerName,
-					)
+					logger.Info("closed segment fell off retention period and wasn't needed by any consumer group, deleted")
 					return
 				}
 			}
 		}
 
+		if !segment.OffloadedAt.IsZero() {
+			// data are kept by tiered storage, nodes only fetch them on demand => nothing to replicate
+			return
+		}
+
 		replicationFactor = topic.ReplicationFactor
 
 	} else if segment.Type == ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS {
 		// segment is closed only after offsets were saved to cluster state => delete closed offset commits segment right away
 
-		_, err := r.delegate.Apply(&ClusterCommandSegmentDelete{
+		_, err := r.apply(&ClusterCommandSegmentDelete{
 			ID:    segment.ID,
 			Which: ClusterCommandSegmentDelete_CLOSED,
 		})
 		if err != nil {
-			log.Printf("could not delete closed consumer group offset commits segment: %v", err)
+			logger.Error("could not delete closed consumer group offset commits segment", logging.Error(err))
 			return
 		}
-		log.Printf(
-			"closed segment %d for consumer group %s/%s offset commits deleted",
-			segment.ID,
-			segment.OwnerNamespace,
-			segment.OwnerNamespace,
-		)
+		logger.Info("closed consumer group offset commits segment deleted")
 		return
 	}
 
+	// replica being moved away by rebalancing is treated the same way as replica on draining node
+	move := r.getMove(segment.ID)
+	active := func(nodeID uint64) bool {
+		return nodeMap[nodeID].IsActive() && (move == nil || move.SourceNodeID != nodeID)
+	}
+	// replicas not completed yet are treated as if on draining node if disk of their node is full
+	replicating := func(nodeID uint64) bool {
+		return active(nodeID) && r.acceptsReplicas(nodeMap[nodeID])
+	}
+
+	// replicas on draining nodes do not count, however, they can be still used as source of data for new replicas
 	aliveReplicas := uint32(0)
 	aliveDone := uint32(0)
+	activeDone := uint32(0)
+	drainingReplicas := uint32(0)
 	for _, nodeID := range segment.Nodes.DoneNodeIDs {
-		if nodeMap[nodeID].State == ClusterNode_ALIVE {
+		if active(nodeID) {
 			aliveReplicas++
+			activeDone++
+		} else if nodeMap[nodeID].State == ClusterNode_ALIVE {
+			drainingReplicas++
+		}
+		if nodeMap[nodeID].State == ClusterNode_ALIVE {
 			aliveDone++
 		}
 	}
 	for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-		if nodeMap[nodeID].State == ClusterNode_ALIVE {
+		if replicating(nodeID) {
 			aliveReplicas++
+		} else if nodeMap[nodeID].State == ClusterNode_ALIVE {
+			drainingReplicas++
 		}
 	}
 
@@ -380,15 +367,9 @@ func (r *Reconciler) reconcileClosedSegment(segment *ClusterSegment, state *Clus
 		panic("replication factor is zero")
 	}
 
-	if aliveReplicas > replicationFactor {
+	if aliveReplicas > replicationFactor || (drainingReplicas > 0 && activeDone >= replicationFactor) {
 		if aliveDone == 0 {
-			log.Printf(
-				"closed segment %d (%s %s/%s) over-replicated, but none alive done replica found, won't do anything for now",
-				segment.ID,
-				segment.Type.String(),
-				segment.OwnerNamespace,
-				segment.OwnerName,
-			)
+			logger.Warn("closed segment over-replicated, but none alive done replica found, won't do anything for now")
 			return
 		}
 
@@ -398,17 +379,26 @@ func (r *Reconciler) reconcileClosedSegment(segment *ClusterSegment, state *Clus
 		}
 		cmd.Nodes.DoneNodeIDs = make([]uint64, 0, len(segment.Nodes.DoneNodeIDs))
 		for _, nodeID := range segment.Nodes.DoneNodeIDs {
-			if nodeMap[nodeID].State == ClusterNode_ALIVE {
+			if active(nodeID) {
 				cmd.Nodes.DoneNodeIDs = append(cmd.Nodes.DoneNodeIDs, nodeID)
 				if uint32(len(cmd.Nodes.DoneNodeIDs)) >= replicationFactor {
 					break
 				}
 			}
 		}
+		// keep done replicas on draining nodes until there are enough replicas on active nodes
+		for _, nodeID := range segment.Nodes.DoneNodeIDs {
+			if uint32(len(cmd.Nodes.DoneNodeIDs)) >= replicationFactor {
+				break
+			}
+			if nodeMap[nodeID].State == ClusterNode_ALIVE && !active(nodeID) {
+				cmd.Nodes.DoneNodeIDs = append(cmd.Nodes.DoneNodeIDs, nodeID)
+			}
+		}
 		if uint32(len(cmd.Nodes.DoneNodeIDs)) < replicationFactor {
 			cmd.Nodes.ReplicatingNodeIDs = make([]uint64, 0, replicationFactor-uint32(len(cmd.Nodes.DoneNodeIDs)))
 			for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-				if nodeMap[nodeID].State == ClusterNode_ALIVE &&
+				if replicating(nodeID) &&
 					uint32(len(cmd.Nodes.DoneNodeIDs)+len(cmd.Nodes.ReplicatingNodeIDs)) < replicationFactor {
 
 					cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, nodeID)
@@ -419,28 +409,16 @@ func (r *Reconciler) reconcileClosedSegment(segment *ClusterSegment, state *Clus
 			}
 		}
 
-		_, err := r.delegate.Apply(cmd)
+		_, err := r.apply(cmd)
 		if err != nil {
-			log.Printf("could not add segment replica(s): %v", err)
+			logger.Error("could not remove segment replica(s)", logging.Error(err))
 			return
 		}
-		log.Printf(
-			"closed segment %d (%s %s/%s) was over-replicated, removed replica(s)",
-			segment.ID,
-			segment.Type.String(),
-			segment.OwnerNamespace,
-			segment.OwnerName,
-		)
+		logger.Info("closed segment was over-replicated, removed replica(s)")
 
 	} else if aliveReplicas < replicationFactor {
 		if aliveDone == 0 {
-			log.Printf(
-				"closed segment %d (%s %s/%s) under-replicated, but none alive done replica found, won't do anything for now",
-				segment.ID,
-				segment.Type.String(),
-				segment.OwnerNamespace,
-				segment.OwnerName,
-			)
+			logger.Warn("closed segment under-replicated, but none alive done replica found, won't do anything for now")
 			return
 		}
 
@@ -454,9 +432,9 @@ func (r *Reconciler) reconcileClosedSegment(segment *ClusterSegment, state *Clus
 				cmd.Nodes.DoneNodeIDs = append(cmd.Nodes.DoneNodeIDs, nodeID)
 			}
 		}
-		cmd.Nodes.ReplicatingNodeIDs = make([]uint64, 0, replicationFactor-uint32(len(cmd.Nodes.DoneNodeIDs)))
+		cmd.Nodes.ReplicatingNodeIDs = make([]uint64, 0, replicationFactor)
 		for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-			if nodeMap[nodeID].State == ClusterNode_ALIVE {
+			if replicating(nodeID) {
 				cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, nodeID)
 			}
 		}
@@ -478,37 +456,30 @@ func (r *Reconciler) reconcileClosedSegment(segment *ClusterSegment, state *Clus
 			candidateNodeIDs = append(candidateNodeIDs, candidateNodeID)
 		}
 
-		added := false
-
-		for len(candidateNodeIDs) > 0 && uint32(len(cmd.Nodes.DoneNodeIDs)+len(cmd.Nodes.ReplicatingNodeIDs)) < replicationFactor {
-			var candidateIndex = -1
-			for i, candidateNodeID := range candidateNodeIDs {
-				if candidateIndex == -1 || nodeSegmentCounts[candidateNodeID] < nodeSegmentCounts[candidateNodeIDs[candidateIndex]] {
-					candidateIndex = i
-				}
+		placedNodeIDs := make([]uint64, 0, len(cmd.Nodes.DoneNodeIDs)+len(cmd.Nodes.ReplicatingNodeIDs))
+		for _, nodeID := range cmd.Nodes.DoneNodeIDs {
+			if active(nodeID) {
+				placedNodeIDs = append(placedNodeIDs, nodeID)
 			}
-
-			nodeSegmentCounts[candidateNodeIDs[candidateIndex]]++
-
-			cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, candidateNodeIDs[candidateIndex])
-			copy(candidateNodeIDs[candidateIndex:], candidateNodeIDs[candidateIndex+1:])
-			candidateNodeIDs = candidateNodeIDs[:len(candidateNodeIDs)-1]
-			added = true
 		}
+		placedNodeIDs = append(placedNodeIDs, cmd.Nodes.ReplicatingNodeIDs...)
 
-		if added {
-			_, err := r.delegate.Apply(cmd)
+		selectedNodeIDs := selectNodes(
+			candidateNodeIDs,
+			int(replicationFactor)-len(placedNodeIDs),
+			placedNodeIDs,
+			nodeMap,
+			nodeSegmentCounts,
+		)
+		cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, selectedNodeIDs...)
+
+		if len(selectedNodeIDs) > 0 {
+			_, err := r.apply(cmd)
 			if err != nil {
-				log.Printf("could not add segment replica(s): %v", err)
+				logger.Error("could not add segment replica(s)", logging.Error(err))
 				return
 			}
-			log.Printf(
-				"closed segment %d (%s %s/%s) was under-replicated, added replica(s)",
-				segment.ID,
-				segment.Type.String(),
-				segment.OwnerNamespace,
-				segment.OwnerName,
-			)
+			logger.Info("closed segment was under-replicated, added replica(s)")
 		}
 	}
 }
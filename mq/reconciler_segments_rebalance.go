@@ -0,0 +1,310 @@
+package mq
+
+import (
+	"sort"
+	"time"
+
+	"eventter.io/mq/logging"
+)
+
+const (
+	// Maximum number of closed segment replicas being moved between nodes at once.
+	maxConcurrentSegmentMoves = 2
+	// Move that does not complete in this period is cancelled.
+	segmentMoveTimeout = 1 * time.Hour
+)
+
+func (r *Reconciler) getMove(segmentID uint64) *SegmentMove {
+	r.movesMutex.Lock()
+	defer r.movesMutex.Unlock()
+	return r.moves[segmentID]
+}
+
+// Moves are kept only in memory of the leader. After leadership changes, the new leader rebuilds them from cluster
+// state, so that replicas being copied aren't trimmed as extra ones: fully replicated closed segment with single
+// replica being copied to active node is a move. Its source is the done node storing the most data, i.e. the one the
+// move would have been started from.
+func (r *Reconciler) restoreMoves(state *ClusterState, nodeMap map[uint64]*ClusterNode) {
+	r.movesMutex.Lock()
+	defer r.movesMutex.Unlock()
+
+	var usage map[uint64]*SegmentRebalanceStatusResponse_NodeUsage
+
+SEGMENT:
+	for _, segment := range state.ClosedSegments {
+		if segment.Type != ClusterSegment_TOPIC || len(segment.Nodes.ReplicatingNodeIDs) != 1 {
+			continue
+		}
+		if _, ok := r.moves[segment.ID]; ok {
+			continue
+		}
+		if topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName); topic == nil || uint32(len(segment.Nodes.DoneNodeIDs)) != topic.ReplicationFactor {
+			continue
+		}
+		targetNodeID := segment.Nodes.ReplicatingNodeIDs[0]
+		if !r.acceptsReplicas(nodeMap[targetNodeID]) {
+			continue
+		}
+		for _, nodeID := range segment.Nodes.DoneNodeIDs {
+			if node := nodeMap[nodeID]; node == nil || !node.IsActive() {
+				continue SEGMENT
+			}
+		}
+
+		if usage == nil {
+			usage = r.nodeUsage(state)
+		}
+		sourceNodeID := segment.Nodes.DoneNodeIDs[0]
+		for _, nodeID := range segment.Nodes.DoneNodeIDs[1:] {
+			if usage[nodeID].Bytes > usage[sourceNodeID].Bytes {
+				sourceNodeID = nodeID
+			}
+		}
+
+		r.moves[segment.ID] = &SegmentMove{
+			SegmentID:    segment.ID,
+			SourceNodeID: sourceNodeID,
+			TargetNodeID: targetNodeID,
+			Size_:        segment.Size_,
+			StartedAt:    time.Now(),
+		}
+
+		r.logger.With(segmentFields(segment)...).Info(
+			"restored move of segment",
+			logging.F("size", segment.Size_),
+			logging.F("source_node_id", NodeIDToString(sourceNodeID)),
+			logging.F("target_node_id", NodeIDToString(targetNodeID)),
+		)
+	}
+}
+
+// Moves closed segment replicas from nodes storing the most data to the ones storing the least. Replica is first
+// copied to the target node, after it's done, replica on source node is removed by closed segment reconciliation.
+func (r *Reconciler) rebalanceClosedSegments(state *ClusterState, nodeMap map[uint64]*ClusterNode) {
+	r.movesMutex.Lock()
+	defer r.movesMutex.Unlock()
+
+	// 1) check progress of running moves
+
+	for segmentID, move := range r.moves {
+		segment := state.GetClosedSegment(segmentID)
+		if segment == nil {
+			delete(r.moves, segmentID)
+			r.cancelledMoves++
+			r.logger.Info("move of segment cancelled, segment was deleted", logging.SegmentID(segmentID))
+			continue
+		}
+
+		if !segment.Nodes.Contains(move.SourceNodeID) {
+			delete(r.moves, segmentID)
+			r.completedMoves++
+			r.logger.Info(
+				"move of segment completed",
+				logging.SegmentID(segmentID),
+				logging.F("source_node_id", NodeIDToString(move.SourceNodeID)),
+				logging.F("target_node_id", NodeIDToString(move.TargetNodeID)),
+			)
+			continue
+		}
+
+		source, target := nodeMap[move.SourceNodeID], nodeMap[move.TargetNodeID]
+		timedOut := time.Since(move.StartedAt) > segmentMoveTimeout
+		if source == nil || !source.IsActive() ||
+			!r.acceptsReplicas(target) ||
+			!segment.Nodes.Contains(move.TargetNodeID) ||
+			timedOut {
+
+			if timedOut {
+				// remove replica being copied, otherwise the move would be restored again
+				cmd := &ClusterCommandSegmentNodesUpdate{
+					ID:    segmentID,
+					Which: ClusterCommandSegmentNodesUpdate_CLOSED,
+				}
+				cmd.Nodes.DoneNodeIDs = segment.Nodes.DoneNodeIDs
+				for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
+					if nodeID != move.TargetNodeID {
+						cmd.Nodes.ReplicatingNodeIDs = append(cmd.Nodes.ReplicatingNodeIDs, nodeID)
+					}
+				}
+				if _, err := r.apply(cmd); err != nil {
+					r.logger.Error("could not cancel move of segment", logging.SegmentID(segmentID), logging.Error(err))
+					continue
+				}
+			}
+
+			delete(r.moves, segmentID)
+			r.cancelledMoves++
+			r.logger.Info(
+				"move of segment cancelled",
+				logging.SegmentID(segmentID),
+				logging.F("source_node_id", NodeIDToString(move.SourceNodeID)),
+				logging.F("target_node_id", NodeIDToString(move.TargetNodeID)),
+			)
+		}
+	}
+
+	// 2) start new move (at most one per reconciliation to rate limit data transfers)
+
+	if len(r.moves) >= maxConcurrentSegmentMoves {
+		return
+	}
+
+	usage := r.nodeUsage(state)
+
+	var activeNodeIDs []uint64
+	for _, node := range state.Nodes {
+		if node.IsActive() {
+			activeNodeIDs = append(activeNodeIDs, node.ID)
+		}
+	}
+	if len(activeNodeIDs) < 2 {
+		return
+	}
+
+	sort.Slice(activeNodeIDs, func(i, j int) bool {
+		return usage[activeNodeIDs[i]].Bytes > usage[activeNodeIDs[j]].Bytes
+	})
+	sourceNodeID := activeNodeIDs[0]
+	var targetNodeID uint64
+	for i := len(activeNodeIDs) - 1; i > 0; i-- {
+		if !nodeMap[activeNodeIDs[i]].IsDiskFull(r.diskHighWatermark) {
+			targetNodeID = activeNodeIDs[i]
+			break
+		}
+	}
+	if targetNodeID == 0 {
+		return
+	}
+	diff := usage[sourceNodeID].Bytes - usage[targetNodeID].Bytes
+
+	var candidate *ClusterSegment
+
+SEGMENT:
+	for _, segment := range state.ClosedSegments {
+		if segment.Type != ClusterSegment_TOPIC {
+			continue
+		}
+		// move must decrease the difference between source & target
+		if segment.Size_ <= 0 || segment.Size_ > diff/2 {
+			continue
+		}
+		if candidate != nil && segment.Size_ <= candidate.Size_ {
+			continue
+		}
+		if _, ok := r.moves[segment.ID]; ok {
+			continue
+		}
+		// only fully replicated segments are moved
+		if len(segment.Nodes.ReplicatingNodeIDs) > 0 {
+			continue
+		}
+		if topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName); topic == nil || uint32(len(segment.Nodes.DoneNodeIDs)) != topic.ReplicationFactor {
+			continue
+		}
+
+		isOnSource := false
+		for _, nodeID := range segment.Nodes.DoneNodeIDs {
+			if nodeID == targetNodeID || !nodeMap[nodeID].IsActive() {
+				continue SEGMENT
+			}
+			if nodeID == sourceNodeID {
+				isOnSource = true
+			}
+		}
+		if !isOnSource {
+			continue
+		}
+
+		// move must not decrease zone diversity
+		if nodeMap[targetNodeID].Zone != nodeMap[sourceNodeID].Zone {
+			for _, nodeID := range segment.Nodes.DoneNodeIDs {
+				if nodeMap[nodeID].Zone == nodeMap[targetNodeID].Zone {
+					continue SEGMENT
+				}
+			}
+		}
+
+		candidate = segment
+	}
+
+	if candidate == nil {
+		return
+	}
+
+	cmd := &ClusterCommandSegmentNodesUpdate{
+		ID:    candidate.ID,
+		Which: ClusterCommandSegmentNodesUpdate_CLOSED,
+	}
+	cmd.Nodes.DoneNodeIDs = candidate.Nodes.DoneNodeIDs
+	cmd.Nodes.ReplicatingNodeIDs = []uint64{targetNodeID}
+
+	if _, err := r.apply(cmd); err != nil {
+		r.logger.Error("could not start move of segment", logging.SegmentID(candidate.ID), logging.Error(err))
+		return
+	}
+
+	r.moves[candidate.ID] = &SegmentMove{
+		SegmentID:    candidate.ID,
+		SourceNodeID: sourceNodeID,
+		TargetNodeID: targetNodeID,
+		Size_:        candidate.Size_,
+		StartedAt:    time.Now(),
+	}
+
+	r.logger.With(segmentFields(candidate)...).Info(
+		"started move of segment",
+		logging.F("size", candidate.Size_),
+		logging.F("source_node_id", NodeIDToString(sourceNodeID)),
+		logging.F("target_node_id", NodeIDToString(targetNodeID)),
+	)
+}
+
+// Computes closed segments stored on each node. Running moves are accounted as if they were already completed. Must be
+// called with moves mutex held.
+func (r *Reconciler) nodeUsage(state *ClusterState) map[uint64]*SegmentRebalanceStatusResponse_NodeUsage {
+	usage := make(map[uint64]*SegmentRebalanceStatusResponse_NodeUsage)
+	for _, node := range state.Nodes {
+		usage[node.ID] = &SegmentRebalanceStatusResponse_NodeUsage{NodeID: node.ID}
+	}
+
+	for _, segment := range state.ClosedSegments {
+		for _, nodeIDs := range [][]uint64{segment.Nodes.DoneNodeIDs, segment.Nodes.ReplicatingNodeIDs} {
+			for _, nodeID := range nodeIDs {
+				if move, ok := r.moves[segment.ID]; ok && move.SourceNodeID == nodeID {
+					continue
+				}
+				if u, ok := usage[nodeID]; ok {
+					u.Segments++
+					u.Bytes += segment.Size_
+				}
+			}
+		}
+	}
+
+	return usage
+}
+
+func (r *Reconciler) SegmentRebalanceStatus(state *ClusterState) *SegmentRebalanceStatusResponse {
+	r.movesMutex.Lock()
+	defer r.movesMutex.Unlock()
+
+	response := &SegmentRebalanceStatusResponse{
+		CompletedMoves: r.completedMoves,
+		CancelledMoves: r.cancelledMoves,
+	}
+
+	for _, move := range r.moves {
+		m := *move
+		response.Moves = append(response.Moves, &m)
+	}
+	sort.Slice(response.Moves, func(i, j int) bool {
+		return response.Moves[i].SegmentID < response.Moves[j].SegmentID
+	})
+
+	usage := r.nodeUsage(state)
+	for _, node := range state.Nodes {
+		response.Nodes = append(response.Nodes, usage[node.ID])
+	}
+
+	return response
+}
@@ -0,0 +1,212 @@
+package mq
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"eventter.io/mq/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciler_RebalanceClosedSegments(t *testing.T) {
+	closedSegment := func(id uint64, size int64, doneNodeIDs []uint64, replicatingNodeIDs []uint64) *ClusterSegment {
+		return &ClusterSegment{
+			ID:             id,
+			Type:           ClusterSegment_TOPIC,
+			OwnerNamespace: "default",
+			OwnerName:      "topic",
+			Size_:          size,
+			Nodes: ClusterSegment_Nodes{
+				DoneNodeIDs:        doneNodeIDs,
+				ReplicatingNodeIDs: replicatingNodeIDs,
+			},
+		}
+	}
+	unbalanced := []*ClusterSegment{
+		closedSegment(1, 100, []uint64{1}, nil),
+		closedSegment(2, 300, []uint64{1}, nil),
+		closedSegment(3, 600, []uint64{1}, nil),
+	}
+
+	tests := []struct {
+		name              string
+		nodes             []*ClusterNode
+		replicationFactor uint32
+		closedSegments    []*ClusterSegment
+		segmentID         uint64
+		targetNodeID      uint64
+	}{
+		{
+			"balanced",
+			[]*ClusterNode{{ID: 1}, {ID: 2}},
+			1,
+			[]*ClusterSegment{
+				closedSegment(1, 100, []uint64{1}, nil),
+				closedSegment(2, 100, []uint64{2}, nil),
+			},
+			0, 0,
+		},
+		{
+			"largest segment decreasing difference",
+			[]*ClusterNode{{ID: 1}, {ID: 2}},
+			1,
+			unbalanced,
+			2, 2,
+		},
+		{
+			"least used target",
+			[]*ClusterNode{{ID: 1}, {ID: 2}, {ID: 3}},
+			1,
+			append([]*ClusterSegment{closedSegment(4, 50, []uint64{2}, nil)}, unbalanced...),
+			2, 3,
+		},
+		{
+			"full target",
+			[]*ClusterNode{{ID: 1}, {ID: 2, DiskTotal: 100, DiskFree: 5}},
+			1,
+			unbalanced,
+			0, 0,
+		},
+		{
+			"draining nodes",
+			[]*ClusterNode{{ID: 1, AdminState: ClusterNode_DRAINING}, {ID: 2}},
+			1,
+			unbalanced,
+			0, 0,
+		},
+		{
+			"replicating segment",
+			[]*ClusterNode{{ID: 1}, {ID: 2}, {ID: 3}},
+			1,
+			[]*ClusterSegment{
+				closedSegment(1, 100, []uint64{1}, nil),
+				closedSegment(2, 300, []uint64{1}, []uint64{3}),
+				closedSegment(3, 600, []uint64{1}, nil),
+			},
+			1, 2,
+		},
+		{
+			"zone diversity",
+			[]*ClusterNode{{ID: 1, Zone: "a"}, {ID: 2, Zone: "b"}, {ID: 3, Zone: "b"}, {ID: 4, Zone: "b"}},
+			2,
+			[]*ClusterSegment{
+				closedSegment(1, 100, []uint64{1, 3}, nil),
+				closedSegment(2, 300, []uint64{1, 2}, nil),
+				closedSegment(3, 200, []uint64{1, 3}, nil),
+			},
+			0, 0,
+		},
+		{
+			"same zone",
+			[]*ClusterNode{{ID: 1, Zone: "a"}, {ID: 2, Zone: "a"}, {ID: 3, Zone: "b"}, {ID: 4, Zone: "a"}},
+			2,
+			[]*ClusterSegment{
+				closedSegment(1, 100, []uint64{1, 3}, nil),
+				closedSegment(2, 300, []uint64{1, 2}, nil),
+				closedSegment(3, 200, []uint64{1, 3}, nil),
+			},
+			2, 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			nodeMap := make(map[uint64]*ClusterNode)
+			for _, node := range test.nodes {
+				node.State = ClusterNode_ALIVE
+				nodeMap[node.ID] = node
+			}
+			state := &ClusterState{
+				Namespaces: []*ClusterNamespace{
+					{
+						Name:   "default",
+						Topics: []*ClusterTopic{{Name: "topic", ReplicationFactor: test.replicationFactor}},
+					},
+				},
+				Nodes:          test.nodes,
+				ClosedSegments: test.closedSegments,
+			}
+
+			delegate := &reconcilerTestDelegate{}
+			reconciler := NewReconciler(delegate, logging.New(ioutil.Discard, logging.InfoLevel, logging.TextFormat), 0.9)
+			reconciler.rebalanceClosedSegments(state, nodeMap)
+
+			if test.segmentID == 0 {
+				assert.Empty(delegate.updates)
+				assert.Empty(reconciler.moves)
+				return
+			}
+
+			assert.Len(delegate.updates, 1)
+			assert.Equal(test.segmentID, delegate.updates[0].ID)
+			assert.Equal([]uint64{test.targetNodeID}, delegate.updates[0].Nodes.ReplicatingNodeIDs)
+			assert.Len(reconciler.moves, 1)
+			assert.Equal(test.targetNodeID, reconciler.moves[test.segmentID].TargetNodeID)
+		})
+	}
+}
+
+func TestReconciler_RestoreMoves(t *testing.T) {
+	assert := require.New(t)
+
+	nodes := []*ClusterNode{
+		{ID: 1, State: ClusterNode_ALIVE},
+		{ID: 2, State: ClusterNode_ALIVE},
+		{ID: 3, State: ClusterNode_ALIVE},
+	}
+	state := &ClusterState{
+		Namespaces: []*ClusterNamespace{
+			{
+				Name:   "default",
+				Topics: []*ClusterTopic{{Name: "topic", ReplicationFactor: 1}},
+			},
+		},
+		Nodes: nodes,
+		ClosedSegments: []*ClusterSegment{
+			{
+				ID:             1,
+				Type:           ClusterSegment_TOPIC,
+				OwnerNamespace: "default",
+				OwnerName:      "topic",
+				Size_:          300,
+				Nodes:          ClusterSegment_Nodes{DoneNodeIDs: []uint64{1}, ReplicatingNodeIDs: []uint64{3}},
+			},
+			{
+				ID:             2,
+				Type:           ClusterSegment_TOPIC,
+				OwnerNamespace: "default",
+				OwnerName:      "topic",
+				Size_:          100,
+				Nodes:          ClusterSegment_Nodes{DoneNodeIDs: []uint64{2}},
+			},
+		},
+	}
+
+	// new leader doesn't know about move started by the previous one
+	delegate := &reconcilerTestDelegate{}
+	reconciler := NewReconciler(delegate, logging.New(ioutil.Discard, logging.InfoLevel, logging.TextFormat), 0)
+	reconciler.ReconcileSegments(state)
+
+	// replica being copied isn't trimmed, move is reported
+	assert.Empty(delegate.updates)
+	status := reconciler.SegmentRebalanceStatus(state)
+	assert.Len(status.Moves, 1)
+	assert.Equal(uint64(1), status.Moves[0].SegmentID)
+	assert.Equal(uint64(1), status.Moves[0].SourceNodeID)
+	assert.Equal(uint64(3), status.Moves[0].TargetNodeID)
+
+	// after copy is done, replica on source node is removed
+	state.ClosedSegments[0].Nodes = ClusterSegment_Nodes{DoneNodeIDs: []uint64{1, 3}}
+	reconciler.ReconcileSegments(state)
+	assert.Len(delegate.updates, 1)
+	assert.Equal(uint64(1), delegate.updates[0].ID)
+	assert.Equal([]uint64{3}, delegate.updates[0].Nodes.DoneNodeIDs)
+
+	state.ClosedSegments[0].Nodes = ClusterSegment_Nodes{DoneNodeIDs: []uint64{3}}
+	reconciler.ReconcileSegments(state)
+	status = reconciler.SegmentRebalanceStatus(state)
+	assert.Empty(status.Moves)
+	assert.Equal(uint64(1), status.CompletedMoves)
+}
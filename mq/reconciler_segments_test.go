@@ -0,0 +1,58 @@
+package mq
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"eventter.io/mq/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciler_ReconcileSegmentsDiskFull(t *testing.T) {
+	assert := require.New(t)
+
+	segment := func(id uint64, nodes ClusterSegment_Nodes) *ClusterSegment {
+		return &ClusterSegment{
+			ID:             id,
+			Type:           ClusterSegment_TOPIC,
+			OwnerNamespace: "default",
+			OwnerName:      "topic",
+			Nodes:          nodes,
+		}
+	}
+	state := &ClusterState{
+		Namespaces: []*ClusterNamespace{
+			{
+				Name:   "default",
+				Topics: []*ClusterTopic{{Name: "topic", ReplicationFactor: 2}},
+			},
+		},
+		Nodes: []*ClusterNode{
+			{ID: 1, State: ClusterNode_ALIVE},
+			{ID: 2, State: ClusterNode_ALIVE},
+			{ID: 3, State: ClusterNode_ALIVE, DiskTotal: 100, DiskFree: 5},
+		},
+		OpenSegments: []*ClusterSegment{
+			segment(1, ClusterSegment_Nodes{PrimaryNodeID: 1, ReplicatingNodeIDs: []uint64{3}}),
+		},
+		ClosedSegments: []*ClusterSegment{
+			segment(2, ClusterSegment_Nodes{DoneNodeIDs: []uint64{1}, ReplicatingNodeIDs: []uint64{3}}),
+			segment(3, ClusterSegment_Nodes{DoneNodeIDs: []uint64{1, 3}}),
+		},
+	}
+
+	delegate := &reconcilerTestDelegate{}
+	reconciler := NewReconciler(delegate, logging.New(ioutil.Discard, logging.InfoLevel, logging.TextFormat), 0.9)
+	reconciler.ReconcileSegments(state)
+
+	// replicas being copied to full node are moved to other node, completed replica stays
+	assert.Len(delegate.updates, 2)
+	assert.Equal(uint64(1), delegate.updates[0].ID)
+	assert.Equal(ClusterCommandSegmentNodesUpdate_OPEN, delegate.updates[0].Which)
+	assert.Equal(uint64(1), delegate.updates[0].Nodes.PrimaryNodeID)
+	assert.Equal([]uint64{2}, delegate.updates[0].Nodes.ReplicatingNodeIDs)
+	assert.Equal(uint64(2), delegate.updates[1].ID)
+	assert.Equal(ClusterCommandSegmentNodesUpdate_CLOSED, delegate.updates[1].Which)
+	assert.Equal([]uint64{1}, delegate.updates[1].Nodes.DoneNodeIDs)
+	assert.Equal([]uint64{2}, delegate.updates[1].Nodes.ReplicatingNodeIDs)
+}
@@ -0,0 +1,38 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/hashicorp/memberlist"
+)
+
+type reconcilerTestDelegate struct {
+	updates []*ClusterCommandSegmentNodesUpdate
+	deleted []uint64
+}
+
+func (d *reconcilerTestDelegate) Apply(cmd interface{}) (uint64, error) {
+	switch cmd := cmd.(type) {
+	case *ClusterCommandSegmentNodesUpdate:
+		d.updates = append(d.updates, cmd)
+	case *ClusterCommandSegmentDelete:
+		d.deleted = append(d.deleted, cmd.ID)
+	}
+	return 0, nil
+}
+
+func (d *reconcilerTestDelegate) Members() []*memberlist.Node {
+	return nil
+}
+
+func (d *reconcilerTestDelegate) AddVoter(id string, addr string) error {
+	return nil
+}
+
+func (d *reconcilerTestDelegate) GetSegmentSizeFromNode(ctx context.Context, segmentID uint64, nodeID uint64, nodeAddr string) (size int64, err error) {
+	return 0, nil
+}
+
+func (d *reconcilerTestDelegate) NextSegmentID() uint64 {
+	return 0
+}
@@ -0,0 +1,211 @@
+package mq
+
+import (
+	"io"
+	"reflect"
+	"sort"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+)
+
+// Max number of distinct values of routing key / header in segment. If there are more, the field isn't indexed.
+const segmentIndexMaxValues = 1024
+
+func buildSegmentIndex(segmentHandle *segments.File, indexedHeaders []string) (*SegmentIndex, error) {
+	iterator, err := segmentHandle.Read(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "segment read failed")
+	}
+
+	routingKeys := make(map[string]bool)
+	headerValues := make(map[string]map[string]*types.Value)
+	for _, header := range indexedHeaders {
+		headerValues[header] = make(map[string]*types.Value)
+	}
+
+	for {
+		data, offset, _, err := iterator.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "iterator next failed")
+		}
+
+		publishing := Publishing{}
+		if err := proto.Unmarshal(data, &publishing); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal failed at %d", offset)
+		}
+		if publishing.Message == nil {
+			continue
+		}
+
+		if routingKeys != nil {
+			routingKeys[publishing.Message.RoutingKey] = true
+			if len(routingKeys) > segmentIndexMaxValues {
+				routingKeys = nil
+			}
+		}
+
+		if publishing.Message.Headers == nil {
+			continue
+		}
+		for header, values := range headerValues {
+			if values == nil {
+				continue
+			}
+			value, ok := publishing.Message.Headers.Fields[header]
+			if !ok {
+				continue
+			}
+			key, err := proto.Marshal(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "marshal of header %s failed", header)
+			}
+			values[string(key)] = value
+			if len(values) > segmentIndexMaxValues {
+				headerValues[header] = nil
+			}
+		}
+	}
+
+	index := &SegmentIndex{}
+	if routingKeys != nil {
+		index.RoutingKeysIndexed = true
+		for routingKey := range routingKeys {
+			index.RoutingKeys = append(index.RoutingKeys, routingKey)
+		}
+		sort.Strings(index.RoutingKeys)
+	}
+	for header, values := range headerValues {
+		if values == nil {
+			continue
+		}
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		indexHeader := &SegmentIndex_Header{Name: header}
+		for _, key := range keys {
+			indexHeader.Values = append(indexHeader.Values, values[key])
+		}
+		index.Headers = append(index.Headers, indexHeader)
+	}
+	sort.Slice(index.Headers, func(i, j int) bool {
+		return index.Headers[i].Name < index.Headers[j].Name
+	})
+
+	return index, nil
+}
+
+func (i *SegmentIndex) findHeader(name string) *SegmentIndex_Header {
+	for _, header := range i.Headers {
+		if header.Name == name {
+			return header
+		}
+	}
+	return nil
+}
+
+// Returns false only if no message in segment can match the pattern.
+func (i *SegmentIndex) mayMatchRoutingKey(pattern string, exact bool) bool {
+	if !i.RoutingKeysIndexed {
+		return true
+	}
+	for _, routingKey := range i.RoutingKeys {
+		if exact && routingKey == pattern || !exact && routingKeyMatches(pattern, routingKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns false only if there is an indexed header for which no message in segment has expected value.
+func (i *SegmentIndex) mayMatchHeadersAll(expected *types.Struct) bool {
+	if expected == nil {
+		return true
+	}
+	for name, expectedValue := range expected.Fields {
+		header := i.findHeader(name)
+		if header != nil && !header.hasValue(expectedValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns false only if all headers are indexed & no message in segment has any of expected values.
+func (i *SegmentIndex) mayMatchHeadersAny(expected *types.Struct) bool {
+	if expected == nil {
+		return true
+	}
+	for name, expectedValue := range expected.Fields {
+		header := i.findHeader(name)
+		if header == nil || header.hasValue(expectedValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *SegmentIndex_Header) hasValue(expected *types.Value) bool {
+	for _, value := range h.Values {
+		if reflect.DeepEqual(value, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *SegmentIndex) mayMatchSearch(request *emq.TopicSearchRequest) bool {
+	if request.RoutingKey != "" && !i.mayMatchRoutingKey(request.RoutingKey, false) {
+		return false
+	}
+	if request.HeadersAll != nil && !i.mayMatchHeadersAll(request.HeadersAll) {
+		return false
+	}
+	if request.HeadersAny != nil && !i.mayMatchHeadersAny(request.HeadersAny) {
+		return false
+	}
+	return true
+}
+
+func (i *SegmentIndex) mayMatchConsumerGroup(topicName string, consumerGroup *ClusterConsumerGroup) bool {
+	for _, binding := range consumerGroup.Bindings {
+		if binding.TopicName != topicName {
+			continue
+		}
+
+		switch binding.ExchangeType {
+		case emq.ExchangeTypeDirect:
+			if by, ok := binding.By.(*ClusterConsumerGroup_Binding_RoutingKey); !ok || i.mayMatchRoutingKey(by.RoutingKey, true) {
+				return true
+			}
+		case emq.ExchangeTypeTopic:
+			if by, ok := binding.By.(*ClusterConsumerGroup_Binding_RoutingKey); !ok || i.mayMatchRoutingKey(by.RoutingKey, false) {
+				return true
+			}
+		case emq.ExchangeTypeHeaders:
+			switch by := binding.By.(type) {
+			case *ClusterConsumerGroup_Binding_HeadersAll:
+				if i.mayMatchHeadersAll(by.HeadersAll) {
+					return true
+				}
+			case *ClusterConsumerGroup_Binding_HeadersAny:
+				if i.mayMatchHeadersAny(by.HeadersAny) {
+					return true
+				}
+			default:
+				return true
+			}
+		default:
+			return true
+		}
+	}
+
+	return false
+}
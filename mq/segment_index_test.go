@@ -0,0 +1,85 @@
+package mq
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentIndex(t *testing.T) {
+	assert := require.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	segmentHandle, err := segments.Open(filepath.Join(tmpDir, "1.seg"), 0644, 1<<20)
+	assert.NoError(err)
+	defer segmentHandle.Close()
+
+	region := func(region string) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{
+			"region": {Kind: &types.Value_StringValue{StringValue: region}},
+		}}
+	}
+
+	for _, message := range []*emq.Message{
+		{RoutingKey: "orders.created", Headers: region("eu")},
+		{RoutingKey: "orders.cancelled", Headers: region("us")},
+		{RoutingKey: "orders.created"},
+	} {
+		buf, err := proto.Marshal(&Publishing{Message: message})
+		assert.NoError(err)
+		assert.NoError(segmentHandle.Write(buf))
+	}
+
+	index, err := buildSegmentIndex(segmentHandle, []string{"region", "tier"})
+	assert.NoError(err)
+	assert.True(index.RoutingKeysIndexed)
+	assert.Equal([]string{"orders.cancelled", "orders.created"}, index.RoutingKeys)
+	assert.Len(index.Headers, 2)
+	assert.Equal("region", index.Headers[0].Name)
+	assert.Len(index.Headers[0].Values, 2)
+	assert.Equal("tier", index.Headers[1].Name)
+	assert.Empty(index.Headers[1].Values)
+
+	assert.True(index.mayMatchSearch(&emq.TopicSearchRequest{RoutingKey: "orders.*"}))
+	assert.False(index.mayMatchSearch(&emq.TopicSearchRequest{RoutingKey: "payments.#"}))
+	assert.True(index.mayMatchSearch(&emq.TopicSearchRequest{HeadersAll: region("eu")}))
+	assert.False(index.mayMatchSearch(&emq.TopicSearchRequest{HeadersAll: region("asia")}))
+	assert.False(index.mayMatchSearch(&emq.TopicSearchRequest{HeadersAny: region("asia")}))
+	assert.True(index.mayMatchSearch(&emq.TopicSearchRequest{HeadersAll: &types.Struct{Fields: map[string]*types.Value{
+		"unindexed": {Kind: &types.Value_StringValue{StringValue: "x"}},
+	}}}))
+
+	consumerGroup := &ClusterConsumerGroup{
+		Bindings: []*ClusterConsumerGroup_Binding{
+			{
+				TopicName:    "test-topic",
+				ExchangeType: emq.ExchangeTypeDirect,
+				By:           &ClusterConsumerGroup_Binding_RoutingKey{RoutingKey: "orders.shipped"},
+			},
+			{
+				TopicName:    "test-topic",
+				ExchangeType: emq.ExchangeTypeHeaders,
+				By:           &ClusterConsumerGroup_Binding_HeadersAny{HeadersAny: region("asia")},
+			},
+		},
+	}
+	assert.False(index.mayMatchConsumerGroup("test-topic", consumerGroup))
+
+	consumerGroup.Bindings = append(consumerGroup.Bindings, &ClusterConsumerGroup_Binding{
+		TopicName:    "test-topic",
+		ExchangeType: emq.ExchangeTypeTopic,
+		By:           &ClusterConsumerGroup_Binding_RoutingKey{RoutingKey: "#.cancelled"},
+	})
+	assert.True(index.mayMatchConsumerGroup("test-topic", consumerGroup))
+	assert.False(index.mayMatchConsumerGroup("other-topic", consumerGroup))
+}
@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import emq "eventter.io/mq/emq"
 import _ "github.com/gogo/protobuf/gogoproto"
+import types "github.com/gogo/protobuf/types"
 
 import io "io"
 
@@ -25,7 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type Publishing struct {
 	Message *emq.Message `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
 	// Delta from time the segment was opened.
-	Delta                int64    `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Delta int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Producer ID & sequence number of deduplicated publishing.
+	ProducerID           string   `protobuf:"bytes,3,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	Sequence             uint64   `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -34,7 +38,7 @@ func (m *Publishing) Reset()         { *m = Publishing{} }
 func (m *Publishing) String() string { return proto.CompactTextString(m) }
 func (*Publishing) ProtoMessage()    {}
 func (*Publishing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_segments_e86ffaad2dd03b76, []int{0}
+	return fileDescriptor_segments_d1ac614b2c543bdd, []int{0}
 }
 func (m *Publishing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,8 +81,142 @@ func (m *Publishing) GetDelta() int64 {
 	return 0
 }
 
+func (m *Publishing) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+func (m *Publishing) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type SegmentIndex struct {
+	// If false, segment contained too many distinct routing keys & they weren't indexed.
+	RoutingKeysIndexed bool     `protobuf:"varint,1,opt,name=routing_keys_indexed,json=routingKeysIndexed,proto3" json:"routing_keys_indexed,omitempty"`
+	RoutingKeys        []string `protobuf:"bytes,2,rep,name=routing_keys,json=routingKeys" json:"routing_keys,omitempty"`
+	// Only headers with not too many distinct values are present.
+	Headers              []*SegmentIndex_Header `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SegmentIndex) Reset()         { *m = SegmentIndex{} }
+func (m *SegmentIndex) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndex) ProtoMessage()    {}
+func (*SegmentIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_segments_d1ac614b2c543bdd, []int{1}
+}
+func (m *SegmentIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SegmentIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndex.Merge(dst, src)
+}
+func (m *SegmentIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentIndex proto.InternalMessageInfo
+
+func (m *SegmentIndex) GetRoutingKeysIndexed() bool {
+	if m != nil {
+		return m.RoutingKeysIndexed
+	}
+	return false
+}
+
+func (m *SegmentIndex) GetRoutingKeys() []string {
+	if m != nil {
+		return m.RoutingKeys
+	}
+	return nil
+}
+
+func (m *SegmentIndex) GetHeaders() []*SegmentIndex_Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type SegmentIndex_Header struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values               []*types.Value `protobuf:"bytes,2,rep,name=values" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SegmentIndex_Header) Reset()         { *m = SegmentIndex_Header{} }
+func (m *SegmentIndex_Header) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndex_Header) ProtoMessage()    {}
+func (*SegmentIndex_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_segments_d1ac614b2c543bdd, []int{1, 0}
+}
+func (m *SegmentIndex_Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentIndex_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentIndex_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SegmentIndex_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndex_Header.Merge(dst, src)
+}
+func (m *SegmentIndex_Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentIndex_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndex_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentIndex_Header proto.InternalMessageInfo
+
+func (m *SegmentIndex_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SegmentIndex_Header) GetValues() []*types.Value {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Publishing)(nil), "io.eventter.mq.Publishing")
+	proto.RegisterType((*SegmentIndex)(nil), "io.eventter.mq.SegmentIndex")
+	proto.RegisterType((*SegmentIndex_Header)(nil), "io.eventter.mq.SegmentIndex.Header")
 }
 func (m *Publishing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -110,6 +248,108 @@ func (m *Publishing) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintSegments(dAtA, i, uint64(m.Delta))
 	}
+	if len(m.ProducerID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSegments(dAtA, i, uint64(len(m.ProducerID)))
+		i += copy(dAtA[i:], m.ProducerID)
+	}
+	if m.Sequence != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSegments(dAtA, i, uint64(m.Sequence))
+	}
+	return i, nil
+}
+
+func (m *SegmentIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RoutingKeysIndexed {
+		dAtA[i] = 0x8
+		i++
+		if m.RoutingKeysIndexed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RoutingKeys) > 0 {
+		for _, s := range m.RoutingKeys {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintSegments(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SegmentIndex_Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentIndex_Header) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSegments(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSegments(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -132,6 +372,50 @@ func (m *Publishing) Size() (n int) {
 	if m.Delta != 0 {
 		n += 1 + sovSegments(uint64(m.Delta))
 	}
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovSegments(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovSegments(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *SegmentIndex) Size() (n int) {
+	var l int
+	_ = l
+	if m.RoutingKeysIndexed {
+		n += 2
+	}
+	if len(m.RoutingKeys) > 0 {
+		for _, s := range m.RoutingKeys {
+			l = len(s)
+			n += 1 + l + sovSegments(uint64(l))
+		}
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovSegments(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SegmentIndex_Header) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSegments(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovSegments(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -229,6 +513,294 @@ func (m *Publishing) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSegments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSegments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSegments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSegments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKeysIndexed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoutingKeysIndexed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSegments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKeys = append(m.RoutingKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSegments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &SegmentIndex_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSegments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSegments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentIndex_Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSegments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSegments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSegments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &types.Value{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSegments(dAtA[iNdEx:])
@@ -355,19 +927,32 @@ var (
 	ErrIntOverflowSegments   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("segments.proto", fileDescriptor_segments_e86ffaad2dd03b76) }
-
-var fileDescriptor_segments_e86ffaad2dd03b76 = []byte{
-	// 168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2b, 0x4e, 0x4d, 0xcf,
-	0x4d, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcb, 0xcc, 0xd7, 0x4b,
-	0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0x49, 0x2d, 0xd2, 0xcb, 0x2d, 0x94, 0xe2, 0x4d, 0xcd, 0x2d, 0xd4,
-	0x4f, 0xcd, 0x2d, 0x84, 0x48, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0x29, 0x94, 0x8b, 0x2b, 0xa0, 0x34, 0x29, 0x27, 0xb3, 0x38, 0x23, 0x33, 0x2f, 0x5d,
-	0xc8, 0x90, 0x8b, 0x3d, 0x37, 0xb5, 0xb8, 0x38, 0x31, 0x3d, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x5c, 0x0f, 0xd5, 0x50, 0x3d, 0x5f, 0x88, 0x74, 0x10, 0x4c, 0x9d, 0x90, 0x08, 0x17,
-	0x6b, 0x4a, 0x6a, 0x4e, 0x49, 0xa2, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x84, 0xe3, 0x24,
-	0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x10, 0xc5, 0x94, 0x5b, 0x98, 0xc4, 0x06, 0xb6, 0xd3, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0xff, 0xc6, 0xa5, 0xf7, 0xba, 0x00, 0x00, 0x00,
+func init() { proto.RegisterFile("segments.proto", fileDescriptor_segments_d1ac614b2c543bdd) }
+
+var fileDescriptor_segments_d1ac614b2c543bdd = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x0e, 0x93, 0x40,
+	0x10, 0xc6, 0xdd, 0x52, 0xfb, 0x67, 0xa8, 0x3d, 0x6c, 0x88, 0x12, 0x62, 0x10, 0xeb, 0x85, 0xd3,
+	0xa2, 0xf5, 0xec, 0xa5, 0xf1, 0x20, 0x51, 0x93, 0x66, 0x4d, 0x3c, 0x78, 0x69, 0x68, 0x19, 0xb7,
+	0x44, 0x60, 0x0b, 0xbb, 0x34, 0xf6, 0x2d, 0x7c, 0x06, 0x9f, 0xc6, 0xa3, 0x4f, 0x60, 0x0c, 0xbe,
+	0x80, 0x8f, 0x60, 0xca, 0x82, 0xa9, 0xde, 0xe6, 0xfb, 0xbe, 0xdf, 0x66, 0x66, 0x67, 0x60, 0xa9,
+	0x50, 0x14, 0x58, 0x6a, 0xc5, 0x4e, 0xb5, 0xd4, 0x92, 0x2e, 0x33, 0xc9, 0xf0, 0x8c, 0xa5, 0xd6,
+	0x58, 0xb3, 0xa2, 0xf2, 0xee, 0x61, 0x51, 0x45, 0x58, 0x54, 0x26, 0xf6, 0x1c, 0x21, 0x85, 0xec,
+	0xca, 0xe8, 0x5a, 0xf5, 0xee, 0x43, 0x21, 0xa5, 0xc8, 0x31, 0xea, 0xd4, 0xbe, 0xf9, 0x18, 0x29,
+	0x5d, 0x37, 0x07, 0x6d, 0xd2, 0xd5, 0x57, 0x02, 0xb0, 0x6d, 0xf6, 0x79, 0xa6, 0x8e, 0x59, 0x29,
+	0xe8, 0x33, 0x98, 0x16, 0xa8, 0x54, 0x22, 0xd0, 0x25, 0x01, 0x09, 0xed, 0xf5, 0x03, 0xf6, 0x6f,
+	0x4f, 0xf6, 0xd6, 0xc4, 0x7c, 0xe0, 0xa8, 0x03, 0x77, 0x53, 0xcc, 0x75, 0xe2, 0x8e, 0x02, 0x12,
+	0x5a, 0xdc, 0x08, 0x1a, 0x81, 0x7d, 0xaa, 0x65, 0xda, 0x1c, 0xb0, 0xde, 0x65, 0xa9, 0x6b, 0x05,
+	0x24, 0x9c, 0x6f, 0x96, 0xed, 0x8f, 0x47, 0xb0, 0xed, 0xed, 0xf8, 0x25, 0x87, 0x01, 0x89, 0x53,
+	0xea, 0xc1, 0x4c, 0x61, 0xd5, 0x60, 0x79, 0x40, 0x77, 0x1c, 0x90, 0x70, 0xcc, 0xff, 0xea, 0xd5,
+	0x6f, 0x02, 0x8b, 0x77, 0x66, 0x15, 0x71, 0x99, 0xe2, 0x67, 0xfa, 0x14, 0x9c, 0x5a, 0x36, 0x3a,
+	0x2b, 0xc5, 0xee, 0x13, 0x5e, 0xd4, 0x2e, 0xbb, 0xba, 0x98, 0x76, 0x33, 0xcf, 0x38, 0xed, 0xb3,
+	0xd7, 0x78, 0x51, 0xb1, 0x49, 0xe8, 0x63, 0x58, 0xdc, 0xbe, 0x70, 0x47, 0x81, 0x15, 0xce, 0xb9,
+	0x7d, 0x43, 0xd2, 0x17, 0x30, 0x3d, 0x62, 0x92, 0x62, 0xad, 0x5c, 0x2b, 0xb0, 0x42, 0x7b, 0xfd,
+	0xe4, 0xff, 0xbf, 0xdf, 0xce, 0xc0, 0x5e, 0x75, 0x2c, 0x1f, 0xde, 0x78, 0x6f, 0x60, 0x62, 0x2c,
+	0x4a, 0x61, 0x5c, 0x26, 0x85, 0xd9, 0xe0, 0x9c, 0x77, 0x35, 0x65, 0x30, 0x39, 0x27, 0x79, 0x83,
+	0xa6, 0xb3, 0xbd, 0xbe, 0xcf, 0xcc, 0x59, 0xd8, 0x70, 0x16, 0xf6, 0xfe, 0x1a, 0xf3, 0x9e, 0xda,
+	0x38, 0xdf, 0x5a, 0x9f, 0x7c, 0x6f, 0x7d, 0xf2, 0xb3, 0xf5, 0xc9, 0x97, 0x5f, 0xfe, 0x9d, 0x0f,
+	0xa3, 0xa2, 0xda, 0x4f, 0x3a, 0xfa, 0xf9, 0x9f, 0x01, 0x00, 0xdb, 0x99, 0x7d, 0x65, 0x19, 0x02,
+	0x00, 0x00,
 }
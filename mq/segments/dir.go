@@ -1,6 +1,8 @@
 package segments
 
 import (
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -14,6 +16,7 @@ import (
 const (
 	dirBuckets = 64
 	fileExt    = ".seg"
+	indexExt   = ".idx"
 )
 
 var (
@@ -131,11 +134,118 @@ func (d *Dir) Open(id uint64) (*File, error) {
 }
 
 func (d *Dir) getPath(id uint64) string {
+	return FilePath(d.dirName, id)
+}
+
+// FilePath returns path of segment file with given ID within segments directory.
+func FilePath(dirName string, id uint64) string {
 	name := strconv.FormatUint(id, 16)
 	if l := len(name); l < 16 {
 		name = strings.Repeat("0", 16-l) + name
 	}
-	return filepath.Join(d.dirName, name[14:16], name+fileExt)
+	return filepath.Join(dirName, name[14:16], name+fileExt)
+}
+
+// FileID returns ID of segment from its file path. Zero is returned if the path is not a segment file path.
+func FileID(path string) uint64 {
+	name := filepath.Base(path)
+	if !strings.HasSuffix(name, fileExt) {
+		return 0
+	}
+	id, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 16, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+func (d *Dir) getIndexPath(id uint64) string {
+	path := d.getPath(id)
+	return strings.TrimSuffix(path, fileExt) + indexExt
+}
+
+// IndexExists returns true if index data are stored next to segment file.
+func (d *Dir) IndexExists(id uint64) bool {
+	if id == 0 {
+		return false
+	}
+	_, err := os.Stat(d.getIndexPath(id))
+	return err == nil
+}
+
+// ReadIndex returns index data stored next to segment file. If the index does not exist, returned error satisfies
+// os.IsNotExist.
+func (d *Dir) ReadIndex(id uint64) ([]byte, error) {
+	if id == 0 {
+		return nil, ErrInvalidID
+	}
+	return ioutil.ReadFile(d.getIndexPath(id))
+}
+
+// WriteIndex atomically replaces index data stored next to segment file.
+func (d *Dir) WriteIndex(id uint64, data []byte) error {
+	if id == 0 {
+		return ErrInvalidID
+	}
+
+	path := d.getIndexPath(id)
+	if err := os.MkdirAll(filepath.Dir(path), d.dirPerm); err != nil {
+		return errors.Wrap(err, "mkdir failed")
+	}
+
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, d.filePerm); err != nil {
+		return errors.Wrap(err, "write failed")
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "rename failed")
+	}
+	return nil
+}
+
+// Import atomically creates segment file with raw data read from r (e.g. segment file copied elsewhere by
+// File.Reader). Segment file must not exist.
+func (d *Dir) Import(id uint64, r io.Reader) error {
+	if id == 0 {
+		return ErrInvalidID
+	}
+
+	bucket := id % dirBuckets
+
+	d.locks[bucket].Lock()
+	defer d.locks[bucket].Unlock()
+
+	path := d.getPath(id)
+	if _, ok := d.fileMaps[bucket][id]; ok {
+		return errors.New("segment already exists")
+	} else if _, err := os.Stat(path); err == nil {
+		return errors.New("segment already exists")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), d.dirPerm); err != nil {
+		return errors.Wrap(err, "mkdir failed")
+	}
+
+	tmpPath := path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, d.filePerm)
+	if err != nil {
+		return errors.Wrap(err, "open failed")
+	}
+	_, err = io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "write failed")
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "rename failed")
+	}
+	return nil
 }
 
 func (d *Dir) Exists(id uint64) bool {
@@ -247,6 +357,10 @@ func (d *Dir) Remove(id uint64) error {
 		return errors.Wrap(err, "remove failed")
 	}
 
+	if err := os.Remove(d.getIndexPath(id)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "index remove failed")
+	}
+
 	return nil
 }
 
@@ -255,6 +369,16 @@ func (d *Dir) closeFile(bucket uint64, file *File) error {
 	return file.Close()
 }
 
+// Closed returns true if the dir has been closed & cannot be used anymore.
+func (d *Dir) Closed() bool {
+	select {
+	case <-d.closeC:
+		return true
+	default:
+		return false
+	}
+}
+
 func (d *Dir) Close() error {
 	close(d.closeC)
 
@@ -1,6 +1,8 @@
 package segments
 
 import (
+	"bytes"
+	"io"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -285,3 +287,146 @@ func TestDir_Remove(t *testing.T) {
 		t.Fatalf("expected 0 file(s), got: %d", len(newInfos))
 	}
 }
+
+func TestDir_Index(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dir, err := NewDir(tmpDir, 0755, 0644, 1024, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Close()
+
+	f, err := dir.Open(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dir.Release(f); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dir.ReadIndex(1); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got: %v", err)
+	}
+
+	if err := dir.WriteIndex(1, []byte("index")); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := dir.ReadIndex(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "index" {
+		t.Fatalf("expected index data, got: %q", data)
+	}
+
+	infos, err := dir.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 1 {
+		t.Fatalf("expected 1 file(s), got: %d", len(infos))
+	}
+
+	if err := dir.Remove(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dir.ReadIndex(1); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error after remove, got: %v", err)
+	}
+}
+
+func TestDir_Import(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dir, err := NewDir(tmpDir, 0755, 0644, 1024, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Close()
+
+	f, err := dir.Open(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, message := range []string{"hello", "world"} {
+		if err := f.Write([]byte(message)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, f.Reader()); err != nil {
+		t.Fatal(err)
+	}
+	size := f.Size()
+
+	if err := dir.Import(1, bytes.NewReader(buf.Bytes())); err == nil {
+		t.Fatal("expected error when importing existing segment")
+	}
+	if err := dir.Release(f); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := dir.Import(2, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = dir.Open(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Release(f)
+
+	if f.Size() != size {
+		t.Fatalf("expected size %d, got: %d", size, f.Size())
+	}
+
+	iterator, err := f.Read(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"hello", "world"} {
+		data, _, _, err := iterator.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Fatalf("expected %q, got: %q", expected, data)
+		}
+	}
+}
+
+func TestDir_Usage(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dir, err := NewDir(tmpDir, 0755, 0644, 1024, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Close()
+
+	total, free, err := dir.Usage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total == 0 {
+		t.Fatal("expected total to be non-zero")
+	}
+	if free > total {
+		t.Fatalf("expected free (%d) to be at most total (%d)", free, total)
+	}
+}
@@ -0,0 +1,18 @@
+// +build linux darwin freebsd dragonfly
+
+package segments
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// Returns capacity & free space (available to unprivileged users) of the volume the directory is located on.
+func (d *Dir) Usage() (total uint64, free uint64, err error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(d.dirName, &stat); err != nil {
+		return 0, 0, errors.Wrap(err, "statfs failed")
+	}
+
+	return uint64(stat.Blocks) * uint64(stat.Bsize), uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
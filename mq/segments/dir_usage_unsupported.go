@@ -0,0 +1,12 @@
+// +build !linux,!darwin,!freebsd,!dragonfly
+
+package segments
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Returns capacity & free space (available to unprivileged users) of the volume the directory is located on.
+func (d *Dir) Usage() (total uint64, free uint64, err error) {
+	return 0, 0, errors.New("disk usage not supported on this platform")
+}
@@ -121,12 +121,49 @@ func Open(path string, filePerm os.FileMode, maxSize int64) (f *File, err error)
 	return f, nil
 }
 
+// OpenReadOnly opens existing segment file for reading without modifying it, i.e. unlike Open it doesn't truncate
+// incomplete trailing message. Segment is sealed at its current size, iterators return error on malformed messages.
+func OpenReadOnly(path string) (*File, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open failed")
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "stat failed")
+	}
+
+	var buf [1]byte
+	if _, err := io.ReadFull(file, buf[:]); err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "read version failed")
+	}
+	if buf[0] != version {
+		file.Close()
+		return nil, errors.Errorf("bad version, expected: %d, got: %d", version, buf[0])
+	}
+
+	f := &File{
+		path:    path,
+		file:    file,
+		maxSize: stat.Size(),
+		offset:  stat.Size(),
+		term:    1,
+	}
+
+	f.cond.L = &f.mutex
+
+	return f, nil
+}
+
 func (f *File) Write(message []byte) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
 	currentOffset := atomic.LoadInt64(&f.offset)
-	if currentOffset >= f.maxSize {
+	if currentOffset >= atomic.LoadInt64(&f.maxSize) {
 		return ErrFull
 	}
 
@@ -152,7 +189,18 @@ func (f *File) Write(message []byte) error {
 }
 
 func (f *File) IsFull() bool {
-	return atomic.LoadInt64(&f.offset) >= f.maxSize
+	return atomic.LoadInt64(&f.offset) >= atomic.LoadInt64(&f.maxSize)
+}
+
+// Seal makes segment full at its current size, therefore no more messages can be written to it & waiting iterators
+// finish with io.EOF after they read all messages.
+func (f *File) Seal() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	atomic.StoreInt64(&f.maxSize, atomic.LoadInt64(&f.offset))
+
+	f.cond.Broadcast()
 }
 
 func (f *File) Truncate(size int64) error {
@@ -222,12 +270,22 @@ func (f *File) Sum(h hash.Hash, size int64) (sum []byte, actualSize int64, err e
 	return h.Sum(nil), size, nil
 }
 
+// Reader returns reader of raw segment file data up to its current size, e.g. to copy the file elsewhere.
+func (f *File) Reader() *io.SectionReader {
+	return io.NewSectionReader(f.file, 0, atomic.LoadInt64(&f.offset))
+}
+
+// Size returns current size of the segment file in bytes.
+func (f *File) Size() int64 {
+	return atomic.LoadInt64(&f.offset)
+}
+
 func (f *File) String() string {
 	return fmt.Sprintf(
 		"segment %d: path=%s maxSize=%d size=%d",
 		f.id,
 		f.path,
-		f.maxSize,
+		atomic.LoadInt64(&f.maxSize),
 		atomic.LoadInt64(&f.offset),
 	)
 }
@@ -39,6 +39,75 @@ func TestOpen(t *testing.T) {
 	}
 }
 
+func TestOpenReadOnly(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, t.Name())
+
+	f, err := Open(path, 0644, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// incomplete trailing message
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write([]byte{10, 'b'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	readOnlyF, err := OpenReadOnly(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer readOnlyF.Close()
+
+	if size := readOnlyF.Size(); size != 7 {
+		t.Fatalf("expected size 7, got %d", size)
+	}
+
+	iterator, err := readOnlyF.Read(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iterator.Close()
+
+	message, _, _, err := iterator.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(message) != "foo" {
+		t.Fatalf("expected foo, got %s", message)
+	}
+
+	if _, _, _, err := iterator.Next(); err == nil || err == io.EOF {
+		t.Fatalf("expected read error, got %v", err)
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Size() != 7 {
+		t.Fatalf("file was modified, size %d", stat.Size())
+	}
+}
+
 func TestFile_Write(t *testing.T) {
 	tmpDir, err := ioutil.TempDir("", "")
 	if err != nil {
@@ -201,3 +270,54 @@ func TestFile_Truncate(t *testing.T) {
 		t.Fatalf("expected 10 messages, got: %d", n)
 	}
 }
+
+func TestFile_Seal(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	f, err := Open(filepath.Join(tmpDir, t.Name()), 0644, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := f.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	iterator, err := f.Read(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan int, 1)
+	go func() {
+		n := 0
+		for {
+			_, _, _, err := iterator.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Error(err)
+				break
+			}
+			n++
+		}
+		done <- n
+	}()
+
+	f.Seal()
+
+	if !f.IsFull() {
+		t.Fatalf("expected sealed segment to be full")
+	}
+	if err := f.Write([]byte("bar")); err != ErrFull {
+		t.Fatalf("expected ErrFull, got: %v", err)
+	}
+	if n := <-done; n != 1 {
+		t.Fatalf("expected 1 message, got: %d", n)
+	}
+}
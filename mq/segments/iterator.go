@@ -78,7 +78,7 @@ Next:
 }
 
 func (i *Iterator) nextWait() error {
-	if i.endOffset >= i.file.maxSize {
+	if i.endOffset >= atomic.LoadInt64(&i.file.maxSize) {
 		return io.EOF
 	}
 
@@ -90,6 +90,9 @@ func (i *Iterator) nextWait() error {
 		if atomic.LoadUint32(&i.closed) == 1 {
 			return ErrIteratorClosed
 		}
+		if i.endOffset >= atomic.LoadInt64(&i.file.maxSize) {
+			return io.EOF
+		}
 		i.file.cond.Wait()
 		currentOffset = atomic.LoadInt64(&i.file.offset)
 	}
@@ -7,10 +7,15 @@ import (
 
 	"eventter.io/mq/consumers"
 	"eventter.io/mq/emq"
+	"eventter.io/mq/logging"
 	"eventter.io/mq/segments"
+	"eventter.io/mq/tiered"
+	"eventter.io/mq/tracing"
 	"github.com/hashicorp/memberlist"
 	"github.com/hashicorp/raft"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -22,30 +27,51 @@ const (
 	applyTimeout                 = 10 * time.Second
 	barrierTimeout               = 10 * time.Second
 	defaultReplicationFactor     = 3
+	defaultDiskHighWatermark     = 0.85
+	defaultDiskCriticalFree      = 256 * 1024 * 1024
 )
 
 var (
-	errNoLeaderElected       = errors.New("no leader elected")
-	errNotALeader            = errors.New("request would be forwarded to leader node, however, leader_only flag was set")
-	errWontForward           = errors.New("request would be forwarded to another node, however, do_not_forward flag was set")
-	errForwardNodeDead       = errors.New("forward node is dead")
-	defaultConsumerGroupSize = 1024 * 1024 / uint32(unsafe.Sizeof(consumers.Message{})) // approx ~1 MiB of memory for the group
+	errNoLeaderElected          = errors.New("no leader elected")
+	errNotALeader               = errors.New("request would be forwarded to leader node, however, leader_only flag was set")
+	errWontForward              = errors.New("request would be forwarded to another node, however, do_not_forward flag was set")
+	errForwardNodeDead          = errors.New("forward node is dead")
+	errDiskFull                 = status.Error(codes.ResourceExhausted, "node is running out of disk space, publishing rejected")
+	errDeduplicationUnavailable = status.Error(codes.Unavailable, "segment of deduplicated topic could not be read, publishing rejected")
+	defaultConsumerGroupSize    = 1024 * 1024 / uint32(unsafe.Sizeof(consumers.Message{})) // approx ~1 MiB of memory for the group
 )
 
 type Server struct {
+	diskTotal        uint64 // first for 64-bit alignment of atomic ops
+	diskFree         uint64
+	loopHeartbeat    int64 // unix nanoseconds of the last main loop iteration
 	nodeID           uint64
+	config           *Config
+	logger           logging.Logger
+	tracer           *tracing.Tracer
 	members          *memberlist.Memberlist
+	delegate         *DiscoveryDelegate
 	raftNode         *raft.Raft
 	pool             *ClientConnPool
 	clusterState     *ClusterStateStore
 	segmentDir       *segments.Dir
+	tieredStorage    tiered.Storage
 	tx               sync.Mutex
 	publishForwardRR uint32
 	closed           chan struct{}
 	groupMutex       sync.RWMutex
 	groups           map[string]*consumers.Group
 	subscriptions    map[uint64]*consumers.Subscription
+	connectionMutex  sync.Mutex
+	connections      map[uint64]*emq.Connection
 	reconciler       *Reconciler
+
+	deduplicationMutex   sync.Mutex
+	deduplicationIndexes map[string]*deduplicationIndex
+
+	offloadedSegmentsMutex   sync.Mutex
+	offloadedSegmentsFetched map[uint64]time.Time               // offloaded segment ID -> last time its local copy was needed
+	offloadedSegmentsOps     map[uint64]*offloadedSegmentCopyOp // offloaded segment ID -> running fetch / removal of local copy
 }
 
 var (
@@ -53,19 +79,38 @@ var (
 	_ NodeRPCServer        = (*Server)(nil)
 )
 
-func NewServer(nodeID uint64, members *memberlist.Memberlist, raftNode *raft.Raft, pool *ClientConnPool, clusterState *ClusterStateStore, segmentDir *segments.Dir) *Server {
+func NewServer(config *Config, logger logging.Logger, tracer *tracing.Tracer, members *memberlist.Memberlist, delegate *DiscoveryDelegate, raftNode *raft.Raft, pool *ClientConnPool, clusterState *ClusterStateStore, segmentDir *segments.Dir, tieredStorage tiered.Storage) *Server {
+	c := *config
+	if c.DiskHighWatermark == 0 {
+		c.DiskHighWatermark = defaultDiskHighWatermark
+	}
+	if c.DiskCriticalFree == 0 {
+		c.DiskCriticalFree = defaultDiskCriticalFree
+	}
+
 	s := &Server{
-		nodeID:        nodeID,
+		nodeID:        c.ID,
+		config:        &c,
+		logger:        logger.With(logging.NodeID(c.ID)),
+		tracer:        tracer,
 		members:       members,
+		delegate:      delegate,
 		raftNode:      raftNode,
 		pool:          pool,
 		clusterState:  clusterState,
 		segmentDir:    segmentDir,
+		tieredStorage: tieredStorage,
 		closed:        make(chan struct{}),
 		groups:        make(map[string]*consumers.Group),
 		subscriptions: make(map[uint64]*consumers.Subscription),
+		connections:   make(map[uint64]*emq.Connection),
+
+		deduplicationIndexes: make(map[string]*deduplicationIndex),
+
+		offloadedSegmentsFetched: make(map[uint64]time.Time),
+		offloadedSegmentsOps:     make(map[uint64]*offloadedSegmentCopyOp),
 	}
-	s.reconciler = NewReconciler(s)
+	s.reconciler = NewReconciler(s, s.logger, c.DiskHighWatermark)
 	return s
 }
 
@@ -39,11 +39,16 @@ func (s *Server) ServeAMQPv0(ctx context.Context, transport *v0.Transport) error
 		return errors.Wrap(err, "send connect.close failed")
 	}
 
+	defer s.trackConnection(ctx, "amqp-"+amqpProtocolV0, namespace)()
+
 	err = transport.Send(&v0.ConnectionOpenOk{})
 	if err != nil {
 		return errors.Wrap(err, "send connection.open-ok failed")
 	}
 
+	amqpConnections.WithLabelValues(amqpProtocolV0).Inc()
+	defer amqpConnections.WithLabelValues(amqpProtocolV0).Dec()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -107,6 +112,7 @@ func (s *Server) ServeAMQPv0(ctx context.Context, transport *v0.Transport) error
 							deliveries:      deliveries,
 							consumers:       make(map[string]*subscribeConsumer),
 						}
+						amqpChannels.WithLabelValues(amqpProtocolV0).Inc()
 						err := transport.Send(&v0.ChannelOpenOk{FrameMeta: v0.FrameMeta{Channel: meta.Channel}})
 						if err != nil {
 							return s.forceCloseAMQPv0(transport, v0.InternalError, err)
@@ -281,6 +287,7 @@ func (ch *serverAMQPv0Channel) Close() error {
 	for _, consumer := range ch.consumers {
 		consumer.Close()
 	}
+	amqpChannels.WithLabelValues(amqpProtocolV0).Dec()
 	return nil
 }
 
@@ -35,6 +35,22 @@ func (s *Server) handleAMQPv0ExchangeDeclare(ctx context.Context, transport *v0.
 	if err != nil {
 		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "retention field failed"))
 	}
+	deduplicationWindow, err := structvalue.Duration(frame.Arguments, "deduplication-window", 0)
+	if err != nil {
+		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "deduplication-window field failed"))
+	}
+	compactionKey, err := structvalue.String(frame.Arguments, "compaction-key", "")
+	if err != nil {
+		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "compaction-key field failed"))
+	}
+	retentionBytes, err := structvalue.Uint64(frame.Arguments, "retention-bytes", 0)
+	if err != nil {
+		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "retention-bytes field failed"))
+	}
+	forceRetentionBytes, err := structvalue.Bool(frame.Arguments, "force-retention-bytes", false)
+	if err != nil {
+		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "force-retention-bytes field failed"))
+	}
 
 	request := &emq.TopicCreateRequest{
 		Topic: emq.Topic{
@@ -44,6 +60,10 @@ func (s *Server) handleAMQPv0ExchangeDeclare(ctx context.Context, transport *v0.
 			Shards:              shards,
 			ReplicationFactor:   replicationFactor,
 			Retention:           retention,
+			DeduplicationWindow: deduplicationWindow,
+			CompactionKey:       compactionKey,
+			RetentionBytes:      retentionBytes,
+			ForceRetentionBytes: forceRetentionBytes,
 		},
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	"eventter.io/mq/amqp/v0"
 	"eventter.io/mq/emq"
+	"eventter.io/mq/filter"
 	"eventter.io/mq/structvalue"
 	"github.com/pkg/errors"
 )
@@ -36,6 +37,22 @@ func (s *Server) handleAMQPv0QueueBind(ctx context.Context, transport *v0.Transp
 		ExchangeType: tp.DefaultExchangeType,
 	}
 
+	if frame.Arguments != nil && frame.Arguments.Fields != nil {
+		expr, err := structvalue.String(frame.Arguments, "x-filter", "")
+		if err != nil {
+			return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "x-filter field failed"))
+		}
+		if expr != "" {
+			if _, err := filter.Parse(expr); err != nil {
+				return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "x-filter field failed"))
+			}
+		}
+
+		delete(frame.Arguments.Fields, "x-filter")
+
+		newBinding.Filter = expr
+	}
+
 	switch tp.DefaultExchangeType {
 	case emq.ExchangeTypeFanout:
 		// do nothing
@@ -44,6 +61,10 @@ func (s *Server) handleAMQPv0QueueBind(ctx context.Context, transport *v0.Transp
 	case emq.ExchangeTypeTopic:
 		newBinding.By = &emq.ConsumerGroup_Binding_RoutingKey{RoutingKey: frame.RoutingKey}
 	case emq.ExchangeTypeHeaders:
+		if newBinding.Filter != "" && (frame.Arguments == nil || len(frame.Arguments.Fields) == 0) {
+			// only filter applies
+			break
+		}
 		if frame.Arguments == nil || frame.Arguments.Fields == nil {
 			return s.makeConnectionClose(v0.SyntaxError, errors.New("trying to bind to headers exchange, but arguments not set"))
 		}
@@ -71,9 +92,11 @@ func (s *Server) handleAMQPv0QueueBind(ctx context.Context, transport *v0.Transp
 
 	request := &emq.ConsumerGroupCreateRequest{
 		ConsumerGroup: emq.ConsumerGroup{
-			Namespace: namespaceName,
-			Name:      frame.Queue,
-			Size_:     cg.Size_,
+			Namespace:            namespaceName,
+			Name:                 frame.Queue,
+			Size_:                cg.Size_,
+			MaxPriority:          cg.MaxPriority,
+			SingleActiveConsumer: cg.SingleActiveConsumer,
 		},
 	}
 
@@ -13,24 +13,35 @@ func TestServer_ServeAMQPv0_QueueBind(t *testing.T) {
 		exchangeType string
 		routingKey   string
 		arguments    *types.Struct
+		filter       string
 	}{
-		{"fanout", "", nil},
-		{"direct", "foo", nil},
-		{"topic", "foo.#", nil},
+		{"fanout", "", nil, ""},
+		{"direct", "foo", nil, ""},
+		{"topic", "foo.#", nil, ""},
+		{"topic", "foo.*", &types.Struct{
+			Fields: map[string]*types.Value{
+				"x-filter": {Kind: &types.Value_StringValue{StringValue: "priority > 5"}},
+			},
+		}, "priority > 5"},
 		{"headers", "all", &types.Struct{
 			Fields: map[string]*types.Value{
 				"x-match": {Kind: &types.Value_StringValue{StringValue: "all"}},
 				"foo":     {Kind: &types.Value_StringValue{StringValue: "bar"}},
 				"baz":     {Kind: &types.Value_StringValue{StringValue: "qux"}},
 			},
-		}},
+		}, ""},
 		{"headers", "any", &types.Struct{
 			Fields: map[string]*types.Value{
 				"x-match": {Kind: &types.Value_StringValue{StringValue: "any"}},
 				"foo":     {Kind: &types.Value_StringValue{StringValue: "bar"}},
 				"baz":     {Kind: &types.Value_StringValue{StringValue: "qux"}},
 			},
-		}},
+		}, ""},
+		{"headers", "filter", &types.Struct{
+			Fields: map[string]*types.Value{
+				"x-filter": {Kind: &types.Value_StringValue{StringValue: "region IN ('eu', 'us')"}},
+			},
+		}, "region IN ('eu', 'us')"},
 	}
 	for _, test := range tests {
 		t.Run(test.exchangeType+"/"+test.routingKey, func(t *testing.T) {
@@ -95,6 +106,7 @@ func TestServer_ServeAMQPv0_QueueBind(t *testing.T) {
 					assert.NotNil(cg)
 					assert.Len(cg.Bindings, 2)
 					assert.Equal("xchng", cg.Bindings[1].TopicName)
+					assert.Equal(test.filter, cg.Bindings[1].Filter)
 				}
 			}
 		})
@@ -50,6 +50,16 @@ func (s *Server) handleAMQPv0QueueDeclare(ctx context.Context, transport *v0.Tra
 		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "size field failed"))
 	}
 
+	maxPriority, err := structvalue.Uint32(frame.Arguments, "x-max-priority", 0)
+	if err != nil {
+		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "x-max-priority field failed"))
+	}
+
+	singleActiveConsumer, err := structvalue.Bool(frame.Arguments, "x-single-active-consumer", false)
+	if err != nil {
+		return s.makeConnectionClose(v0.SyntaxError, errors.Wrap(err, "x-single-active-consumer field failed"))
+	}
+
 	if frame.Queue == "" {
 		generated, err := uuid.GenerateUUID()
 		if err != nil {
@@ -60,9 +70,11 @@ func (s *Server) handleAMQPv0QueueDeclare(ctx context.Context, transport *v0.Tra
 
 	request := &emq.ConsumerGroupCreateRequest{
 		ConsumerGroup: emq.ConsumerGroup{
-			Namespace: namespaceName,
-			Name:      frame.Queue,
-			Size_:     size,
+			Namespace:            namespaceName,
+			Name:                 frame.Queue,
+			Size_:                size,
+			MaxPriority:          maxPriority,
+			SingleActiveConsumer: singleActiveConsumer,
 		},
 	}
 
@@ -61,7 +61,8 @@ func TestServer_ServeAMQPv0_QueueDeclare(t *testing.T) {
 				Durable:   true,
 				Arguments: &types.Struct{
 					Fields: map[string]*types.Value{
-						"size": {Kind: &types.Value_NumberValue{NumberValue: 50}},
+						"size":           {Kind: &types.Value_NumberValue{NumberValue: 50}},
+						"x-max-priority": {Kind: &types.Value_NumberValue{NumberValue: 10}},
 					},
 				},
 			}, &response)
@@ -74,6 +75,7 @@ func TestServer_ServeAMQPv0_QueueDeclare(t *testing.T) {
 			assert.NotNil(cg)
 			assert.Len(cg.Bindings, 1)
 			assert.Equal(uint32(50), cg.Size_)
+			assert.Equal(uint32(10), cg.MaxPriority)
 			assert.Len(cg.OffsetCommits, 0)
 		}
 	}
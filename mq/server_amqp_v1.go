@@ -73,6 +73,11 @@ func (s *Server) ServeAMQPv1(ctx context.Context, transport *v1.Transport) (err
 		return errors.Wrap(err, "set send timeout failed")
 	}
 
+	amqpConnections.WithLabelValues(amqpProtocolV1).Inc()
+	defer amqpConnections.WithLabelValues(amqpProtocolV1).Dec()
+
+	defer s.trackConnection(ctx, "amqp-"+amqpProtocolV1, "")()
+
 	connection := newConnectionAMQPv1(ctx, s, transport, heartbeat)
 	defer connection.Close()
 
@@ -117,6 +117,7 @@ func (s *sessionAMQPv1) attachTopic(ctx context.Context, frame *v1.Attach) (err
 		link.initialLinkCredit = link.base.linkCredit
 
 		s.links[frame.Handle] = link
+		amqpLinks.Inc()
 		err = s.Send(&v1.Attach{
 			Name:          frame.Name,
 			Handle:        frame.Handle,
@@ -226,6 +227,7 @@ func (s *sessionAMQPv1) attachConsumerGroup(ctx context.Context, frame *v1.Attac
 		link.cond.L = &link.mutex
 
 		s.links[frame.Handle] = link
+		amqpLinks.Inc()
 		err = s.Send(&v1.Attach{
 			Name:                 frame.Name,
 			Handle:               frame.Handle,
@@ -42,6 +42,7 @@ func (c *connectionAMQPv1) Begin(ctx context.Context, frame *v1.Begin) (err erro
 	session.initialOutgoingID = session.nextOutgoingID
 
 	c.sessions[session.remoteChannel] = session
+	amqpChannels.WithLabelValues(amqpProtocolV1).Inc()
 	err = c.Send(&v1.Begin{
 		FrameMeta:      v1.FrameMeta{Channel: session.channel},
 		RemoteChannel:  session.remoteChannel,
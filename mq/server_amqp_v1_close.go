@@ -2,18 +2,18 @@ package mq
 
 import (
 	"context"
-	"log"
 
 	"eventter.io/mq/amqp/v1"
+	"eventter.io/mq/logging"
 	"github.com/pkg/errors"
 )
 
 func (c *connectionAMQPv1) RespondClose(ctx context.Context, frame *v1.Close) (err error) {
 	if frame.Error != nil {
-		log.Printf(
-			"received connection error from client [condition=%s, description=%s]",
-			frame.Error.Condition,
-			frame.Error.Description,
+		c.logger.Warn(
+			"received connection error from client",
+			logging.F("condition", frame.Error.Condition),
+			logging.F("description", frame.Error.Description),
 		)
 	}
 
@@ -2,16 +2,17 @@ package mq
 
 import (
 	"context"
-	"log"
 	"sync"
 	"time"
 
 	"eventter.io/mq/amqp/v1"
+	"eventter.io/mq/logging"
 	"github.com/pkg/errors"
 )
 
 type connectionAMQPv1 struct {
 	server        *Server
+	logger        logging.Logger
 	mutex         sync.Mutex
 	transport     *v1.Transport
 	heartbeat     time.Duration
@@ -23,6 +24,7 @@ type connectionAMQPv1 struct {
 func newConnectionAMQPv1(ctx context.Context, server *Server, transport *v1.Transport, heartbeat time.Duration) *connectionAMQPv1 {
 	c := &connectionAMQPv1{
 		server:        server,
+		logger:        logging.FromContext(ctx),
 		transport:     transport,
 		heartbeat:     heartbeat,
 		frames:        make(chan v1.Frame, 64),
@@ -55,7 +57,7 @@ func (c *connectionAMQPv1) Close() error {
 	for _, session := range c.sessions {
 		err := session.Close()
 		if err != nil {
-			log.Printf("session close failed with: %v", err)
+			c.logger.Error("session close failed", logging.Error(err))
 		}
 	}
 	return nil
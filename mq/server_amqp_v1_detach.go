@@ -2,9 +2,9 @@ package mq
 
 import (
 	"context"
-	"log"
 
 	"eventter.io/mq/amqp/v1"
+	"eventter.io/mq/logging"
 	"github.com/pkg/errors"
 )
 
@@ -21,10 +21,10 @@ func (s *sessionAMQPv1) Detach(ctx context.Context, frame *v1.Detach) error {
 	}
 
 	if frame.Error != nil {
-		log.Printf(
-			"received link error from client [condition=%s, description=%s]",
-			frame.Error.Condition,
-			frame.Error.Description,
+		s.connection.logger.Warn(
+			"received link error from client",
+			logging.F("condition", frame.Error.Condition),
+			logging.F("description", frame.Error.Description),
 		)
 	}
 
@@ -38,7 +38,7 @@ func (s *sessionAMQPv1) Detach(ctx context.Context, frame *v1.Detach) error {
 			Description: err.Error(),
 		}
 		if linkState == linkStateDetaching {
-			log.Printf("link close failed: %s", err)
+			s.connection.logger.Error("link close failed", logging.Error(err))
 		}
 	}
 
@@ -2,9 +2,9 @@ package mq
 
 import (
 	"context"
-	"log"
 
 	"eventter.io/mq/amqp/v1"
+	"eventter.io/mq/logging"
 	"github.com/pkg/errors"
 )
 
@@ -18,10 +18,10 @@ func (c *connectionAMQPv1) End(ctx context.Context, frame *v1.End) (err error) {
 	}
 
 	if frame.Error != nil {
-		log.Printf(
-			"received session error from client [condition=%s, description=%s]",
-			frame.Error.Condition,
-			frame.Error.Description,
+		c.logger.Warn(
+			"received session error from client",
+			logging.F("condition", frame.Error.Condition),
+			logging.F("description", frame.Error.Description),
 		)
 	}
 
@@ -34,7 +34,7 @@ func (c *connectionAMQPv1) End(ctx context.Context, frame *v1.End) (err error) {
 			Description: err.Error(),
 		}
 		if session.state == sessionStateEnding {
-			log.Printf("session close failed: %s", err)
+			c.logger.Error("session close failed", logging.Error(err))
 		}
 	}
 
@@ -3,13 +3,13 @@ package mq
 import (
 	"bytes"
 	"context"
-	"log"
 	"strconv"
 	"sync"
 	"time"
 
 	"eventter.io/mq/amqp/v1"
 	"eventter.io/mq/emq"
+	"eventter.io/mq/logging"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/metadata"
 )
@@ -56,6 +56,7 @@ func (l *topicLinkAMQPV1) Credit() (v1.SequenceNo, uint32) {
 }
 
 func (l *topicLinkAMQPV1) Close() error {
+	amqpLinks.Dec()
 	return nil
 }
 
@@ -93,7 +94,7 @@ func (l *consumerGroupLinkAMQPv1) Receive() {
 			},
 		})
 		if sendErr != nil {
-			log.Printf("detach link error: %v", sendErr)
+			l.base.session.connection.logger.Error("detach link failed", logging.Error(sendErr))
 		}
 	}
 }
@@ -115,6 +116,7 @@ func (l *consumerGroupLinkAMQPv1) Credit() (deliveryCount v1.SequenceNo, linkCre
 
 func (l *consumerGroupLinkAMQPv1) Close() error {
 	l.cancel()
+	amqpLinks.Dec()
 	return nil
 }
 
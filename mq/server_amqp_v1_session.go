@@ -2,10 +2,10 @@ package mq
 
 import (
 	"context"
-	"log"
 	"sync"
 
 	"eventter.io/mq/amqp/v1"
+	"eventter.io/mq/logging"
 	"github.com/pkg/errors"
 )
 
@@ -53,10 +53,11 @@ func (s *sessionAMQPv1) Close() error {
 	for _, link := range s.links {
 		err := link.Close()
 		if err != nil {
-			log.Printf("link close failed with: %v", err)
+			s.connection.logger.Error("link close failed", logging.Error(err))
 		}
 	}
 	s.links = make(map[v1.Handle]linkAMQPv1)
+	amqpChannels.WithLabelValues(amqpProtocolV1).Dec()
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package mq
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 )
@@ -30,8 +32,16 @@ func (s *Server) Apply(cmd interface{}) (index uint64, err error) {
 		outer.Command = &ClusterCommand_UpdateSegmentNodes{cmd}
 	case *ClusterCommandSegmentDelete:
 		outer.Command = &ClusterCommand_DeleteSegment{cmd}
+	case *ClusterCommandSegmentsReplace:
+		outer.Command = &ClusterCommand_ReplaceSegments{cmd}
+	case *ClusterCommandSegmentOffload:
+		outer.Command = &ClusterCommand_OffloadSegment{cmd}
+	case *ClusterCommandSegmentsReplacingCreate:
+		outer.Command = &ClusterCommand_CreateReplacingSegments{cmd}
 	case *ClusterCommandNodeUpdate:
 		outer.Command = &ClusterCommand_UpdateNode{cmd}
+	case *ClusterCommandNodeAdminStateUpdate:
+		outer.Command = &ClusterCommand_UpdateNodeAdminState{cmd}
 	default:
 		return 0, errors.Errorf("unhandled command of type: %T", cmd)
 	}
@@ -41,10 +51,12 @@ func (s *Server) Apply(cmd interface{}) (index uint64, err error) {
 		return 0, err
 	}
 
+	start := time.Now()
 	future := s.raftNode.Apply(buf, applyTimeout)
 	if err := future.Error(); err != nil {
 		return 0, err
 	}
+	raftApplyDuration.Observe(time.Since(start).Seconds())
 
 	return future.Index(), nil
 }
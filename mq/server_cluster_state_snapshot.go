@@ -0,0 +1,38 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) ClusterStateSnapshot(ctx context.Context, request *ClusterStateSnapshotRequest) (*ClusterStateSnapshotResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return NewNodeRPCClient(conn).ClusterStateSnapshot(ctx, request)
+	}
+
+	// barrier ensures all committed commands were applied to the state
+	if err := s.raftNode.Barrier(barrierTimeout).Error(); err != nil {
+		return nil, errors.Wrap(err, "barrier failed")
+	}
+
+	return &ClusterStateSnapshotResponse{
+		ClusterState: s.clusterState.Current(),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package mq
+
+import (
+	"context"
+	"testing"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ClusterStateSnapshot(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-snapshot",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              1,
+				ReplicationFactor:   1,
+				Retention:           1,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.ClusterStateSnapshot(ctx, &ClusterStateSnapshotRequest{})
+		assert.NoError(err)
+		assert.NotNil(response.ClusterState)
+		assert.NotNil(response.ClusterState.GetTopic("default", "test-snapshot"))
+		assert.Equal(ts.ClusterStateStore.Current().Index, response.ClusterState.Index)
+	}
+}
@@ -0,0 +1,91 @@
+package mq
+
+import (
+	"context"
+	"sort"
+
+	"eventter.io/mq/amqp"
+	"eventter.io/mq/emq"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) ListConnections(ctx context.Context, request *emq.ConnectionListRequest) (*emq.ConnectionListResponse, error) {
+	var connections []*emq.Connection
+
+	if !request.DoNotForward {
+		for _, node := range s.clusterState.Current().Nodes {
+			if node.ID == s.nodeID || node.State != ClusterNode_ALIVE {
+				continue
+			}
+
+			nodeConnections, err := s.listNodeConnections(ctx, node)
+			if err != nil {
+				return nil, errors.Wrapf(err, "list connections of node %016x failed", node.ID)
+			}
+			connections = append(connections, nodeConnections...)
+		}
+	}
+
+	s.connectionMutex.Lock()
+	for _, connection := range s.connections {
+		c := *connection
+		connections = append(connections, &c)
+	}
+	s.connectionMutex.Unlock()
+
+	sort.Slice(connections, func(i, j int) bool {
+		if connections[i].NodeID != connections[j].NodeID {
+			return connections[i].NodeID < connections[j].NodeID
+		}
+		return connections[i].ID < connections[j].ID
+	})
+
+	return &emq.ConnectionListResponse{
+		OK:          true,
+		Connections: connections,
+	}, nil
+}
+
+func (s *Server) listNodeConnections(ctx context.Context, node *ClusterNode) ([]*emq.Connection, error) {
+	conn, err := s.pool.Get(ctx, node.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial failed")
+	}
+	defer s.pool.Put(conn)
+
+	response, err := emq.NewEventterMQClient(conn).ListConnections(ctx, &emq.ConnectionListRequest{DoNotForward: true})
+	if err != nil {
+		return nil, err
+	}
+	return response.Connections, nil
+}
+
+// Registers client connection, so that it's listed by ListConnections. Returned function removes the connection.
+func (s *Server) trackConnection(ctx context.Context, protocol string, namespace string) func() {
+	info, err := amqp.ConnectionFromContext(ctx)
+	if err != nil {
+		return func() {}
+	}
+
+	connection := &emq.Connection{
+		NodeID:        s.nodeID,
+		ID:            info.ID,
+		Protocol:      protocol,
+		RemoteAddress: info.RemoteAddr,
+		Namespace:     namespace,
+		ConnectedAt:   info.ConnectedAt,
+	}
+	if token, err := amqp.TokenFromContext(ctx); err == nil {
+		connection.User = token.Subject()
+	}
+
+	s.connectionMutex.Lock()
+	s.connections[connection.ID] = connection
+	s.connectionMutex.Unlock()
+
+	return func() {
+		s.connectionMutex.Lock()
+		delete(s.connections, connection.ID)
+		s.connectionMutex.Unlock()
+	}
+}
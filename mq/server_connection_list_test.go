@@ -0,0 +1,63 @@
+package mq
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"eventter.io/mq/amqp"
+	"eventter.io/mq/amqp/v0"
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ListConnections(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer serverConn.Close()
+		ctx := amqp.NewConnectionContext(ctx, &amqp.Connection{ID: 7, RemoteAddr: "192.0.2.1:5672", ConnectedAt: time.Now()})
+		ts.Server.ServeAMQPv0(ctx, v0.NewTransport(serverConn))
+	}()
+
+	client := v0.NewTransport(clientConn)
+	var openOk *v0.ConnectionOpenOk
+	assert.NoError(client.Call(&v0.ConnectionOpen{VirtualHost: "/"}, &openOk))
+
+	{
+		response, err := ts.Server.ListConnections(ctx, &emq.ConnectionListRequest{})
+		assert.NoError(err)
+		assert.True(response.OK)
+		assert.Len(response.Connections, 1)
+		connection := response.Connections[0]
+		assert.Equal(ts.Server.nodeID, connection.NodeID)
+		assert.Equal(uint64(7), connection.ID)
+		assert.Equal("amqp-0-9-1", connection.Protocol)
+		assert.Equal("192.0.2.1:5672", connection.RemoteAddress)
+		assert.Equal(emq.DefaultNamespace, connection.Namespace)
+	}
+
+	var closeOk *v0.ConnectionCloseOk
+	assert.NoError(client.Call(&v0.ConnectionClose{}, &closeOk))
+	<-done
+
+	{
+		response, err := ts.Server.ListConnections(ctx, &emq.ConnectionListRequest{})
+		assert.NoError(err)
+		assert.True(response.OK)
+		assert.Len(response.Connections, 0)
+	}
+}
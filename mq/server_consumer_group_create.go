@@ -50,9 +50,11 @@ func (s *Server) CreateConsumerGroup(ctx context.Context, request *emq.ConsumerG
 	cmd := &ClusterCommandConsumerGroupCreate{
 		Namespace: request.ConsumerGroup.Namespace,
 		ConsumerGroup: &ClusterConsumerGroup{
-			Name:  request.ConsumerGroup.Name,
-			Size_: request.ConsumerGroup.Size_,
-			Since: request.ConsumerGroup.Since,
+			Name:                 request.ConsumerGroup.Name,
+			Size_:                request.ConsumerGroup.Size_,
+			Since:                request.ConsumerGroup.Since,
+			MaxPriority:          request.ConsumerGroup.MaxPriority,
+			SingleActiveConsumer: request.ConsumerGroup.SingleActiveConsumer,
 		},
 	}
 
@@ -73,6 +75,7 @@ func (s *Server) CreateConsumerGroup(ctx context.Context, request *emq.ConsumerG
 		clusterBinding := &ClusterConsumerGroup_Binding{
 			TopicName:    clientBinding.TopicName,
 			ExchangeType: clientBinding.ExchangeType,
+			Filter:       clientBinding.Filter,
 		}
 		switch clusterBinding.ExchangeType {
 		case emq.ExchangeTypeDirect:
@@ -102,6 +105,17 @@ func (s *Server) CreateConsumerGroup(ctx context.Context, request *emq.ConsumerG
 				clusterBinding.By = &ClusterConsumerGroup_Binding_HeadersAll{
 					HeadersAll: by.HeadersAll,
 				}
+			case nil:
+				if clientBinding.Filter == "" {
+					return nil, errors.Errorf(
+						"trying to bind to %s %s/%s of type %s, but neither headers, nor filter set",
+						entityTopic,
+						request.ConsumerGroup.Namespace,
+						clientBinding.TopicName,
+						clusterBinding.ExchangeType,
+					)
+				}
+				// leave by to null, only filter applies
 			default:
 				return nil, errors.Errorf(
 					"trying to bind to %s %s/%s of type %s, but no headers set",
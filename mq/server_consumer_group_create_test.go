@@ -35,4 +35,51 @@ func TestServer_CreateConsumerGroup(t *testing.T) {
 		assert.Len(cg.Bindings, 0)
 		assert.Equal(uint32(defaultConsumerGroupSize), cg.Size_)
 	}
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-create-consumer-group-topic",
+				DefaultExchangeType: emq.ExchangeTypeHeaders,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		_, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-create-consumer-group-filter",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-create-consumer-group-topic", ExchangeType: emq.ExchangeTypeHeaders, Filter: "priority >"},
+				},
+			},
+		})
+		assert.Error(err)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-create-consumer-group-filter",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-create-consumer-group-topic", ExchangeType: emq.ExchangeTypeHeaders, Filter: "priority > 5"},
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ns, _ := ts.ClusterStateStore.Current().FindNamespace("default")
+		assert.NotNil(ns)
+		cg, _ := ns.FindConsumerGroup("test-create-consumer-group-filter")
+		assert.NotNil(cg)
+		assert.Len(cg.Bindings, 1)
+		assert.Nil(cg.Bindings[0].By)
+		assert.Equal("priority > 5", cg.Bindings[0].Filter)
+	}
 }
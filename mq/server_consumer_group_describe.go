@@ -0,0 +1,320 @@
+package mq
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+const consumerGroupLagSampleSize = 100
+
+func (s *Server) DescribeConsumerGroup(ctx context.Context, request *emq.ConsumerGroupDescribeRequest) (*emq.ConsumerGroupDescribeResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	state := s.clusterState.Current()
+
+	namespace, _ := state.FindNamespace(request.Namespace)
+	if namespace == nil {
+		return nil, errors.Errorf(namespaceNotFoundErrorFormat, request.Namespace)
+	}
+
+	consumerGroup, _ := namespace.FindConsumerGroup(request.Name)
+	if consumerGroup == nil {
+		return nil, errors.Errorf(
+			notFoundErrorFormat,
+			entityConsumerGroup,
+			request.Namespace,
+			request.Name,
+		)
+	}
+
+	offsetSegments := state.FindOpenSegmentsFor(
+		ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS,
+		request.Namespace,
+		request.Name,
+	)
+
+	var offsetSegment *ClusterSegment
+	if len(offsetSegments) == 1 {
+		offsetSegment = offsetSegments[0]
+	} else if len(offsetSegments) > 1 {
+		return nil, errors.New("consumer group assigned to multiple nodes")
+	}
+
+	if offsetSegment != nil && offsetSegment.Nodes.PrimaryNodeID != s.nodeID {
+		if request.DoNotForward {
+			return nil, errWontForward
+		}
+
+		node := state.GetNode(offsetSegment.Nodes.PrimaryNodeID)
+		if node == nil {
+			return nil, errors.Errorf("node %d not found", offsetSegment.Nodes.PrimaryNodeID)
+		}
+
+		conn, err := s.pool.Get(ctx, node.Address)
+		if err != nil {
+			return nil, errors.Wrap(err, "dial failed")
+		}
+		defer s.pool.Put(conn)
+
+		request.DoNotForward = true
+		return emq.NewEventterMQClient(conn).DescribeConsumerGroup(ctx, request)
+	}
+
+	response := &emq.ConsumerGroupDescribeResponse{
+		OK:    true,
+		Index: state.Index,
+		ConsumerGroup: &emq.ConsumerGroup{
+			Namespace:            namespace.Name,
+			Name:                 consumerGroup.Name,
+			Size_:                consumerGroup.Size_,
+			Since:                consumerGroup.Since,
+			MaxPriority:          consumerGroup.MaxPriority,
+			SingleActiveConsumer: consumerGroup.SingleActiveConsumer,
+		},
+	}
+	for _, binding := range consumerGroup.Bindings {
+		response.ConsumerGroup.Bindings = append(response.ConsumerGroup.Bindings, s.convertClusterBinding(binding))
+	}
+
+	committedOffsets := make(map[uint64]int64)
+	for _, commit := range consumerGroup.OffsetCommits {
+		committedOffsets[commit.SegmentID] = commit.Offset
+	}
+
+	if offsetSegment != nil {
+		response.NodeID = offsetSegment.Nodes.PrimaryNodeID
+
+		if !consumerGroup.OffsetCommitsImportPending && s.segmentDir.Exists(offsetSegment.ID) {
+			segmentHandle, err := s.segmentDir.Open(offsetSegment.ID)
+			if err != nil {
+				return nil, errors.Wrap(err, "segment open failed")
+			}
+			err = s.readOffsetCommits(segmentHandle, offsetSegment.ID, committedOffsets)
+			s.segmentDir.Release(segmentHandle)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		s.groupMutex.RLock()
+		group, ok := s.groups[s.makeConsumerGroupMapKey(namespace.Name, consumerGroup.Name)]
+		if ok {
+			for _, subscription := range s.subscriptions {
+				if subscription.Group() != group {
+					continue
+				}
+				size, inflight := subscription.Stats()
+				response.Subscriptions = append(response.Subscriptions, &emq.ConsumerGroupDescribeResponse_Subscription{
+					SubscriptionID: subscription.ID,
+					Size_:          size,
+					InFlight:       inflight,
+				})
+			}
+		}
+		s.groupMutex.RUnlock()
+
+		if ok {
+			stats := group.Stats()
+			response.Waiting = uint32(stats.Waiting)
+			response.InFlight = uint32(stats.InFlight)
+			response.AckPending = uint32(stats.AckPending)
+
+			if activeSubscriptionID, activeSince := group.ActiveSubscription(); activeSubscriptionID != 0 {
+				response.ActiveSubscriptionID = activeSubscriptionID
+				response.ActiveSince = &activeSince
+				for _, subscription := range response.Subscriptions {
+					subscription.Active = subscription.SubscriptionID == activeSubscriptionID
+				}
+			}
+		}
+
+		sort.Slice(response.Subscriptions, func(i, j int) bool {
+			return response.Subscriptions[i].SubscriptionID < response.Subscriptions[j].SubscriptionID
+		})
+	}
+
+	topicNames := make(map[string]bool)
+	for _, binding := range consumerGroup.Bindings {
+		if topicNames[binding.TopicName] {
+			continue
+		}
+		topicNames[binding.TopicName] = true
+
+		topicLag, err := s.describeTopicLag(ctx, state, namespace.Name, binding.TopicName, committedOffsets)
+		if err != nil {
+			return nil, errors.Wrapf(err, "lag of topic %s/%s failed", namespace.Name, binding.TopicName)
+		}
+		response.Topics = append(response.Topics, topicLag)
+	}
+
+	return response, nil
+}
+
+func (s *Server) describeTopicLag(ctx context.Context, state *ClusterState, namespaceName string, topicName string, committedOffsets map[uint64]int64) (*emq.ConsumerGroupDescribeResponse_TopicLag, error) {
+	topicLag := &emq.ConsumerGroupDescribeResponse_TopicLag{
+		TopicName: topicName,
+	}
+
+	var (
+		oldestSegment *ClusterSegment
+		oldestOffset  int64
+	)
+
+	for _, segments := range [][]*ClusterSegment{state.OpenSegments, state.ClosedSegments} {
+		for _, segment := range segments {
+			if segment.Type != ClusterSegment_TOPIC || segment.OwnerNamespace != namespaceName || segment.OwnerName != topicName {
+				continue
+			}
+			offset, ok := committedOffsets[segment.ID]
+			if !ok {
+				continue
+			}
+			if offset < 1 {
+				offset = 1 // version byte
+			}
+
+			size := segment.Size_
+			if segment.ClosedAt.IsZero() {
+				node := state.GetNode(segment.Nodes.PrimaryNodeID)
+				if node == nil {
+					continue
+				}
+				var err error
+				size, err = s.GetSegmentSizeFromNode(ctx, segment.ID, node.ID, node.Address)
+				if err != nil {
+					return nil, errors.Wrapf(err, "size of segment %d failed", segment.ID)
+				}
+			}
+
+			if size <= offset {
+				continue
+			}
+
+			topicLag.LagBytes += size - offset
+			if oldestSegment == nil || segment.CreatedAt.Before(oldestSegment.CreatedAt) {
+				oldestSegment = segment
+				oldestOffset = offset
+			}
+		}
+	}
+
+	if oldestSegment == nil {
+		return topicLag, nil
+	}
+
+	oldest, averageSize, err := s.sampleSegment(ctx, state, oldestSegment, oldestOffset, consumerGroupLagSampleSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sample of segment %d failed", oldestSegment.ID)
+	}
+	if averageSize > 0 {
+		topicLag.LagMessages = int64(float64(topicLag.LagBytes)/averageSize + 0.5)
+	}
+	if !oldest.IsZero() {
+		topicLag.OldestUnconsumed = &oldest
+		topicLag.LagTime = time.Since(oldest)
+	}
+
+	return topicLag, nil
+}
+
+// Reads up to n messages from segment starting at given offset, returns publish time of the first message & average size
+// of read messages (including length prefix).
+func (s *Server) sampleSegment(ctx context.Context, state *ClusterState, segment *ClusterSegment, offset int64, n int) (first time.Time, averageSize float64, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var next func() (data []byte, commitOffset int64, err error)
+
+	if !segment.OffloadedAt.IsZero() {
+		if err := s.fetchOffloadedSegment(ctx, segment); err != nil {
+			return time.Time{}, 0, err
+		}
+	}
+
+	if s.segmentDir.Exists(segment.ID) {
+		segmentHandle, err := s.segmentDir.Open(segment.ID)
+		if err != nil {
+			return time.Time{}, 0, errors.Wrap(err, "segment open failed")
+		}
+		defer s.segmentDir.Release(segmentHandle)
+
+		iterator, err := segmentHandle.ReadAt(offset, false)
+		if err != nil {
+			return time.Time{}, 0, errors.Wrap(err, "segment read failed")
+		}
+		defer iterator.Close()
+
+		next = func() ([]byte, int64, error) {
+			data, _, commitOffset, err := iterator.Next()
+			return data, commitOffset, err
+		}
+
+	} else {
+		nodeID := segment.Nodes.PrimaryNodeID
+		if !segment.ClosedAt.IsZero() && len(segment.Nodes.DoneNodeIDs) > 0 {
+			nodeID = segment.Nodes.DoneNodeIDs[0]
+		}
+		node := state.GetNode(nodeID)
+		if node == nil {
+			return time.Time{}, 0, errors.Errorf("node %d not found", nodeID)
+		}
+
+		conn, err := s.pool.Get(ctx, node.Address)
+		if err != nil {
+			return time.Time{}, 0, errors.Wrap(err, "dial failed")
+		}
+		defer s.pool.Put(conn)
+
+		stream, err := NewNodeRPCClient(conn).SegmentRead(ctx, &SegmentReadRequest{
+			SegmentID: segment.ID,
+			Offset:    offset,
+		})
+		if err != nil {
+			return time.Time{}, 0, errors.Wrap(err, "segment read failed")
+		}
+
+		next = func() ([]byte, int64, error) {
+			response, err := stream.Recv()
+			if err != nil {
+				return nil, 0, err
+			}
+			return response.Data, response.CommitOffset, nil
+		}
+	}
+
+	endOffset := offset
+	i := 0
+	for ; i < n; i++ {
+		data, commitOffset, err := next()
+		if err == io.EOF || err == segments.ErrIteratorClosed {
+			break
+		} else if err != nil {
+			return time.Time{}, 0, errors.Wrap(err, "segment next failed")
+		}
+
+		if i == 0 {
+			publishing := Publishing{}
+			if err := proto.Unmarshal(data, &publishing); err != nil {
+				return time.Time{}, 0, errors.Wrap(err, "unmarshal failed")
+			}
+			first = segment.CreatedAt.Add(time.Duration(publishing.Delta))
+		}
+
+		endOffset = commitOffset
+	}
+
+	if i > 0 {
+		averageSize = float64(endOffset-offset) / float64(i)
+	}
+
+	return first, averageSize, nil
+}
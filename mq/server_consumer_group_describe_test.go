@@ -0,0 +1,182 @@
+package mq
+
+import (
+	"context"
+	"testing"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_DescribeConsumerGroup(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-describe-topic",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-describe-consumer-group",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-describe-topic", ExchangeType: emq.ExchangeTypeFanout},
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ts.WaitForConsumerGroup(t, ctx, "default", "test-describe-consumer-group")
+	}
+
+	for i := 0; i < 2; i++ {
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-describe-topic",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.DescribeConsumerGroup(ctx, &emq.ConsumerGroupDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-consumer-group",
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+		assert.Equal("test-describe-consumer-group", response.ConsumerGroup.Name)
+		assert.Equal(ts.Server.nodeID, response.NodeID)
+		assert.Len(response.Topics, 1)
+		topic := response.Topics[0]
+		assert.Equal("test-describe-topic", topic.TopicName)
+		assert.True(topic.LagBytes > 0)
+		assert.Equal(int64(2), topic.LagMessages)
+		assert.NotNil(topic.OldestUnconsumed)
+		assert.Empty(response.Subscriptions)
+	}
+
+	{
+		_, err := ts.Server.DescribeConsumerGroup(ctx, &emq.ConsumerGroupDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-nonexistent",
+		})
+		assert.Error(err)
+	}
+}
+
+func TestServer_DescribeConsumerGroup_SingleActiveConsumer(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-describe-sac-topic",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-describe-sac-consumer-group",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-describe-sac-topic", ExchangeType: emq.ExchangeTypeFanout},
+				},
+				SingleActiveConsumer: true,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-describe-sac-topic",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ts.WaitForMessage(t, ctx, "default", "test-describe-sac-consumer-group")
+	}
+
+	ts.Server.groupMutex.Lock()
+	g := ts.Server.groups[ts.Server.makeConsumerGroupMapKey("default", "test-describe-sac-consumer-group")]
+	s1 := g.Subscribe()
+	ts.Server.subscriptions[s1.ID] = s1
+	s2 := g.Subscribe()
+	ts.Server.subscriptions[s2.ID] = s2
+	ts.Server.groupMutex.Unlock()
+	defer s2.Close()
+
+	m, err := s1.Next()
+	assert.NoError(err)
+	assert.NotNil(m)
+
+	{
+		response, err := ts.Server.DescribeConsumerGroup(ctx, &emq.ConsumerGroupDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-sac-consumer-group",
+		})
+		assert.NoError(err)
+		assert.True(response.ConsumerGroup.SingleActiveConsumer)
+		assert.Equal(s1.ID, response.ActiveSubscriptionID)
+		assert.NotNil(response.ActiveSince)
+		assert.Len(response.Subscriptions, 2)
+		assert.True(response.Subscriptions[0].Active)
+		assert.False(response.Subscriptions[1].Active)
+	}
+
+	assert.NoError(s1.Close())
+	s2.SetBlocking(false)
+	m, err = s2.Next()
+	assert.NoError(err)
+	assert.NotNil(m)
+
+	{
+		response, err := ts.Server.DescribeConsumerGroup(ctx, &emq.ConsumerGroupDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-sac-consumer-group",
+		})
+		assert.NoError(err)
+		assert.Equal(s2.ID, response.ActiveSubscriptionID)
+		assert.False(response.Subscriptions[0].Active)
+		assert.True(response.Subscriptions[1].Active)
+	}
+}
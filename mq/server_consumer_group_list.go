@@ -49,11 +49,13 @@ func (s *Server) ListConsumerGroups(ctx context.Context, request *emq.ConsumerGr
 		}
 
 		consumerGroups = append(consumerGroups, &emq.ConsumerGroup{
-			Namespace: namespace.Name,
-			Name:      cg.Name,
-			Bindings:  clientBindings,
-			Size_:     cg.Size_,
-			Since:     cg.Since,
+			Namespace:            namespace.Name,
+			Name:                 cg.Name,
+			Bindings:             clientBindings,
+			Size_:                cg.Size_,
+			Since:                cg.Since,
+			MaxPriority:          cg.MaxPriority,
+			SingleActiveConsumer: cg.SingleActiveConsumer,
 		})
 	}
 
@@ -68,6 +70,7 @@ func (s *Server) convertClusterBinding(clusterBinding *ClusterConsumerGroup_Bind
 	clientBinding := &emq.ConsumerGroup_Binding{
 		TopicName:    clusterBinding.TopicName,
 		ExchangeType: clusterBinding.ExchangeType,
+		Filter:       clusterBinding.Filter,
 	}
 	switch by := clusterBinding.By.(type) {
 	case nil:
@@ -0,0 +1,109 @@
+package mq
+
+import (
+	"context"
+
+	"eventter.io/mq/emq"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) ExportConsumerGroupOffsets(ctx context.Context, request *emq.ConsumerGroupOffsetsExportRequest) (*emq.ConsumerGroupOffsetsExportResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+
+	state := s.clusterState.Current()
+
+	namespace, _ := state.FindNamespace(request.Namespace)
+	if namespace == nil {
+		return nil, errors.Errorf(namespaceNotFoundErrorFormat, request.Namespace)
+	}
+
+	consumerGroup, _ := namespace.FindConsumerGroup(request.Name)
+	if consumerGroup == nil {
+		return nil, errors.Errorf(
+			notFoundErrorFormat,
+			entityConsumerGroup,
+			request.Namespace,
+			request.Name,
+		)
+	}
+
+	offsetSegments := state.FindOpenSegmentsFor(
+		ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS,
+		request.Namespace,
+		request.Name,
+	)
+
+	var offsetSegment *ClusterSegment
+	if len(offsetSegments) == 1 {
+		offsetSegment = offsetSegments[0]
+	} else if len(offsetSegments) > 1 {
+		return nil, errors.New("consumer group assigned to multiple nodes")
+	}
+
+	if offsetSegment != nil && offsetSegment.Nodes.PrimaryNodeID != s.nodeID {
+		if request.DoNotForward {
+			return nil, errWontForward
+		}
+
+		node := state.GetNode(offsetSegment.Nodes.PrimaryNodeID)
+		if node == nil {
+			return nil, errors.Errorf("node %d not found", offsetSegment.Nodes.PrimaryNodeID)
+		}
+
+		conn, err := s.pool.Get(ctx, node.Address)
+		if err != nil {
+			return nil, errors.Wrap(err, "dial failed")
+		}
+		defer s.pool.Put(conn)
+
+		request.DoNotForward = true
+		return emq.NewEventterMQClient(conn).ExportConsumerGroupOffsets(ctx, request)
+	}
+
+	committedOffsets := make(map[uint64]int64)
+	for _, commit := range consumerGroup.OffsetCommits {
+		committedOffsets[commit.SegmentID] = commit.Offset
+	}
+
+	if offsetSegment != nil && !consumerGroup.OffsetCommitsImportPending && s.segmentDir.Exists(offsetSegment.ID) {
+		segmentHandle, err := s.segmentDir.Open(offsetSegment.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "segment open failed")
+		}
+		err = s.readOffsetCommits(segmentHandle, offsetSegment.ID, committedOffsets)
+		s.segmentDir.Release(segmentHandle)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &emq.ConsumerGroupOffsetsExportResponse{
+		OK:    true,
+		Index: state.Index,
+	}
+
+	// iterate over cluster state commits, they're sorted by segment ID
+	for _, commit := range consumerGroup.OffsetCommits {
+		segment := state.GetSegment(commit.SegmentID)
+		if segment == nil {
+			// segment waiting to be deleted from cluster state committed offsets
+			continue
+		}
+
+		offset := &emq.ConsumerGroupOffset{
+			SegmentID:        segment.ID,
+			TopicName:        segment.OwnerName,
+			Offset:           committedOffsets[segment.ID],
+			SegmentCreatedAt: segment.CreatedAt,
+		}
+		if !segment.ClosedAt.IsZero() {
+			closedAt := segment.ClosedAt
+			offset.SegmentClosedAt = &closedAt
+		}
+		response.Offsets = append(response.Offsets, offset)
+	}
+
+	return response, nil
+}
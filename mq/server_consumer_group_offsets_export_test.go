@@ -0,0 +1,93 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ExportConsumerGroupOffsets(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-export-topic",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-export-consumer-group",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-export-topic", ExchangeType: emq.ExchangeTypeFanout},
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ts.WaitForConsumerGroup(t, ctx, "default", "test-export-consumer-group")
+	}
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-export-topic",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		var response *emq.ConsumerGroupOffsetsExportResponse
+		for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			response, err = ts.Server.ExportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsExportRequest{
+				Namespace: "default",
+				Name:      "test-export-consumer-group",
+			})
+			assert.NoError(err)
+			assert.True(response.OK)
+			if len(response.Offsets) > 0 {
+				break
+			}
+		}
+		assert.Len(response.Offsets, 1)
+		offset := response.Offsets[0]
+		segments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-export-topic")
+		assert.Len(segments, 1)
+		assert.Equal(segments[0].ID, offset.SegmentID)
+		assert.Equal("test-export-topic", offset.TopicName)
+		assert.Equal(int64(0), offset.Offset)
+		assert.True(offset.SegmentCreatedAt.Equal(segments[0].CreatedAt))
+		assert.Nil(offset.SegmentClosedAt)
+	}
+
+	{
+		_, err := ts.Server.ExportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsExportRequest{
+			Namespace: "default",
+			Name:      "test-export-nonexistent",
+		})
+		assert.Error(err)
+	}
+}
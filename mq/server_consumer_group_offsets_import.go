@@ -0,0 +1,138 @@
+package mq
+
+import (
+	"context"
+
+	"eventter.io/mq/emq"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) ImportConsumerGroupOffsets(ctx context.Context, request *emq.ConsumerGroupOffsetsImportRequest) (*emq.ConsumerGroupOffsetsImportResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return emq.NewEventterMQClient(conn).ImportConsumerGroupOffsets(ctx, request)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+
+	if err := s.beginTransaction(); err != nil {
+		return nil, errors.Wrap(err, "tx begin failed")
+	}
+	defer s.releaseTransaction()
+
+	state := s.clusterState.Current()
+
+	namespace, _ := state.FindNamespace(request.Namespace)
+	if namespace == nil {
+		return nil, errors.Errorf(namespaceNotFoundErrorFormat, request.Namespace)
+	}
+
+	consumerGroup, _ := namespace.FindConsumerGroup(request.Name)
+	if consumerGroup == nil {
+		return nil, errors.Errorf(notFoundErrorFormat, entityConsumerGroup, request.Namespace, request.Name)
+	}
+
+	boundTopics := make(map[string]bool)
+	for _, binding := range consumerGroup.Bindings {
+		boundTopics[binding.TopicName] = true
+	}
+
+	committedOffsets := make(map[uint64]int64)
+	for _, commit := range consumerGroup.OffsetCommits {
+		committedOffsets[commit.SegmentID] = commit.Offset
+	}
+
+	for _, offset := range request.Offsets {
+		segment := state.GetSegment(offset.SegmentID)
+		if segment == nil || segment.Type != ClusterSegment_TOPIC || segment.OwnerNamespace != request.Namespace {
+			return nil, errors.Errorf("segment %d not found in namespace %s", offset.SegmentID, request.Namespace)
+		}
+		if offset.TopicName != "" && offset.TopicName != segment.OwnerName {
+			return nil, errors.Errorf(
+				"segment %d belongs to %s %s/%s, not %s",
+				segment.ID,
+				entityTopic,
+				segment.OwnerNamespace,
+				segment.OwnerName,
+				offset.TopicName,
+			)
+		}
+		if !boundTopics[segment.OwnerName] {
+			return nil, errors.Errorf(
+				"segment %d belongs to %s %s/%s not bound to %s %s/%s",
+				segment.ID,
+				entityTopic,
+				segment.OwnerNamespace,
+				segment.OwnerName,
+				entityConsumerGroup,
+				request.Namespace,
+				request.Name,
+			)
+		}
+		if !offset.SegmentCreatedAt.IsZero() && !offset.SegmentCreatedAt.Equal(segment.CreatedAt) {
+			return nil, errors.Errorf(
+				"segment %d created at %s, not %s",
+				segment.ID,
+				segment.CreatedAt,
+				offset.SegmentCreatedAt,
+			)
+		}
+		if !segment.ClosedAt.IsZero() {
+			if offset.Offset > segment.Size_ {
+				return nil, errors.Errorf("offset %d out of bounds of segment %d of size %d", offset.Offset, segment.ID, segment.Size_)
+			}
+			if offset.Offset < segment.Size_ && segment.ClosedAt.Before(consumerGroup.Since) {
+				return nil, errors.Errorf(
+					"segment %d closed at %s, before %s %s/%s since %s, its messages won't be consumed",
+					segment.ID,
+					segment.ClosedAt,
+					entityConsumerGroup,
+					request.Namespace,
+					request.Name,
+					consumerGroup.Since,
+				)
+			}
+		}
+
+		committedOffsets[segment.ID] = offset.Offset
+	}
+
+	cmd := &ClusterCommandConsumerGroupOffsetCommitsUpdate{
+		Namespace: request.Namespace,
+		Name:      request.Name,
+		Imported:  true,
+	}
+	for segmentID, offset := range committedOffsets {
+		cmd.OffsetCommits = append(cmd.OffsetCommits, &ClusterConsumerGroup_OffsetCommit{
+			SegmentID: segmentID,
+			Offset:    offset,
+		})
+	}
+
+	index, err := s.Apply(cmd)
+	if err != nil {
+		return nil, errors.Wrap(err, "apply failed")
+	}
+
+	return &emq.ConsumerGroupOffsetsImportResponse{
+		OK:    true,
+		Index: index,
+	}, nil
+}
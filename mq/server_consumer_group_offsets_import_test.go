@@ -0,0 +1,167 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ImportConsumerGroupOffsets(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-import-topic",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-import-consumer-group",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-import-topic", ExchangeType: emq.ExchangeTypeFanout},
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ts.WaitForConsumerGroup(t, ctx, "default", "test-import-consumer-group")
+	}
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-import-topic",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	segments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-import-topic")
+	assert.Len(segments, 1)
+	segmentID := segments[0].ID
+
+	{
+		ts.Server.groupMutex.Lock()
+		g := ts.Server.groups[ts.Server.makeConsumerGroupMapKey("default", "test-import-consumer-group")]
+		ts.Server.groupMutex.Unlock()
+		assert.NotNil(g)
+
+		subscription := g.Subscribe()
+		subscription.SetSize(1)
+		m, err := subscription.Next()
+		assert.NoError(err)
+		assert.NoError(subscription.Ack(m.SeqNo))
+		subscription.Close()
+	}
+
+	export := func() *emq.ConsumerGroupOffset {
+		response, err := ts.Server.ExportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsExportRequest{
+			Namespace: "default",
+			Name:      "test-import-consumer-group",
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+		for _, offset := range response.Offsets {
+			if offset.SegmentID == segmentID {
+				return offset
+			}
+		}
+		return nil
+	}
+
+	var consumed int64
+	for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if offset := export(); offset != nil && offset.Offset > 0 {
+			consumed = offset.Offset
+			break
+		}
+	}
+	assert.True(consumed > 0)
+
+	{
+		response, err := ts.Server.ImportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsImportRequest{
+			Namespace: "default",
+			Name:      "test-import-consumer-group",
+			Offsets: []*emq.ConsumerGroupOffset{
+				{SegmentID: segmentID, TopicName: "test-import-topic", Offset: 0},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		state := ts.ClusterStateStore.Current()
+		consumerGroup := state.GetConsumerGroup("default", "test-import-consumer-group")
+		assert.Equal(uint64(1), consumerGroup.OffsetCommitsGeneration)
+		assert.Equal(int64(0), export().Offset)
+	}
+
+	for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		consumerGroup := ts.ClusterStateStore.Current().GetConsumerGroup("default", "test-import-consumer-group")
+		if !consumerGroup.OffsetCommitsImportPending {
+			break
+		}
+	}
+	assert.False(ts.ClusterStateStore.Current().GetConsumerGroup("default", "test-import-consumer-group").OffsetCommitsImportPending)
+
+	// consumed message is delivered again
+	ts.WaitForMessage(t, ctx, "default", "test-import-consumer-group")
+	assert.Equal(int64(0), export().Offset)
+
+	{
+		_, err := ts.Server.ImportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsImportRequest{
+			Namespace: "default",
+			Name:      "test-import-consumer-group",
+			Offsets: []*emq.ConsumerGroupOffset{
+				{SegmentID: segmentID, TopicName: "test-import-other-topic", Offset: 0},
+			},
+		})
+		assert.Error(err)
+	}
+
+	{
+		_, err := ts.Server.ImportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsImportRequest{
+			Namespace: "default",
+			Name:      "test-import-consumer-group",
+			Offsets: []*emq.ConsumerGroupOffset{
+				{SegmentID: segmentID + 1, Offset: 0},
+			},
+		})
+		assert.Error(err)
+	}
+
+	{
+		_, err := ts.Server.ImportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsImportRequest{
+			Namespace: "default",
+			Name:      "test-import-consumer-group",
+			Offsets: []*emq.ConsumerGroupOffset{
+				{SegmentID: segmentID, Offset: -1},
+			},
+		})
+		assert.Error(err)
+	}
+}
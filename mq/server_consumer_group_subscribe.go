@@ -5,6 +5,7 @@ import (
 
 	"eventter.io/mq/consumers"
 	"eventter.io/mq/emq"
+	"eventter.io/mq/tracing"
 	"github.com/pkg/errors"
 )
 
@@ -144,7 +145,13 @@ func (s *Server) Subscribe(request *emq.ConsumerGroupSubscribeRequest, stream em
 			response.SeqNo = message.SeqNo
 		}
 
+		_, span := s.startMessageSpan(ctx, message.Message, "Deliver", tracing.KindConsumer)
+		span.SetAttribute(traceAttributeNamespace, request.Namespace)
+		span.SetAttribute(traceAttributeConsumerGroup, request.Name)
+		span.SetAttribute(traceAttributeSubscription, subscription.ID)
 		err = stream.Send(response)
+		span.SetError(err)
+		span.End()
 		if err != nil {
 			return errors.Wrap(err, "send failed")
 		}
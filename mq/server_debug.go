@@ -22,8 +22,43 @@ func (s *Server) Debug(ctx context.Context, request *DebugRequest) (*DebugRespon
 		}
 	}
 
+	nodeMap := make(map[uint64]*ClusterNode)
+	availableZones := make(map[string]bool)
+	for _, node := range state.Nodes {
+		nodeMap[node.ID] = node
+		if node.State == ClusterNode_ALIVE {
+			availableZones[node.Zone] = true
+		}
+	}
+
+	var underDiversifiedSegments []string
+	if len(availableZones) > 1 {
+		for _, segments := range [][]*ClusterSegment{state.OpenSegments, state.ClosedSegments} {
+			for _, segment := range segments {
+				zones, nodes := countSegmentZones(segment, nodeMap)
+				expectedZones := len(availableZones)
+				if nodes < expectedZones {
+					expectedZones = nodes
+				}
+				if zones < expectedZones {
+					underDiversifiedSegments = append(underDiversifiedSegments, fmt.Sprintf(
+						"segment %d (%s %s/%s): %d replica(s) in %d zone(s), %d zone(s) available",
+						segment.ID,
+						segment.Type.String(),
+						segment.OwnerNamespace,
+						segment.OwnerName,
+						nodes,
+						zones,
+						len(availableZones),
+					))
+				}
+			}
+		}
+	}
+
 	return &DebugResponse{
-		ClusterState: proto.MarshalTextString(state),
-		Segments:     segmentDumps,
+		ClusterState:             proto.MarshalTextString(state),
+		Segments:                 segmentDumps,
+		UnderDiversifiedSegments: underDiversifiedSegments,
 	}, nil
 }
@@ -22,5 +22,6 @@ func TestServer_Debug(t *testing.T) {
 		assert.NoError(err)
 		assert.NotEmpty(response.ClusterState)
 		assert.Empty(response.Segments)
+		assert.Empty(response.UnderDiversifiedSegments)
 	}
 }
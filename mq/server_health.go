@@ -0,0 +1,134 @@
+package mq
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// Max time since the last main loop iteration for the node to be considered live. Single loop iteration may
+	// legitimately wait for several Raft barriers & applies.
+	loopLivenessTimeout = 1 * time.Minute
+	healthCheckInterval = 1 * time.Second
+)
+
+var (
+	errClosed         = errors.New("server is closed")
+	errLoopNotRunning = errors.New("main loop not running")
+)
+
+// Live returns nil if the main loop is making progress, otherwise an error describing why the node is not live.
+func (s *Server) Live() error {
+	select {
+	case <-s.closed:
+		return errClosed
+	default:
+	}
+
+	heartbeat := atomic.LoadInt64(&s.loopHeartbeat)
+	if heartbeat == 0 {
+		return errLoopNotRunning
+	}
+	if since := time.Since(time.Unix(0, heartbeat)); since > loopLivenessTimeout {
+		return errors.Errorf("main loop wedged, last iteration %s ago", since.Truncate(time.Millisecond))
+	}
+
+	return nil
+}
+
+// Ready returns nil if node is ready to serve requests, i.e. it has opened segments dir, joined the cluster, knows
+// Raft leader & has applied cluster state up to leader's commit index. Otherwise error describing why the node is
+// not ready is returned.
+func (s *Server) Ready() error {
+	if err := s.Live(); err != nil {
+		return err
+	}
+
+	if s.segmentDir == nil || s.segmentDir.Closed() {
+		return errors.New("segments dir not open")
+	}
+
+	if s.raftNode.Leader() == "" {
+		return errNoLeaderElected
+	}
+
+	commitIndex, err := strconv.ParseUint(s.raftNode.Stats()["commit_index"], 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not get commit index")
+	}
+	if appliedIndex := s.raftNode.AppliedIndex(); appliedIndex < commitIndex {
+		return errors.Errorf("cluster state not caught up, applied index %d, commit index %d", appliedIndex, commitIndex)
+	}
+
+	node := s.clusterState.Current().GetNode(s.nodeID)
+	if node == nil || node.State != ClusterNode_ALIVE {
+		return errors.New("node has not joined the cluster yet")
+	}
+
+	return nil
+}
+
+// WatchHealth periodically propagates node's health to gRPC health service until the server is closed. Overall
+// health (empty service name) reflects liveness, health of the public API service reflects readiness.
+func (s *Server) WatchHealth(healthServer *health.Server) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		s.updateHealth(healthServer)
+
+		select {
+		case <-ticker.C:
+		case <-s.closed:
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+			healthServer.SetServingStatus(emq.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+			return
+		}
+	}
+}
+
+func (s *Server) updateHealth(healthServer *health.Server) {
+	live := healthpb.HealthCheckResponse_SERVING
+	if s.Live() != nil {
+		live = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	healthServer.SetServingStatus("", live)
+
+	ready := healthpb.HealthCheckResponse_SERVING
+	if s.Ready() != nil {
+		ready = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	healthServer.SetServingStatus(emq.ServiceName, ready)
+}
+
+// HealthHandler returns HTTP handler serving liveness probe at `/healthz` & readiness probe at `/readyz`.
+func (s *Server) HealthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeProbe(w, s.Live())
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		writeProbe(w, s.Ready())
+	})
+	return mux
+}
+
+func writeProbe(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "ok")
+}
@@ -0,0 +1,76 @@
+package mq
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestServer_Health(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for ts.Server.Ready() != nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NoError(ts.Server.Live())
+	assert.NoError(ts.Server.Ready())
+
+	handler := ts.Server.HealthHandler()
+	for _, path := range []string{"/healthz", "/readyz"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(http.StatusOK, w.Code, path)
+		assert.Equal("ok\n", w.Body.String(), path)
+	}
+
+	healthServer := health.NewServer()
+	ts.Server.updateHealth(healthServer)
+	for _, service := range []string{"", emq.ServiceName} {
+		response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.NoError(err)
+		assert.Equal(healthpb.HealthCheckResponse_SERVING, response.Status, service)
+	}
+
+	// pretend the main loop got stuck; loop may overwrite the heartbeat in the meantime, therefore retry
+	wedged := func() bool {
+		atomic.StoreInt64(&ts.Server.loopHeartbeat, time.Now().Add(-2*loopLivenessTimeout).UnixNano())
+
+		for _, path := range []string{"/healthz", "/readyz"} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+			if w.Code != http.StatusServiceUnavailable {
+				return false
+			}
+			assert.Contains(w.Body.String(), "main loop wedged", path)
+		}
+
+		ts.Server.updateHealth(healthServer)
+		for _, service := range []string{"", emq.ServiceName} {
+			response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+			assert.NoError(err)
+			if response.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+				return false
+			}
+		}
+
+		return true
+	}
+	ok := false
+	for i := 0; i < 10 && !ok; i++ {
+		ok = wedged()
+	}
+	assert.True(ok)
+}
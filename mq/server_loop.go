@@ -3,10 +3,11 @@ package mq
 import (
 	"context"
 	"fmt"
-	"log"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
+	"eventter.io/mq/logging"
 	"eventter.io/mq/tasks"
 	"github.com/hashicorp/memberlist"
 	"github.com/hashicorp/raft"
@@ -29,21 +30,36 @@ func (s *Server) Loop(memberEventsC chan memberlist.NodeEvent) {
 	nodeTicker := time.NewTicker(100 * time.Millisecond)
 	defer nodeTicker.Stop()
 
-	taskManager := tasks.NewManager(context.Background(), "main")
+	taskManager := tasks.NewManager(context.Background(), s.logger.With(logging.F("task_manager", "main")))
 	defer taskManager.Close()
 
 	runningConsumerGroups := make(map[string]*tasks.Task)
 	runningOpenSegmentReplications := make(map[uint64]*tasks.Task)
 	runningClosedSegmentReplications := make(map[uint64]*tasks.Task)
+	runningOpenSegmentDrains := make(map[uint64]*tasks.Task)
+	runningSegmentIndexings := make(map[uint64]*tasks.Task)
+	runningTopicCompactions := make(map[string]*tasks.Task)
+	runningSegmentOffloads := make(map[uint64]*tasks.Task)
+	var runningTieredStorageGarbageCollection *tasks.Task
+	indexedSegmentIDs := make(map[uint64]bool)
 
 	garbageCollectionTicker := time.NewTicker(10 * time.Second)
 	defer garbageCollectionTicker.Stop()
 
+	tieredStorageGarbageCollectionTicker := time.NewTicker(10 * time.Minute)
+	defer tieredStorageGarbageCollectionTicker.Stop()
+
+	s.updateDiskUsage()
+	diskUsageTicker := time.NewTicker(1 * time.Second)
+	defer diskUsageTicker.Stop()
+
 LOOP:
 	for {
+		atomic.StoreInt64(&s.loopHeartbeat, time.Now().UnixNano())
+
 		select {
 		case becameLeader := <-s.raftNode.LeaderCh():
-			log.Printf("leadership status changed: before=%t, now=%t", isLeader, becameLeader)
+			s.logger.Info("leadership status changed", logging.F("before", isLeader), logging.F("now", becameLeader))
 
 			if becameLeader {
 				// "tick" immediately
@@ -64,7 +80,7 @@ LOOP:
 
 			func() {
 				if err := s.beginTransaction(); err != nil {
-					log.Printf("could not begin leader loop tx")
+					s.logger.Error("could not begin leader loop tx", logging.Error(err))
 					return
 				}
 				defer s.releaseTransaction()
@@ -72,27 +88,36 @@ LOOP:
 				s.reconciler.ReconcileNodes(s.clusterState.Current())
 
 				if err := s.raftNode.Barrier(barrierTimeout).Error(); err != nil {
-					log.Printf("could not add barrier: %v", err)
+					s.logger.Error("could not add barrier", logging.Error(err))
 					return
 				}
 
 				s.reconciler.ReconcileNamespaces(s.clusterState.Current())
 
 				if err := s.raftNode.Barrier(barrierTimeout).Error(); err != nil {
-					log.Printf("could not add barrier: %v", err)
+					s.logger.Error("could not add barrier", logging.Error(err))
+					return
+				}
+
+				s.reconciler.ReconcileRetention(s.clusterState.Current())
+
+				if err := s.raftNode.Barrier(barrierTimeout).Error(); err != nil {
+					s.logger.Error("could not add barrier", logging.Error(err))
 					return
 				}
 
 				s.reconciler.ReconcileSegments(s.clusterState.Current())
 
 				if err := s.raftNode.Barrier(barrierTimeout).Error(); err != nil {
-					log.Printf("could not add barrier: %v", err)
+					s.logger.Error("could not add barrier", logging.Error(err))
 					return
 				}
 
 				s.reconciler.ReconcileConsumerGroups(s.clusterState.Current())
 			}()
 
+			state = nil // !!! force re-read of state, closed segments might have become old enough to be offloaded
+
 		case <-nodeTicker.C:
 			newState := s.clusterState.Current()
 			if newState == state {
@@ -102,9 +127,29 @@ LOOP:
 			state = newState
 
 			replicatingOpenSegmentIDs := make(map[uint64]bool)
+			drainingOpenSegmentIDs := make(map[uint64]bool)
 			consumerGroups := make(map[string]bool)
 
+			node := state.GetNode(s.nodeID)
+			draining := node != nil && node.AdminState == ClusterNode_DRAINING
+
 			for _, segment := range state.OpenSegments {
+				if draining && segment.Type == ClusterSegment_TOPIC && segment.Nodes.PrimaryNodeID == s.nodeID {
+					drainingOpenSegmentIDs[segment.ID] = true
+
+					if _, ok := runningOpenSegmentDrains[segment.ID]; !ok {
+						runningOpenSegmentDrains[segment.ID] = taskManager.Start(
+							fmt.Sprintf("drain of open segment %d", segment.ID),
+							func(segmentID uint64) func(context.Context) error {
+								return func(ctx context.Context) error {
+									return s.taskSegmentDrain(ctx, segmentID)
+								}
+							}(segment.ID),
+							segment.ID,
+						)
+					}
+				}
+
 				if segment.Type == ClusterSegment_CONSUMER_GROUP_OFFSET_COMMITS && segment.Nodes.PrimaryNodeID == s.nodeID {
 					name := segment.OwnerNamespace + "/" + segment.OwnerName
 					consumerGroups[name] = true
@@ -143,43 +188,142 @@ LOOP:
 
 			for segmentID, task := range runningOpenSegmentReplications {
 				if !replicatingOpenSegmentIDs[segmentID] {
-					log.Printf("stopping replication of open segment %d", segmentID)
+					s.logger.Info("stopping replication of open segment", logging.SegmentID(segmentID))
+					task.Cancel()
+				}
+			}
+
+			for segmentID, task := range runningOpenSegmentDrains {
+				if !drainingOpenSegmentIDs[segmentID] {
 					task.Cancel()
 				}
 			}
 
 			for name, task := range runningConsumerGroups {
 				if !consumerGroups[name] {
-					log.Printf("stopping consumer group %s", name)
+					s.logger.Info("stopping consumer group", logging.F("task_id", task.ID), logging.F("task", task.Name))
 					task.Cancel()
 				}
 			}
 
 			replicatingClosedSegmentIDs := make(map[uint64]bool)
 
+			for _, segments := range [][]*ClusterSegment{state.ClosedSegments, state.ReplacingSegments} {
+				for _, segment := range segments {
+					for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
+						if nodeID == s.nodeID {
+							replicatingClosedSegmentIDs[segment.ID] = true
+
+							if _, ok := runningClosedSegmentReplications[segment.ID]; !ok {
+								runningClosedSegmentReplications[segment.ID] = taskManager.Start(
+									fmt.Sprintf("replication of closed segment %d from node %d", segment.ID, segment.Nodes.PrimaryNodeID),
+									func(segmentID uint64, nodeID uint64) func(context.Context) error {
+										return func(ctx context.Context) error {
+											return s.taskSegmentReplication(ctx, segmentID, nodeID, false)
+										}
+									}(segment.ID, segment.Nodes.DoneNodeIDs[rand.Intn(len(segment.Nodes.DoneNodeIDs))]), // select random node that is done
+									segment.ID,
+								)
+							}
+						}
+					}
+				}
+			}
+
+			for segmentID, task := range runningClosedSegmentReplications {
+				if !replicatingClosedSegmentIDs[segmentID] {
+					s.logger.Info("stopping replication of closed segment", logging.SegmentID(segmentID))
+					task.Cancel()
+				}
+			}
+
+			nextIndexedSegmentIDs := make(map[uint64]bool)
+
 			for _, segment := range state.ClosedSegments {
-				for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
-					if nodeID == s.nodeID {
-						replicatingClosedSegmentIDs[segment.ID] = true
+				if segment.Type != ClusterSegment_TOPIC || !s.holdsSegment(segment) {
+					continue
+				}
 
-						if _, ok := runningClosedSegmentReplications[segment.ID]; !ok {
-							runningClosedSegmentReplications[segment.ID] = taskManager.Start(
-								fmt.Sprintf("replication of closed segment %d from node %d", segment.ID, segment.Nodes.PrimaryNodeID),
-								func(segmentID uint64, nodeID uint64) func(context.Context) error {
+				if indexedSegmentIDs[segment.ID] || s.segmentDir.IndexExists(segment.ID) {
+					nextIndexedSegmentIDs[segment.ID] = true
+					continue
+				}
+
+				if _, ok := runningSegmentIndexings[segment.ID]; !ok {
+					runningSegmentIndexings[segment.ID] = taskManager.Start(
+						fmt.Sprintf("index of segment %d", segment.ID),
+						func(segmentID uint64) func(context.Context) error {
+							return func(ctx context.Context) error {
+								return s.taskSegmentIndex(ctx, segmentID)
+							}
+						}(segment.ID),
+						segment.ID,
+					)
+				}
+			}
+
+			indexedSegmentIDs = nextIndexedSegmentIDs
+
+			compactedTopics := make(map[string]bool)
+
+			if isLeader {
+				for _, namespace := range state.Namespaces {
+					for _, topic := range namespace.Topics {
+						if topic.CompactionKey == "" {
+							continue
+						}
+
+						name := namespace.Name + "/" + topic.Name
+						compactedTopics[name] = true
+
+						if _, ok := runningTopicCompactions[name]; !ok {
+							runningTopicCompactions[name] = taskManager.Start(
+								fmt.Sprintf("compaction of topic %s", name),
+								func(namespace string, name string) func(context.Context) error {
 									return func(ctx context.Context) error {
-										return s.taskSegmentReplication(ctx, segmentID, nodeID, false)
+										return s.taskTopicCompaction(ctx, namespace, name)
 									}
-								}(segment.ID, segment.Nodes.DoneNodeIDs[rand.Intn(len(segment.Nodes.DoneNodeIDs))]), // select random node that is done
-								segment.ID,
+								}(namespace.Name, topic.Name),
+								name,
 							)
 						}
 					}
 				}
 			}
 
-			for segmentID, task := range runningClosedSegmentReplications {
-				if !replicatingClosedSegmentIDs[segmentID] {
-					log.Printf("stopping replication of closed segment %d", segmentID)
+			for name, task := range runningTopicCompactions {
+				if !compactedTopics[name] {
+					task.Cancel()
+				}
+			}
+
+			offloadedSegmentIDs := make(map[uint64]bool)
+
+			if isLeader {
+				now := time.Now()
+				for _, segment := range state.ClosedSegments {
+					if !s.segmentOffloadable(segment, now) {
+						continue
+					}
+
+					offloadedSegmentIDs[segment.ID] = true
+
+					if _, ok := runningSegmentOffloads[segment.ID]; !ok {
+						runningSegmentOffloads[segment.ID] = taskManager.Start(
+							fmt.Sprintf("offload of segment %d", segment.ID),
+							func(segmentID uint64) func(context.Context) error {
+								return func(ctx context.Context) error {
+									return s.taskSegmentOffload(ctx, segmentID)
+								}
+							}(segment.ID),
+							segment.ID,
+						)
+					}
+				}
+			}
+
+			for segmentID, task := range runningSegmentOffloads {
+				if !offloadedSegmentIDs[segmentID] {
 					task.Cancel()
 				}
 			}
@@ -189,6 +333,13 @@ LOOP:
 			case string:
 				if task, ok := runningConsumerGroups[data]; ok && completedTask.ID == task.ID {
 					delete(runningConsumerGroups, data)
+					state = nil // !!! force re-read of state, consumer group might have been restarted with rotated segment
+				}
+				if task, ok := runningTopicCompactions[data]; ok && completedTask.ID == task.ID {
+					delete(runningTopicCompactions, data)
+				}
+				if task := runningTieredStorageGarbageCollection; task != nil && completedTask.ID == task.ID {
+					runningTieredStorageGarbageCollection = nil
 				}
 			case uint64:
 				if task, ok := runningOpenSegmentReplications[data]; ok && completedTask.ID == task.ID {
@@ -197,6 +348,15 @@ LOOP:
 				if task, ok := runningClosedSegmentReplications[data]; ok && completedTask.ID == task.ID {
 					delete(runningClosedSegmentReplications, data)
 				}
+				if task, ok := runningOpenSegmentDrains[data]; ok && completedTask.ID == task.ID {
+					delete(runningOpenSegmentDrains, data)
+				}
+				if task, ok := runningSegmentIndexings[data]; ok && completedTask.ID == task.ID {
+					delete(runningSegmentIndexings, data)
+				}
+				if task, ok := runningSegmentOffloads[data]; ok && completedTask.ID == task.ID {
+					delete(runningSegmentOffloads, data)
+				}
 			}
 			if completedTask.Err != nil {
 				state = nil // !!! force re-read of state and possibly restart the task
@@ -207,9 +367,13 @@ LOOP:
 				continue
 			}
 
+			meta := decodeDiscoveryNodeMeta(ev.Node, s.logger)
 			cmd := &ClusterCommandNodeUpdate{
-				ID:      MustIDFromString(ev.Node.Name),
-				Address: ev.Node.Address(),
+				ID:        MustIDFromString(ev.Node.Name),
+				Address:   ev.Node.Address(),
+				Zone:      meta.Zone,
+				DiskTotal: meta.DiskTotal,
+				DiskFree:  meta.DiskFree,
 			}
 
 			if ev.Event == memberlist.NodeJoin || ev.Event == memberlist.NodeUpdate {
@@ -222,16 +386,16 @@ LOOP:
 
 			_, err := s.Apply(cmd)
 			if err != nil {
-				log.Printf("could not Apply update node by members event: %v", err)
+				s.logger.Error("could not apply update node by members event", logging.Error(err))
 				continue
 			}
 
-			log.Printf("updated node by members event: %s", cmd.String())
+			s.logger.Info("updated node by members event", logging.F("command", cmd.String()))
 
 			if cmd.State == ClusterNode_ALIVE {
 				future := s.raftNode.AddVoter(raft.ServerID(ev.Node.Name), raft.ServerAddress(cmd.Address), 0, 10*time.Second)
 				if err := future.Error(); err != nil {
-					log.Printf("could not add peer: %v", err)
+					s.logger.Error("could not add peer", logging.F("peer", ev.Node.Name), logging.Error(err))
 					continue
 				}
 			}
@@ -239,8 +403,10 @@ LOOP:
 		case <-garbageCollectionTicker.C:
 			state := s.clusterState.Current()
 
+			s.forgetOffloadedSegments(state)
+
 			active := make(map[uint64]bool)
-			for _, segments := range [][]*ClusterSegment{state.OpenSegments, state.ClosedSegments} {
+			for _, segments := range [][]*ClusterSegment{state.OpenSegments, state.ClosedSegments, state.ReplacingSegments} {
 			SEGMENT:
 				for _, segment := range segments {
 					if segment.Nodes.PrimaryNodeID == s.nodeID {
@@ -264,7 +430,7 @@ LOOP:
 
 			segmentInfos, err := s.segmentDir.List()
 			if err != nil {
-				log.Printf("could not list local segments: %v", err)
+				s.logger.Error("could not list local segments", logging.Error(err))
 				continue
 			}
 
@@ -274,23 +440,81 @@ LOOP:
 				}
 
 				if segmentInfo.ReferenceCount > 0 {
-					log.Printf("segment %d cannot be garbage collected, someone is still using it", segmentInfo.ID)
+					s.logger.Warn("segment cannot be garbage collected, someone is still using it", logging.SegmentID(segmentInfo.ID))
+					continue
+				}
+
+				if segment := state.GetClosedSegment(segmentInfo.ID); segment != nil && !segment.OffloadedAt.IsZero() {
+					// local copy of offloaded segment fetched from tiered storage is kept while it's being used
+					if removed, err := s.removeOffloadedSegmentCopy(segmentInfo.ID); err != nil {
+						s.logger.Error("remove of offloaded segment copy failed", logging.SegmentID(segmentInfo.ID), logging.Error(err))
+					} else if removed {
+						s.logger.Info("offloaded segment copy garbage collected", logging.SegmentID(segmentInfo.ID))
+					}
 					continue
 				}
 
 				if err := s.segmentDir.Remove(segmentInfo.ID); err != nil {
-					log.Printf("remove of segment %d failed: %v", segmentInfo.ID, err)
+					s.logger.Error("remove of segment failed", logging.SegmentID(segmentInfo.ID), logging.Error(err))
 				} else {
-					log.Printf("segment %d garbage collected", segmentInfo.ID)
+					s.logger.Info("segment garbage collected", logging.SegmentID(segmentInfo.ID))
 				}
 			}
 
+		case <-tieredStorageGarbageCollectionTicker.C:
+			if isLeader && s.tieredStorage != nil && runningTieredStorageGarbageCollection == nil {
+				runningTieredStorageGarbageCollection = taskManager.Start(
+					"tiered storage garbage collection",
+					s.taskTieredStorageGarbageCollection,
+					"tiered storage garbage collection",
+				)
+			}
+
+		case <-diskUsageTicker.C:
+			s.updateDiskUsage()
+
 		case <-s.closed:
 			break LOOP
 		}
 	}
 }
 
+// Reads usage of segments volume & if it changed significantly, propagates it to other members of the cluster.
+func (s *Server) updateDiskUsage() {
+	total, free, err := s.segmentDir.Usage()
+	if err != nil {
+		s.logger.Error("could not get disk usage", logging.Error(err))
+		return
+	}
+
+	atomic.StoreUint64(&s.diskTotal, total)
+	atomic.StoreUint64(&s.diskFree, free)
+
+	if s.delegate == nil || s.members == nil {
+		return
+	}
+
+	meta := s.delegate.Meta()
+	// propagate only changes of at least 1 % of the volume capacity, so that cluster state isn't updated all the time
+	diff := int64(meta.DiskFree) - int64(free)
+	if diff < 0 {
+		diff = -diff
+	}
+	if meta.DiskTotal == total && uint64(diff) < total/100 {
+		return
+	}
+
+	meta.DiskTotal = total
+	meta.DiskFree = free
+	if err := s.delegate.SetMeta(&meta); err != nil {
+		s.logger.Error("could not set node meta", logging.Error(err))
+		return
+	}
+	if err := s.members.UpdateNode(10 * time.Second); err != nil {
+		s.logger.Error("could not propagate node meta", logging.Error(err))
+	}
+}
+
 func (s *Server) Members() []*memberlist.Node {
 	if s.members == nil {
 		return nil
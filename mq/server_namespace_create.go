@@ -37,7 +37,10 @@ func (s *Server) CreateNamespace(ctx context.Context, request *emq.NamespaceCrea
 	}
 	defer s.releaseTransaction()
 
-	index, err := s.Apply(&ClusterCommandNamespaceCreate{Namespace: request.Namespace})
+	index, err := s.Apply(&ClusterCommandNamespaceCreate{
+		Namespace:      request.Namespace,
+		RetentionBytes: request.RetentionBytes,
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "apply failed")
 	}
@@ -0,0 +1,43 @@
+package mq
+
+import (
+	"context"
+
+	"eventter.io/mq/emq"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) ListNamespaces(ctx context.Context, request *emq.NamespaceListRequest) (*emq.NamespaceListResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return emq.NewEventterMQClient(conn).ListNamespaces(ctx, request)
+	}
+
+	state := s.clusterState.Current()
+
+	var namespaces []string
+	for _, namespace := range state.Namespaces {
+		namespaces = append(namespaces, namespace.Name)
+	}
+
+	return &emq.NamespaceListResponse{
+		OK:         true,
+		Index:      state.Index,
+		Namespaces: namespaces,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package mq
+
+import (
+	"context"
+	"testing"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ListNamespaces(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateNamespace(ctx, &emq.NamespaceCreateRequest{Namespace: "test-list-namespace"})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.ListNamespaces(ctx, &emq.NamespaceListRequest{})
+		assert.NoError(err)
+		assert.True(response.OK)
+		assert.Equal([]string{emq.DefaultNamespace, "test-list-namespace"}, response.Namespaces)
+	}
+}
@@ -0,0 +1,68 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) NodeDrain(ctx context.Context, request *NodeDrainRequest) (*NodeDrainResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return NewNodeRPCClient(conn).NodeDrain(ctx, request)
+	}
+
+	if err := s.beginTransaction(); err != nil {
+		return nil, errors.Wrap(err, "tx begin failed")
+	}
+	defer s.releaseTransaction()
+
+	state := s.clusterState.Current()
+
+	node := state.GetNode(request.NodeID)
+	if node == nil {
+		return nil, errors.Errorf("node %d not found", request.NodeID)
+	}
+
+	adminState := ClusterNode_DRAINING
+	if request.Cancel {
+		// open segments already closed by draining (see taskSegmentDrain) aren't reopened
+		adminState = ClusterNode_ACTIVE
+	}
+
+	if node.AdminState != adminState {
+		_, err := s.Apply(&ClusterCommandNodeAdminStateUpdate{
+			ID:         request.NodeID,
+			AdminState: adminState,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "apply failed")
+		}
+		state = s.clusterState.Current()
+	}
+
+	openSegments, closedSegments := state.CountSegmentsIn(request.NodeID)
+
+	return &NodeDrainResponse{
+		NodeID:         request.NodeID,
+		AdminState:     adminState,
+		OpenSegments:   uint32(openSegments),
+		ClosedSegments: uint32(closedSegments),
+		SafeToRemove:   adminState == ClusterNode_DRAINING && openSegments == 0 && closedSegments == 0,
+	}, nil
+}
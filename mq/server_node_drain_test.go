@@ -0,0 +1,101 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_NodeDrain(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-node-drain",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              1,
+				ReplicationFactor:   1,
+				Retention:           time.Hour,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-node-drain",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.NodeDrain(ctx, &NodeDrainRequest{NodeID: ts.Server.nodeID})
+		assert.NoError(err)
+		assert.Equal(ClusterNode_DRAINING, response.AdminState)
+		assert.False(response.SafeToRemove)
+
+		node := ts.ClusterStateStore.Current().GetNode(ts.Server.nodeID)
+		assert.Equal(ClusterNode_DRAINING, node.AdminState)
+	}
+
+	{
+		// open segment gets closed, however, there is no other node to move closed segment to
+		for len(ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-node-drain")) > 0 {
+			select {
+			case <-ctx.Done():
+				t.Fatal(ctx.Err())
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+
+		response, err := ts.Server.NodeDrain(ctx, &NodeDrainRequest{NodeID: ts.Server.nodeID})
+		assert.NoError(err)
+		assert.Equal(uint32(0), response.OpenSegments)
+		assert.Equal(uint32(1), response.ClosedSegments)
+		assert.False(response.SafeToRemove)
+	}
+
+	{
+		_, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-node-drain",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.Error(err)
+	}
+
+	{
+		response, err := ts.Server.NodeDrain(ctx, &NodeDrainRequest{NodeID: ts.Server.nodeID, Cancel: true})
+		assert.NoError(err)
+		assert.Equal(ClusterNode_ACTIVE, response.AdminState)
+		assert.False(response.SafeToRemove)
+
+		node := ts.ClusterStateStore.Current().GetNode(ts.Server.nodeID)
+		assert.Equal(ClusterNode_ACTIVE, node.AdminState)
+	}
+
+	{
+		_, err := ts.Server.NodeDrain(ctx, &NodeDrainRequest{NodeID: ts.Server.nodeID + 1})
+		assert.Error(err)
+	}
+}
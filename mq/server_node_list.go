@@ -0,0 +1,53 @@
+package mq
+
+import (
+	"context"
+
+	"eventter.io/mq/emq"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) ListNodes(ctx context.Context, request *emq.NodeListRequest) (*emq.NodeListResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return emq.NewEventterMQClient(conn).ListNodes(ctx, request)
+	}
+
+	state := s.clusterState.Current()
+
+	var nodes []*emq.Node
+	for _, node := range state.Nodes {
+		nodes = append(nodes, &emq.Node{
+			ID:            node.ID,
+			Address:       node.Address,
+			Zone:          node.Zone,
+			Alive:         node.State == ClusterNode_ALIVE,
+			Draining:      node.AdminState == ClusterNode_DRAINING,
+			Leader:        node.ID == s.nodeID,
+			LastSeenAlive: node.LastSeenAlive,
+			DiskTotal:     node.DiskTotal,
+			DiskFree:      node.DiskFree,
+		})
+	}
+
+	return &emq.NodeListResponse{
+		OK:    true,
+		Index: state.Index,
+		Nodes: nodes,
+	}, nil
+}
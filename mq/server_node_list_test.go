@@ -0,0 +1,29 @@
+package mq
+
+import (
+	"context"
+	"testing"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ListNodes(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	response, err := ts.Server.ListNodes(ctx, &emq.NodeListRequest{})
+	assert.NoError(err)
+	assert.True(response.OK)
+	assert.Len(response.Nodes, 1)
+	assert.Equal(ts.Server.nodeID, response.Nodes[0].ID)
+	assert.True(response.Nodes[0].Alive)
+	assert.True(response.Nodes[0].Leader)
+	assert.False(response.Nodes[0].Draining)
+}
@@ -2,10 +2,10 @@ package mq
 
 import (
 	"context"
-	"log"
 	"math/rand"
 	"time"
 
+	"eventter.io/mq/logging"
 	"github.com/hashicorp/raft"
 	"github.com/pkg/errors"
 )
@@ -35,15 +35,34 @@ func (s *Server) SegmentOpen(ctx context.Context, request *SegmentOpenRequest) (
 	}
 	defer s.releaseTransaction()
 
-	return s.txSegmentOpen(s.clusterState.Current(), request.NodeID, request.Type, request.OwnerNamespace, request.OwnerName)
+	return s.txSegmentOpen(s.clusterState.Current(), request.NodeID, request.Type, request.OwnerNamespace, request.OwnerName, request.Shard)
 }
 
-func (s *Server) txSegmentOpen(state *ClusterState, primaryNodeID uint64, segmentType ClusterSegment_Type, ownerNamespace string, ownerName string) (*SegmentOpenResponse, error) {
+func (s *Server) txSegmentOpen(state *ClusterState, primaryNodeID uint64, segmentType ClusterSegment_Type, ownerNamespace string, ownerName string, shard uint32) (*SegmentOpenResponse, error) {
 	node := state.GetNode(primaryNodeID)
 	if node == nil {
 		return nil, errors.Errorf("node %d not found", primaryNodeID)
 	}
 
+	// draining or full node should not become primary for new segments => move them to other node
+	if node.AdminState == ClusterNode_DRAINING || node.IsDiskFull(s.config.DiskHighWatermark) {
+		nodeSegmentCounts := state.CountSegmentsPerNode()
+		var candidate *ClusterNode
+		for _, candidateNode := range state.Nodes {
+			if candidateNode.IsActive() && !candidateNode.IsDiskFull(s.config.DiskHighWatermark) &&
+				(candidate == nil || nodeSegmentCounts[candidateNode.ID] < nodeSegmentCounts[candidate.ID]) {
+				candidate = candidateNode
+			}
+		}
+		if candidate != nil {
+			node = candidate
+		} else if node.AdminState == ClusterNode_DRAINING {
+			return nil, errors.Errorf("node %d is draining & there is no other active node", primaryNodeID)
+		}
+		// full node stays primary if there is no other node to choose from
+		primaryNodeID = node.ID
+	}
+
 	var (
 		shards            uint32 = 1
 		replicationFactor uint32 = defaultReplicationFactor
@@ -66,25 +85,42 @@ func (s *Server) txSegmentOpen(state *ClusterState, primaryNodeID uint64, segmen
 
 	openSegments := state.FindOpenSegmentsFor(segmentType, ownerNamespace, ownerName)
 
-	// return node's existing segment if it exists
-	for _, segment := range openSegments {
-		if segment.Nodes.PrimaryNodeID == primaryNodeID {
+	if shard > 0 {
+		if shard > shards {
+			return nil, errors.Errorf("shard %d out of range, %s %s/%s has %d shards", shard, entityTopic, ownerNamespace, ownerName, shards)
+		}
+
+		// partitioned topic => return shard's existing segment, regardless of node it's on
+		for _, segment := range openSegments {
+			if segment.Shard == shard {
+				return &SegmentOpenResponse{
+					SegmentID:     segment.ID,
+					PrimaryNodeID: segment.Nodes.PrimaryNodeID,
+				}, nil
+			}
+		}
+
+	} else {
+		// return node's existing segment if it exists
+		for _, segment := range openSegments {
+			if segment.Nodes.PrimaryNodeID == primaryNodeID {
+				return &SegmentOpenResponse{
+					SegmentID:     segment.ID,
+					PrimaryNodeID: primaryNodeID,
+				}, nil
+			}
+		}
+
+		// return random segment from another node if there would be more shards than configured
+		if shards > 0 && uint32(len(openSegments)) >= shards {
+			segment := openSegments[rand.Intn(len(openSegments))]
 			return &SegmentOpenResponse{
 				SegmentID:     segment.ID,
-				PrimaryNodeID: primaryNodeID,
+				PrimaryNodeID: segment.Nodes.PrimaryNodeID,
 			}, nil
 		}
 	}
 
-	// return random segment from another node if there would be more shards than configured
-	if shards > 0 && uint32(len(openSegments)) >= shards {
-		segment := openSegments[rand.Intn(len(openSegments))]
-		return &SegmentOpenResponse{
-			SegmentID:     segment.ID,
-			PrimaryNodeID: segment.Nodes.PrimaryNodeID,
-		}, nil
-	}
-
 	// open new segment
 
 	// a) find latest generation
@@ -106,39 +142,49 @@ func (s *Server) txSegmentOpen(state *ClusterState, primaryNodeID uint64, segmen
 	}
 
 	// b) find first available shard in generation
-	shard := uint32(1)
-	if shards > 0 && uint32(len(generationSegments)) >= shards {
-		// whole previous generation is assigned => start a new one
-		generation++
-	} else {
-		nodeFound := false
+	if shard > 0 {
+		// partitioned topic => each shard must have at most one segment in each generation
 		for _, segment := range generationSegments {
-			if segment.Nodes.PrimaryNodeID == primaryNodeID {
-				nodeFound = true
+			if segment.Shard == shard {
+				generation++
 				break
 			}
-			for _, nodeID := range segment.Nodes.DoneNodeIDs {
-				if nodeID == primaryNodeID {
-					nodeFound = true
-					break
-				}
-			}
 		}
-		if nodeFound {
-			// each node must have at most one segment in each generation => start a new one
+	} else {
+		shard = 1
+		if shards > 0 && uint32(len(generationSegments)) >= shards {
+			// whole previous generation is assigned => start a new one
 			generation++
 		} else {
-			for i := uint32(1); i <= shards; i++ {
-				found := false
-				for _, segment := range generationSegments {
-					if segment.Shard == i {
-						found = true
+			nodeFound := false
+			for _, segment := range generationSegments {
+				if segment.Nodes.PrimaryNodeID == primaryNodeID {
+					nodeFound = true
+					break
+				}
+				for _, nodeID := range segment.Nodes.DoneNodeIDs {
+					if nodeID == primaryNodeID {
+						nodeFound = true
 						break
 					}
 				}
-				if !found {
-					shard = i
-					break
+			}
+			if nodeFound {
+				// each node must have at most one segment in each generation => start a new one
+				generation++
+			} else {
+				for i := uint32(1); i <= shards; i++ {
+					found := false
+					for _, segment := range generationSegments {
+						if segment.Shard == i {
+							found = true
+							break
+						}
+					}
+					if !found {
+						shard = i
+						break
+					}
 				}
 			}
 		}
@@ -148,26 +194,23 @@ func (s *Server) txSegmentOpen(state *ClusterState, primaryNodeID uint64, segmen
 	var replicatingNodeIDs []uint64
 	if replicationFactor > 1 {
 		nodeSegmentCounts := state.CountSegmentsPerNode()
+		nodeMap := make(map[uint64]*ClusterNode)
 		var candidateNodeIDs []uint64
 
 		for _, node := range state.Nodes {
-			if node.ID != primaryNodeID && node.State == ClusterNode_ALIVE {
+			nodeMap[node.ID] = node
+			if node.ID != primaryNodeID && node.IsActive() && !node.IsDiskFull(s.config.DiskHighWatermark) {
 				candidateNodeIDs = append(candidateNodeIDs, node.ID)
 			}
 		}
 
-		for len(candidateNodeIDs) > 0 && uint32(len(replicatingNodeIDs)) < replicationFactor-1 {
-			var candidateIndex = -1
-			for i, candidateNodeID := range candidateNodeIDs {
-				if candidateIndex == -1 || nodeSegmentCounts[candidateNodeID] < nodeSegmentCounts[candidateNodeIDs[candidateIndex]] {
-					candidateIndex = i
-				}
-			}
-
-			replicatingNodeIDs = append(replicatingNodeIDs, candidateNodeIDs[candidateIndex])
-			copy(candidateNodeIDs[candidateIndex:], candidateNodeIDs[candidateIndex+1:])
-			candidateNodeIDs = candidateNodeIDs[:len(candidateNodeIDs)-1]
-		}
+		replicatingNodeIDs = selectNodes(
+			candidateNodeIDs,
+			int(replicationFactor-1),
+			[]uint64{primaryNodeID},
+			nodeMap,
+			nodeSegmentCounts,
+		)
 	}
 
 	// d) create segment
@@ -217,12 +260,12 @@ func (s *Server) txSegmentOpen(state *ClusterState, primaryNodeID uint64, segmen
 				OffsetCommits: nextCommits,
 			}
 			if _, err := s.Apply(cmd); err != nil {
-				log.Printf(
-					"could not update offset commits of consumer group %s/%s for newly created segment %d: %v",
-					namespace.Name,
-					consumerGroup.Name,
-					segmentID,
-					err,
+				s.logger.Error(
+					"could not update offset commits of consumer group for newly created segment",
+					logging.Namespace(namespace.Name),
+					logging.ConsumerGroup(consumerGroup.Name),
+					logging.SegmentID(segmentID),
+					logging.Error(err),
 				)
 			}
 		}
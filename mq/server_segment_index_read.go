@@ -0,0 +1,87 @@
+package mq
+
+import (
+	"context"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) SegmentIndexRead(ctx context.Context, request *SegmentIndexReadRequest) (*SegmentIndexReadResponse, error) {
+	data, err := s.segmentDir.ReadIndex(request.SegmentID)
+	if os.IsNotExist(err) {
+		return &SegmentIndexReadResponse{}, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "index read failed")
+	}
+
+	return &SegmentIndexReadResponse{
+		Found: true,
+		Data:  data,
+	}, nil
+}
+
+// Returns index of closed topic segment, either from local segment directory, or from alive node holding the segment.
+// Nil is returned if the index has not been built yet, or the segment was offloaded to tiered storage (indexes aren't).
+func (s *Server) segmentIndex(ctx context.Context, state *ClusterState, segment *ClusterSegment) (*SegmentIndex, error) {
+	if segment.ClosedAt.IsZero() || !segment.OffloadedAt.IsZero() {
+		return nil, nil
+	}
+
+	var data []byte
+	if s.holdsSegment(segment) {
+		var err error
+		data, err = s.segmentDir.ReadIndex(segment.ID)
+		if os.IsNotExist(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, errors.Wrap(err, "index read failed")
+		}
+
+	} else {
+		nodes := segmentReadNodes(state, segment)
+		if len(nodes) == 0 {
+			return nil, errors.Errorf("segment %d has no alive node to read from", segment.ID)
+		}
+
+		var err error
+		for _, node := range nodes {
+			if data, err = s.fetchSegmentIndex(ctx, node, segment.ID); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, err
+		} else if data == nil {
+			return nil, nil
+		}
+	}
+
+	index := &SegmentIndex{}
+	if err := proto.Unmarshal(data, index); err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+	return index, nil
+}
+
+func (s *Server) fetchSegmentIndex(ctx context.Context, node *ClusterNode, segmentID uint64) ([]byte, error) {
+	cc, err := s.pool.Get(ctx, node.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial failed")
+	}
+	defer s.pool.Put(cc)
+
+	response, err := NewNodeRPCClient(cc).SegmentIndexRead(ctx, &SegmentIndexReadRequest{SegmentID: segmentID})
+	if err != nil {
+		return nil, errors.Wrap(err, "index read failed")
+	}
+	if !response.Found {
+		return nil, nil
+	}
+
+	if err := proto.Unmarshal(response.Data, &SegmentIndex{}); err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+	return response.Data, nil
+}
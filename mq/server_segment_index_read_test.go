@@ -0,0 +1,84 @@
+package mq
+
+import (
+	"context"
+	"testing"
+
+	"eventter.io/mq/emq"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_SegmentIndexRead(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-index-topic",
+				DefaultExchangeType: emq.ExchangeTypeTopic,
+				IndexedHeaders:      []string{"region"},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-index-topic",
+			Message: &emq.Message{
+				RoutingKey: "orders.created",
+				Data:       []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	openSegments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-index-topic")
+	assert.Len(openSegments, 1)
+	segmentID := openSegments[0].ID
+
+	{
+		response, err := ts.Server.SegmentIndexRead(ctx, &SegmentIndexReadRequest{SegmentID: segmentID})
+		assert.NoError(err)
+		assert.False(response.Found)
+	}
+
+	ts.CloseSegment(t, ctx, segmentID)
+
+	var response *SegmentIndexReadResponse
+	ts.WaitFor(t, func() bool {
+		response, err = ts.Server.SegmentIndexRead(ctx, &SegmentIndexReadRequest{SegmentID: segmentID})
+		assert.NoError(err)
+		return response.Found
+	})
+
+	index := &SegmentIndex{}
+	assert.NoError(proto.Unmarshal(response.Data, index))
+	assert.True(index.RoutingKeysIndexed)
+	assert.Equal([]string{"orders.created"}, index.RoutingKeys)
+	assert.Len(index.Headers, 1)
+	assert.Equal("region", index.Headers[0].Name)
+
+	{
+		stream := &searchTopicStream{ctx: ctx}
+		err := ts.Server.SearchTopic(&emq.TopicSearchRequest{
+			Namespace:  "default",
+			Name:       "test-index-topic",
+			RoutingKey: "orders.*",
+		}, stream)
+		assert.NoError(err)
+		assert.Len(stream.responses, 1)
+	}
+}
@@ -3,12 +3,22 @@ package mq
 import (
 	"context"
 	"io"
+	"math"
+	"math/rand"
 
+	"eventter.io/mq/logging"
 	"eventter.io/mq/segments"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 )
 
 func (s *Server) SegmentRead(request *SegmentReadRequest, stream NodeRPC_SegmentReadServer) error {
+	if segment := s.clusterState.Current().GetClosedSegment(request.SegmentID); segment != nil && !segment.OffloadedAt.IsZero() {
+		if err := s.fetchOffloadedSegment(stream.Context(), segment); err != nil {
+			return err
+		}
+	}
+
 	if !s.segmentDir.Exists(request.SegmentID) {
 		return errors.Errorf("segment %d does not exist", request.SegmentID)
 	}
@@ -58,3 +68,82 @@ func (s *Server) SegmentRead(request *SegmentReadRequest, stream NodeRPC_Segment
 		}
 	}
 }
+
+// Returns alive nodes segment can be read from - primary of open segment, or nodes holding copy of closed segment in
+// random order, so that reads are spread among replicas.
+func segmentReadNodes(state *ClusterState, segment *ClusterSegment) []*ClusterNode {
+	var nodeIDs []uint64
+	if segment.ClosedAt.IsZero() {
+		nodeIDs = []uint64{segment.Nodes.PrimaryNodeID}
+	} else {
+		nodeIDs = segment.Nodes.DoneNodeIDs
+	}
+
+	var nodes []*ClusterNode
+	for _, i := range rand.Perm(len(nodeIDs)) {
+		if node := state.GetNode(nodeIDs[i]); node != nil && node.State == ClusterNode_ALIVE {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// Reads segment from other nodes. If reading from a node fails, it's continued from the last received message on the
+// next node. Errors returned by fn aren't retried.
+func (s *Server) readRemoteSegment(ctx context.Context, nodes []*ClusterNode, segmentID uint64, fn func(response *SegmentReadResponse) error) error {
+	if len(nodes) == 0 {
+		return errors.Errorf("segment %d has no alive node to read from", segmentID)
+	}
+
+	var (
+		offset int64
+		err    error
+		fnErr  error
+	)
+	for _, node := range nodes {
+		err = s.readRemoteSegmentFrom(ctx, node, segmentID, offset, func(response *SegmentReadResponse) error {
+			if fnErr = fn(response); fnErr != nil {
+				return fnErr
+			}
+			offset = response.CommitOffset
+			return nil
+		})
+		if err == nil || fnErr != nil || ctx.Err() != nil {
+			return err
+		}
+		s.logger.Warn("segment read from node failed", logging.SegmentID(segmentID), logging.NodeID(node.ID), logging.Error(err))
+	}
+	return err
+}
+
+func (s *Server) readRemoteSegmentFrom(ctx context.Context, node *ClusterNode, segmentID uint64, offset int64, fn func(response *SegmentReadResponse) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cc, err := s.pool.Get(ctx, node.Address)
+	if err != nil {
+		return errors.Wrap(err, "dial failed")
+	}
+	defer s.pool.Put(cc)
+
+	stream, err := NewNodeRPCClient(cc).SegmentRead(ctx, &SegmentReadRequest{
+		SegmentID: segmentID,
+		Offset:    offset,
+	}, grpc.MaxCallRecvMsgSize(math.MaxUint32))
+	if err != nil {
+		return errors.Wrap(err, "segment read failed")
+	}
+
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "receive failed")
+		}
+
+		if err := fn(response); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) SegmentRebalanceStatus(ctx context.Context, request *SegmentRebalanceStatusRequest) (*SegmentRebalanceStatusResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return NewNodeRPCClient(conn).SegmentRebalanceStatus(ctx, request)
+	}
+
+	return s.reconciler.SegmentRebalanceStatus(s.clusterState.Current()), nil
+}
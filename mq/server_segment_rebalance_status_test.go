@@ -0,0 +1,28 @@
+package mq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_SegmentRebalanceStatus(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.SegmentRebalanceStatus(ctx, &SegmentRebalanceStatusRequest{})
+		assert.NoError(err)
+		assert.Empty(response.Moves)
+		assert.Len(response.Nodes, 1)
+		assert.Equal(ts.Server.nodeID, response.Nodes[0].NodeID)
+		assert.Equal(uint32(0), response.Nodes[0].Segments)
+	}
+}
@@ -35,7 +35,12 @@ func (s *Server) SegmentReplicaClose(ctx context.Context, request *SegmentCloseR
 	defer s.releaseTransaction()
 
 	state := s.clusterState.Current()
+	which := ClusterCommandSegmentNodesUpdate_CLOSED
 	segment := state.GetClosedSegment(request.SegmentID)
+	if segment == nil {
+		which = ClusterCommandSegmentNodesUpdate_REPLACING
+		segment = state.GetReplacingSegment(request.SegmentID)
+	}
 	if segment == nil {
 		return nil, errors.Errorf("segment %d not found", request.SegmentID)
 	}
@@ -52,7 +57,7 @@ func (s *Server) SegmentReplicaClose(ctx context.Context, request *SegmentCloseR
 
 	cmd := &ClusterCommandSegmentNodesUpdate{
 		ID:    segment.ID,
-		Which: ClusterCommandSegmentNodesUpdate_CLOSED,
+		Which: which,
 	}
 
 	cmd.Nodes.ReplicatingNodeIDs = make([]uint64, 0, len(segment.Nodes.ReplicatingNodeIDs)-1)
@@ -33,7 +33,8 @@ func (s *Server) SegmentRotate(ctx context.Context, request *SegmentCloseRequest
 	defer s.releaseTransaction()
 
 	if request.OffsetCommitsUpdate != nil {
-		if _, err := s.Apply(request.OffsetCommitsUpdate); err != nil {
+		update := rebaseOffsetCommitsUpdate(s.clusterState.Current(), request.OffsetCommitsUpdate)
+		if _, err := s.Apply(update); err != nil {
 			return nil, errors.Wrap(err, "offset commit failed")
 		}
 	}
@@ -54,5 +55,45 @@ func (s *Server) SegmentRotate(ctx context.Context, request *SegmentCloseRequest
 		return nil, errors.New("segment deleted in between")
 	}
 
-	return s.txSegmentOpen(state, request.NodeID, oldSegment.Type, oldSegment.OwnerNamespace, oldSegment.OwnerName)
+	var shard uint32
+	if oldSegment.Type == ClusterSegment_TOPIC {
+		if topic := state.GetTopic(oldSegment.OwnerNamespace, oldSegment.OwnerName); topic != nil && shardRouted(topic) {
+			// shard-routed topic => keep messages with the same key in the same shard
+			shard = oldSegment.Shard
+		}
+	}
+
+	return s.txSegmentOpen(state, request.NodeID, oldSegment.Type, oldSegment.OwnerNamespace, oldSegment.OwnerName, shard)
+}
+
+// Consumer group sends offsets of segments it knew about when it rotated its offset commits segment. Segments might
+// have been added, or replaced (e.g. by compaction) in between => keep segments from cluster state, update only their
+// offsets.
+func rebaseOffsetCommitsUpdate(state *ClusterState, update *ClusterCommandConsumerGroupOffsetCommitsUpdate) *ClusterCommandConsumerGroupOffsetCommitsUpdate {
+	consumerGroup := state.GetConsumerGroup(update.Namespace, update.Name)
+	if consumerGroup == nil || update.Imported {
+		return update
+	}
+
+	offsets := make(map[uint64]int64)
+	for _, commit := range update.OffsetCommits {
+		offsets[commit.SegmentID] = commit.Offset
+	}
+
+	commits := make([]*ClusterConsumerGroup_OffsetCommit, 0, len(consumerGroup.OffsetCommits))
+	for _, commit := range consumerGroup.OffsetCommits {
+		if offset, ok := offsets[commit.SegmentID]; ok {
+			commit = &ClusterConsumerGroup_OffsetCommit{
+				SegmentID: commit.SegmentID,
+				Offset:    offset,
+			}
+		}
+		commits = append(commits, commit)
+	}
+
+	return &ClusterCommandConsumerGroupOffsetCommitsUpdate{
+		Namespace:     update.Namespace,
+		Name:          update.Name,
+		OffsetCommits: commits,
+	}
 }
@@ -2,6 +2,7 @@ package mq
 
 import (
 	"context"
+	"crypto/sha1"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -12,7 +13,9 @@ import (
 	"time"
 
 	"eventter.io/mq/emq"
+	"eventter.io/mq/logging"
 	"eventter.io/mq/segments"
+	"eventter.io/mq/tiered"
 	"github.com/hashicorp/memberlist"
 	"github.com/hashicorp/raft"
 	"github.com/pkg/errors"
@@ -29,7 +32,12 @@ type testServer struct {
 	*Server
 }
 
-func newTestServer(nodeID uint64) (ret *testServer, err error) {
+func newTestServer(nodeID uint64) (*testServer, error) {
+	return newTieredTestServer(nodeID, nil, 0)
+}
+
+// Creates test server offloading closed segments older than tieredStorageAge to tieredStorage.
+func newTieredTestServer(nodeID uint64, tieredStorage tiered.Storage, tieredStorageAge time.Duration) (ret *testServer, err error) {
 	for nodeID == 0 {
 		nodeID = rand.Uint64()
 	}
@@ -119,7 +127,7 @@ func newTestServer(nodeID uint64) (ret *testServer, err error) {
 	}
 
 	{ // server
-		ts.Server = NewServer(nodeID, ts.Memberlist, ts.Raft, NewClientConnPool(1*time.Second), ts.ClusterStateStore, ts.Dir)
+		ts.Server = NewServer(&Config{ID: nodeID, TieredStorageAge: tieredStorageAge}, logging.New(os.Stderr, logging.InfoLevel, logging.TextFormat), nil, ts.Memberlist, nil, ts.Raft, NewClientConnPool(1*time.Second), ts.ClusterStateStore, ts.Dir, tieredStorage)
 		go ts.Server.Loop(ts.MemberlistNodeEvents)
 	}
 
@@ -188,6 +196,38 @@ func (ts *testServer) WaitForMessage(t *testing.T, ctx context.Context, namespac
 	assert.NoError(err)
 }
 
+// Seals open segment stored on test server & closes it as if the node rotated it.
+func (ts *testServer) CloseSegment(t *testing.T, ctx context.Context, segmentID uint64) {
+	assert := require.New(t)
+
+	segmentHandle, err := ts.Server.segmentDir.Open(segmentID)
+	assert.NoError(err)
+	segmentHandle.Seal()
+	sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+	assert.NoError(err)
+	assert.NoError(ts.Server.segmentDir.Release(segmentHandle))
+
+	_, err = ts.Server.SegmentClose(ctx, &SegmentCloseRequest{
+		NodeID:    ts.Server.nodeID,
+		SegmentID: segmentID,
+		Size_:     size,
+		Sha1:      sha1Sum,
+	})
+	assert.NoError(err)
+}
+
+// Polls condition until it's true, fails the test if it doesn't become true in time.
+func (ts *testServer) WaitFor(t *testing.T, condition func() bool) {
+	assert := require.New(t)
+
+	for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return
+		}
+	}
+	assert.True(condition())
+}
+
 func (ts *testServer) Close() error {
 	ts.Server.Close()
 	ts.Dir.Close()
@@ -65,6 +65,12 @@ func (s *Server) CreateTopic(ctx context.Context, request *emq.TopicCreateReques
 			Shards:              request.Topic.Shards,
 			ReplicationFactor:   request.Topic.ReplicationFactor,
 			Retention:           request.Topic.Retention,
+			IndexedHeaders:      request.Topic.IndexedHeaders,
+			PartitionKey:        request.Topic.PartitionKey,
+			DeduplicationWindow: request.Topic.DeduplicationWindow,
+			CompactionKey:       request.Topic.CompactionKey,
+			RetentionBytes:      request.Topic.RetentionBytes,
+			ForceRetentionBytes: request.Topic.ForceRetentionBytes,
 		},
 	}
 
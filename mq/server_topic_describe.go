@@ -0,0 +1,148 @@
+package mq
+
+import (
+	"context"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) DescribeTopic(ctx context.Context, request *emq.TopicDescribeRequest) (*emq.TopicDescribeResponse, error) {
+	if s.raftNode.State() != raft.Leader {
+		if request.LeaderOnly {
+			return nil, errNotALeader
+		}
+		leader := s.raftNode.Leader()
+		if leader == "" {
+			return nil, errNoLeaderElected
+		}
+
+		conn, err := s.pool.Get(ctx, string(leader))
+		if err != nil {
+			return nil, errors.Wrap(err, couldNotDialLeaderError)
+		}
+		defer s.pool.Put(conn)
+
+		request.LeaderOnly = true
+		return emq.NewEventterMQClient(conn).DescribeTopic(ctx, request)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	state := s.clusterState.Current()
+
+	namespace, _ := state.FindNamespace(request.Namespace)
+	if namespace == nil {
+		return nil, errors.Errorf(namespaceNotFoundErrorFormat, request.Namespace)
+	}
+
+	topic, _ := namespace.FindTopic(request.Name)
+	if topic == nil {
+		return nil, errors.Errorf(notFoundErrorFormat, entityTopic, request.Namespace, request.Name)
+	}
+
+	response := &emq.TopicDescribeResponse{
+		OK:    true,
+		Index: state.Index,
+		Topic: &emq.Topic{
+			Namespace:           namespace.Name,
+			Name:                topic.Name,
+			DefaultExchangeType: topic.DefaultExchangeType,
+			Shards:              topic.Shards,
+			ReplicationFactor:   topic.ReplicationFactor,
+			Retention:           topic.Retention,
+			IndexedHeaders:      topic.IndexedHeaders,
+			PartitionKey:        topic.PartitionKey,
+			DeduplicationWindow: topic.DeduplicationWindow,
+			CompactionKey:       topic.CompactionKey,
+			RetentionBytes:      topic.RetentionBytes,
+			ForceRetentionBytes: topic.ForceRetentionBytes,
+		},
+	}
+
+	var oldest, newest time.Time
+
+	for _, segment := range state.OpenSegments {
+		if segment.Type != ClusterSegment_TOPIC || segment.OwnerNamespace != namespace.Name || segment.OwnerName != topic.Name {
+			continue
+		}
+
+		var size int64
+		if node := state.GetNode(segment.Nodes.PrimaryNodeID); node != nil && node.State == ClusterNode_ALIVE {
+			if n, err := s.GetSegmentSizeFromNode(ctx, segment.ID, node.ID, node.Address); err == nil {
+				size = n
+			}
+		}
+
+		underReplicated := uint32(state.CountAliveNodes(segment.Nodes.PrimaryNodeID)+state.CountAliveNodes(segment.Nodes.ReplicatingNodeIDs...)) < topic.ReplicationFactor
+		response.Segments = append(response.Segments, &emq.TopicDescribeResponse_Segment{
+			ID:                 segment.ID,
+			Open:               true,
+			Shard:              segment.Shard,
+			PrimaryNodeID:      segment.Nodes.PrimaryNodeID,
+			ReplicatingNodeIDs: segment.Nodes.ReplicatingNodeIDs,
+			Size_:              size,
+			CreatedAt:          segment.CreatedAt,
+			UnderReplicated:    underReplicated,
+		})
+
+		response.RetainedBytes += size
+		if size > 1 { // more than version byte => there are messages in open segment
+			if oldest.IsZero() || segment.CreatedAt.Before(oldest) {
+				oldest = segment.CreatedAt
+			}
+			newest = time.Now()
+		}
+		if underReplicated {
+			response.UnderReplicatedSegments++
+		}
+	}
+
+	for _, segment := range state.ClosedSegments {
+		if segment.Type != ClusterSegment_TOPIC || segment.OwnerNamespace != namespace.Name || segment.OwnerName != topic.Name {
+			continue
+		}
+
+		closedAt := segment.ClosedAt
+		var offloadedAt *time.Time
+		if !segment.OffloadedAt.IsZero() {
+			offloadedAt = &segment.OffloadedAt
+		}
+		underReplicated := offloadedAt == nil && uint32(state.CountAliveNodes(segment.Nodes.DoneNodeIDs...)) < topic.ReplicationFactor
+		response.Segments = append(response.Segments, &emq.TopicDescribeResponse_Segment{
+			ID:                 segment.ID,
+			Shard:              segment.Shard,
+			DoneNodeIDs:        segment.Nodes.DoneNodeIDs,
+			ReplicatingNodeIDs: segment.Nodes.ReplicatingNodeIDs,
+			Size_:              segment.Size_,
+			CreatedAt:          segment.CreatedAt,
+			ClosedAt:           &closedAt,
+			UnderReplicated:    underReplicated,
+			OffloadedAt:        offloadedAt,
+		})
+
+		response.RetainedBytes += segment.Size_
+		if oldest.IsZero() || segment.CreatedAt.Before(oldest) {
+			oldest = segment.CreatedAt
+		}
+		if newest.IsZero() || segment.ClosedAt.After(newest) {
+			newest = segment.ClosedAt
+		}
+		if underReplicated {
+			response.UnderReplicatedSegments++
+		}
+	}
+
+	if !oldest.IsZero() {
+		response.OldestMessage = &oldest
+	}
+	if !newest.IsZero() {
+		response.NewestMessage = &newest
+	}
+
+	return response, nil
+}
@@ -0,0 +1,126 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_DescribeTopic(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-describe-topic",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              1,
+				ReplicationFactor:   1,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.DescribeTopic(ctx, &emq.TopicDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-topic",
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+		assert.Equal("test-describe-topic", response.Topic.Name)
+		assert.Empty(response.Segments)
+		assert.Equal(int64(0), response.RetainedBytes)
+		assert.Nil(response.OldestMessage)
+		assert.Nil(response.NewestMessage)
+	}
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-describe-topic",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.DescribeTopic(ctx, &emq.TopicDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-topic",
+		})
+		assert.NoError(err)
+		assert.Len(response.Segments, 1)
+		segment := response.Segments[0]
+		assert.True(segment.Open)
+		assert.Equal(ts.Server.nodeID, segment.PrimaryNodeID)
+		assert.True(segment.Size_ > 1)
+		assert.Nil(segment.ClosedAt)
+		assert.False(segment.UnderReplicated)
+		assert.Equal(segment.Size_, response.RetainedBytes)
+		assert.NotNil(response.OldestMessage)
+		assert.NotNil(response.NewestMessage)
+		assert.Equal(uint32(0), response.UnderReplicatedSegments)
+	}
+
+	{
+		// closed segment whose only copy is on dead node
+		_, err := ts.Server.Apply(&ClusterCommandNodeUpdate{
+			ID:      42,
+			Address: "127.0.0.1:42",
+			State:   ClusterNode_DEAD,
+		})
+		assert.NoError(err)
+		segmentID := ts.ClusterStateStore.NextSegmentID()
+		_, err = ts.Server.Apply(&ClusterCommandSegmentCreate{
+			ID:             segmentID,
+			Type:           ClusterSegment_TOPIC,
+			OwnerNamespace: "default",
+			OwnerName:      "test-describe-topic",
+			Shard:          1,
+			OpenedAt:       time.Now(),
+			PrimaryNodeID:  42,
+		})
+		assert.NoError(err)
+		_, err = ts.Server.Apply(&ClusterCommandSegmentClose{
+			ID:         segmentID,
+			DoneNodeID: 42,
+			ClosedAt:   time.Now(),
+		})
+		assert.NoError(err)
+
+		response, err := ts.Server.DescribeTopic(ctx, &emq.TopicDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-topic",
+		})
+		assert.NoError(err)
+		assert.Len(response.Segments, 2)
+		for _, segment := range response.Segments {
+			assert.Equal(segment.ID == segmentID, segment.UnderReplicated)
+		}
+		assert.Equal(uint32(1), response.UnderReplicatedSegments)
+	}
+
+	{
+		_, err := ts.Server.DescribeTopic(ctx, &emq.TopicDescribeRequest{
+			Namespace: "default",
+			Name:      "test-describe-nonexistent",
+		})
+		assert.Error(err)
+	}
+}
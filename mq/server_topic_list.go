@@ -48,6 +48,12 @@ func (s *Server) ListTopics(ctx context.Context, request *emq.TopicListRequest)
 			Shards:              t.Shards,
 			ReplicationFactor:   t.ReplicationFactor,
 			Retention:           t.Retention,
+			IndexedHeaders:      t.IndexedHeaders,
+			PartitionKey:        t.PartitionKey,
+			DeduplicationWindow: t.DeduplicationWindow,
+			CompactionKey:       t.CompactionKey,
+			RetentionBytes:      t.RetentionBytes,
+			ForceRetentionBytes: t.ForceRetentionBytes,
 		})
 	}
 
@@ -9,15 +9,31 @@ import (
 
 	"eventter.io/mq/emq"
 	"eventter.io/mq/segments"
+	"eventter.io/mq/tracing"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 )
 
-func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest) (*emq.TopicPublishResponse, error) {
+func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest) (_ *emq.TopicPublishResponse, err error) {
 	if err := request.Validate(); err != nil {
 		return nil, errors.Wrap(err, "validation failed")
 	}
 
+	start := time.Now()
+
+	// trace context in message headers (set by client, or by previous forwarding hop) takes precedence over metadata
+	ctx = tracing.ExtractHeaders(tracing.ExtractGRPC(ctx), request.Message.GetHeaders())
+	ctx, span := s.tracer.Start(ctx, "Publish", tracing.KindServer)
+	span.SetAttribute(traceAttributeNodeID, NodeIDToString(s.nodeID))
+	span.SetAttribute(traceAttributeNamespace, request.Namespace)
+	span.SetAttribute(traceAttributeTopic, request.Name)
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+	if request.Message != nil {
+		request.Message.Headers = tracing.InjectHeaders(ctx, request.Message.Headers)
+	}
 	state := s.clusterState.Current()
 
 	namespace, _ := state.FindNamespace(request.Namespace)
@@ -36,10 +52,67 @@ func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest)
 	)
 
 	openSegments := state.FindOpenSegmentsFor(ClusterSegment_TOPIC, request.Namespace, request.Name)
-	for _, openSegment := range openSegments {
-		if openSegment.Nodes.PrimaryNodeID == s.nodeID {
-			localSegmentID = openSegment.ID
-			break
+
+	if shardRouted(topic) {
+		// partitioned (or deduplicated) topic => messages with the same key go to the same shard
+		var shard uint32
+		key := messagePartitionKey(topic, request.Message)
+		if key == "" && topic.DeduplicationWindow > 0 {
+			// retries of the same message must end up in the same shard to be recognized as duplicates
+			key = deduplicationKey(request)
+		}
+		if key != "" {
+			shard = partitionShard(key, topic.Shards)
+		} else {
+			// message without key => prefer local segment, otherwise spread messages across shards
+			for _, openSegment := range openSegments {
+				if openSegment.Nodes.PrimaryNodeID == s.nodeID {
+					shard = openSegment.Shard
+					break
+				}
+			}
+			if shard == 0 {
+				shard = atomic.AddUint32(&s.publishForwardRR, 1)%topic.Shards + 1
+			}
+		}
+
+		for _, openSegment := range openSegments {
+			if openSegment.Shard == shard {
+				if openSegment.Nodes.PrimaryNodeID != s.nodeID {
+					forwardNodeID = openSegment.Nodes.PrimaryNodeID
+					goto Forward
+				}
+				localSegmentID = openSegment.ID
+				break
+			}
+		}
+
+		if localSegmentID == 0 {
+			response, err := s.SegmentOpen(ctx, &SegmentOpenRequest{
+				NodeID:         s.nodeID,
+				Type:           ClusterSegment_TOPIC,
+				OwnerNamespace: request.Namespace,
+				OwnerName:      request.Name,
+				Shard:          shard,
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "segment open failed")
+			}
+
+			if response.PrimaryNodeID != s.nodeID {
+				forwardNodeID = response.PrimaryNodeID
+				goto Forward
+			}
+
+			localSegmentID = response.SegmentID
+		}
+
+	} else {
+		for _, openSegment := range openSegments {
+			if openSegment.Nodes.PrimaryNodeID == s.nodeID {
+				localSegmentID = openSegment.ID
+				break
+			}
 		}
 	}
 
@@ -72,6 +145,10 @@ func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest)
 	}
 
 	{
+		if total := atomic.LoadUint64(&s.diskTotal); total > 0 && atomic.LoadUint64(&s.diskFree) < s.config.DiskCriticalFree {
+			return nil, errDiskFull
+		}
+
 		segment := state.GetOpenSegment(localSegmentID)
 		// cluster state from leader wasn't applied yet to this node => busy wait for new state
 		for segment == nil {
@@ -100,6 +177,16 @@ func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest)
 			Delta:   int64(time.Now().Sub(segment.CreatedAt)),
 		}
 
+		var messageID string
+		if request.Message != nil && request.Message.Properties != nil {
+			messageID = request.Message.Properties.MessageID
+		}
+		deduplicated := topic.DeduplicationWindow > 0 && (request.ProducerID != "" || messageID != "")
+		if deduplicated {
+			publishing.ProducerID = request.ProducerID
+			publishing.Sequence = request.Sequence
+		}
+
 		// possible clock skew => move time to segment open time
 		if publishing.Delta < 0 {
 			publishing.Delta = 0
@@ -111,7 +198,40 @@ func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest)
 		}
 
 	Write:
-		if err := segmentHandle.Write(buf); err == segments.ErrFull {
+		var deduplication *deduplicationIndex
+		if deduplicated {
+			deduplication, err = s.lockDeduplicationIndex(ctx, state, topic, segment)
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now()
+			deduplication.prune(now, topic.DeduplicationWindow)
+			if deduplication.isDuplicate(request.ProducerID, request.Sequence, messageID, now, topic.DeduplicationWindow) {
+				deduplication.mutex.Unlock()
+
+				publishesTotal.WithLabelValues(request.Namespace, request.Name, publishRouteDuplicate).Inc()
+				publishDuration.WithLabelValues(request.Namespace, request.Name).Observe(time.Since(start).Seconds())
+
+				return &emq.TopicPublishResponse{
+					OK:        true,
+					Duplicate: true,
+				}, nil
+			}
+		}
+
+		// index stays locked until the message is written, so that concurrent retries cannot both pass the check;
+		// it's unlocked before segment rotation, close & forwarding, i.e. it's never held across RPC (forwarded
+		// publishing might come back to this node & wait for the index)
+		err = segmentHandle.Write(buf)
+		if deduplication != nil {
+			if err == nil {
+				deduplication.add(request.ProducerID, request.Sequence, messageID, time.Now())
+			}
+			deduplication.mutex.Unlock()
+		}
+
+		if err == segments.ErrFull {
 			sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
 			if err != nil {
 				return nil, errors.Wrap(err, "segment sum failed")
@@ -160,6 +280,9 @@ func (s *Server) Publish(ctx context.Context, request *emq.TopicPublishRequest)
 			}
 		}
 
+		publishesTotal.WithLabelValues(request.Namespace, request.Name, publishRouteLocal).Inc()
+		publishDuration.WithLabelValues(request.Namespace, request.Name).Observe(time.Since(start).Seconds())
+
 		return &emq.TopicPublishResponse{
 			OK: true,
 		}, nil
@@ -194,7 +317,17 @@ Forward:
 		}
 		defer s.pool.Put(conn)
 
+		span.SetAttribute(traceAttributeForwardNodeID, NodeIDToString(forwardNodeID))
+
 		request.DoNotForward = true
-		return emq.NewEventterMQClient(conn).Publish(ctx, request)
+		response, err := emq.NewEventterMQClient(conn).Publish(tracing.InjectGRPC(ctx), request)
+		if err != nil {
+			return nil, err
+		}
+
+		publishesTotal.WithLabelValues(request.Namespace, request.Name, publishRouteForwarded).Inc()
+		publishDuration.WithLabelValues(request.Namespace, request.Name).Observe(time.Since(start).Seconds())
+
+		return response, nil
 	}
 }
@@ -2,10 +2,16 @@ package mq
 
 import (
 	"context"
+	"io"
+	"math"
 	"testing"
+	"time"
 
 	"eventter.io/mq/emq"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_Publish(t *testing.T) {
@@ -51,3 +57,286 @@ func TestServer_Publish(t *testing.T) {
 		assert.Len(segments, 1)
 	}
 }
+
+func TestServer_PublishDiskFull(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-publish-disk-full",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              1,
+				ReplicationFactor:   1,
+				Retention:           1,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	ts.Server.config.DiskCriticalFree = math.MaxUint64
+	ts.Server.updateDiskUsage()
+
+	{
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-publish-disk-full",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.Error(err)
+		assert.Nil(response)
+		assert.Equal(codes.ResourceExhausted, status.Code(err))
+	}
+}
+
+func TestServer_PublishPartitioned(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-publish-partitioned",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              4,
+				ReplicationFactor:   1,
+				Retention:           1,
+				PartitionKey:        emq.PartitionKeyRoutingKey,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	shards := make(map[uint32]bool)
+	for i := 0; i < 2; i++ {
+		for _, key := range keys {
+			response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+				Namespace: "default",
+				Name:      "test-publish-partitioned",
+				Message: &emq.Message{
+					RoutingKey: key,
+					Data:       []byte(key),
+				},
+			})
+			assert.NoError(err)
+			assert.True(response.OK)
+			shards[partitionShard(key, 4)] = true
+		}
+	}
+
+	openSegments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-publish-partitioned")
+	assert.Len(openSegments, len(shards))
+
+	keySegments := make(map[string]uint64)
+	for _, segment := range openSegments {
+		assert.True(shards[segment.Shard])
+
+		segmentHandle, err := ts.Server.segmentDir.Open(segment.ID)
+		assert.NoError(err)
+		iterator, err := segmentHandle.Read(false)
+		assert.NoError(err)
+		for {
+			data, _, _, err := iterator.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(err)
+			publishing := Publishing{}
+			assert.NoError(proto.Unmarshal(data, &publishing))
+
+			key := publishing.Message.RoutingKey
+			assert.Equal(partitionShard(key, 4), segment.Shard)
+			if segmentID, ok := keySegments[key]; ok {
+				assert.Equal(segmentID, segment.ID)
+			}
+			keySegments[key] = segment.ID
+		}
+		ts.Server.segmentDir.Release(segmentHandle)
+	}
+	assert.Len(keySegments, len(keys))
+}
+
+func TestServer_PublishDeduplicated(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-publish-deduplicated",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              2,
+				ReplicationFactor:   1,
+				Retention:           1,
+				DeduplicationWindow: time.Hour,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	publish := func(producerID string, sequence uint64, messageID string) *emq.TopicPublishResponse {
+		request := &emq.TopicPublishRequest{
+			Namespace:  "default",
+			Name:       "test-publish-deduplicated",
+			ProducerID: producerID,
+			Sequence:   sequence,
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		}
+		if messageID != "" {
+			request.Message.Properties = &emq.Message_Properties{MessageID: messageID}
+		}
+		response, err := ts.Server.Publish(ctx, request)
+		assert.NoError(err)
+		assert.True(response.OK)
+		return response
+	}
+
+	assert.False(publish("producer-1", 1, "").Duplicate)
+	assert.True(publish("producer-1", 1, "").Duplicate)
+	assert.False(publish("producer-1", 2, "").Duplicate)
+	assert.False(publish("producer-2", 1, "").Duplicate)
+	assert.False(publish("", 0, "message-1").Duplicate)
+	assert.True(publish("", 0, "message-1").Duplicate)
+	assert.False(publish("", 0, "").Duplicate)
+	assert.False(publish("", 0, "").Duplicate)
+
+	// forget in-memory state as if shard's primary changed => it must be rebuilt from segments
+	ts.Server.deduplicationMutex.Lock()
+	ts.Server.deduplicationIndexes = make(map[string]*deduplicationIndex)
+	ts.Server.deduplicationMutex.Unlock()
+
+	assert.True(publish("producer-1", 2, "").Duplicate)
+	assert.True(publish("producer-2", 1, "").Duplicate)
+	assert.True(publish("", 0, "message-1").Duplicate)
+	assert.False(publish("producer-1", 3, "").Duplicate)
+
+	state := ts.ClusterStateStore.Current()
+	written := 0
+	for _, segment := range state.FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-publish-deduplicated") {
+		assert.NoError(ts.Server.readSegmentPublishings(ctx, state, segment, func(publishing *Publishing, _ int64) {
+			written++
+		}))
+	}
+	assert.Equal(7, written)
+}
+
+func TestServer_PublishDeduplicatedUnreadableSegment(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-publish-deduplicated-unreadable",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+				Shards:              1,
+				ReplicationFactor:   1,
+				DeduplicationWindow: time.Hour,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	publish := func(sequence uint64) *emq.TopicPublishResponse {
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace:  "default",
+			Name:       "test-publish-deduplicated-unreadable",
+			ProducerID: "producer-1",
+			Sequence:   sequence,
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+		return response
+	}
+
+	assert.False(publish(1).Duplicate)
+
+	openSegments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-publish-deduplicated-unreadable")
+	assert.Len(openSegments, 1)
+
+	// closed segment of the shard held only by node that isn't alive
+	segmentID := ts.ClusterStateStore.NextSegmentID()
+	_, err = ts.Server.Apply(&ClusterCommandSegmentCreate{
+		ID:             segmentID,
+		Type:           ClusterSegment_TOPIC,
+		OwnerNamespace: "default",
+		OwnerName:      "test-publish-deduplicated-unreadable",
+		Shard:          openSegments[0].Shard,
+		OpenedAt:       time.Now(),
+		PrimaryNodeID:  42,
+	})
+	assert.NoError(err)
+	_, err = ts.Server.Apply(&ClusterCommandSegmentClose{
+		ID:         segmentID,
+		DoneNodeID: 42,
+		ClosedAt:   time.Now(),
+	})
+	assert.NoError(err)
+
+	ts.Server.deduplicationMutex.Lock()
+	ts.Server.deduplicationIndexes = make(map[string]*deduplicationIndex)
+	ts.Server.deduplicationMutex.Unlock()
+
+	// duplicates in unreadable segment couldn't be recognized => publishing is rejected, so that client retries
+	_, err = ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+		Namespace:  "default",
+		Name:       "test-publish-deduplicated-unreadable",
+		ProducerID: "producer-1",
+		Sequence:   2,
+		Message: &emq.Message{
+			Data: []byte("hello, world"),
+		},
+	})
+	assert.Error(err)
+	assert.Equal(codes.Unavailable, status.Code(err))
+
+	_, err = ts.Server.Apply(&ClusterCommandSegmentDelete{
+		ID:    segmentID,
+		Which: ClusterCommandSegmentDelete_CLOSED,
+	})
+	assert.NoError(err)
+
+	assert.True(publish(1).Duplicate)
+	assert.False(publish(2).Duplicate)
+}
@@ -0,0 +1,166 @@
+package mq
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+var errSearchLimitReached = errors.New("search limit reached")
+
+func (s *Server) SearchTopic(request *emq.TopicSearchRequest, stream emq.EventterMQ_SearchTopicServer) error {
+	if err := request.Validate(); err != nil {
+		return errors.Wrap(err, "validation failed")
+	}
+
+	state := s.clusterState.Current()
+
+	namespace, _ := state.FindNamespace(request.Namespace)
+	if namespace == nil {
+		return errors.Errorf(namespaceNotFoundErrorFormat, request.Namespace)
+	}
+
+	topic, _ := namespace.FindTopic(request.Name)
+	if topic == nil {
+		return errors.Errorf(notFoundErrorFormat, entityTopic, request.Namespace, request.Name)
+	}
+
+	var topicSegments []*ClusterSegment
+	for _, segments := range [][]*ClusterSegment{state.ClosedSegments, state.OpenSegments} {
+		for _, segment := range segments {
+			if segment.Type != ClusterSegment_TOPIC || segment.OwnerNamespace != namespace.Name || segment.OwnerName != topic.Name {
+				continue
+			}
+			if request.Until != nil && !segment.CreatedAt.Before(*request.Until) {
+				continue
+			}
+			if request.Since != nil && !segment.ClosedAt.IsZero() && segment.ClosedAt.Before(*request.Since) {
+				continue
+			}
+			topicSegments = append(topicSegments, segment)
+		}
+	}
+	sort.Slice(topicSegments, func(i, j int) bool {
+		if topicSegments[i].CreatedAt.Equal(topicSegments[j].CreatedAt) {
+			return topicSegments[i].ID < topicSegments[j].ID
+		}
+		return topicSegments[i].CreatedAt.Before(topicSegments[j].CreatedAt)
+	})
+
+	ctx := stream.Context()
+	sent := uint32(0)
+
+	for _, segment := range topicSegments {
+		if index, err := s.segmentIndex(ctx, state, segment); err == nil && index != nil && !index.mayMatchSearch(request) {
+			// no message in segment can match => skip it
+			continue
+		}
+
+		err := s.readSegment(ctx, state, segment, func(data []byte, offset int64) error {
+			publishing := Publishing{}
+			if err := proto.Unmarshal(data, &publishing); err != nil {
+				return errors.Wrapf(err, "unmarshal failed in segment %d at %d", segment.ID, offset)
+			}
+
+			messageTime := segment.CreatedAt.Add(time.Duration(publishing.Delta))
+			if !searchMatches(publishing.Message, messageTime, request) {
+				return nil
+			}
+
+			err := stream.Send(&emq.TopicSearchResponse{
+				SegmentID: segment.ID,
+				Offset:    offset,
+				Time:      messageTime,
+				Message:   publishing.Message,
+			})
+			if err != nil {
+				return errors.Wrap(err, "send failed")
+			}
+
+			sent++
+			if request.Limit > 0 && sent >= request.Limit {
+				return errSearchLimitReached
+			}
+			return nil
+		})
+		if err == errSearchLimitReached {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "search in segment %d failed", segment.ID)
+		}
+	}
+
+	return nil
+}
+
+// Calls fn for each message currently in segment. Segment is read from local segment directory if this node holds
+// a complete copy (or segment is offloaded & fetched from tiered storage), otherwise it's read from primary (open
+// segment) or alive replicas (closed segment), another replica is tried if reading from one fails.
+func (s *Server) readSegment(ctx context.Context, state *ClusterState, segment *ClusterSegment, fn func(data []byte, offset int64) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	local := s.holdsSegment(segment)
+	if !segment.OffloadedAt.IsZero() {
+		if err := s.fetchOffloadedSegment(ctx, segment); err != nil {
+			return err
+		}
+		local = true
+	}
+
+	if local {
+		segmentHandle, err := s.segmentDir.Open(segment.ID)
+		if err != nil {
+			return errors.Wrap(err, "segment open failed")
+		}
+		defer s.segmentDir.Release(segmentHandle)
+
+		iterator, err := segmentHandle.Read(false)
+		if err != nil {
+			return errors.Wrap(err, "segment read failed")
+		}
+
+		go func() {
+			<-ctx.Done()
+			iterator.Close()
+		}()
+
+		for {
+			data, offset, _, err := iterator.Next()
+			if err == io.EOF {
+				return nil
+			} else if err == segments.ErrIteratorClosed && ctx.Err() != nil {
+				return ctx.Err()
+			} else if err != nil {
+				return errors.Wrap(err, "iterator next failed")
+			}
+
+			if err := fn(data, offset); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.readRemoteSegment(ctx, segmentReadNodes(state, segment), segment.ID, func(response *SegmentReadResponse) error {
+		return fn(response.Data, response.Offset)
+	})
+}
+
+// Returns true if this node holds complete copy of the segment (or is primary of open segment).
+func (s *Server) holdsSegment(segment *ClusterSegment) bool {
+	if segment.Nodes.PrimaryNodeID == s.nodeID {
+		return true
+	}
+	for _, nodeID := range segment.Nodes.DoneNodeIDs {
+		if nodeID == s.nodeID {
+			return true
+		}
+	}
+	return false
+}
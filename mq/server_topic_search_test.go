@@ -0,0 +1,139 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type searchTopicStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*emq.TopicSearchResponse
+}
+
+func (s *searchTopicStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *searchTopicStream) Send(response *emq.TopicSearchResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestServer_SearchTopic(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-search-topic",
+				DefaultExchangeType: emq.ExchangeTypeTopic,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	for _, message := range []*emq.Message{
+		{RoutingKey: "orders.created", Data: []byte(`{"id":1}`)},
+		{RoutingKey: "orders.cancelled", Data: []byte(`{"id":2}`)},
+		{RoutingKey: "orders.created", Data: []byte(`{"id":3}`)},
+	} {
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-search-topic",
+			Message:   message,
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		stream := &searchTopicStream{ctx: ctx}
+		err := ts.Server.SearchTopic(&emq.TopicSearchRequest{
+			Namespace:  "default",
+			Name:       "test-search-topic",
+			RoutingKey: "orders.created",
+		}, stream)
+		assert.NoError(err)
+		assert.Len(stream.responses, 2)
+		assert.Equal(`{"id":1}`, string(stream.responses[0].Message.Data))
+		assert.Equal(`{"id":3}`, string(stream.responses[1].Message.Data))
+		assert.Equal(stream.responses[0].SegmentID, stream.responses[1].SegmentID)
+		assert.True(stream.responses[0].Offset < stream.responses[1].Offset)
+		assert.False(stream.responses[0].Time.IsZero())
+	}
+
+	{
+		stream := &searchTopicStream{ctx: ctx}
+		err := ts.Server.SearchTopic(&emq.TopicSearchRequest{
+			Namespace:    "default",
+			Name:         "test-search-topic",
+			DataContains: `"id":2`,
+		}, stream)
+		assert.NoError(err)
+		assert.Len(stream.responses, 1)
+		assert.Equal("orders.cancelled", stream.responses[0].Message.RoutingKey)
+	}
+
+	{
+		stream := &searchTopicStream{ctx: ctx}
+		err := ts.Server.SearchTopic(&emq.TopicSearchRequest{
+			Namespace: "default",
+			Name:      "test-search-topic",
+			Limit:     1,
+		}, stream)
+		assert.NoError(err)
+		assert.Len(stream.responses, 1)
+	}
+
+	{
+		err := ts.Server.SearchTopic(&emq.TopicSearchRequest{
+			Namespace: "default",
+			Name:      "test-search-nonexistent",
+		}, &searchTopicStream{ctx: ctx})
+		assert.Error(err)
+	}
+}
+
+func TestSegmentReadNodes(t *testing.T) {
+	assert := require.New(t)
+
+	state := &ClusterState{
+		Nodes: []*ClusterNode{
+			{ID: 1, State: ClusterNode_ALIVE},
+			{ID: 2, State: ClusterNode_DEAD},
+			{ID: 3, State: ClusterNode_ALIVE},
+		},
+	}
+
+	nodeIDs := func(nodes []*ClusterNode) []uint64 {
+		var ids []uint64
+		for _, node := range nodes {
+			ids = append(ids, node.ID)
+		}
+		return ids
+	}
+
+	open := &ClusterSegment{Nodes: ClusterSegment_Nodes{PrimaryNodeID: 1, ReplicatingNodeIDs: []uint64{3}}}
+	assert.Equal([]uint64{1}, nodeIDs(segmentReadNodes(state, open)))
+
+	open.Nodes.PrimaryNodeID = 2
+	assert.Empty(segmentReadNodes(state, open))
+
+	closed := &ClusterSegment{ClosedAt: time.Now(), Nodes: ClusterSegment_Nodes{DoneNodeIDs: []uint64{1, 2, 3, 4}}}
+	assert.ElementsMatch([]uint64{1, 3}, nodeIDs(segmentReadNodes(state, closed)))
+}
@@ -31,6 +31,29 @@ func Uint32(s *types.Struct, field string, defaultValue uint32) (uint32, error)
 	}
 }
 
+func Uint64(s *types.Struct, field string, defaultValue uint64) (uint64, error) {
+	if s == nil || s.Fields == nil {
+		return defaultValue, nil
+	}
+	value, ok := s.Fields[field]
+	if !ok {
+		return defaultValue, nil
+	}
+
+	switch value := value.Kind.(type) {
+	case *types.Value_NumberValue:
+		return uint64(value.NumberValue), nil
+	case *types.Value_StringValue:
+		i, err := strconv.ParseUint(value.StringValue, 10, 64)
+		if err != nil {
+			return defaultValue, errors.Wrap(err, "parse number failed")
+		}
+		return i, nil
+	default:
+		return defaultValue, errors.Errorf("unexpected value kind %T", value)
+	}
+}
+
 func Duration(s *types.Struct, field string, defaultValue time.Duration) (time.Duration, error) {
 	if s == nil || s.Fields == nil {
 		return defaultValue, nil
@@ -70,3 +93,26 @@ func String(s *types.Struct, field string, defaultValue string) (string, error)
 		return defaultValue, errors.Errorf("unexpected value kind %T", value)
 	}
 }
+
+func Bool(s *types.Struct, field string, defaultValue bool) (bool, error) {
+	if s == nil || s.Fields == nil {
+		return defaultValue, nil
+	}
+	value, ok := s.Fields[field]
+	if !ok {
+		return defaultValue, nil
+	}
+
+	switch value := value.Kind.(type) {
+	case *types.Value_BoolValue:
+		return value.BoolValue, nil
+	case *types.Value_StringValue:
+		b, err := strconv.ParseBool(value.StringValue)
+		if err != nil {
+			return defaultValue, errors.Wrap(err, "parse bool failed")
+		}
+		return b, nil
+	default:
+		return defaultValue, errors.Errorf("unexpected value kind %T", value)
+	}
+}
@@ -7,11 +7,25 @@ import (
 
 	"eventter.io/mq/consumers"
 	"eventter.io/mq/segments"
+	"eventter.io/mq/tracing"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 )
 
 func (s *Server) taskConsumeSegmentLocal(ctx context.Context, state *ClusterState, namespaceName string, consumerGroup *ClusterConsumerGroup, topicName string, segment *ClusterSegment, group *consumers.Group, startOffset int64) error {
+	if index, err := s.segmentIndex(ctx, state, segment); err == nil && index != nil && !index.mayMatchConsumerGroup(topicName, consumerGroup) {
+		// no message in segment can match bindings => consumer group commits the whole segment
+		return nil
+	}
+
+	topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName)
+
+	if !segment.OffloadedAt.IsZero() {
+		if err := s.fetchOffloadedSegment(ctx, segment); err != nil {
+			return err
+		}
+	}
+
 	segmentHandle, err := s.segmentDir.Open(segment.ID)
 	if err != nil {
 		return errors.Wrap(err, "segment open failed")
@@ -60,6 +74,10 @@ func (s *Server) taskConsumeSegmentLocal(ctx context.Context, state *ClusterStat
 		messageTime := segment.CreatedAt.Add(time.Duration(publishing.Delta))
 
 		if messageMatches(publishing.Message, messageTime, topicName, consumerGroup) {
+			_, span := s.startMessageSpan(ctx, publishing.Message, "ConsumerGroupOffer", tracing.KindConsumer)
+			span.SetAttribute(traceAttributeNamespace, namespaceName)
+			span.SetAttribute(traceAttributeConsumerGroup, consumerGroup.Name)
+			span.SetAttribute(traceAttributeSegmentID, segment.ID)
 			err = group.Offer(&consumers.Message{
 				TopicNamespace: segment.OwnerNamespace,
 				TopicName:      segment.OwnerName,
@@ -67,7 +85,10 @@ func (s *Server) taskConsumeSegmentLocal(ctx context.Context, state *ClusterStat
 				CommitOffset:   commitOffset,
 				Time:           messageTime,
 				Message:        publishing.Message,
+				PartitionKey:   messagePartitionKey(topic, publishing.Message),
 			})
+			span.SetError(err)
+			span.End()
 			if err != nil {
 				return errors.Wrap(err, "offer failed")
 			}
@@ -7,12 +7,20 @@ import (
 	"time"
 
 	"eventter.io/mq/consumers"
+	"eventter.io/mq/tracing"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 )
 
 func (s *Server) taskConsumeSegmentRemote(ctx context.Context, state *ClusterState, namespaceName string, consumerGroup *ClusterConsumerGroup, topicName string, segment *ClusterSegment, group *consumers.Group, nodeID uint64, startOffset int64) error {
+	if index, err := s.segmentIndex(ctx, state, segment); err == nil && index != nil && !index.mayMatchConsumerGroup(topicName, consumerGroup) {
+		// no message in segment can match bindings => consumer group commits the whole segment
+		return nil
+	}
+
+	topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName)
+
 	node := state.GetNode(nodeID)
 	if node == nil {
 		return errors.Errorf("node %d not found", nodeID)
@@ -65,6 +73,11 @@ func (s *Server) taskConsumeSegmentRemote(ctx context.Context, state *ClusterSta
 		messageTime := segment.CreatedAt.Add(time.Duration(publishing.Delta))
 
 		if messageMatches(publishing.Message, messageTime, topicName, consumerGroup) {
+			_, span := s.startMessageSpan(ctx, publishing.Message, "ConsumerGroupOffer", tracing.KindConsumer)
+			span.SetAttribute(traceAttributeNamespace, namespaceName)
+			span.SetAttribute(traceAttributeConsumerGroup, consumerGroup.Name)
+			span.SetAttribute(traceAttributeSegmentID, segment.ID)
+			span.SetAttribute(traceAttributeSourceNodeID, NodeIDToString(nodeID))
 			err = group.Offer(&consumers.Message{
 				TopicNamespace: segment.OwnerNamespace,
 				TopicName:      segment.OwnerName,
@@ -72,7 +85,10 @@ func (s *Server) taskConsumeSegmentRemote(ctx context.Context, state *ClusterSta
 				CommitOffset:   response.CommitOffset,
 				Time:           messageTime,
 				Message:        publishing.Message,
+				PartitionKey:   messagePartitionKey(topic, publishing.Message),
 			})
+			span.SetError(err)
+			span.End()
 			if err != nil {
 				return errors.Wrap(err, "offer failed")
 			}
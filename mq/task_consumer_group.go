@@ -5,12 +5,12 @@ import (
 	"crypto/sha1"
 	"fmt"
 	"io"
-	"log"
 	"math/rand"
 	"runtime"
 	"time"
 
 	"eventter.io/mq/consumers"
+	"eventter.io/mq/logging"
 	"eventter.io/mq/segments"
 	"eventter.io/mq/tasks"
 	"github.com/gogo/protobuf/proto"
@@ -31,6 +31,7 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 	for _, commit := range consumerGroup.OffsetCommits {
 		committedOffsets[commit.SegmentID] = commit.Offset
 	}
+	generation := consumerGroup.OffsetCommitsGeneration
 
 	// 2) find segment with offset commits, update from messages
 
@@ -44,28 +45,11 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 		}
 	}()
 
-	iterator, err := segmentHandle.Read(false)
-	if err != nil {
-		return errors.Wrap(err, "segment read failed")
-	}
-	commit := ClusterConsumerGroup_OffsetCommit{}
-	for {
-		buf, off, _, err := iterator.Next()
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return errors.Wrap(err, "segment next failed")
-		}
-
-		if err := proto.Unmarshal(buf, &commit); err != nil {
-			return errors.Wrapf(err, "unmarshal failed in segment %d at %d", segmentID, off)
-		}
-
-		if offset, ok := committedOffsets[commit.SegmentID]; ok && commit.Offset > offset {
-			committedOffsets[commit.SegmentID] = commit.Offset
+	if !consumerGroup.OffsetCommitsImportPending {
+		if err := s.readOffsetCommits(segmentHandle, segmentID, committedOffsets); err != nil {
+			return err
 		}
 	}
-	iterator = nil
 
 	// 3) register as consumer group
 
@@ -74,6 +58,8 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 		return errors.Wrap(err, "group create failed")
 	}
 	group.Commits = make(chan consumers.Commit, int(consumerGroup.Size_))
+	group.SetMaxPriority(uint8(consumerGroup.MaxPriority))
+	group.SetSingleActiveConsumer(consumerGroup.SingleActiveConsumer)
 
 	mapKey := s.makeConsumerGroupMapKey(namespaceName, consumerGroupName)
 	s.groupMutex.Lock()
@@ -87,9 +73,40 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 		s.groupMutex.Unlock()
 	}()
 
+	rotate := func() (*SegmentOpenResponse, error) {
+		sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+		if err != nil {
+			return nil, errors.Wrap(err, "sum failed")
+		}
+		var offsetCommitsUpdate []*ClusterConsumerGroup_OffsetCommit
+		for segmentID, offset := range committedOffsets {
+			offsetCommitsUpdate = append(offsetCommitsUpdate, &ClusterConsumerGroup_OffsetCommit{
+				SegmentID: segmentID,
+				Offset:    offset,
+			})
+		}
+		response, err := s.SegmentRotate(ctx, &SegmentCloseRequest{
+			OffsetCommitsUpdate: &ClusterCommandConsumerGroupOffsetCommitsUpdate{
+				Namespace:     namespaceName,
+				Name:          consumerGroupName,
+				OffsetCommits: offsetCommitsUpdate,
+			},
+			NodeID:    s.nodeID,
+			SegmentID: segmentID,
+			Size_:     size,
+			Sha1:      sha1Sum,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "rotate failed")
+		}
+		return response, nil
+	}
+
 	// 4) main loop
 
-	taskManager := tasks.NewManager(ctx, fmt.Sprintf("consumer group %s/%s", namespaceName, consumerGroupName))
+	logger := s.logger.With(logging.Namespace(namespaceName), logging.ConsumerGroup(consumerGroupName))
+
+	taskManager := tasks.NewManager(ctx, logger)
 	defer taskManager.Close()
 
 	ticker := time.NewTicker(100 * time.Millisecond)
@@ -120,6 +137,43 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 				return errors.Errorf(notFoundErrorFormat, entityConsumerGroup, namespaceName, consumerGroupName)
 			}
 
+			if consumerGroup.OffsetCommitsGeneration != generation || consumerGroup.OffsetCommitsImportPending {
+				// offsets were imported => discard locally committed offsets, rotate segment & start over
+				committedOffsets = make(map[uint64]int64)
+				for _, commit := range consumerGroup.OffsetCommits {
+					committedOffsets[commit.SegmentID] = commit.Offset
+				}
+				response, err := rotate()
+				if err != nil {
+					return err
+				}
+				logger.Info(
+					"consumer group offsets imported, rotated segment",
+					logging.SegmentID(segmentID),
+					logging.F("new_segment_id", response.SegmentID),
+					logging.F("new_primary_node_id", NodeIDToString(response.PrimaryNodeID)),
+				)
+				return nil
+			}
+
+			if node := state.GetNode(s.nodeID); node != nil && node.AdminState == ClusterNode_DRAINING {
+				// node is being drained => save offsets & hand over consumer group to another node
+				response, err := rotate()
+				if err != nil {
+					return err
+				}
+				logger.Info(
+					"consumer group moved from draining node, rotated segment assigned to another node",
+					logging.SegmentID(segmentID),
+					logging.F("new_segment_id", response.SegmentID),
+					logging.F("new_primary_node_id", NodeIDToString(response.PrimaryNodeID)),
+				)
+				return nil
+			}
+
+			group.SetMaxPriority(uint8(consumerGroup.MaxPriority))
+			group.SetSingleActiveConsumer(consumerGroup.SingleActiveConsumer)
+
 			nextCommittedOffsets := make(map[uint64]int64)
 			for _, commit := range consumerGroup.OffsetCommits {
 				nextCommittedOffsets[commit.SegmentID] = commit.Offset
@@ -162,7 +216,8 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 				}
 
 				local := false
-				if segment.Nodes.PrimaryNodeID == s.nodeID {
+				if segment.Nodes.PrimaryNodeID == s.nodeID || !segment.OffloadedAt.IsZero() {
+					// offloaded segment is fetched from tiered storage & consumed locally
 					local = true
 				} else {
 					for _, nodeID := range segment.Nodes.DoneNodeIDs {
@@ -183,6 +238,11 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 					)
 				}
 
+				if topic.PartitionKey != "" && partitionPredecessorPending(state, segment, committedOffsets, consumerGroup.Since) {
+					// partitioned topic => shard's segments must be read one after another to keep per-key ordering
+					continue
+				}
+
 				taskName := fmt.Sprintf("consume segment %d", offsetSegmentID)
 				if local {
 					running[offsetSegmentID] = taskManager.Start(
@@ -224,7 +284,7 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 				delete(running, offsetSegmentID)
 				if completed.Err == nil {
 					// task completed without error => busy wait for segment to close
-					state := s.clusterState.Current()
+					state = s.clusterState.Current()
 					segment := state.GetSegment(offsetSegmentID)
 					for segment != nil && segment.ClosedAt.IsZero() {
 						select {
@@ -274,36 +334,16 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 
 	Write:
 		if err := segmentHandle.Write(buf); err == segments.ErrFull {
-			sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+			response, err := rotate()
 			if err != nil {
-				return errors.Wrap(err, "sum failed")
+				return err
 			}
-			var offsetCommitsUpdate []*ClusterConsumerGroup_OffsetCommit
-			for segmentID, offset := range committedOffsets {
-				offsetCommitsUpdate = append(offsetCommitsUpdate, &ClusterConsumerGroup_OffsetCommit{
-					SegmentID: segmentID,
-					Offset:    offset,
-				})
-			}
-			response, err := s.SegmentRotate(ctx, &SegmentCloseRequest{
-				OffsetCommitsUpdate: &ClusterCommandConsumerGroupOffsetCommitsUpdate{
-					Namespace:     namespaceName,
-					Name:          consumerGroupName,
-					OffsetCommits: offsetCommitsUpdate,
-				},
-				NodeID:    s.nodeID,
-				SegmentID: segmentID,
-				Size_:     size,
-				Sha1:      sha1Sum,
-			})
 			if response.PrimaryNodeID != s.nodeID {
-				log.Printf(
-					"consumer group %s/%s rotated segment (%d->%d) assigned to different node: %d",
-					namespaceName,
-					consumerGroupName,
-					segmentID,
-					response.SegmentID,
-					response.PrimaryNodeID,
+				logger.Info(
+					"consumer group rotated segment assigned to different node",
+					logging.SegmentID(segmentID),
+					logging.F("new_segment_id", response.SegmentID),
+					logging.F("new_primary_node_id", NodeIDToString(response.PrimaryNodeID)),
 				)
 				return nil
 			}
@@ -323,3 +363,29 @@ func (s *Server) taskConsumerGroup(ctx context.Context, namespaceName string, co
 		}
 	}
 }
+
+// Updates committed offsets with commits written to consumer group offset commits segment since the last offset commits
+// update in cluster state.
+func (s *Server) readOffsetCommits(segmentHandle *segments.File, segmentID uint64, committedOffsets map[uint64]int64) error {
+	iterator, err := segmentHandle.Read(false)
+	if err != nil {
+		return errors.Wrap(err, "segment read failed")
+	}
+	commit := ClusterConsumerGroup_OffsetCommit{}
+	for {
+		buf, off, _, err := iterator.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "segment next failed")
+		}
+
+		if err := proto.Unmarshal(buf, &commit); err != nil {
+			return errors.Wrapf(err, "unmarshal failed in segment %d at %d", segmentID, off)
+		}
+
+		if offset, ok := committedOffsets[commit.SegmentID]; ok && commit.Offset > offset {
+			committedOffsets[commit.SegmentID] = commit.Offset
+		}
+	}
+}
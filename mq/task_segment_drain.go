@@ -0,0 +1,44 @@
+package mq
+
+import (
+	"context"
+	"crypto/sha1"
+
+	"eventter.io/mq/logging"
+	"eventter.io/mq/segments"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) taskSegmentDrain(ctx context.Context, segmentID uint64) error {
+	logger := s.logger.With(logging.SegmentID(segmentID))
+	logger.Info("draining open segment")
+
+	segmentHandle, err := s.segmentDir.Open(segmentID)
+	if err != nil {
+		return errors.Wrap(err, "open failed")
+	}
+	defer s.segmentDir.Release(segmentHandle)
+
+	// no more messages can be published to the segment, publishers will get new segment on another node;
+	// sealing is permanent - if draining is cancelled, the segment stays closed
+	segmentHandle.Seal()
+
+	sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+	if err != nil {
+		return errors.Wrap(err, "sum failed")
+	}
+
+	_, err = s.SegmentClose(ctx, &SegmentCloseRequest{
+		NodeID:    s.nodeID,
+		SegmentID: segmentID,
+		Size_:     size,
+		Sha1:      sha1Sum,
+	})
+	if err != nil {
+		return errors.Wrap(err, "close failed")
+	}
+
+	logger.Info("open segment drained")
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package mq
+
+import (
+	"context"
+
+	"eventter.io/mq/logging"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+func (s *Server) taskSegmentIndex(ctx context.Context, segmentID uint64) error {
+	logger := s.logger.With(logging.SegmentID(segmentID))
+
+	state := s.clusterState.Current()
+	segment := state.GetClosedSegment(segmentID)
+	if segment == nil {
+		// segment deleted in between
+		return nil
+	}
+
+	if !s.segmentDir.Exists(segmentID) {
+		return errors.Errorf("segment %d does not exist", segmentID)
+	}
+
+	// 1) copy index from another node that holds the segment
+
+	for _, nodeID := range segment.Nodes.DoneNodeIDs {
+		if nodeID == s.nodeID {
+			continue
+		}
+		node := state.GetNode(nodeID)
+		if node == nil || node.State != ClusterNode_ALIVE {
+			continue
+		}
+
+		data, err := s.fetchSegmentIndex(ctx, node, segmentID)
+		if err != nil {
+			logger.Warn("could not fetch segment index", logging.F("source_node_id", NodeIDToString(nodeID)), logging.Error(err))
+			continue
+		} else if data == nil {
+			continue
+		}
+
+		if err := s.segmentDir.WriteIndex(segmentID, data); err != nil {
+			return errors.Wrap(err, "index write failed")
+		}
+		logger.Info("replicated segment index", logging.F("source_node_id", NodeIDToString(nodeID)))
+		return nil
+	}
+
+	// 2) no other node has the index => build it
+
+	var indexedHeaders []string
+	if topic := state.GetTopic(segment.OwnerNamespace, segment.OwnerName); topic != nil {
+		indexedHeaders = topic.IndexedHeaders
+	}
+
+	segmentHandle, err := s.segmentDir.Open(segmentID)
+	if err != nil {
+		return errors.Wrap(err, "segment open failed")
+	}
+	defer s.segmentDir.Release(segmentHandle)
+
+	index, err := buildSegmentIndex(segmentHandle, indexedHeaders)
+	if err != nil {
+		return errors.Wrap(err, "index build failed")
+	}
+
+	data, err := proto.Marshal(index)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	if err := s.segmentDir.WriteIndex(segmentID, data); err != nil {
+		return errors.Wrap(err, "index write failed")
+	}
+	logger.Info("built segment index")
+
+	return nil
+}
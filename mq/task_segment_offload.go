@@ -0,0 +1,292 @@
+package mq
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventter.io/mq/logging"
+	"eventter.io/mq/segments"
+	"eventter.io/mq/tiered"
+	"github.com/pkg/errors"
+)
+
+const (
+	offloadedSegmentKeyPrefix = "segments/"
+	offloadedSegmentKeySuffix = ".seg"
+	// Local copy of offloaded segment is garbage collected after it wasn't needed for this long.
+	offloadedSegmentCacheTTL = 10 * time.Minute
+	// Objects in tiered storage not referenced by cluster state are deleted only after they're this old, so that
+	// segment uploaded right now, but not yet marked as offloaded, isn't deleted.
+	tieredStorageGarbageGracePeriod = 1 * time.Hour
+)
+
+func offloadedSegmentKey(segmentID uint64) string {
+	return fmt.Sprintf("%s%016x%s", offloadedSegmentKeyPrefix, segmentID, offloadedSegmentKeySuffix)
+}
+
+// Returns ID of segment stored under the key, or zero if the key doesn't belong to segment.
+func offloadedSegmentID(key string) uint64 {
+	if !strings.HasPrefix(key, offloadedSegmentKeyPrefix) || !strings.HasSuffix(key, offloadedSegmentKeySuffix) {
+		return 0
+	}
+	id, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(key, offloadedSegmentKeyPrefix), offloadedSegmentKeySuffix), 16, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// Returns true if closed segment should be offloaded to tiered storage by the leader.
+func (s *Server) segmentOffloadable(segment *ClusterSegment, now time.Time) bool {
+	return s.tieredStorage != nil && s.config.TieredStorageAge > 0 &&
+		segment.Type == ClusterSegment_TOPIC &&
+		segment.OffloadedAt.IsZero() &&
+		len(segment.Nodes.DoneNodeIDs) > 0 &&
+		len(segment.Nodes.ReplicatingNodeIDs) == 0 &&
+		segment.ClosedAt.Before(now.Add(-s.config.TieredStorageAge))
+}
+
+// Uploads closed segment to tiered storage & marks it as offloaded, therefore, nodes holding it delete their copies.
+func (s *Server) taskSegmentOffload(ctx context.Context, segmentID uint64) error {
+	state := s.clusterState.Current()
+	segment := state.GetClosedSegment(segmentID)
+	if segment == nil || !segment.OffloadedAt.IsZero() {
+		return nil
+	}
+
+	logger := s.logger.With(segmentFields(segment)...)
+
+	segmentHandle, err := s.segmentDir.Open(segment.ID)
+	if err != nil {
+		return errors.Wrap(err, "segment open failed")
+	}
+	defer s.segmentDir.Release(segmentHandle)
+
+	if !s.holdsSegment(segment) {
+		// leader doesn't hold the segment => copy it from node that does, local copy is garbage collected afterwards
+		if err := segmentHandle.Truncate(segments.TruncateAll); err != nil {
+			return errors.Wrap(err, "truncate failed")
+		}
+		err := s.readSegment(ctx, state, segment, func(data []byte, _ int64) error {
+			return segmentHandle.Write(data)
+		})
+		if err != nil {
+			return errors.Wrap(err, "segment copy failed")
+		}
+	}
+
+	sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+	if err != nil {
+		return errors.Wrap(err, "segment sum failed")
+	}
+	if size != segment.Size_ || !bytes.Equal(sha1Sum, segment.Sha1) {
+		return errors.Errorf("segment %d copy corrupted, expected size: %d, got size: %d", segment.ID, segment.Size_, size)
+	}
+
+	if err := s.tieredStorage.Put(ctx, offloadedSegmentKey(segment.ID), segmentHandle.Reader(), size); err != nil {
+		return errors.Wrap(err, "upload failed")
+	}
+
+	if err := s.beginTransaction(); err != nil {
+		return err
+	}
+	defer s.releaseTransaction()
+
+	if segment := s.clusterState.Current().GetClosedSegment(segment.ID); segment == nil || !segment.OffloadedAt.IsZero() {
+		// segment deleted in between => uploaded object is garbage collected later
+		return nil
+	}
+
+	_, err = s.Apply(&ClusterCommandSegmentOffload{
+		ID:          segment.ID,
+		OffloadedAt: time.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "apply failed")
+	}
+
+	logger.Info("segment offloaded to tiered storage", logging.F("size", size))
+
+	return nil
+}
+
+// Fetch or removal of offloaded segment's local copy. Only one operation runs for a segment at a time, others wait
+// for it to be done.
+type offloadedSegmentCopyOp struct {
+	done chan struct{}
+}
+
+// Starts operation on segment's local copy. If another operation is running, it's returned instead & false.
+func (s *Server) startOffloadedSegmentCopyOp(segmentID uint64, markFetched bool) (*offloadedSegmentCopyOp, bool) {
+	s.offloadedSegmentsMutex.Lock()
+	defer s.offloadedSegmentsMutex.Unlock()
+
+	if markFetched {
+		s.offloadedSegmentsFetched[segmentID] = time.Now()
+	}
+
+	if op, ok := s.offloadedSegmentsOps[segmentID]; ok {
+		return op, false
+	}
+	op := &offloadedSegmentCopyOp{done: make(chan struct{})}
+	s.offloadedSegmentsOps[segmentID] = op
+	return op, true
+}
+
+func (s *Server) finishOffloadedSegmentCopyOp(segmentID uint64, op *offloadedSegmentCopyOp) {
+	s.offloadedSegmentsMutex.Lock()
+	delete(s.offloadedSegmentsOps, segmentID)
+	s.offloadedSegmentsMutex.Unlock()
+	close(op.done)
+}
+
+// Makes sure that offloaded segment is in local segment directory, downloads it from tiered storage if it isn't.
+func (s *Server) fetchOffloadedSegment(ctx context.Context, segment *ClusterSegment) error {
+	if s.tieredStorage == nil {
+		return errors.Errorf("segment %d is offloaded, however, tiered storage is not configured", segment.ID)
+	}
+
+	for {
+		op, started := s.startOffloadedSegmentCopyOp(segment.ID, true)
+		if started {
+			err := s.downloadOffloadedSegment(ctx, segment)
+			s.finishOffloadedSegmentCopyOp(segment.ID, op)
+			return err
+		}
+
+		// segment is being fetched, or its copy removed => wait & check the local copy again
+		select {
+		case <-op.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *Server) downloadOffloadedSegment(ctx context.Context, segment *ClusterSegment) error {
+	if s.segmentDir.Exists(segment.ID) {
+		segmentHandle, err := s.segmentDir.Open(segment.ID)
+		if err != nil {
+			return errors.Wrap(err, "segment open failed")
+		}
+		size := segmentHandle.Size()
+		s.segmentDir.Release(segmentHandle)
+		if size == segment.Size_ {
+			return nil
+		}
+		// incomplete local copy (e.g. interrupted copy to the leader) => replace it
+		if err := s.segmentDir.Remove(segment.ID); err != nil {
+			return errors.Wrapf(err, "segment %d incomplete copy remove failed", segment.ID)
+		}
+	}
+
+	r, err := s.tieredStorage.Get(ctx, offloadedSegmentKey(segment.ID))
+	if err != nil {
+		return errors.Wrapf(err, "segment %d download failed", segment.ID)
+	}
+	defer r.Close()
+
+	if err := s.segmentDir.Import(segment.ID, r); err != nil {
+		return errors.Wrapf(err, "segment %d import failed", segment.ID)
+	}
+
+	segmentHandle, err := s.segmentDir.Open(segment.ID)
+	if err != nil {
+		return errors.Wrap(err, "segment open failed")
+	}
+	sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+	s.segmentDir.Release(segmentHandle)
+	if err == nil && (size != segment.Size_ || !bytes.Equal(sha1Sum, segment.Sha1)) {
+		err = errors.Errorf("expected size: %d, got size: %d", segment.Size_, size)
+	}
+	if err != nil {
+		s.segmentDir.Remove(segment.ID)
+		return errors.Wrapf(err, "segment %d downloaded from tiered storage corrupted", segment.ID)
+	}
+
+	s.logger.Info("segment fetched from tiered storage", segmentFields(segment)...)
+
+	return nil
+}
+
+// Removes local copy of offloaded segment unless it was needed within offloadedSegmentCacheTTL, or it's being fetched
+// right now. Returns true if the copy was removed.
+func (s *Server) removeOffloadedSegmentCopy(segmentID uint64) (bool, error) {
+	op, started := s.startOffloadedSegmentCopyOp(segmentID, false)
+	if !started {
+		return false, nil
+	}
+	defer s.finishOffloadedSegmentCopyOp(segmentID, op)
+
+	s.offloadedSegmentsMutex.Lock()
+	if t, ok := s.offloadedSegmentsFetched[segmentID]; ok && time.Since(t) <= offloadedSegmentCacheTTL {
+		s.offloadedSegmentsMutex.Unlock()
+		return false, nil
+	}
+	delete(s.offloadedSegmentsFetched, segmentID)
+	s.offloadedSegmentsMutex.Unlock()
+
+	if err := s.segmentDir.Remove(segmentID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Forgets fetches of offloaded segments that were deleted from cluster state.
+func (s *Server) forgetOffloadedSegments(state *ClusterState) {
+	s.offloadedSegmentsMutex.Lock()
+	defer s.offloadedSegmentsMutex.Unlock()
+
+	for segmentID := range s.offloadedSegmentsFetched {
+		if segment := state.GetClosedSegment(segmentID); segment == nil || segment.OffloadedAt.IsZero() {
+			delete(s.offloadedSegmentsFetched, segmentID)
+		}
+	}
+}
+
+// Returns objects of segments stored in tiered storage by segment ID.
+func ListOffloadedSegments(ctx context.Context, tieredStorage tiered.Storage) (map[uint64]*tiered.Object, error) {
+	objects, err := tieredStorage.List(ctx, offloadedSegmentKeyPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	offloadedSegments := make(map[uint64]*tiered.Object)
+	for _, object := range objects {
+		if segmentID := offloadedSegmentID(object.Key); segmentID != 0 {
+			offloadedSegments[segmentID] = object
+		}
+	}
+	return offloadedSegments, nil
+}
+
+// Deletes objects in tiered storage of segments that were deleted from cluster state.
+func (s *Server) taskTieredStorageGarbageCollection(ctx context.Context) error {
+	offloadedSegments, err := ListOffloadedSegments(ctx, s.tieredStorage)
+	if err != nil {
+		return err
+	}
+
+	state := s.clusterState.Current()
+	deleteBefore := time.Now().Add(-tieredStorageGarbageGracePeriod)
+	for segmentID, object := range offloadedSegments {
+		if !object.ModifiedAt.Before(deleteBefore) {
+			continue
+		}
+		if segment := state.GetClosedSegment(segmentID); segment != nil && !segment.OffloadedAt.IsZero() {
+			continue
+		}
+
+		if err := s.tieredStorage.Delete(ctx, object.Key); err != nil {
+			return errors.Wrapf(err, "delete of segment %d failed", segmentID)
+		}
+		s.logger.Info("offloaded segment garbage collected from tiered storage", logging.SegmentID(segmentID))
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package mq
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/segments"
+	"eventter.io/mq/tiered"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_SegmentOffload(t *testing.T) {
+	assert := require.New(t)
+
+	dirName, err := ioutil.TempDir("", "tiered")
+	assert.NoError(err)
+	defer os.RemoveAll(dirName)
+	tieredStorage, err := tiered.NewDirStorage(dirName, 0755, 0644)
+	assert.NoError(err)
+
+	ts, err := newTieredTestServer(0, tieredStorage, time.Nanosecond)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-offload-topic",
+				DefaultExchangeType: emq.ExchangeTypeDirect,
+				Shards:              1,
+				ReplicationFactor:   1,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	for _, data := range []string{"1", "2", "3"} {
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-offload-topic",
+			Message: &emq.Message{
+				RoutingKey: "key",
+				Data:       []byte(data),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	openSegments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-offload-topic")
+	assert.Len(openSegments, 1)
+	segmentID := openSegments[0].ID
+
+	ts.CloseSegment(t, ctx, segmentID)
+
+	var segment *ClusterSegment
+	ts.WaitFor(t, func() bool {
+		segment = ts.ClusterStateStore.Current().GetClosedSegment(segmentID)
+		return segment != nil && !segment.OffloadedAt.IsZero()
+	})
+	assert.Empty(segment.Nodes.DoneNodeIDs)
+
+	objects, err := tieredStorage.List(ctx, offloadedSegmentKeyPrefix)
+	assert.NoError(err)
+	assert.Len(objects, 1)
+	assert.Equal(offloadedSegmentKey(segmentID), objects[0].Key)
+	assert.Equal(segmentID, offloadedSegmentID(objects[0].Key))
+	assert.Equal(segment.Size_, objects[0].Size)
+
+	offloadedSegments, err := ListOffloadedSegments(ctx, tieredStorage)
+	assert.NoError(err)
+	assert.Len(offloadedSegments, 1)
+	assert.Equal(objects[0], offloadedSegments[segmentID])
+
+	// local copy is deleted once it's not used (offload task might still hold it for a while)
+	ts.WaitFor(t, func() bool {
+		ts.Server.removeOffloadedSegmentCopy(segmentID)
+		return !ts.Server.segmentDir.Exists(segmentID)
+	})
+
+	// segment is fetched from tiered storage on demand
+	state := ts.ClusterStateStore.Current()
+	var data []string
+	assert.NoError(ts.Server.readSegmentPublishings(ctx, state, segment, func(publishing *Publishing, commitOffset int64) {
+		data = append(data, string(publishing.Message.Data))
+	}))
+	assert.Equal([]string{"1", "2", "3"}, data)
+	assert.True(ts.Server.segmentDir.Exists(segmentID))
+
+	// fetched copy is kept while it's being used
+	removed, err := ts.Server.removeOffloadedSegmentCopy(segmentID)
+	assert.NoError(err)
+	assert.False(removed)
+
+	n := 0
+	assert.NoError(ts.Server.readSegment(ctx, state, segment, func(data []byte, offset int64) error {
+		n++
+		return nil
+	}))
+	assert.Equal(3, n)
+
+	{
+		response, err := ts.Server.DescribeTopic(ctx, &emq.TopicDescribeRequest{
+			Namespace: "default",
+			Name:      "test-offload-topic",
+		})
+		assert.NoError(err)
+		var offloaded *emq.TopicDescribeResponse_Segment
+		for _, describedSegment := range response.Segments {
+			if describedSegment.ID == segmentID {
+				offloaded = describedSegment
+			}
+		}
+		assert.NotNil(offloaded)
+		assert.NotNil(offloaded.OffloadedAt)
+		assert.False(offloaded.UnderReplicated)
+	}
+}
+
+// Tiered storage whose downloads wait until gate is closed.
+type gatedTieredStorage struct {
+	tiered.Storage
+	gate chan struct{}
+	gets int32
+}
+
+func (s *gatedTieredStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	atomic.AddInt32(&s.gets, 1)
+	<-s.gate
+	return s.Storage.Get(ctx, key)
+}
+
+func TestServer_FetchOffloadedSegment(t *testing.T) {
+	assert := require.New(t)
+
+	dirName, err := ioutil.TempDir("", "tiered")
+	assert.NoError(err)
+	defer os.RemoveAll(dirName)
+	dirStorage, err := tiered.NewDirStorage(dirName, 0755, 0644)
+	assert.NoError(err)
+	tieredStorage := &gatedTieredStorage{Storage: dirStorage, gate: make(chan struct{})}
+
+	ts, err := newTieredTestServer(0, tieredStorage, 0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newSegment := func(id uint64) *ClusterSegment {
+		segmentHandle, err := ts.Server.segmentDir.Open(id)
+		assert.NoError(err)
+		assert.NoError(segmentHandle.Write([]byte("hello, world")))
+		segmentHandle.Seal()
+		sha1Sum, size, err := segmentHandle.Sum(sha1.New(), segments.SumAll)
+		assert.NoError(err)
+		data, err := ioutil.ReadAll(segmentHandle.Reader())
+		assert.NoError(err)
+		assert.NoError(ts.Server.segmentDir.Release(segmentHandle))
+		assert.NoError(dirStorage.Put(ctx, offloadedSegmentKey(id), bytes.NewReader(data), size))
+		return &ClusterSegment{ID: id, Size_: size, Sha1: sha1Sum, OffloadedAt: time.Now()}
+	}
+
+	fetched := newSegment(100)
+	assert.NoError(ts.Server.segmentDir.Remove(fetched.ID))
+	other := newSegment(101)
+
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			errs <- ts.Server.fetchOffloadedSegment(ctx, fetched)
+		}()
+	}
+	ts.WaitFor(t, func() bool { return atomic.LoadInt32(&tieredStorage.gets) > 0 })
+
+	// download in progress doesn't block operations on other segments, copy being fetched isn't removed
+	removed, err := ts.Server.removeOffloadedSegmentCopy(other.ID)
+	assert.NoError(err)
+	assert.True(removed)
+	removed, err = ts.Server.removeOffloadedSegmentCopy(fetched.ID)
+	assert.NoError(err)
+	assert.False(removed)
+
+	close(tieredStorage.gate)
+	assert.NoError(<-errs)
+	assert.NoError(<-errs)
+	assert.Equal(int32(1), atomic.LoadInt32(&tieredStorage.gets))
+	assert.True(ts.Server.segmentDir.Exists(fetched.ID))
+}
@@ -5,17 +5,18 @@ import (
 	"context"
 	"crypto/sha1"
 	"io"
-	"log"
 	"math"
 	"runtime"
 
+	"eventter.io/mq/logging"
 	"eventter.io/mq/segments"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 )
 
 func (s *Server) taskSegmentReplication(ctx context.Context, segmentID uint64, nodeID uint64, wait bool) error {
-	log.Printf("starting to replicate segment %d from node %d", segmentID, nodeID)
+	logger := s.logger.With(logging.SegmentID(segmentID), logging.F("source_node_id", NodeIDToString(nodeID)))
+	logger.Info("starting to replicate segment")
 
 	node := s.clusterState.Current().GetNode(nodeID)
 	if node == nil {
@@ -84,7 +85,7 @@ func (s *Server) taskSegmentReplication(ctx context.Context, segmentID uint64, n
 		}
 	}
 
-	log.Printf("completed replication of segment %d from node %d", segmentID, nodeID)
+	logger.Info("completed replication of segment")
 
 	if wait {
 		// primary closed the segment => busy wait for state to replicate
@@ -119,7 +120,7 @@ func (s *Server) taskSegmentReplication(ctx context.Context, segmentID uint64, n
 		return errors.Wrap(err, "close replica failed")
 	}
 
-	log.Printf("closed replica of segment %d", segmentID)
+	logger.Info("closed replica of segment")
 
 	return nil
 }
@@ -0,0 +1,507 @@
+package mq
+
+import (
+	"context"
+	"crypto/sha1"
+	"sort"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/logging"
+	"eventter.io/mq/segments"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Shard is compacted when size of its segments that weren't compacted yet reaches this ratio of the total size.
+	compactionDirtyRatio = 0.5
+	// How often is progress of replication of compacted segments checked.
+	compactionReplicationCheckInterval = 100 * time.Millisecond
+	// Compaction is abandoned if compacted segments aren't replicated in this period.
+	compactionReplicationTimeout = 1 * time.Hour
+)
+
+// Position of message in replaced segment & the corresponding commit offset in compacted segment.
+type compactionRemap struct {
+	segmentID    uint64
+	commitOffset int64
+	outputOffset int64
+}
+
+type compactionOutput struct {
+	segment *ClusterSegment
+	handle  *segments.File
+	remaps  []compactionRemap
+}
+
+func (s *Server) taskTopicCompaction(ctx context.Context, namespaceName string, topicName string) error {
+	state := s.clusterState.Current()
+	topic := state.GetTopic(namespaceName, topicName)
+	if topic == nil || topic.CompactionKey == "" {
+		return nil
+	}
+
+	// compaction runs only on the leader => replacing segments left behind by previous leader won't replace anything
+	var staleSegmentIDs []uint64
+	for _, segment := range state.ReplacingSegments {
+		if segment.OwnerNamespace == namespaceName && segment.OwnerName == topicName {
+			staleSegmentIDs = append(staleSegmentIDs, segment.ID)
+		}
+	}
+	if err := s.deleteReplacingSegments(staleSegmentIDs); err != nil {
+		return errors.Wrap(err, "delete of stale replacing segments failed")
+	}
+
+	// newest message for a key can be determined only within shard, messages across shards aren't ordered
+	shards := make(map[uint32][]*ClusterSegment)
+	for _, segment := range state.ClosedSegments {
+		if segment.Type == ClusterSegment_TOPIC && segment.OwnerNamespace == namespaceName && segment.OwnerName == topicName &&
+			(len(segment.Nodes.DoneNodeIDs) > 0 || !segment.OffloadedAt.IsZero()) {
+			shards[segment.Shard] = append(shards[segment.Shard], segment)
+		}
+	}
+
+	for shard, shardSegments := range shards {
+		if !compactionNeeded(shardSegments) {
+			continue
+		}
+
+		sort.Slice(shardSegments, func(i, j int) bool {
+			if shardSegments[i].CreatedAt.Equal(shardSegments[j].CreatedAt) {
+				return shardSegments[i].ID < shardSegments[j].ID
+			}
+			return shardSegments[i].CreatedAt.Before(shardSegments[j].CreatedAt)
+		})
+
+		if err := s.compactSegments(ctx, state, topic, namespaceName, shardSegments); err != nil {
+			return errors.Wrapf(err, "compaction of shard %d failed", shard)
+		}
+	}
+
+	return nil
+}
+
+func compactionNeeded(segments []*ClusterSegment) bool {
+	var (
+		dirty     int
+		dirtySize int64
+		totalSize int64
+	)
+	for _, segment := range segments {
+		totalSize += segment.Size_
+		if !segment.Compacted {
+			dirty++
+			dirtySize += segment.Size_
+		}
+	}
+	return dirty > 0 && float64(dirtySize) >= compactionDirtyRatio*float64(totalSize)
+}
+
+// Rewrites segments (ordered from the oldest) into new closed segment(s) with only the newest message for each key.
+func (s *Server) compactSegments(ctx context.Context, state *ClusterState, topic *ClusterTopic, namespaceName string, inputs []*ClusterSegment) error {
+	logger := s.logger.With(logging.Namespace(namespaceName), logging.Topic(topic.Name), logging.F("shard", inputs[0].Shard))
+
+	// 1) find the newest message for each key
+
+	type position struct {
+		segmentID    uint64
+		commitOffset int64
+	}
+	newest := make(map[string]position)
+	for _, input := range inputs {
+		err := s.readSegmentPublishings(ctx, state, input, func(publishing *Publishing, commitOffset int64) {
+			if key := messageKey(topic.CompactionKey, publishing.Message); key != "" {
+				newest[key] = position{input.ID, commitOffset}
+			}
+		})
+		if err != nil {
+			return errors.Wrapf(err, "segment %d read failed", input.ID)
+		}
+	}
+
+	// 2) write messages to be kept to new segments
+
+	createdAt := inputs[0].CreatedAt
+	var (
+		closedAt   time.Time
+		generation uint32
+		replaced   = make(map[uint64]bool)
+	)
+	for _, input := range inputs {
+		if input.ClosedAt.After(closedAt) {
+			closedAt = input.ClosedAt
+		}
+		if input.Generation > generation {
+			generation = input.Generation
+		}
+		replaced[input.ID] = true
+	}
+	tombstonesBefore := time.Time{}
+	if topic.Retention > 0 {
+		tombstonesBefore = time.Now().Add(-topic.Retention)
+	}
+
+	var outputs []*compactionOutput
+	defer func() {
+		for _, output := range outputs {
+			s.segmentDir.Release(output.handle)
+		}
+	}()
+
+	openOutput := func() (*compactionOutput, error) {
+		segmentID := s.clusterState.NextSegmentID()
+		handle, err := s.segmentDir.Open(segmentID)
+		if err != nil {
+			return nil, errors.Wrap(err, "segment open failed")
+		}
+		output := &compactionOutput{
+			segment: &ClusterSegment{
+				ID:             segmentID,
+				Type:           ClusterSegment_TOPIC,
+				OwnerNamespace: namespaceName,
+				OwnerName:      topic.Name,
+				Generation:     generation,
+				Shard:          inputs[0].Shard,
+				CreatedAt:      createdAt,
+				ClosedAt:       closedAt,
+				Nodes: ClusterSegment_Nodes{
+					DoneNodeIDs: []uint64{s.nodeID},
+				},
+				Compacted: true,
+			},
+			handle: handle,
+		}
+		outputs = append(outputs, output)
+		return output, nil
+	}
+
+	var (
+		output   *compactionOutput
+		writeErr error
+		kept     int
+		removed  int
+	)
+	for _, input := range inputs {
+		err := s.readSegmentPublishings(ctx, state, input, func(publishing *Publishing, commitOffset int64) {
+			if writeErr != nil {
+				return
+			}
+
+			messageTime := input.CreatedAt.Add(time.Duration(publishing.Delta))
+			if key := messageKey(topic.CompactionKey, publishing.Message); key != "" {
+				if newest[key] != (position{input.ID, commitOffset}) {
+					removed++
+					return
+				}
+				if len(publishing.Message.Data) == 0 && messageTime.Before(tombstonesBefore) {
+					// tombstone fell off retention period => key can be forgotten
+					removed++
+					return
+				}
+			}
+
+			publishing.Delta = int64(messageTime.Sub(createdAt))
+			buf, err := proto.Marshal(publishing)
+			if err != nil {
+				writeErr = errors.Wrap(err, "marshal failed")
+				return
+			}
+
+			if output == nil {
+				if output, writeErr = openOutput(); writeErr != nil {
+					return
+				}
+			}
+			if err := output.handle.Write(buf); err == segments.ErrFull {
+				if output, writeErr = openOutput(); writeErr != nil {
+					return
+				}
+				err = output.handle.Write(buf)
+				if err != nil {
+					writeErr = errors.Wrap(err, "segment write failed")
+					return
+				}
+			} else if err != nil {
+				writeErr = errors.Wrap(err, "segment write failed")
+				return
+			}
+
+			output.remaps = append(output.remaps, compactionRemap{
+				segmentID:    input.ID,
+				commitOffset: commitOffset,
+				outputOffset: output.handle.Size(),
+			})
+			kept++
+		})
+		if err != nil {
+			return errors.Wrapf(err, "segment %d read failed", input.ID)
+		}
+		if writeErr != nil {
+			return writeErr
+		}
+	}
+
+	for _, output := range outputs {
+		output.handle.Seal()
+		sha1Sum, size, err := output.handle.Sum(sha1.New(), segments.SumAll)
+		if err != nil {
+			return errors.Wrap(err, "segment sum failed")
+		}
+		output.segment.Size_ = size
+		output.segment.Sha1 = sha1Sum
+	}
+
+	// 3) replicate new segments, replaced segments are kept until there are enough replicas of the new ones
+
+	outputIDs := make([]uint64, 0, len(outputs))
+	for _, output := range outputs {
+		outputIDs = append(outputIDs, output.segment.ID)
+	}
+	replacingDone := false
+	defer func() {
+		if replacingDone {
+			return
+		}
+		if err := s.deleteReplacingSegments(outputIDs); err != nil {
+			logger.Error("could not delete replacing segments of failed compaction", logging.Error(err))
+		}
+	}()
+
+	if err := s.replicateCompactedSegments(ctx, topic, outputs); err != nil {
+		return errors.Wrap(err, "replication failed")
+	}
+
+	// 4) get offsets consumer groups committed so far, cluster state holds only offsets as of the last rotation of
+	// their offset commits segments
+
+	namespace, _ := state.FindNamespace(namespaceName)
+	if namespace == nil {
+		return errors.Errorf(namespaceNotFoundErrorFormat, namespaceName)
+	}
+	committedOffsets := make(map[string]map[uint64]int64)
+	for _, consumerGroup := range namespace.ConsumerGroups {
+		if !readsReplacedSegments(consumerGroup, replaced) {
+			continue
+		}
+		response, err := s.ExportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsExportRequest{
+			Namespace: namespaceName,
+			Name:      consumerGroup.Name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "consumer group %s offsets export failed", consumerGroup.Name)
+		}
+		offsets := make(map[uint64]int64)
+		for _, offset := range response.Offsets {
+			if replaced[offset.SegmentID] {
+				offsets[offset.SegmentID] = offset.Offset
+			}
+		}
+		committedOffsets[consumerGroup.Name] = offsets
+	}
+
+	// 5) replace segments & remap consumer group offsets in single transaction
+
+	if err := s.beginTransaction(); err != nil {
+		return err
+	}
+	defer s.releaseTransaction()
+
+	state = s.clusterState.Current()
+	cmd := &ClusterCommandSegmentsReplace{}
+	for _, input := range inputs {
+		if state.GetClosedSegment(input.ID) == nil {
+			return errors.Errorf("segment %d deleted in between", input.ID)
+		}
+		cmd.ReplacedSegmentIDs = append(cmd.ReplacedSegmentIDs, input.ID)
+	}
+	for _, output := range outputs {
+		segment := state.GetReplacingSegment(output.segment.ID)
+		if segment == nil {
+			return errors.Errorf("replacing segment %d deleted in between", output.segment.ID)
+		}
+		cmd.Segments = append(cmd.Segments, segment)
+	}
+
+	namespace, _ = state.FindNamespace(namespaceName)
+	if namespace == nil {
+		return errors.Errorf(namespaceNotFoundErrorFormat, namespaceName)
+	}
+	for _, consumerGroup := range namespace.ConsumerGroups {
+		if !readsReplacedSegments(consumerGroup, replaced) {
+			continue
+		}
+		if consumerGroup.OffsetCommitsImportPending {
+			// offsets committed before the import might be remapped => wait for the import to complete
+			return errors.Errorf("consumer group %s offsets import pending", consumerGroup.Name)
+		}
+		cmd.OffsetCommitsUpdates = append(
+			cmd.OffsetCommitsUpdates,
+			remapOffsetCommits(namespaceName, consumerGroup, committedOffsets[consumerGroup.Name], replaced, outputs),
+		)
+	}
+
+	if _, err := s.Apply(cmd); err != nil {
+		return errors.Wrap(err, "apply failed")
+	}
+	replacingDone = true
+
+	logger.Info(
+		"segments compacted",
+		logging.F("replaced_segments", len(inputs)),
+		logging.F("new_segments", len(outputs)),
+		logging.F("kept_messages", kept),
+		logging.F("removed_messages", removed),
+	)
+
+	return nil
+}
+
+// Registers compacted segments as replacing segments with replicas assigned to other nodes & waits until they're
+// replicated.
+func (s *Server) replicateCompactedSegments(ctx context.Context, topic *ClusterTopic, outputs []*compactionOutput) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	if err := s.beginTransaction(); err != nil {
+		return err
+	}
+
+	state := s.clusterState.Current()
+	nodeMap := make(map[uint64]*ClusterNode)
+	var candidateNodeIDs []uint64
+	for _, node := range state.Nodes {
+		nodeMap[node.ID] = node
+		if node.ID != s.nodeID && node.IsActive() && !node.IsDiskFull(s.config.DiskHighWatermark) {
+			candidateNodeIDs = append(candidateNodeIDs, node.ID)
+		}
+	}
+	nodeSegmentCounts := state.CountSegmentsPerNode()
+
+	cmd := &ClusterCommandSegmentsReplacingCreate{}
+	for _, output := range outputs {
+		// if there aren't enough nodes, reconciler adds missing replicas after segments are replaced
+		output.segment.Nodes.ReplicatingNodeIDs = selectNodes(
+			candidateNodeIDs,
+			int(topic.ReplicationFactor)-len(output.segment.Nodes.DoneNodeIDs),
+			output.segment.Nodes.DoneNodeIDs,
+			nodeMap,
+			nodeSegmentCounts,
+		)
+		cmd.Segments = append(cmd.Segments, output.segment)
+	}
+
+	_, err := s.Apply(cmd)
+	s.releaseTransaction()
+	if err != nil {
+		return errors.Wrap(err, "apply failed")
+	}
+
+	ticker := time.NewTicker(compactionReplicationCheckInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(compactionReplicationTimeout)
+	defer timeout.Stop()
+
+	for {
+		state := s.clusterState.Current()
+		replicated := true
+		for _, output := range outputs {
+			segment := state.GetReplacingSegment(output.segment.ID)
+			if segment == nil {
+				return errors.Errorf("replacing segment %d deleted in between", output.segment.ID)
+			}
+			for _, nodeID := range segment.Nodes.ReplicatingNodeIDs {
+				if node := state.GetNode(nodeID); node == nil || !node.IsActive() {
+					return errors.Errorf("node %s replicating segment %d isn't active", NodeIDToString(nodeID), segment.ID)
+				}
+				replicated = false
+			}
+		}
+		if replicated {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			return errors.New("timed out")
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) deleteReplacingSegments(segmentIDs []uint64) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+
+	if err := s.beginTransaction(); err != nil {
+		return err
+	}
+	defer s.releaseTransaction()
+
+	for _, segmentID := range segmentIDs {
+		_, err := s.Apply(&ClusterCommandSegmentDelete{
+			ID:    segmentID,
+			Which: ClusterCommandSegmentDelete_REPLACING,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "delete of segment %d failed", segmentID)
+		}
+	}
+
+	return nil
+}
+
+func readsReplacedSegments(consumerGroup *ClusterConsumerGroup, replaced map[uint64]bool) bool {
+	for _, commit := range consumerGroup.OffsetCommits {
+		if replaced[commit.SegmentID] {
+			return true
+		}
+	}
+	return false
+}
+
+// Moves consumer group's offset commits from replaced segments to compacted ones, commits of other segments are kept
+// as they are. Commit offset in compacted segment stays before the first message that wasn't consumed from its replaced
+// segment, so no message is skipped (but some may be delivered again). Committed offsets (if known) take precedence
+// over offsets in cluster state.
+func remapOffsetCommits(namespaceName string, consumerGroup *ClusterConsumerGroup, committedOffsets map[uint64]int64, replaced map[uint64]bool, outputs []*compactionOutput) *ClusterCommandConsumerGroupOffsetCommitsUpdate {
+	replacedOffsets := make(map[uint64]int64)
+	var commits []*ClusterConsumerGroup_OffsetCommit
+	for _, commit := range consumerGroup.OffsetCommits {
+		if replaced[commit.SegmentID] {
+			offset := commit.Offset
+			if committedOffset, ok := committedOffsets[commit.SegmentID]; ok && committedOffset > offset {
+				offset = committedOffset
+			}
+			replacedOffsets[commit.SegmentID] = offset
+		} else {
+			commits = append(commits, commit)
+		}
+	}
+
+	for _, output := range outputs {
+		var offset int64
+		for _, remap := range output.remaps {
+			// group has no commit in replaced segment if it was created after the segment had been closed, such
+			// segment isn't read by the group, i.e. its messages count as consumed
+			if replacedOffset, ok := replacedOffsets[remap.segmentID]; ok && remap.commitOffset > replacedOffset {
+				break
+			}
+			offset = remap.outputOffset
+		}
+		commits = append(commits, &ClusterConsumerGroup_OffsetCommit{
+			SegmentID: output.segment.ID,
+			Offset:    offset,
+		})
+	}
+
+	return &ClusterCommandConsumerGroupOffsetCommitsUpdate{
+		Namespace:     namespaceName,
+		Name:          consumerGroup.Name,
+		OffsetCommits: commits,
+	}
+}
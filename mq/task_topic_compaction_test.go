@@ -0,0 +1,309 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_TopicCompaction(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-compaction-topic",
+				DefaultExchangeType: emq.ExchangeTypeDirect,
+				Shards:              1,
+				ReplicationFactor:   1,
+				CompactionKey:       emq.PartitionKeyRoutingKey,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-compaction-consumer-group",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-compaction-topic", ExchangeType: emq.ExchangeTypeFanout},
+				},
+				// consumer group must not read ahead, otherwise it'd commit the whole segment
+				Size_: 1,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ts.WaitForConsumerGroup(t, ctx, "default", "test-compaction-consumer-group")
+	}
+
+	for _, message := range []struct {
+		key  string
+		data string
+	}{
+		{"a", "1"},
+		{"b", "1"},
+		{"a", "2"},
+		{"b", ""}, // tombstone
+		{"c", "1"},
+	} {
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-compaction-topic",
+			Message: &emq.Message{
+				RoutingKey: message.key,
+				Data:       []byte(message.data),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	state := ts.ClusterStateStore.Current()
+	openSegments := state.FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-compaction-topic")
+	assert.Len(openSegments, 1)
+	segmentID := openSegments[0].ID
+
+	var commitOffsets []int64
+	assert.NoError(ts.Server.readSegmentPublishings(ctx, state, openSegments[0], func(publishing *Publishing, commitOffset int64) {
+		commitOffsets = append(commitOffsets, commitOffset)
+	}))
+	assert.Len(commitOffsets, 5)
+
+	ts.Server.groupMutex.Lock()
+	group := ts.Server.groups[ts.Server.makeConsumerGroupMapKey("default", "test-compaction-consumer-group")]
+	ts.Server.groupMutex.Unlock()
+	assert.NotNil(group)
+
+	subscription := group.Subscribe()
+	defer subscription.Close()
+	subscription.SetSize(1)
+
+	// consumer group consumes messages up to the newest value of "a"
+	for _, expected := range []string{"1", "1", "2"} {
+		m, err := subscription.Next()
+		assert.NoError(err)
+		assert.Equal(expected, string(m.Message.Data))
+		assert.NoError(subscription.Ack(m.SeqNo))
+	}
+
+	exportOffset := func(segmentID uint64) int64 {
+		response, err := ts.Server.ExportConsumerGroupOffsets(ctx, &emq.ConsumerGroupOffsetsExportRequest{
+			Namespace: "default",
+			Name:      "test-compaction-consumer-group",
+		})
+		assert.NoError(err)
+		for _, offset := range response.Offsets {
+			if offset.SegmentID == segmentID {
+				return offset.Offset
+			}
+		}
+		return -1
+	}
+	ts.WaitFor(t, func() bool { return exportOffset(segmentID) == commitOffsets[2] })
+
+	consumerGroup := ts.ClusterStateStore.Current().GetConsumerGroup("default", "test-compaction-consumer-group")
+	generation := consumerGroup.OffsetCommitsGeneration
+	for _, commit := range consumerGroup.OffsetCommits {
+		// committed offsets are in offset commits segment, not in cluster state
+		assert.Equal(int64(0), commit.Offset)
+	}
+
+	ts.CloseSegment(t, ctx, segmentID)
+
+	var compacted *ClusterSegment
+	ts.WaitFor(t, func() bool {
+		state = ts.ClusterStateStore.Current()
+		for _, segment := range state.ClosedSegments {
+			if segment.OwnerName == "test-compaction-topic" && segment.Compacted {
+				compacted = segment
+			}
+		}
+		return compacted != nil
+	})
+	assert.Nil(state.GetSegment(segmentID))
+	assert.Equal(openSegments[0].CreatedAt, compacted.CreatedAt)
+
+	var (
+		keys    []string
+		data    []string
+		offsets []int64
+	)
+	assert.NoError(ts.Server.readSegmentPublishings(ctx, state, compacted, func(publishing *Publishing, commitOffset int64) {
+		keys = append(keys, publishing.Message.RoutingKey)
+		data = append(data, string(publishing.Message.Data))
+		offsets = append(offsets, commitOffset)
+	}))
+	assert.Equal([]string{"a", "b", "c"}, keys)
+	assert.Equal([]string{"2", "", "1"}, data)
+	assert.Equal(compacted.Size_, offsets[len(offsets)-1])
+
+	consumerGroup = state.GetConsumerGroup("default", "test-compaction-consumer-group")
+	assert.Equal(generation, consumerGroup.OffsetCommitsGeneration)
+	assert.False(consumerGroup.OffsetCommitsImportPending)
+	assert.Equal(int64(-1), exportOffset(segmentID))
+	assert.Equal(offsets[0], exportOffset(compacted.ID))
+
+	// consumer group continues after the newest value of "a" (messages it read from the replaced segment before the
+	// compaction are delivered again)
+	for {
+		m, err := subscription.Next()
+		assert.NoError(err)
+		assert.NotEqual("a", m.Message.RoutingKey)
+		assert.NoError(subscription.Ack(m.SeqNo))
+		if m.SegmentID == compacted.ID && m.Message.RoutingKey == "c" {
+			break
+		}
+	}
+	ts.WaitFor(t, func() bool { return exportOffset(compacted.ID) == compacted.Size_ })
+}
+
+func TestServer_TopicCompactionReplication(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// node 42 isn't discovered => reconciliation marks it dead, after that, there's a period until the next one when it
+	// can be marked alive
+	node := &ClusterCommandNodeUpdate{
+		ID:      42,
+		Address: "127.0.0.1:42",
+		State:   ClusterNode_ALIVE,
+	}
+	_, err = ts.Server.Apply(node)
+	assert.NoError(err)
+	for deadline := time.Now().Add(15 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if ts.ClusterStateStore.Current().GetNode(42).State == ClusterNode_DEAD {
+			break
+		}
+	}
+	assert.Equal(ClusterNode_DEAD, ts.ClusterStateStore.Current().GetNode(42).State)
+	_, err = ts.Server.Apply(node)
+	assert.NoError(err)
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-compaction-replication-topic",
+				DefaultExchangeType: emq.ExchangeTypeDirect,
+				Shards:              1,
+				ReplicationFactor:   2,
+				CompactionKey:       emq.PartitionKeyRoutingKey,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	for _, key := range []string{"a", "a"} {
+		response, err := ts.Server.Publish(ctx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-compaction-replication-topic",
+			Message: &emq.Message{
+				RoutingKey: key,
+				Data:       []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	openSegments := ts.ClusterStateStore.Current().FindOpenSegmentsFor(ClusterSegment_TOPIC, "default", "test-compaction-replication-topic")
+	assert.Len(openSegments, 1)
+	segmentID := openSegments[0].ID
+
+	ts.CloseSegment(t, ctx, segmentID)
+
+	// compacted segment waits for replica on the other node, replaced segment is kept meanwhile
+	var replacing *ClusterSegment
+	ts.WaitFor(t, func() bool {
+		for _, segment := range ts.ClusterStateStore.Current().ReplacingSegments {
+			if segment.OwnerName == "test-compaction-replication-topic" {
+				replacing = segment
+			}
+		}
+		return replacing != nil
+	})
+	assert.Equal([]uint64{ts.Server.nodeID}, replacing.Nodes.DoneNodeIDs)
+	assert.Equal([]uint64{42}, replacing.Nodes.ReplicatingNodeIDs)
+	assert.NotNil(ts.ClusterStateStore.Current().GetClosedSegment(segmentID))
+	assert.Nil(ts.ClusterStateStore.Current().GetClosedSegment(replacing.ID))
+
+	_, err = ts.Server.SegmentReplicaClose(ctx, &SegmentCloseRequest{
+		NodeID:    42,
+		SegmentID: replacing.ID,
+		Size_:     replacing.Size_,
+		Sha1:      replacing.Sha1,
+	})
+	assert.NoError(err)
+
+	ts.WaitFor(t, func() bool { return ts.ClusterStateStore.Current().GetClosedSegment(replacing.ID) != nil })
+	state := ts.ClusterStateStore.Current()
+	assert.Nil(state.GetSegment(segmentID))
+	assert.Nil(state.GetReplacingSegment(replacing.ID))
+	compacted := state.GetClosedSegment(replacing.ID)
+	assert.True(compacted.Compacted)
+	assert.Equal([]uint64{ts.Server.nodeID, 42}, compacted.Nodes.DoneNodeIDs)
+	assert.Empty(compacted.Nodes.ReplicatingNodeIDs)
+}
+
+func TestRemapOffsetCommits(t *testing.T) {
+	assert := require.New(t)
+
+	// segment 1 was closed before consumer group had been created, so the group has commit only in segment 2
+	consumerGroup := &ClusterConsumerGroup{
+		Name: "cg",
+		OffsetCommits: []*ClusterConsumerGroup_OffsetCommit{
+			{SegmentID: 2, Offset: 50},
+			{SegmentID: 3, Offset: 70},
+		},
+	}
+	replaced := map[uint64]bool{1: true, 2: true}
+	outputs := []*compactionOutput{
+		{
+			segment: &ClusterSegment{ID: 4},
+			remaps: []compactionRemap{
+				{segmentID: 1, commitOffset: 10, outputOffset: 10},
+				{segmentID: 1, commitOffset: 20, outputOffset: 20},
+				{segmentID: 2, commitOffset: 30, outputOffset: 30},
+				{segmentID: 2, commitOffset: 60, outputOffset: 40},
+			},
+		},
+	}
+
+	cmd := remapOffsetCommits("default", consumerGroup, nil, replaced, outputs)
+	assert.Equal("default", cmd.Namespace)
+	assert.Equal("cg", cmd.Name)
+	assert.Equal([]*ClusterConsumerGroup_OffsetCommit{
+		{SegmentID: 3, Offset: 70},
+		{SegmentID: 4, Offset: 30},
+	}, cmd.OffsetCommits)
+
+	// committed offset newer than the one in cluster state takes precedence
+	cmd = remapOffsetCommits("default", consumerGroup, map[uint64]int64{2: 60}, replaced, outputs)
+	assert.Equal([]*ClusterConsumerGroup_OffsetCommit{
+		{SegmentID: 3, Offset: 70},
+		{SegmentID: 4, Offset: 40},
+	}, cmd.OffsetCommits)
+}
@@ -2,14 +2,15 @@ package tasks
 
 import (
 	"context"
-	"log"
 	"sync/atomic"
+
+	"eventter.io/mq/logging"
 )
 
 var currentTaskID uint64
 
 type TaskManager struct {
-	name          string
+	logger        logging.Logger
 	managerCtx    context.Context
 	cancelAll     func()
 	currentID     uint64
@@ -18,11 +19,11 @@ type TaskManager struct {
 	closed        uint32
 }
 
-func NewManager(ctx context.Context, name string) *TaskManager {
+func NewManager(ctx context.Context, logger logging.Logger) *TaskManager {
 	managerCtx, cancelAll := context.WithCancel(ctx)
 	completed := make(chan *Task, 128)
 	return &TaskManager{
-		name:          name,
+		logger:        logger,
 		managerCtx:    managerCtx,
 		cancelAll:     cancelAll,
 		Completed:     completed,
@@ -39,6 +40,8 @@ func (m *TaskManager) Start(name string, run func(ctx context.Context) error, da
 		Cancel: cancel,
 	}
 
+	logger := m.logger.With(logging.F("task_id", task.ID), logging.F("task", task.Name))
+
 	go func() {
 		defer func() {
 			cancel()
@@ -48,14 +51,14 @@ func (m *TaskManager) Start(name string, run func(ctx context.Context) error, da
 		}()
 		err := run(ctx)
 		if err == nil {
-			log.Printf("[%s] task %d (%s) completed successfully", m.name, task.ID, task.Name)
+			logger.Info("task completed successfully")
 		} else {
 			task.Err = err
-			log.Printf("[%s] task %d (%s) failed: %v", m.name, task.ID, task.Name, err)
+			logger.Error("task failed", logging.Error(err))
 		}
 	}()
 
-	log.Printf("[%s] task %d (%s) started", m.name, task.ID, task.Name)
+	logger.Info("task started")
 
 	return task
 }
@@ -0,0 +1,136 @@
+package tiered
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DirStorage stores objects as files in directory, keys are paths relative to the directory.
+type DirStorage struct {
+	dirName  string
+	dirPerm  os.FileMode
+	filePerm os.FileMode
+}
+
+var _ Storage = (*DirStorage)(nil)
+
+func NewDirStorage(dirName string, dirPerm os.FileMode, filePerm os.FileMode) (*DirStorage, error) {
+	if err := os.MkdirAll(dirName, dirPerm); err != nil {
+		return nil, errors.Wrap(err, "mkdir failed")
+	}
+
+	return &DirStorage{
+		dirName:  dirName,
+		dirPerm:  dirPerm,
+		filePerm: filePerm,
+	}, nil
+}
+
+func (s *DirStorage) getPath(key string) (string, error) {
+	path := filepath.Join(s.dirName, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, filepath.Clean(s.dirName)+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid key %q", key)
+	}
+	return path, nil
+}
+
+func (s *DirStorage) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	path, err := s.getPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), s.dirPerm); err != nil {
+		return errors.Wrap(err, "mkdir failed")
+	}
+
+	tmpPath := path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, s.filePerm)
+	if err != nil {
+		return errors.Wrap(err, "open failed")
+	}
+
+	n, err := io.Copy(file, r)
+	if err == nil && n != size {
+		err = errors.Errorf("expected %d bytes, got %d", size, n)
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "write failed")
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return errors.Wrap(err, "rename failed")
+	}
+	return nil
+}
+
+func (s *DirStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.getPath(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "open failed")
+	}
+	return file, nil
+}
+
+func (s *DirStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.getPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove failed")
+	}
+	return nil
+}
+
+func (s *DirStorage) List(ctx context.Context, prefix string) ([]*Object, error) {
+	var objects []*Object
+
+	err := filepath.Walk(s.dirName, func(path string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fileInfo.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(s.dirName, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relPath)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		objects = append(objects, &Object{
+			Key:        key,
+			Size:       fileInfo.Size(),
+			ModifiedAt: fileInfo.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "walk failed")
+	}
+
+	return objects, nil
+}
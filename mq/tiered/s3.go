@@ -0,0 +1,307 @@
+package tiered
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	s3DefaultRegion   = "us-east-1"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+)
+
+// S3Storage stores objects in S3-compatible object storage (AWS S3, MinIO, Ceph RGW, ...). Requests are signed with
+// AWS Signature Version 4, buckets are addressed path-style (`endpoint/bucket/key`).
+type S3Storage struct {
+	endpoint  string
+	bucket    string
+	prefix    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+var _ Storage = (*S3Storage)(nil)
+
+// NewS3Storage creates storage for bucket at the endpoint (e.g. `http://localhost:9000`). All keys are prefixed with
+// the prefix. If credentials are empty, requests are sent unsigned (anonymous).
+func NewS3Storage(endpoint string, bucket string, prefix string, region string, accessKey string, secretKey string) (*S3Storage, error) {
+	if endpoint == "" {
+		return nil, errors.New("endpoint not set")
+	}
+	if bucket == "" {
+		return nil, errors.New("bucket not set")
+	}
+	if region == "" {
+		region = s3DefaultRegion
+	}
+
+	return &S3Storage{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		bucket:    bucket,
+		prefix:    prefix,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{},
+		now:       time.Now,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	request, err := s.newRequest(ctx, http.MethodPut, s.prefix+key, nil, r)
+	if err != nil {
+		return err
+	}
+	request.ContentLength = size
+	if size == 0 {
+		request.Body = http.NoBody
+	}
+
+	response, err := s.do(request)
+	if err != nil {
+		return err
+	}
+	return drain(response)
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	request, err := s.newRequest(ctx, http.MethodGet, s.prefix+key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.do(request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	request, err := s.newRequest(ctx, http.MethodDelete, s.prefix+key, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.do(request)
+	if err == ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return drain(response)
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key          string
+		Size         int64
+		LastModified time.Time
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]*Object, error) {
+	var (
+		objects           []*Object
+		continuationToken string
+	)
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", s.prefix+prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		request, err := s.newRequest(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := s.do(request)
+		if err != nil {
+			return nil, err
+		}
+
+		result := s3ListBucketResult{}
+		err = xml.NewDecoder(response.Body).Decode(&result)
+		response.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "decode failed")
+		}
+
+		for _, content := range result.Contents {
+			objects = append(objects, &Object{
+				Key:        strings.TrimPrefix(content.Key, s.prefix),
+				Size:       content.Size,
+				ModifiedAt: content.LastModified,
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (s *S3Storage) newRequest(ctx context.Context, method string, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	path := "/" + s.bucket
+	if key != "" {
+		path += "/" + key
+	}
+
+	rawURL := s.endpoint + s3EscapePath(path)
+	if len(query) > 0 {
+		rawURL += "?" + s3CanonicalQuery(query)
+	}
+
+	request, err := http.NewRequest(method, rawURL, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "new request failed")
+	}
+	return request.WithContext(ctx), nil
+}
+
+func (s *S3Storage) do(request *http.Request) (*http.Response, error) {
+	s.sign(request, s.now())
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s request failed", request.Method)
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		response.Body.Close()
+		return nil, ErrNotFound
+	} else if response.StatusCode/100 != 2 {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 4096))
+		response.Body.Close()
+		return nil, errors.Errorf("%s request failed with status %d: %s", request.Method, response.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return response, nil
+}
+
+// Signs request using AWS Signature Version 4, payload is left unsigned.
+func (s *S3Storage) sign(request *http.Request, now time.Time) {
+	if s.accessKey == "" {
+		return
+	}
+
+	now = now.UTC()
+	if request.Header.Get("X-Amz-Content-Sha256") == "" {
+		request.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+	}
+	request.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+
+	headerNames := []string{"host"}
+	for name := range request.Header {
+		name = strings.ToLower(name)
+		if name == "x-amz-content-sha256" || name == "x-amz-date" || name == "range" || name == "content-type" {
+			headerNames = append(headerNames, name)
+		}
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headerNames {
+		value := request.Host
+		if name == "host" {
+			if value == "" {
+				value = request.URL.Host
+			}
+		} else {
+			value = request.Header.Get(name)
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		s3EscapePath(request.URL.Path),
+		s3CanonicalQuery(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		request.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	scope := now.Format(s3DateFormat) + "/" + s.region + "/s3/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + now.Format(s3TimeFormat) + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := s3HMAC([]byte("AWS4"+s.secretKey), now.Format(s3DateFormat))
+	key = s3HMAC(key, s.region)
+	key = s3HMAC(key, "s3")
+	key = s3HMAC(key, "aws4_request")
+	signature := hex.EncodeToString(s3HMAC(key, stringToSign))
+
+	request.Header.Set(
+		"Authorization",
+		fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", s.accessKey, scope, signedHeaders, signature),
+	)
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// Escapes path as required by AWS Signature Version 4, i.e. everything except unreserved characters & slashes.
+func s3EscapePath(path string) string {
+	return s3Escape(path, false)
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, s3Escape(name, true)+"="+s3Escape(value, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !escapeSlash) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func drain(response *http.Response) error {
+	defer response.Body.Close()
+	_, err := io.Copy(ioutil.Discard, response.Body)
+	return err
+}
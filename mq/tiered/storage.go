@@ -0,0 +1,76 @@
+package tiered
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores objects (e.g. segment files offloaded from local disks) in cheaper, usually remote, storage.
+type Storage interface {
+	// Put stores object of given size read from r under the key. Existing object is replaced.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get returns reader of object's data. If object does not exist, returned error is ErrNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes object. Deleting object that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns all objects whose keys start with the prefix.
+	List(ctx context.Context, prefix string) ([]*Object, error)
+}
+
+type Object struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// Options of S3-compatible storage not contained in its URL.
+type Options struct {
+	Region    string
+	AccessKey string
+	SecretKey string
+}
+
+// Open returns storage for URL. `file:///path/to/dir` stores objects in local (usually network-mounted) directory,
+// `http(s)://host:port/bucket[/prefix]` stores objects in S3-compatible object storage (path-style addressing).
+func Open(rawURL string, options Options) (Storage, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse url failed")
+	}
+
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return nil, errors.New("file url must contain path")
+		}
+		return NewDirStorage(u.Path, 0755, 0644)
+
+	case "http", "https":
+		parts := strings.SplitN(strings.Trim(u.Path, "/"), "/", 2)
+		if parts[0] == "" {
+			return nil, errors.New("s3 url must contain bucket")
+		}
+		prefix := ""
+		if len(parts) > 1 && parts[1] != "" {
+			prefix = strings.TrimSuffix(parts[1], "/") + "/"
+		}
+		if options.AccessKey == "" {
+			options.AccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		}
+		if options.SecretKey == "" {
+			options.SecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		}
+		return NewS3Storage(u.Scheme+"://"+u.Host, parts[0], prefix, options.Region, options.AccessKey, options.SecretKey)
+
+	default:
+		return nil, errors.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+}
@@ -0,0 +1,34 @@
+package mq
+
+import (
+	"context"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/tracing"
+)
+
+const (
+	traceAttributeNodeID        = "eventter.node_id"
+	traceAttributeForwardNodeID = "eventter.forward_node_id"
+	traceAttributeSourceNodeID  = "eventter.source_node_id"
+	traceAttributeNamespace     = "eventter.namespace"
+	traceAttributeTopic         = "eventter.topic"
+	traceAttributeConsumerGroup = "eventter.consumer_group"
+	traceAttributeSegmentID     = "eventter.segment_id"
+	traceAttributeSubscription  = "eventter.subscription_id"
+)
+
+// Starts span continuing trace stored in message headers. Messages published without trace context aren't traced,
+// nil span is returned for them.
+func (s *Server) startMessageSpan(ctx context.Context, message *emq.Message, name string, kind tracing.Kind) (context.Context, *tracing.Span) {
+	if message == nil {
+		return ctx, nil
+	}
+	ctx = tracing.ExtractHeaders(ctx, message.Headers)
+	if !tracing.HasParent(ctx) {
+		return ctx, nil
+	}
+	ctx, span := s.tracer.Start(ctx, name, kind)
+	span.SetAttribute(traceAttributeNodeID, NodeIDToString(s.nodeID))
+	return ctx, span
+}
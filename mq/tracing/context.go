@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+// Context holds either local *Span, or remoteParent. The most recently set one is the parent of new spans.
+type remoteParent SpanContext
+
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, contextKey{}, span)
+}
+
+// SpanFromContext returns span in the context. Nil is returned if there is none, or remote parent was set later.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(contextKey{}).(*Span)
+	return span
+}
+
+// ContextWithRemoteParent sets span context received from other process as parent for spans started with the
+// returned context. Invalid span context is ignored.
+func ContextWithRemoteParent(ctx context.Context, parent SpanContext) context.Context {
+	if !parent.IsValid() {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, remoteParent(parent))
+}
+
+// HasParent returns true if span started with the context would continue existing trace.
+func HasParent(ctx context.Context) bool {
+	return parentFromContext(ctx).IsValid()
+}
+
+func parentFromContext(ctx context.Context) SpanContext {
+	switch v := ctx.Value(contextKey{}).(type) {
+	case *Span:
+		return v.SpanContext()
+	case remoteParent:
+		return SpanContext(v)
+	default:
+		return SpanContext{}
+	}
+}
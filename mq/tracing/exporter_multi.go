@@ -0,0 +1,27 @@
+package tracing
+
+type multiExporter []Exporter
+
+// NewMultiExporter creates exporter that passes spans to all given exporters.
+func NewMultiExporter(exporters ...Exporter) Exporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+	return multiExporter(exporters)
+}
+
+func (m multiExporter) Export(span *SpanData) {
+	for _, exporter := range m {
+		exporter.Export(span)
+	}
+}
+
+func (m multiExporter) Close() error {
+	var firstErr error
+	for _, exporter := range m {
+		if err := exporter.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,253 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	otlpTracesPath     = "/v1/traces"
+	otlpBatchSize      = 512
+	otlpQueueSize      = 4096
+	otlpFlushInterval  = 5 * time.Second
+	otlpRequestTimeout = 10 * time.Second
+	otlpStatusError    = 2
+)
+
+// OTLPExporter sends spans in batches to OpenTelemetry collector using OTLP/HTTP with JSON encoding. Spans that don't
+// fit into the queue are dropped.
+type OTLPExporter struct {
+	url       string
+	resource  map[string]interface{}
+	client    *http.Client
+	queue     chan *SpanData
+	closed    chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+	onError   func(err error)
+}
+
+// NewOTLPExporter creates exporter sending spans to the endpoint (e.g. `http://localhost:4318`). Resource attributes
+// (e.g. `service.name`) are attached to all spans. If onError is not nil, it's called when batch could not be sent.
+func NewOTLPExporter(endpoint string, resource map[string]interface{}, onError func(err error)) (*OTLPExporter, error) {
+	if endpoint == "" {
+		return nil, errors.New("endpoint not set")
+	}
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "http://" + endpoint
+	}
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, otlpTracesPath) {
+		url += otlpTracesPath
+	}
+
+	e := &OTLPExporter{
+		url:      url,
+		resource: resource,
+		client:   &http.Client{Timeout: otlpRequestTimeout},
+		queue:    make(chan *SpanData, otlpQueueSize),
+		closed:   make(chan struct{}),
+		onError:  onError,
+	}
+	e.wg.Add(1)
+	go e.run()
+
+	return e, nil
+}
+
+func (e *OTLPExporter) Export(span *SpanData) {
+	select {
+	case e.queue <- span:
+	default:
+		// queue full => drop
+	}
+}
+
+// Close flushes queued spans & stops the exporter.
+func (e *OTLPExporter) Close() error {
+	e.closeOnce.Do(func() {
+		close(e.closed)
+	})
+	e.wg.Wait()
+	return nil
+}
+
+func (e *OTLPExporter) run() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, otlpBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.send(batch); err != nil && e.onError != nil {
+			e.onError(err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case span := <-e.queue:
+			batch = append(batch, span)
+			if len(batch) >= otlpBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.closed:
+			for {
+				select {
+				case span := <-e.queue:
+					batch = append(batch, span)
+					if len(batch) >= otlpBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *OTLPExporter) send(batch []*SpanData) error {
+	body, err := json.Marshal(e.request(batch))
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), otlpRequestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := e.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "export failed")
+	}
+	defer response.Body.Close()
+	io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode/100 != 2 {
+		return errors.Errorf("export failed with status %s", response.Status)
+	}
+
+	return nil
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	TraceState        string         `json:"traceState,omitempty"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func (e *OTLPExporter) request(batch []*SpanData) interface{} {
+	spans := make([]otlpSpan, 0, len(batch))
+	for _, span := range batch {
+		s := otlpSpan{
+			TraceID:           span.SpanContext.TraceID.String(),
+			SpanID:            span.SpanContext.SpanID.String(),
+			TraceState:        span.SpanContext.State,
+			Name:              span.Name,
+			Kind:              int(span.Kind),
+			StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			Attributes:        otlpAttributes(span.Attributes),
+		}
+		if !span.ParentSpanID.IsZero() {
+			s.ParentSpanID = span.ParentSpanID.String()
+		}
+		if span.Error != "" {
+			s.Status = &otlpStatus{Code: otlpStatusError, Message: span.Error}
+		}
+		spans = append(spans, s)
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": otlpAttributes(e.resource),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": "eventter.io/mq"},
+						"spans": spans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpAttributes(attributes map[string]interface{}) []otlpKeyValue {
+	if len(attributes) == 0 {
+		return nil
+	}
+	kvs := make([]otlpKeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		kvs = append(kvs, otlpKeyValue{Key: key, Value: otlpValue(value)})
+	}
+	return kvs
+}
+
+func otlpValue(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case string:
+		return map[string]interface{}{"stringValue": v}
+	case bool:
+		return map[string]interface{}{"boolValue": v}
+	case int:
+		return map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+	case int32:
+		return map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+	case int64:
+		return map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+	case uint32:
+		return map[string]interface{}{"intValue": strconv.FormatUint(uint64(v), 10)}
+	case uint64:
+		return map[string]interface{}{"intValue": strconv.FormatUint(v, 10)}
+	case float64:
+		return map[string]interface{}{"doubleValue": v}
+	default:
+		return map[string]interface{}{"stringValue": fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+type writerExporter struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewWriterExporter creates exporter that writes each span as JSON line to w (e.g. stdout). Meant for local
+// debugging & tests.
+func NewWriterExporter(w io.Writer) Exporter {
+	return &writerExporter{encoder: json.NewEncoder(w)}
+}
+
+type writerSpan struct {
+	TraceID      string                 `json:"trace_id"`
+	SpanID       string                 `json:"span_id"`
+	ParentSpanID string                 `json:"parent_span_id,omitempty"`
+	Name         string                 `json:"name"`
+	Kind         string                 `json:"kind"`
+	StartTime    time.Time              `json:"start_time"`
+	EndTime      time.Time              `json:"end_time"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+func (e *writerExporter) Export(span *SpanData) {
+	s := writerSpan{
+		TraceID:    span.SpanContext.TraceID.String(),
+		SpanID:     span.SpanContext.SpanID.String(),
+		Name:       span.Name,
+		Kind:       span.Kind.String(),
+		StartTime:  span.StartTime,
+		EndTime:    span.EndTime,
+		Attributes: span.Attributes,
+		Error:      span.Error,
+	}
+	if !span.ParentSpanID.IsZero() {
+		s.ParentSpanID = span.ParentSpanID.String()
+	}
+
+	e.mutex.Lock()
+	e.encoder.Encode(s)
+	e.mutex.Unlock()
+}
+
+func (e *writerExporter) Close() error {
+	return nil
+}
@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/metadata"
+)
+
+// ExtractGRPC sets trace context from incoming gRPC metadata as remote parent.
+func ExtractGRPC(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return ContextWithRemoteParent(ctx, parse(first(md[TraceparentHeader]), first(md[TracestateHeader])))
+}
+
+// InjectGRPC adds trace context of the span in context to outgoing gRPC metadata.
+func InjectGRPC(ctx context.Context) context.Context {
+	c := parentFromContext(ctx)
+	if !c.IsValid() {
+		return ctx
+	}
+	kv := []string{TraceparentHeader, c.Traceparent()}
+	if c.State != "" {
+		kv = append(kv, TracestateHeader, c.State)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// ExtractHeaders sets trace context from message headers as remote parent.
+func ExtractHeaders(ctx context.Context, headers *types.Struct) context.Context {
+	return ContextWithRemoteParent(ctx, FromHeaders(headers))
+}
+
+// FromHeaders returns span context stored in message headers. Invalid span context is returned if headers don't
+// contain any, or it is malformed.
+func FromHeaders(headers *types.Struct) SpanContext {
+	if headers == nil || headers.Fields == nil {
+		return SpanContext{}
+	}
+	return parse(stringValue(headers.Fields[TraceparentHeader]), stringValue(headers.Fields[TracestateHeader]))
+}
+
+// InjectHeaders stores trace context of the span in context into message headers. Headers are copied, so that
+// original struct may be safely shared.
+func InjectHeaders(ctx context.Context, headers *types.Struct) *types.Struct {
+	c := parentFromContext(ctx)
+	if !c.IsValid() {
+		return headers
+	}
+
+	fields := make(map[string]*types.Value)
+	if headers != nil {
+		for k, v := range headers.Fields {
+			fields[k] = v
+		}
+	}
+	fields[TraceparentHeader] = &types.Value{Kind: &types.Value_StringValue{StringValue: c.Traceparent()}}
+	if c.State != "" {
+		fields[TracestateHeader] = &types.Value{Kind: &types.Value_StringValue{StringValue: c.State}}
+	} else {
+		delete(fields, TracestateHeader)
+	}
+
+	return &types.Struct{Fields: fields}
+}
+
+func parse(traceparent string, tracestate string) SpanContext {
+	if traceparent == "" {
+		return SpanContext{}
+	}
+	c, err := ParseTraceparent(traceparent)
+	if err != nil {
+		return SpanContext{}
+	}
+	c.State = tracestate
+	return c
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func stringValue(value *types.Value) string {
+	if value == nil {
+		return ""
+	}
+	if s, ok := value.Kind.(*types.Value_StringValue); ok {
+		return s.StringValue
+	}
+	return ""
+}
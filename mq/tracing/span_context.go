@@ -0,0 +1,117 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// W3C trace-context header names. They're used as-is for gRPC metadata & message headers.
+	TraceparentHeader = "traceparent"
+	TracestateHeader  = "tracestate"
+
+	FlagSampled byte = 0x01
+
+	traceparentVersion = "00"
+)
+
+type TraceID [16]byte
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id TraceID) IsZero() bool {
+	return id == TraceID{}
+}
+
+type SpanID [8]byte
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id SpanID) IsZero() bool {
+	return id == SpanID{}
+}
+
+// SpanContext is the part of span that is propagated across process boundaries.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Flags   byte
+	// Vendor-specific trace state, propagated unchanged.
+	State string
+}
+
+func (c SpanContext) IsValid() bool {
+	return !c.TraceID.IsZero() && !c.SpanID.IsZero()
+}
+
+func (c SpanContext) IsSampled() bool {
+	return c.Flags&FlagSampled != 0
+}
+
+// Traceparent formats span context as W3C `traceparent` header value.
+func (c SpanContext) Traceparent() string {
+	return traceparentVersion + "-" + c.TraceID.String() + "-" + c.SpanID.String() + "-" + hex.EncodeToString([]byte{c.Flags})
+}
+
+// ParseTraceparent parses W3C `traceparent` header value.
+func ParseTraceparent(traceparent string) (SpanContext, error) {
+	var c SpanContext
+
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 {
+		return c, errors.Errorf("malformed traceparent %q", traceparent)
+	}
+	if len(parts[0]) != 2 || parts[0] == "ff" {
+		return c, errors.Errorf("unsupported traceparent version %q", parts[0])
+	}
+	if parts[0] == traceparentVersion && len(parts) != 4 {
+		return c, errors.Errorf("malformed traceparent %q", traceparent)
+	}
+
+	if err := decodeHex(c.TraceID[:], parts[1]); err != nil {
+		return c, errors.Wrap(err, "trace ID")
+	}
+	if err := decodeHex(c.SpanID[:], parts[2]); err != nil {
+		return c, errors.Wrap(err, "span ID")
+	}
+	var flags [1]byte
+	if err := decodeHex(flags[:], parts[3]); err != nil {
+		return c, errors.Wrap(err, "flags")
+	}
+	c.Flags = flags[0]
+
+	if !c.IsValid() {
+		return c, errors.Errorf("traceparent %q has zero trace or span ID", traceparent)
+	}
+
+	return c, nil
+}
+
+func decodeHex(dst []byte, s string) error {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return errors.Errorf("expected %d lowercase hex digits, got %q", hex.EncodedLen(len(dst)), s)
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+func newTraceID() (id TraceID) {
+	for id.IsZero() {
+		rand.Read(id[:])
+	}
+	return id
+}
+
+func newSpanID() (id SpanID) {
+	for id.IsZero() {
+		rand.Read(id[:])
+	}
+	return id
+}
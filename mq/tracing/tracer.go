@@ -0,0 +1,174 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Kind int
+
+// Span kinds, values match OTLP.
+const (
+	KindInternal Kind = 1
+	KindServer   Kind = 2
+	KindClient   Kind = 3
+	KindProducer Kind = 4
+	KindConsumer Kind = 5
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindInternal:
+		return "internal"
+	case KindServer:
+		return "server"
+	case KindClient:
+		return "client"
+	case KindProducer:
+		return "producer"
+	case KindConsumer:
+		return "consumer"
+	default:
+		return "unspecified"
+	}
+}
+
+// Exporter receives finished sampled spans.
+type Exporter interface {
+	Export(span *SpanData)
+	Close() error
+}
+
+// SpanData is immutable snapshot of finished span.
+type SpanData struct {
+	Name         string
+	Kind         Kind
+	SpanContext  SpanContext
+	ParentSpanID SpanID
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   map[string]interface{}
+	Error        string
+}
+
+// Tracer starts spans & hands them to exporter once they end. Nil tracer is valid, it doesn't record anything.
+type Tracer struct {
+	exporter Exporter
+}
+
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// Start starts new span. Parent is span in context, or remote span context set by one of the Extract functions.
+// If there's no parent, new trace is started. Returned context contains the new span.
+func (t *Tracer) Start(ctx context.Context, name string, kind Kind) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		tracer:    t,
+		name:      name,
+		kind:      kind,
+		startTime: time.Now(),
+	}
+
+	if parent := parentFromContext(ctx); parent.IsValid() {
+		span.context = SpanContext{
+			TraceID: parent.TraceID,
+			SpanID:  newSpanID(),
+			Flags:   parent.Flags,
+			State:   parent.State,
+		}
+		span.parentSpanID = parent.SpanID
+	} else {
+		span.context = SpanContext{
+			TraceID: newTraceID(),
+			SpanID:  newSpanID(),
+			Flags:   FlagSampled,
+		}
+	}
+
+	return ContextWithSpan(ctx, span), span
+}
+
+func (t *Tracer) Close() error {
+	if t == nil || t.exporter == nil {
+		return nil
+	}
+	return t.exporter.Close()
+}
+
+type Span struct {
+	tracer       *Tracer
+	name         string
+	kind         Kind
+	context      SpanContext
+	parentSpanID SpanID
+	startTime    time.Time
+	mutex        sync.Mutex
+	attributes   map[string]interface{}
+	err          string
+	ended        bool
+}
+
+// SpanContext returns span's context. Nil span returns zero (invalid) context.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+	s.mutex.Unlock()
+}
+
+// SetError marks span as failed. Nil error is ignored.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mutex.Lock()
+	s.err = err.Error()
+	s.mutex.Unlock()
+}
+
+// End finishes the span. Only the first call has effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	if s.ended {
+		s.mutex.Unlock()
+		return
+	}
+	s.ended = true
+	data := &SpanData{
+		Name:         s.name,
+		Kind:         s.kind,
+		SpanContext:  s.context,
+		ParentSpanID: s.parentSpanID,
+		StartTime:    s.startTime,
+		EndTime:      time.Now(),
+		Attributes:   s.attributes,
+		Error:        s.err,
+	}
+	s.mutex.Unlock()
+
+	if s.context.IsSampled() && s.tracer.exporter != nil {
+		s.tracer.exporter.Export(data)
+	}
+}
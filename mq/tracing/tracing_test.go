@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+const testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestParseTraceparent(t *testing.T) {
+	assert := require.New(t)
+
+	c, err := ParseTraceparent(testTraceparent)
+	assert.NoError(err)
+	assert.True(c.IsValid())
+	assert.True(c.IsSampled())
+	assert.Equal("4bf92f3577b34da6a3ce929d0e0e4736", c.TraceID.String())
+	assert.Equal("00f067aa0ba902b7", c.SpanID.String())
+	assert.Equal(testTraceparent, c.Traceparent())
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	} {
+		_, err := ParseTraceparent(invalid)
+		assert.Error(err, invalid)
+	}
+}
+
+func TestTracer_Start(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	tracer := NewTracer(NewWriterExporter(buf))
+
+	parent, err := ParseTraceparent(testTraceparent)
+	assert.NoError(err)
+
+	ctx := ContextWithRemoteParent(context.Background(), parent)
+	ctx, span := tracer.Start(ctx, "parent", KindServer)
+	_, child := tracer.Start(ctx, "child", KindInternal)
+	assert.Equal(parent.TraceID, child.SpanContext().TraceID)
+	child.SetAttribute("key", "value")
+	child.End()
+	child.End() // exported only once
+	span.End()
+
+	var spans []writerSpan
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var s writerSpan
+		assert.NoError(decoder.Decode(&s))
+		spans = append(spans, s)
+	}
+	assert.Len(spans, 2)
+	assert.Equal("child", spans[0].Name)
+	assert.Equal(span.SpanContext().SpanID.String(), spans[0].ParentSpanID)
+	assert.Equal("value", spans[0].Attributes["key"])
+	assert.Equal("parent", spans[1].Name)
+	assert.Equal(parent.SpanID.String(), spans[1].ParentSpanID)
+	assert.Equal(parent.TraceID.String(), spans[1].TraceID)
+
+	var nilTracer *Tracer
+	_, nilSpan := nilTracer.Start(context.Background(), "nil", KindInternal)
+	assert.Nil(nilSpan)
+	nilSpan.SetAttribute("key", "value")
+	nilSpan.End()
+}
+
+func TestPropagation(t *testing.T) {
+	assert := require.New(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TraceparentHeader, testTraceparent, TracestateHeader, "a=b"))
+	ctx = ExtractGRPC(ctx)
+	assert.True(HasParent(ctx))
+
+	headers := InjectHeaders(ctx, &types.Struct{Fields: map[string]*types.Value{
+		"x": {Kind: &types.Value_StringValue{StringValue: "y"}},
+	}})
+	assert.Equal("y", headers.Fields["x"].GetStringValue())
+	c := FromHeaders(headers)
+	assert.Equal(testTraceparent, c.Traceparent())
+	assert.Equal("a=b", c.State)
+
+	outgoing := InjectGRPC(ExtractHeaders(context.Background(), headers))
+	md, ok := metadata.FromOutgoingContext(outgoing)
+	assert.True(ok)
+	assert.Equal([]string{testTraceparent}, md[TraceparentHeader])
+
+	assert.False(HasParent(ExtractHeaders(context.Background(), nil)))
+}
@@ -0,0 +1,141 @@
+package mq
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"eventter.io/mq/emq"
+	"eventter.io/mq/tracing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+type lockedBuffer struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) Spans() (spans []map[string]interface{}) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	decoder := json.NewDecoder(bytes.NewReader(b.buf.Bytes()))
+	for decoder.More() {
+		span := make(map[string]interface{})
+		if err := decoder.Decode(&span); err != nil {
+			panic(err)
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+func TestServer_Tracing(t *testing.T) {
+	assert := require.New(t)
+
+	ts, err := newTestServer(0)
+	assert.NoError(err)
+	defer ts.Close()
+
+	buf := &lockedBuffer{}
+	ts.Server.tracer = tracing.NewTracer(tracing.NewWriterExporter(buf))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	{
+		response, err := ts.Server.CreateTopic(ctx, &emq.TopicCreateRequest{
+			Topic: emq.Topic{
+				Namespace:           "default",
+				Name:                "test-tracing-topic",
+				DefaultExchangeType: emq.ExchangeTypeFanout,
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	{
+		response, err := ts.Server.CreateConsumerGroup(ctx, &emq.ConsumerGroupCreateRequest{
+			ConsumerGroup: emq.ConsumerGroup{
+				Namespace: "default",
+				Name:      "test-tracing-consumer-group",
+				Bindings: []*emq.ConsumerGroup_Binding{
+					{TopicName: "test-tracing-topic", ExchangeType: emq.ExchangeTypeFanout},
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+
+		ts.WaitForConsumerGroup(t, ctx, "default", "test-tracing-consumer-group")
+	}
+
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	{
+		publishCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(tracing.TraceparentHeader, traceparent))
+		response, err := ts.Server.Publish(publishCtx, &emq.TopicPublishRequest{
+			Namespace: "default",
+			Name:      "test-tracing-topic",
+			Message: &emq.Message{
+				Data: []byte("hello, world"),
+			},
+		})
+		assert.NoError(err)
+		assert.True(response.OK)
+	}
+
+	var publishSpanID string
+	{
+		spans := buf.Spans()
+		assert.Len(spans, 1)
+		assert.Equal("Publish", spans[0]["name"])
+		assert.Equal("4bf92f3577b34da6a3ce929d0e0e4736", spans[0]["trace_id"])
+		assert.Equal("00f067aa0ba902b7", spans[0]["parent_span_id"])
+		publishSpanID = spans[0]["span_id"].(string)
+	}
+
+	{
+		stream := newSubscribeConsumer(ctx, 0, "", nil)
+
+		go func() {
+			defer stream.Close()
+
+			err := ts.Server.Subscribe(&emq.ConsumerGroupSubscribeRequest{
+				Namespace: "default",
+				Name:      "test-tracing-consumer-group",
+				AutoAck:   true,
+			}, stream)
+			assert.NoError(err)
+		}()
+
+		delivery := <-stream.C
+		spanContext := tracing.FromHeaders(delivery.Response.Message.Headers)
+		assert.Equal("4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID.String())
+		assert.Equal(publishSpanID, spanContext.SpanID.String())
+	}
+
+	names := make(map[string]bool)
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline) && len(names) < 3; {
+		for _, span := range buf.Spans() {
+			if span["trace_id"] == "4bf92f3577b34da6a3ce929d0e0e4736" {
+				names[span["name"].(string)] = true
+			}
+			if span["name"] != "Publish" {
+				assert.Equal(publishSpanID, span["parent_span_id"])
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(map[string]bool{"Publish": true, "ConsumerGroupOffer": true, "Deliver": true}, names)
+}
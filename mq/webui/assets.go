@@ -0,0 +1,569 @@
+package webui
+
+const indexHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>EventterMQ</title>
+<link rel="stylesheet" href="style.css">
+</head>
+<body>
+<header>
+	<h1>EventterMQ</h1>
+	<label>Namespace <select id="namespace"></select></label>
+	<button id="refresh" type="button">Refresh</button>
+	<span id="status"></span>
+</header>
+<main>
+	<section id="nodes">
+		<h2>Nodes</h2>
+		<table>
+			<thead><tr><th>ID</th><th>Address</th><th>Zone</th><th>State</th><th>Last seen alive</th><th>Disk used</th></tr></thead>
+			<tbody></tbody>
+		</table>
+	</section>
+
+	<section id="topics">
+		<h2>Topics</h2>
+		<table>
+			<thead><tr><th>Name</th><th>Exchange type</th><th>Shards</th><th>Replication factor</th><th>Retention</th><th></th></tr></thead>
+			<tbody></tbody>
+		</table>
+		<form id="create-topic">
+			<h3>Create topic</h3>
+			<input name="name" placeholder="name" required>
+			<select name="default_exchange_type">
+				<option>fanout</option><option>direct</option><option>topic</option><option>headers</option>
+			</select>
+			<input name="shards" type="number" min="1" value="1" title="shards">
+			<input name="replication_factor" type="number" min="0" value="0" title="replication factor (0 = server default)">
+			<input name="retention" placeholder="retention, e.g. 24h">
+			<button type="submit">Create</button>
+		</form>
+		<div id="topic-detail" class="detail"></div>
+	</section>
+
+	<section id="consumer-groups">
+		<h2>Consumer groups</h2>
+		<table>
+			<thead><tr><th>Name</th><th>Bindings</th><th>Size</th><th>Since</th><th></th></tr></thead>
+			<tbody></tbody>
+		</table>
+		<form id="create-consumer-group">
+			<h3>Create consumer group</h3>
+			<input name="name" placeholder="name" required>
+			<input name="topic_name" placeholder="topic" required>
+			<select name="exchange_type">
+				<option>fanout</option><option>direct</option><option>topic</option>
+			</select>
+			<input name="routing_key" placeholder="routing key">
+			<input name="size" type="number" min="0" value="0" title="size (0 = server default)">
+			<button type="submit">Create</button>
+		</form>
+		<div id="consumer-group-detail" class="detail"></div>
+	</section>
+
+	<section id="publish">
+		<h2>Publish test message</h2>
+		<form id="publish-message">
+			<input name="topic" placeholder="topic" required>
+			<input name="routing_key" placeholder="routing key">
+			<input name="content_type" placeholder="content type" value="text/plain">
+			<textarea name="data" placeholder="message body"></textarea>
+			<button type="submit">Publish</button>
+		</form>
+	</section>
+
+	<section id="connections">
+		<h2>Connections</h2>
+		<table>
+			<thead><tr><th>Node</th><th>ID</th><th>Protocol</th><th>Remote address</th><th>User</th><th>Namespace</th><th>Connected at</th></tr></thead>
+			<tbody></tbody>
+		</table>
+	</section>
+</main>
+<script src="app.js"></script>
+</body>
+</html>
+`
+
+const styleCSS = `body {
+	margin: 0;
+	font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif;
+	font-size: 14px;
+	color: #222;
+	background: #f6f7f9;
+}
+header {
+	display: flex;
+	align-items: center;
+	gap: 1em;
+	padding: 0.5em 1.5em;
+	background: #1f2a37;
+	color: #fff;
+}
+header h1 {
+	font-size: 1.3em;
+	margin: 0 1em 0 0;
+}
+#status.error {
+	color: #ff9b9b;
+}
+main {
+	padding: 0 1.5em 2em;
+}
+section {
+	margin-top: 1.5em;
+	padding: 1em;
+	background: #fff;
+	border: 1px solid #dde1e6;
+	border-radius: 4px;
+}
+h2 {
+	margin: 0 0 0.5em;
+	font-size: 1.15em;
+}
+h3 {
+	margin: 1em 0 0.5em;
+	font-size: 1em;
+}
+table {
+	width: 100%;
+	border-collapse: collapse;
+}
+th, td {
+	text-align: left;
+	padding: 0.3em 0.6em;
+	border-bottom: 1px solid #eceef1;
+	vertical-align: top;
+}
+th {
+	font-weight: 600;
+	color: #555;
+}
+td.mono, .mono {
+	font-family: Menlo, Consolas, monospace;
+	font-size: 0.9em;
+}
+tr.clickable {
+	cursor: pointer;
+}
+tr.clickable:hover {
+	background: #f0f4fa;
+}
+.badge {
+	display: inline-block;
+	padding: 0 0.5em;
+	margin-right: 0.3em;
+	border-radius: 3px;
+	font-size: 0.85em;
+	color: #fff;
+	background: #7a8594;
+}
+.badge.ok {
+	background: #2e9d57;
+}
+.badge.bad {
+	background: #d64545;
+}
+.badge.warn {
+	background: #d9901a;
+}
+.badge.info {
+	background: #3973c6;
+}
+form input, form select, form textarea, form button {
+	margin: 0 0.3em 0.3em 0;
+	font: inherit;
+}
+form textarea {
+	display: block;
+	width: 40em;
+	height: 5em;
+}
+.detail:empty {
+	display: none;
+}
+.detail {
+	margin-top: 1em;
+	padding-top: 0.5em;
+	border-top: 2px solid #eceef1;
+}
+`
+
+const appJS = `(function () {
+	"use strict";
+
+	var namespaceSelect = document.getElementById("namespace");
+	var statusElement = document.getElementById("status");
+
+	function api(method, path, body) {
+		var init = {method: method, headers: {"Accept": "application/json"}};
+		if (body !== undefined) {
+			init.headers["Content-Type"] = "application/json";
+			init.body = JSON.stringify(body);
+		}
+		return fetch("/" + path, init).then(function (response) {
+			return response.json().then(function (json) {
+				if (!response.ok) {
+					throw new Error(json.error || response.statusText);
+				}
+				return json;
+			});
+		});
+	}
+
+	function setStatus(message, isError) {
+		statusElement.textContent = message || "";
+		statusElement.className = isError ? "error" : "";
+	}
+
+	function fail(err) {
+		setStatus(err.message, true);
+	}
+
+	function el(tag, attrs) {
+		var e = document.createElement(tag);
+		if (attrs) {
+			Object.keys(attrs).forEach(function (k) {
+				if (k === "text") {
+					e.textContent = attrs[k];
+				} else if (k === "onclick") {
+					e.addEventListener("click", attrs[k]);
+				} else {
+					e.setAttribute(k, attrs[k]);
+				}
+			});
+		}
+		for (var i = 2; i < arguments.length; i++) {
+			var child = arguments[i];
+			if (child === null || child === undefined) {
+				continue;
+			}
+			e.appendChild(typeof child === "string" ? document.createTextNode(child) : child);
+		}
+		return e;
+	}
+
+	function badge(text, kind) {
+		return el("span", {"class": "badge " + (kind || ""), text: text});
+	}
+
+	function td(content, cls) {
+		var cell = el("td", cls ? {"class": cls} : null);
+		if (content instanceof Node) {
+			cell.appendChild(content);
+		} else {
+			cell.textContent = content === undefined || content === null ? "" : String(content);
+		}
+		return cell;
+	}
+
+	function fill(tbody, rows, columns) {
+		tbody.textContent = "";
+		if (rows.length === 0) {
+			tbody.appendChild(el("tr", null, el("td", {colspan: String(columns), text: "none"})));
+		}
+		rows.forEach(function (row) {
+			tbody.appendChild(row);
+		});
+	}
+
+	function nodeID(id) {
+		if (!id || id === "0") {
+			return "";
+		}
+		var hex = BigInt(id).toString(16);
+		while (hex.length < 16) {
+			hex = "0" + hex;
+		}
+		return hex;
+	}
+
+	function bytes(n) {
+		n = Number(n || 0);
+		var units = ["B", "KiB", "MiB", "GiB", "TiB"];
+		var i = 0;
+		while (n >= 1024 && i < units.length - 1) {
+			n /= 1024;
+			i++;
+		}
+		return (i === 0 ? n : n.toFixed(1)) + " " + units[i];
+	}
+
+	function time(t) {
+		return t ? new Date(t).toLocaleString() : "";
+	}
+
+	function namespace() {
+		return encodeURIComponent(namespaceSelect.value);
+	}
+
+	function confirmed(message, fn) {
+		return function (event) {
+			event.stopPropagation();
+			if (window.confirm(message)) {
+				fn();
+			}
+		};
+	}
+
+	function loadNamespaces() {
+		return api("GET", "_namespaces").then(function (response) {
+			var current = namespaceSelect.value;
+			namespaceSelect.textContent = "";
+			(response.namespaces || []).forEach(function (name) {
+				namespaceSelect.appendChild(el("option", {value: name, text: name}));
+			});
+			if (current && (response.namespaces || []).indexOf(current) !== -1) {
+				namespaceSelect.value = current;
+			}
+		});
+	}
+
+	function loadNodes() {
+		return api("GET", "_nodes").then(function (response) {
+			var rows = (response.nodes || []).map(function (node) {
+				var state = el("span", null,
+					node.alive ? badge("alive", "ok") : badge("dead", "bad"),
+					node.leader ? badge("leader", "info") : null,
+					node.draining ? badge("draining", "warn") : null);
+				var disk = "";
+				if (node.disk_total && node.disk_total !== "0") {
+					var used = Number(node.disk_total) - Number(node.disk_free || 0);
+					disk = bytes(used) + " / " + bytes(node.disk_total) + " (" + Math.round(100 * used / Number(node.disk_total)) + "%)";
+				}
+				return el("tr", null,
+					td(nodeID(node.id), "mono"),
+					td(node.address),
+					td(node.zone),
+					td(state),
+					td(time(node.last_seen_alive)),
+					td(disk));
+			});
+			fill(document.querySelector("#nodes tbody"), rows, 6);
+		});
+	}
+
+	function loadTopics() {
+		if (!namespaceSelect.value) {
+			return Promise.resolve();
+		}
+		return api("GET", namespace() + "/topics").then(function (response) {
+			var rows = (response.topics || []).map(function (topic) {
+				return el("tr", {"class": "clickable", onclick: function () { describeTopic(topic.name).catch(fail); }},
+					td(topic.name),
+					td(topic.default_exchange_type),
+					td(topic.shards),
+					td(topic.replication_factor),
+					td(topic.retention),
+					td(el("button", {type: "button", text: "Delete", onclick: confirmed("Delete topic " + topic.name + "?", function () {
+						api("DELETE", namespace() + "/topics/" + encodeURIComponent(topic.name)).then(refresh).catch(fail);
+					})})));
+			});
+			fill(document.querySelector("#topics tbody"), rows, 6);
+		});
+	}
+
+	function describeTopic(name) {
+		return api("GET", namespace() + "/topics/" + encodeURIComponent(name)).then(function (response) {
+			var detail = document.getElementById("topic-detail");
+			detail.textContent = "";
+			detail.appendChild(el("h3", {text: "Topic " + name}));
+			detail.appendChild(el("p", null,
+				"Retained " + bytes(response.retained_bytes) + ", oldest message " + (time(response.oldest_message) || "n/a") +
+				", newest message " + (time(response.newest_message) || "n/a") + ", under-replicated segments " +
+				(response.under_replicated_segments || 0) + "."));
+			var tbody = el("tbody");
+			var rows = (response.segments || []).map(function (segment) {
+				var state = el("span", null,
+					segment.open ? badge("open", "info") : badge("closed"),
+					segment.under_replicated ? badge("under-replicated", "warn") : null);
+				return el("tr", null,
+					td(nodeID(segment.id), "mono"),
+					td(state),
+					td(segment.shard || 0),
+					td(nodeID(segment.primary_node_id), "mono"),
+					td((segment.done_node_ids || []).map(nodeID).join(" "), "mono"),
+					td((segment.replicating_node_ids || []).map(nodeID).join(" "), "mono"),
+					td(bytes(segment.size)),
+					td(time(segment.created_at)),
+					td(time(segment.closed_at)));
+			});
+			fill(tbody, rows, 9);
+			detail.appendChild(el("table", null,
+				el("thead", null, el("tr", null,
+					el("th", {text: "Segment"}), el("th", {text: "State"}), el("th", {text: "Shard"}),
+					el("th", {text: "Primary"}), el("th", {text: "Done"}), el("th", {text: "Replicating"}),
+					el("th", {text: "Size"}), el("th", {text: "Created"}), el("th", {text: "Closed"}))),
+				tbody));
+		});
+	}
+
+	function bindingText(binding) {
+		var s = binding.topic_name + " (" + binding.exchange_type;
+		if (binding.routing_key) {
+			s += ", " + binding.routing_key;
+		} else if (binding.headers_all) {
+			s += ", all " + JSON.stringify(binding.headers_all);
+		} else if (binding.headers_any) {
+			s += ", any " + JSON.stringify(binding.headers_any);
+		}
+		return s + ")";
+	}
+
+	function loadConsumerGroups() {
+		if (!namespaceSelect.value) {
+			return Promise.resolve();
+		}
+		return api("GET", namespace() + "/cgs").then(function (response) {
+			var rows = (response.consumer_groups || []).map(function (cg) {
+				return el("tr", {"class": "clickable", onclick: function () { describeConsumerGroup(cg.name).catch(fail); }},
+					td(cg.name),
+					td((cg.bindings || []).map(bindingText).join(", ")),
+					td(cg.size),
+					td(time(cg.since)),
+					td(el("button", {type: "button", text: "Delete", onclick: confirmed("Delete consumer group " + cg.name + "?", function () {
+						api("DELETE", namespace() + "/cgs/" + encodeURIComponent(cg.name)).then(refresh).catch(fail);
+					})})));
+			});
+			fill(document.querySelector("#consumer-groups tbody"), rows, 5);
+		});
+	}
+
+	function describeConsumerGroup(name) {
+		return api("GET", namespace() + "/cgs/" + encodeURIComponent(name)).then(function (response) {
+			var detail = document.getElementById("consumer-group-detail");
+			detail.textContent = "";
+			detail.appendChild(el("h3", {text: "Consumer group " + name}));
+			detail.appendChild(el("p", null,
+				"Managed by node " + (nodeID(response.node_id) || "n/a") + ", waiting " + (response.waiting || 0) +
+				", in-flight " + (response.in_flight || 0) + ", ack pending " + (response.ack_pending || 0) + "."));
+			var lag = el("tbody");
+			fill(lag, (response.topics || []).map(function (topic) {
+				return el("tr", null,
+					td(topic.topic_name),
+					td(topic.lag_messages || 0),
+					td(bytes(topic.lag_bytes)),
+					td(topic.lag_time),
+					td(time(topic.oldest_unconsumed)));
+			}), 5);
+			detail.appendChild(el("table", null,
+				el("thead", null, el("tr", null,
+					el("th", {text: "Topic"}), el("th", {text: "Lag (messages)"}), el("th", {text: "Lag (bytes)"}),
+					el("th", {text: "Lag (time)"}), el("th", {text: "Oldest unconsumed"}))),
+				lag));
+			var subscriptions = el("tbody");
+			fill(subscriptions, (response.subscriptions || []).map(function (subscription) {
+				return el("tr", null,
+					td(subscription.subscription_id),
+					td(subscription.size || "unlimited"),
+					td(subscription.in_flight || 0));
+			}), 3);
+			detail.appendChild(el("table", null,
+				el("thead", null, el("tr", null,
+					el("th", {text: "Subscription"}), el("th", {text: "Size"}), el("th", {text: "In-flight"}))),
+				subscriptions));
+		});
+	}
+
+	function loadConnections() {
+		return api("GET", "_connections").then(function (response) {
+			var rows = (response.connections || []).map(function (connection) {
+				return el("tr", null,
+					td(nodeID(connection.node_id), "mono"),
+					td(connection.id),
+					td(connection.protocol),
+					td(connection.remote_address),
+					td(connection.user),
+					td(connection.namespace),
+					td(time(connection.connected_at)));
+			});
+			fill(document.querySelector("#connections tbody"), rows, 7);
+		});
+	}
+
+	function refresh() {
+		setStatus("loading...");
+		return loadNamespaces()
+			.then(function () {
+				return Promise.all([loadNodes(), loadTopics(), loadConsumerGroups(), loadConnections()]);
+			})
+			.then(function () {
+				setStatus("updated " + new Date().toLocaleTimeString());
+			})
+			.catch(fail);
+	}
+
+	function base64(s) {
+		var utf8 = new TextEncoder().encode(s);
+		var binary = "";
+		for (var i = 0; i < utf8.length; i++) {
+			binary += String.fromCharCode(utf8[i]);
+		}
+		return btoa(binary);
+	}
+
+	function onSubmit(id, fn) {
+		var form = document.getElementById(id);
+		form.addEventListener("submit", function (event) {
+			event.preventDefault();
+			fn(form.elements).then(function () {
+				form.reset();
+				return refresh();
+			}).catch(fail);
+		});
+	}
+
+	onSubmit("create-topic", function (f) {
+		var topic = {
+			default_exchange_type: f.default_exchange_type.value,
+			shards: Number(f.shards.value),
+			replication_factor: Number(f.replication_factor.value)
+		};
+		if (f.retention.value) {
+			topic.retention = f.retention.value;
+		}
+		return api("PUT", namespace() + "/topics/" + encodeURIComponent(f.name.value), topic);
+	});
+
+	onSubmit("create-consumer-group", function (f) {
+		var binding = {topic_name: f.topic_name.value, exchange_type: f.exchange_type.value};
+		if (f.routing_key.value) {
+			binding.routing_key = f.routing_key.value;
+		}
+		return api("PUT", namespace() + "/cgs/" + encodeURIComponent(f.name.value), {
+			bindings: [binding],
+			size: Number(f.size.value)
+		});
+	});
+
+	onSubmit("publish-message", function (f) {
+		var message = {data: base64(f.data.value)};
+		if (f.routing_key.value) {
+			message.routing_key = f.routing_key.value;
+		}
+		if (f.content_type.value) {
+			message.properties = {content_type: f.content_type.value};
+		}
+		return api("POST", namespace() + "/topics/" + encodeURIComponent(f.topic.value), message).then(function () {
+			setStatus("message published");
+		});
+	});
+
+	namespaceSelect.addEventListener("change", function () {
+		document.getElementById("topic-detail").textContent = "";
+		document.getElementById("consumer-group-detail").textContent = "";
+		refresh();
+	});
+	document.getElementById("refresh").addEventListener("click", refresh);
+
+	refresh();
+	window.setInterval(function () {
+		if (!document.hidden) {
+			loadNodes().then(loadConnections).catch(fail);
+		}
+	}, 5000);
+})();
+`
@@ -0,0 +1,54 @@
+// Package webui serves single-page management UI. The UI runs in the browser & talks to the node through HTTP/JSON
+// gateway of the public API, see package `gateway`.
+package webui
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+type asset struct {
+	contentType string
+	content     string
+}
+
+var (
+	assets = map[string]asset{
+		"/":          {"text/html; charset=utf-8", indexHTML},
+		"/app.js":    {"application/javascript; charset=utf-8", appJS},
+		"/style.css": {"text/css; charset=utf-8", styleCSS},
+	}
+	modTime = time.Now()
+)
+
+// New returns handler serving UI assets. Handler expects to be mounted with its prefix stripped, e.g.:
+//
+//	mux.Handle("/_ui/", http.StripPrefix("/_ui", webui.New()))
+//
+// API base path (where the gateway is mounted) is expected to be `/`.
+func New() http.Handler {
+	return http.HandlerFunc(serve)
+}
+
+func serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := r.URL.Path
+	if path == "" {
+		path = "/"
+	}
+	a, ok := assets[path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", a.contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, path, modTime, strings.NewReader(a.content))
+}
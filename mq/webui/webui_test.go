@@ -0,0 +1,47 @@
+package webui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	assert := require.New(t)
+
+	mux := http.NewServeMux()
+	mux.Handle("/_ui/", http.StripPrefix("/_ui", New()))
+
+	for path, contentType := range map[string]string{
+		"/_ui/":          "text/html; charset=utf-8",
+		"/_ui/app.js":    "application/javascript; charset=utf-8",
+		"/_ui/style.css": "text/css; charset=utf-8",
+	} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(http.StatusOK, w.Code, path)
+		assert.Equal(contentType, w.Header().Get("Content-Type"), path)
+		assert.NotEmpty(w.Body.String(), path)
+	}
+
+	{
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/_ui/", nil))
+		assert.True(strings.Contains(w.Body.String(), `<script src="app.js">`))
+	}
+
+	{
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/_ui/missing.js", nil))
+		assert.Equal(http.StatusNotFound, w.Code)
+	}
+
+	{
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/_ui/", nil))
+		assert.Equal(http.StatusMethodNotAllowed, w.Code)
+	}
+}